@@ -0,0 +1,185 @@
+// Package batch calls a unary method once per record of an input for
+// generated commands, over one connection, and writes the outcome of every
+// call in the order of the records.
+package batch
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	structpb "github.com/golang/protobuf/ptypes/struct"
+	"github.com/spf13/pflag"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/tetratelabs/protoc-gen-cobra/iocodec"
+)
+
+// stdin is where the records are read from when the file is "-".
+var stdin io.Reader = os.Stdin
+
+// Options control where the records are read from and how many are called at
+// once.
+type Options struct {
+	File        string
+	Format      string
+	Concurrency int
+}
+
+// AddFlags adds the flags setting the options to fs.
+func (o *Options) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&o.File, "batch", o.File, "call the method once per record of this file (NDJSON, multi-document YAML or CSV); use \"-\" for stdin")
+	fs.StringVar(&o.Format, "batch-format", o.Format, "format of the batch file (json, yaml or csv), by default its extension")
+	fs.IntVar(&o.Concurrency, "batch-concurrency", o.Concurrency, "number of batch records called at once")
+}
+
+// A Record is one request of the input.
+type Record struct {
+	// Line is the line of the input the record starts on.
+	Line int
+
+	format string
+	data   []byte
+}
+
+// Decode decodes the request of the record into m. The errors are
+// InvalidArgument status errors.
+func (r *Record) Decode(m proto.Message) error {
+	if err := iocodec.DefaultDecoders[r.format].NewDecoder(bytes.NewReader(r.data)).Decode(m); err != nil {
+		return Invalid(err)
+	}
+	return nil
+}
+
+// Invalid returns err as an InvalidArgument status error, for requests that
+// fail before they are sent.
+func Invalid(err error) error {
+	return status.Error(codes.InvalidArgument, err.Error())
+}
+
+// A Call calls the method with the request of a record.
+type Call func(ctx context.Context, r *Record) (proto.Message, error)
+
+// Run calls call for every record of the input o names, o.Concurrency at a
+// time, and writes an outcome per record to out, in order: the line of the
+// record along with the response or the error of the call. It fails if any
+// record did.
+func Run(o Options, call Call, out iocodec.Encoder) error {
+	in := stdin
+	if o.File != "-" {
+		f, err := os.Open(o.File)
+		if err != nil {
+			return fmt.Errorf("batch: %v", err)
+		}
+		defer f.Close()
+		in = f
+	}
+	format := o.Format
+	if format == "" {
+		format = strings.TrimPrefix(filepath.Ext(o.File), ".")
+	}
+	split, ok := splitters[format]
+	if !ok {
+		return fmt.Errorf("batch: invalid format %q", format)
+	}
+	if o.Concurrency <= 0 {
+		o.Concurrency = 1
+	}
+
+	// the outcomes are queued in the order of the records, and the queue is
+	// as long as the calls in flight
+	type outcome struct {
+		resp proto.Message
+		err  error
+	}
+	type result struct {
+		line     int
+		outcomes chan outcome
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	inFlight := make(chan struct{}, o.Concurrency)
+	results := make(chan result, o.Concurrency)
+	var readErr error
+	go func() {
+		defer close(results)
+		readErr = split(bufio.NewReader(in), func(r *Record) {
+			// once the context is cancelled, the records left aren't
+			// called
+			select {
+			case inFlight <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+			res := result{r.Line, make(chan outcome, 1)}
+			select {
+			case results <- res:
+			case <-ctx.Done():
+				<-inFlight
+				return
+			}
+			go func() {
+				defer func() { <-inFlight }()
+				resp, err := call(ctx, r)
+				res.outcomes <- outcome{resp, err}
+			}()
+		})
+	}()
+	// stop cancels the calls in flight, and returns err once they are over:
+	// when it holds every slot
+	stop := func(err error) error {
+		cancel()
+		for i := 0; i < cap(inFlight); i++ {
+			inFlight <- struct{}{}
+		}
+		return err
+	}
+
+	records, failed := 0, 0
+	for res := range results {
+		oc := <-res.outcomes
+		records++
+		if oc.err != nil {
+			failed++
+		}
+		s, err := report(res.line, oc.resp, oc.err)
+		if err != nil {
+			return stop(fmt.Errorf("batch: line %d: %v", res.line, err))
+		}
+		if err := out.Encode(s); err != nil {
+			return stop(err)
+		}
+	}
+	if readErr != nil {
+		return fmt.Errorf("batch: %v", readErr)
+	}
+	if failed > 0 {
+		return fmt.Errorf("batch: %d of %d record(s) failed", failed, records)
+	}
+	return nil
+}
+
+// report returns the outcome of the call of the record on line as a Struct,
+// which every response format can encode.
+func report(line int, resp proto.Message, err error) (*structpb.Struct, error) {
+	r := map[string]interface{}{"line": line}
+	if err != nil {
+		st := status.Convert(err)
+		r["error"] = map[string]string{"code": st.Code().String(), "message": st.Message()}
+		return iocodec.Struct(r)
+	}
+	var b bytes.Buffer
+	if err := iocodec.Marshaler().Marshal(&b, resp); err != nil {
+		return nil, err
+	}
+	r["response"] = json.RawMessage(b.Bytes())
+	return iocodec.Struct(r)
+}
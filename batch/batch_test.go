@@ -0,0 +1,150 @@
+package batch
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	pb "github.com/golang/protobuf/protoc-gen-go/descriptor"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/tetratelabs/protoc-gen-cobra/iocodec"
+)
+
+// echo returns the request of the record, later for earlier records so the
+// calls complete out of order, or fails with NotFound for the name "missing".
+func echo(ctx context.Context, r *Record) (proto.Message, error) {
+	var v pb.FieldDescriptorProto
+	if err := r.Decode(&v); err != nil {
+		return nil, err
+	}
+	time.Sleep(time.Duration(10-r.Line) * time.Millisecond)
+	if v.GetName() == "missing" {
+		return nil, status.Error(codes.NotFound, "no such field")
+	}
+	return &v, nil
+}
+
+func run(t *testing.T, name, in string, concurrency int) (string, error) {
+	dir, err := ioutil.TempDir("", "batch")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, name)
+	if err := ioutil.WriteFile(file, []byte(in), 0644); err != nil {
+		t.Fatal(err)
+	}
+	var b bytes.Buffer
+	err = Run(Options{File: file, Concurrency: concurrency}, echo, iocodec.DefaultEncoders["json"].NewEncoder(&b))
+	return b.String(), err
+}
+
+func TestRun(t *testing.T) {
+	for name, tc := range map[string]struct {
+		in    string
+		lines [3]int
+	}{
+		"in.json": {`{"name":"a","number":1}` + "\n\n" + `{"name":"missing"}` + "\n" + `{"name":"c","number":3}`, [3]int{1, 3, 4}},
+		"in.yaml": {"name: a\nnumber: 1\n---\nname: missing\n---\n# c\nname: c\nnumber: 3\n", [3]int{1, 4, 7}},
+		"in.csv":  {"name,number\na,1\n\nmissing,\n\"c\",3\n", [3]int{2, 4, 5}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			got, err := run(t, name, tc.in, 3)
+			if err == nil || err.Error() != "batch: 1 of 3 record(s) failed" {
+				t.Errorf("got error %v", err)
+			}
+			want := fmt.Sprintf(`{"line":%d,"response":{"name":"a","number":1}}
+{"error":{"code":"NotFound","message":"no such field"},"line":%d}
+{"line":%d,"response":{"name":"c","number":3}}
+`, tc.lines[0], tc.lines[1], tc.lines[2])
+			if got != want {
+				t.Errorf("got:\n%swant:\n%s", got, want)
+			}
+		})
+	}
+}
+
+func TestRunInvalidRecord(t *testing.T) {
+	got, err := run(t, "in.json", `{"name":"a"}`+"\n"+`{"nope":1}`+"\n", 1)
+	if err == nil {
+		t.Error("expected an error")
+	}
+	if !strings.Contains(got, `{"error":{"code":"InvalidArgument","message":"unknown field \"nope\"`) || !strings.Contains(got, `"line":2}`) {
+		t.Errorf("got:\n%s", got)
+	}
+}
+
+type failingEncoder struct{}
+
+func (failingEncoder) Encode(v interface{}) error {
+	return errors.New("closed pipe")
+}
+
+func TestRunStopsWhenOutputFails(t *testing.T) {
+	dir, err := ioutil.TempDir("", "batch")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "in.json")
+	if err := ioutil.WriteFile(file, []byte(strings.Repeat(`{"name":"a"}`+"\n", 10)), 0644); err != nil {
+		t.Fatal(err)
+	}
+	var calls, running int32
+	call := func(ctx context.Context, r *Record) (proto.Message, error) {
+		atomic.AddInt32(&calls, 1)
+		atomic.AddInt32(&running, 1)
+		defer atomic.AddInt32(&running, -1)
+		if r.Line > 1 {
+			// the calls after the first are in flight until cancelled
+			<-ctx.Done()
+			return nil, ctx.Err()
+		}
+		return &pb.FieldDescriptorProto{}, nil
+	}
+	err = Run(Options{File: file, Concurrency: 3}, call, failingEncoder{})
+	if err == nil || err.Error() != "closed pipe" {
+		t.Errorf("got error %v", err)
+	}
+	if n := atomic.LoadInt32(&running); n != 0 {
+		t.Errorf("%d call(s) still running", n)
+	}
+	// the first call and those in flight with it, but not the rest
+	if n := atomic.LoadInt32(&calls); n > 4 {
+		t.Errorf("got %d calls, want at most 4", n)
+	}
+}
+
+func TestSplitCSVQuotedNewline(t *testing.T) {
+	var lines []int
+	var v pb.FieldDescriptorProto
+	err := splitCSV(bufio.NewReader(strings.NewReader("name,number\n\"a\nb\",1\nc,2\n")), func(r *Record) {
+		lines = append(lines, r.Line)
+		if r.Line == 2 {
+			if err := r.Decode(&v); err != nil {
+				t.Error(err)
+			}
+		}
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(lines) != 2 || lines[0] != 2 || lines[1] != 4 {
+		t.Errorf("got records on lines %v, want [2 4]", lines)
+	}
+	if v.GetName() != "a\nb" || v.GetNumber() != 1 {
+		t.Errorf("got %v", &v)
+	}
+}
@@ -0,0 +1,103 @@
+package batch
+
+import (
+	"bufio"
+	"io"
+	"strings"
+)
+
+// A splitter reads the records of an input and passes them to emit, in order.
+type splitter func(r *bufio.Reader, emit func(*Record)) error
+
+var splitters = map[string]splitter{
+	"json":   splitJSON,
+	"ndjson": splitJSON,
+	"jsonl":  splitJSON,
+	"yaml":   splitYAML,
+	"yml":    splitYAML,
+	"csv":    splitCSV,
+}
+
+// splitJSON reads newline delimited JSON: a record per line. Blank lines are
+// skipped.
+func splitJSON(r *bufio.Reader, emit func(*Record)) error {
+	return eachLine(r, func(n int, line string) {
+		if strings.TrimSpace(line) != "" {
+			emit(&Record{Line: n, format: "json", data: []byte(line)})
+		}
+	})
+}
+
+// splitYAML reads YAML documents separated by "---" lines: a record per
+// document. Documents without content are skipped.
+func splitYAML(r *bufio.Reader, emit func(*Record)) error {
+	var doc strings.Builder
+	start := 0
+	flush := func() {
+		if start > 0 {
+			emit(&Record{Line: start, format: "yaml", data: []byte(doc.String())})
+		}
+		doc.Reset()
+		start = 0
+	}
+	err := eachLine(r, func(n int, line string) {
+		if strings.HasPrefix(line, "---") || strings.TrimRight(line, "\r\n") == "..." {
+			flush()
+			return
+		}
+		if t := strings.TrimSpace(line); start == 0 && t != "" && !strings.HasPrefix(t, "#") {
+			start = n
+		}
+		doc.WriteString(line)
+	})
+	flush()
+	return err
+}
+
+// splitCSV reads CSV whose first row names the field of each column: a record
+// per following row, which is passed along with the first one. Quoted cells
+// may span lines. Blank lines are skipped.
+func splitCSV(r *bufio.Reader, emit func(*Record)) error {
+	var header string
+	var row strings.Builder
+	start := 0
+	return eachLine(r, func(n int, line string) {
+		if start == 0 {
+			if strings.TrimSpace(line) == "" {
+				return
+			}
+			start = n
+		}
+		row.WriteString(line)
+		if strings.Count(row.String(), `"`)%2 == 1 {
+			// in a quoted cell
+			return
+		}
+		if !strings.HasSuffix(line, "\n") {
+			row.WriteString("\n")
+		}
+		if header == "" {
+			header = row.String()
+		} else {
+			emit(&Record{Line: start, format: "csv", data: []byte(header + row.String())})
+		}
+		row.Reset()
+		start = 0
+	})
+}
+
+// eachLine calls fn with every line of r, and its number, counting from 1.
+func eachLine(r *bufio.Reader, fn func(n int, line string)) error {
+	for n := 1; ; n++ {
+		line, err := r.ReadString('\n')
+		if line != "" {
+			fn(n, line)
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
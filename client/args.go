@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	pb "github.com/golang/protobuf/protoc-gen-go/descriptor"
+	"google.golang.org/genproto/googleapis/api/annotations"
 
 	"github.com/tetratelabs/protoc-gen-cobra/generator"
 )
@@ -51,22 +52,51 @@ func prefix(pre string, l func(...interface{})) func(...interface{}) {
 func noop(...interface{}) {}
 
 // first return is the instantiation of the struct and fields that are messages; second is the set of
-// flag declarations using the fields of the struct to receive values
-func (c *client) generateRequestFlags(file *generator.FileDescriptor, d *pb.DescriptorProto, types protoTypeCache) (string, []string) {
+// flag declarations using the fields of the struct to receive values; third is the set of statements
+// that keep the request file's values of fields whose flags have defaults, unless the flags are set
+func (c *client) generateRequestFlags(file *generator.FileDescriptor, d *pb.DescriptorProto, types protoTypeCache) (string, []string, []string) {
 	if d == nil {
-		return "", []string{}
+		return "", []string{}, []string{}
 	}
-	flags := c.generateSubMessageRequestFlags("reqArgs", "", d, file, types)
+	flags, defaults := c.generateSubMessageRequestFlags("reqArgs", "", "", d, file, types)
 	initialize := c.generateRequestInitialization(d, file, types)
-	return initialize, flags
+	return initialize, flags, defaults
 }
 
-func (c *client) generateSubMessageRequestFlags(objectName, flagPrefix string, d *pb.DescriptorProto, file *generator.FileDescriptor, types protoTypeCache) []string {
+// scalarFlags maps the scalar field types to the pflag functions defining their flags and the
+// flags' zero values.
+var scalarFlags = map[pb.FieldDescriptorProto_Type]struct{ fn, zero string }{
+	pb.FieldDescriptorProto_TYPE_STRING:   {"String", `""`},
+	pb.FieldDescriptorProto_TYPE_BYTES:    {"BytesBase64", "[]byte{}"},
+	pb.FieldDescriptorProto_TYPE_BOOL:     {"Bool", "false"},
+	pb.FieldDescriptorProto_TYPE_FLOAT:    {"Float32", "0"},
+	pb.FieldDescriptorProto_TYPE_DOUBLE:   {"Float64", "0"},
+	pb.FieldDescriptorProto_TYPE_INT32:    {"Int32", "0"},
+	pb.FieldDescriptorProto_TYPE_FIXED32:  {"Int32", "0"},
+	pb.FieldDescriptorProto_TYPE_SFIXED32: {"Int32", "0"},
+	pb.FieldDescriptorProto_TYPE_SINT32:   {"Int32", "0"},
+	pb.FieldDescriptorProto_TYPE_UINT32:   {"Uint32", "0"},
+	pb.FieldDescriptorProto_TYPE_INT64:    {"Int64", "0"},
+	pb.FieldDescriptorProto_TYPE_FIXED64:  {"Int64", "0"},
+	pb.FieldDescriptorProto_TYPE_SFIXED64: {"Int64", "0"},
+	pb.FieldDescriptorProto_TYPE_SINT64:   {"Int64", "0"},
+	pb.FieldDescriptorProto_TYPE_UINT64:   {"Uint64", "0"},
+}
+
+// generateSubMessageRequestFlags returns the flags and defaults of the fields
+// of d, the message of objectName, whose flags are prefixed with flagPrefix
+// and whose JSON paths with pathPrefix.
+func (c *client) generateSubMessageRequestFlags(objectName, flagPrefix, pathPrefix string, d *pb.DescriptorProto, file *generator.FileDescriptor, types protoTypeCache) ([]string, []string) {
 	out := make([]string, 0, len(d.Field))
+	var defaults []string
 
 	for _, f := range d.Field {
 		fieldName := goFieldName(f)
-		fieldFlagName := strings.ToLower(fieldName)
+		opts := flagOptions(f)
+		if opts.GetSkip() || hasBehavior(f, annotations.FieldBehavior_OUTPUT_ONLY) {
+			continue
+		}
+		fieldFlagName := flagName(f)
 		if f.GetLabel() == pb.FieldDescriptorProto_LABEL_REPEATED {
 			// TODO
 			out = append(out, fmt.Sprintf(`.PersistentFlags() // Warning: list flags are not yet supported (field %q)`, fieldName))
@@ -76,70 +106,85 @@ func (c *client) generateSubMessageRequestFlags(objectName, flagPrefix string, d
 		switch f.GetType() {
 		// Field is a complex type (another message, or an enum)
 		case pb.FieldDescriptorProto_TYPE_MESSAGE:
+			if opts.GetShorthand() != "" || opts.GetDefault() != "" {
+				c.gen.Fail(fmt.Sprintf("field %s.%s: only scalar fields can have a flag shorthand or default", d.GetName(), f.GetName()))
+			}
 			// if both type and name are set, descriptor must be either a message or enum
 			_, _, ttype := inputNames(f.GetTypeName())
 			if fdesc, found, _ := types.byName(file.MessageType, ttype, noop /*prefix("// ", c.P)*/); found {
 				if fdesc.GetOptions().GetMapEntry() {
 					// TODO
-					return []string{fmt.Sprintf(`.PersistentFlags() // Warning: map flags are not yet supported (message %q)`, d.GetName())}
+					return []string{fmt.Sprintf(`.PersistentFlags() // Warning: map flags are not yet supported (message %q)`, d.GetName())}, defaults
 				}
 
-				flags := c.generateSubMessageRequestFlags(objectName+"."+fieldName, flagPrefix+fieldFlagName+"-", fdesc, file, types)
+				flags, subDefaults := c.generateSubMessageRequestFlags(objectName+"."+fieldName, flagPrefix+fieldFlagName+"-", pathPrefix+jsonName(f)+".", fdesc, file, types)
 				out = append(out, flags...)
+				defaults = append(defaults, subDefaults...)
 			}
 		case pb.FieldDescriptorProto_TYPE_ENUM:
 			// TODO
-		case pb.FieldDescriptorProto_TYPE_STRING:
-			out = append(out, fmt.Sprintf(`.PersistentFlags().StringVar(&%s.%s, "%s%s", "", "%s")`,
-				objectName, fieldName, flagPrefix, fieldFlagName, "get-comment-from-proto"))
-		case pb.FieldDescriptorProto_TYPE_BYTES:
-			out = append(out, fmt.Sprintf(`.PersistentFlags().BytesBase64Var(&%s.%s, "%s%s", []byte{}, "%s")`,
-				objectName, fieldName, flagPrefix, fieldFlagName, "get-comment-from-proto"))
-		case pb.FieldDescriptorProto_TYPE_BOOL:
-			out = append(out, fmt.Sprintf(`.PersistentFlags().BoolVar(&%s.%s, "%s%s", false, "%s")`,
-				objectName, fieldName, flagPrefix, fieldFlagName, "get-comment-from-proto"))
-		case pb.FieldDescriptorProto_TYPE_FLOAT:
-			out = append(out, fmt.Sprintf(`.PersistentFlags().Float32Var(&%s.%s, "%s%s", 0, "%s")`,
-				objectName, fieldName, flagPrefix, fieldFlagName, "get-comment-from-proto"))
-		case pb.FieldDescriptorProto_TYPE_DOUBLE:
-			out = append(out, fmt.Sprintf(`.PersistentFlags().Float64Var(&%s.%s, "%s%s", 0, "%s")`,
-				objectName, fieldName, flagPrefix, fieldFlagName, "get-comment-from-proto"))
-		case pb.FieldDescriptorProto_TYPE_INT32:
-			out = append(out, fmt.Sprintf(`.PersistentFlags().Int32Var(&%s.%s, "%s%s", 0, "%s")`,
-				objectName, fieldName, flagPrefix, fieldFlagName, "get-comment-from-proto"))
-		case pb.FieldDescriptorProto_TYPE_FIXED32:
-			out = append(out, fmt.Sprintf(`.PersistentFlags().Int32Var(&%s.%s, "%s%s", 0, "%s")`,
-				objectName, fieldName, flagPrefix, fieldFlagName, "get-comment-from-proto"))
-		case pb.FieldDescriptorProto_TYPE_SFIXED32:
-			out = append(out, fmt.Sprintf(`.PersistentFlags().Int32Var(&%s.%s, "%s%s", 0, "%s")`,
-				objectName, fieldName, flagPrefix, fieldFlagName, "get-comment-from-proto"))
-		case pb.FieldDescriptorProto_TYPE_SINT32:
-			out = append(out, fmt.Sprintf(`.PersistentFlags().Int32Var(&%s.%s, "%s%s", 0, "%s")`,
-				objectName, fieldName, flagPrefix, fieldFlagName, "get-comment-from-proto"))
-		case pb.FieldDescriptorProto_TYPE_UINT32:
-			out = append(out, fmt.Sprintf(`.PersistentFlags().Uint32Var(&%s.%s, "%s%s", 0, "%s")`,
-				objectName, fieldName, flagPrefix, fieldFlagName, "get-comment-from-proto"))
-		case pb.FieldDescriptorProto_TYPE_INT64:
-			out = append(out, fmt.Sprintf(`.PersistentFlags().Int64Var(&%s.%s, "%s%s", 0, "%s")`,
-				objectName, fieldName, flagPrefix, fieldFlagName, "get-comment-from-proto"))
-		case pb.FieldDescriptorProto_TYPE_FIXED64:
-			out = append(out, fmt.Sprintf(`.PersistentFlags().Int64Var(&%s.%s, "%s%s", 0, "%s")`,
-				objectName, fieldName, flagPrefix, fieldFlagName, "get-comment-from-proto"))
-		case pb.FieldDescriptorProto_TYPE_SFIXED64:
-			out = append(out, fmt.Sprintf(`.PersistentFlags().Int64Var(&%s.%s, "%s%s", 0, "%s")`,
-				objectName, fieldName, flagPrefix, fieldFlagName, "get-comment-from-proto"))
-		case pb.FieldDescriptorProto_TYPE_SINT64:
-			out = append(out, fmt.Sprintf(`.PersistentFlags().Int64Var(&%s.%s, "%s%s", 0, "%s")`,
-				objectName, fieldName, flagPrefix, fieldFlagName, "get-comment-from-proto"))
-		case pb.FieldDescriptorProto_TYPE_UINT64:
-			out = append(out, fmt.Sprintf(`.PersistentFlags().Uint64Var(&%s.%s, "%s%s", 0, "%s")`,
-				objectName, fieldName, flagPrefix, fieldFlagName, "get-comment-from-proto"))
-
 		case pb.FieldDescriptorProto_TYPE_GROUP:
 		default:
+			flag, ok := scalarFlags[f.GetType()]
+			if !ok {
+				continue
+			}
+			name := flagPrefix + fieldFlagName
+			value := flag.zero
+			if opts.GetDefault() != "" {
+				var err error
+				if value, err = defaultLiteral(f.GetType(), opts.GetDefault()); err != nil {
+					c.gen.Fail(fmt.Sprintf("field %s.%s: %v", d.GetName(), f.GetName(), err))
+				}
+				getter := "v" + getterPath(objectName) + ".Get" + fieldName + "()"
+				defaults = append(defaults, fmt.Sprintf("if !cmd.Flags().Changed(%q) && %s {\n%s.%s = %s\n}",
+					name, zeroCheck(f.GetType(), getter), objectName, fieldName, getter))
+			}
+			switch shorthand := opts.GetShorthand(); {
+			case shorthand == "":
+				out = append(out, fmt.Sprintf(`.PersistentFlags().%sVar(&%s.%s, "%s", %s, "%s")`,
+					flag.fn, objectName, fieldName, name, value, flagUsage(f)))
+			case len(shorthand) != 1 || reservedShorthands[shorthand]:
+				c.gen.Fail(fmt.Sprintf("field %s.%s: invalid flag shorthand %q", d.GetName(), f.GetName(), shorthand))
+			default:
+				out = append(out, fmt.Sprintf(`.PersistentFlags().%sVarP(&%s.%s, "%s", "%s", %s, "%s")`,
+					flag.fn, objectName, fieldName, name, shorthand, value, flagUsage(f)))
+			}
+			if path := pathPrefix + jsonName(f); name != strings.ToLower(strings.Replace(path, ".", "-", -1)) {
+				// tell validation which field the renamed flag sets
+				out = append(out, fmt.Sprintf(`.PersistentFlags().SetAnnotation("%s", validation.FieldAnnotation, []string{"%s"})`, name, path))
+			}
 		}
 	}
-	return out
+	return out, defaults
+}
+
+// flagName returns the name of the flag of the field f, or of the prefix of
+// the flags of its fields if f is a message.
+func flagName(f *pb.FieldDescriptorProto) string {
+	if name := flagOptions(f).GetName(); name != "" {
+		return name
+	}
+	return strings.ToLower(goFieldName(f))
+}
+
+// jsonName returns the name of the field f in the JSON mapping, which request
+// field paths, like outer.inner.field, are made of.
+func jsonName(f *pb.FieldDescriptorProto) string {
+	if name := f.GetJsonName(); name != "" {
+		return name
+	}
+	return f.GetName()
+}
+
+// getterPath turns the path of a nested request field, like reqArgs.Outer.Inner,
+// into the getters reading it, like .GetOuter().GetInner().
+func getterPath(objectName string) string {
+	var b strings.Builder
+	for _, name := range strings.Split(objectName, ".")[1:] {
+		b.WriteString(".Get" + name + "()")
+	}
+	return b.String()
 }
 
 func goFieldName(f *pb.FieldDescriptorProto) string {
@@ -168,6 +213,10 @@ func genReqInit(d *pb.DescriptorProto, file *generator.FileDescriptor, types pro
 	fields := make(map[string]string)
 	fmt.Fprintf(w, "// generating initialization for %s with prefix %q which has %d fields\n", d.GetName(), typePrefix, len(d.Field))
 	for _, f := range d.Field {
+		if hasBehavior(f, annotations.FieldBehavior_OUTPUT_ONLY) {
+			// not sent, so there's nothing to initialize
+			continue
+		}
 		switch f.GetType() {
 		case pb.FieldDescriptorProto_TYPE_MESSAGE:
 			_, _, ttype := inputNames(f.GetTypeName())
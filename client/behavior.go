@@ -0,0 +1,91 @@
+package client
+
+import (
+	"bytes"
+	"sort"
+	"text/template"
+
+	"github.com/golang/protobuf/proto"
+	pb "github.com/golang/protobuf/protoc-gen-go/descriptor"
+	"google.golang.org/genproto/googleapis/api/annotations"
+
+	"github.com/tetratelabs/protoc-gen-cobra/generator"
+)
+
+var generateFieldBehaviorsTemplate = template.Must(template.New("behaviors").Parse(`
+func init() { {{ with .Required }}
+	validation.RegisterRequired({{ range . }}
+		{{ printf "%q" . }},{{ end }}
+	){{ end }}{{ with .OutputOnly }}
+	iocodec.RegisterOutputOnly({{ range . }}
+		{{ printf "%q" . }},{{ end }}
+	){{ end }}
+}
+`))
+
+// hasBehavior reports whether the field f is annotated with the
+// (google.api.field_behavior) b.
+func hasBehavior(f *pb.FieldDescriptorProto, b annotations.FieldBehavior) bool {
+	if f.GetOptions() == nil || !proto.HasExtension(f.GetOptions(), annotations.E_FieldBehavior) {
+		return false
+	}
+	v, err := proto.GetExtension(f.GetOptions(), annotations.E_FieldBehavior)
+	if err != nil {
+		return false
+	}
+	for _, fb := range v.([]annotations.FieldBehavior) {
+		if fb == b {
+			return true
+		}
+	}
+	return false
+}
+
+// flagUsage returns the help of the flag of the field f.
+func flagUsage(f *pb.FieldDescriptorProto) string {
+	usage := "get-comment-from-proto"
+	if hasBehavior(f, annotations.FieldBehavior_REQUIRED) {
+		usage += " (required)"
+	}
+	if hasBehavior(f, annotations.FieldBehavior_IMMUTABLE) {
+		usage += " (immutable)"
+	}
+	return usage
+}
+
+// generateFieldBehaviors registers the request fields annotated as REQUIRED,
+// which are checked before requests are sent, and as OUTPUT_ONLY, which sample
+// requests leave out.
+func (c *client) generateFieldBehaviors(file *generator.FileDescriptor) {
+	var required, outputOnly []string
+	for _, d := range c.requestMessages(file) {
+		name := messageName(d)
+		for _, f := range d.Field {
+			if hasBehavior(f, annotations.FieldBehavior_REQUIRED) {
+				required = append(required, name+"."+f.GetName())
+			}
+			if hasBehavior(f, annotations.FieldBehavior_OUTPUT_ONLY) {
+				outputOnly = append(outputOnly, name+"."+f.GetName())
+			}
+		}
+	}
+	if len(required) == 0 && len(outputOnly) == 0 {
+		return
+	}
+	sort.Strings(required)
+	sort.Strings(outputOnly)
+
+	var b bytes.Buffer
+	err := generateFieldBehaviorsTemplate.Execute(&b, struct {
+		Required   []string
+		OutputOnly []string
+	}{
+		Required:   required,
+		OutputOnly: outputOnly,
+	})
+	if err != nil {
+		c.gen.Error(err, "exec field behaviors template")
+	}
+	c.P(b.String())
+	c.P()
+}
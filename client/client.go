@@ -20,6 +20,7 @@ import (
 	pb "github.com/golang/protobuf/protoc-gen-go/descriptor"
 
 	"github.com/tetratelabs/protoc-gen-cobra/generator"
+	"github.com/tetratelabs/protoc-gen-cobra/options"
 )
 
 // generatedCodeVersion indicates a version of the generated code.
@@ -53,24 +54,42 @@ type pkgInfo struct {
 }
 
 var importPkgsByName = importPkg{
+	"batch":       {ImportPath: "github.com/tetratelabs/protoc-gen-cobra/batch", KnownType: "Options"},
 	"cobra":       {ImportPath: "github.com/spf13/cobra", KnownType: "Command"},
 	"context":     {ImportPath: "golang.org/x/net/context", KnownType: "Context"},
 	"credentials": {ImportPath: "google.golang.org/grpc/credentials", KnownType: "AuthInfo"},
+	"describe":    {ImportPath: "github.com/tetratelabs/protoc-gen-cobra/describe", KnownType: "Definition"},
+	"destructive": {ImportPath: "github.com/tetratelabs/protoc-gen-cobra/destructive", KnownType: "Options"},
+	"dryrun":      {ImportPath: "github.com/tetratelabs/protoc-gen-cobra/dryrun", KnownType: "Plan"},
+	"editing":     {ImportPath: "github.com/tetratelabs/protoc-gen-cobra/editing", KnownType: "=ErrEmpty"},
 	"filepath":    {ImportPath: "path/filepath", KnownType: "WalkFunc"},
 	"grpc":        {ImportPath: "google.golang.org/grpc", KnownType: "ClientConn"},
+	"health":      {ImportPath: "github.com/tetratelabs/protoc-gen-cobra/health", KnownType: "Options"},
 	"io":          {ImportPath: "io", KnownType: "Reader"},
 	"iocodec":     {ImportPath: "github.com/tetratelabs/protoc-gen-cobra/iocodec", KnownType: "Encoder"},
 	"ioutil":      {ImportPath: "io/ioutil", KnownType: "=Discard"},
 	"json":        {ImportPath: "encoding/json", KnownType: "Encoder"},
+	"loadtest":    {ImportPath: "github.com/tetratelabs/protoc-gen-cobra/loadtest", KnownType: "Options"},
 	"log":         {ImportPath: "log", KnownType: "Logger"},
+	"metadata":    {ImportPath: "google.golang.org/grpc/metadata", KnownType: "MD"},
 	"net":         {ImportPath: "net", KnownType: "IP"},
 	"oauth":       {ImportPath: "google.golang.org/grpc/credentials/oauth", KnownType: "TokenSource"},
 	"oauth2":      {ImportPath: "golang.org/x/oauth2", KnownType: "Token"},
+	"operation":   {ImportPath: "github.com/tetratelabs/protoc-gen-cobra/operation", KnownType: "Wait"},
 	"os":          {ImportPath: "os", KnownType: "File"},
+	"override":    {ImportPath: "github.com/tetratelabs/protoc-gen-cobra/override", KnownType: "Options"},
+	"pagination":  {ImportPath: "github.com/tetratelabs/protoc-gen-cobra/pagination", KnownType: "Lister"},
 	"pflag":       {ImportPath: "github.com/spf13/pflag", KnownType: "FlagSet"},
+	"prompting":   {ImportPath: "github.com/tetratelabs/protoc-gen-cobra/prompting", KnownType: "Prompter"},
+	"recording":   {ImportPath: "github.com/tetratelabs/protoc-gen-cobra/recording", KnownType: "Recorder"},
+	"streaming":   {ImportPath: "github.com/tetratelabs/protoc-gen-cobra/streaming", KnownType: "Options"},
 	"template":    {ImportPath: "text/template", KnownType: "Template"},
+	"templating":  {ImportPath: "github.com/tetratelabs/protoc-gen-cobra/templating", KnownType: "Options"},
 	"time":        {ImportPath: "time", KnownType: "Time"},
 	"tls":         {ImportPath: "crypto/tls", KnownType: "Config"},
+	"tracing":     {ImportPath: "github.com/tetratelabs/protoc-gen-cobra/tracing", KnownType: "Tracer"},
+	"validation":  {ImportPath: "github.com/tetratelabs/protoc-gen-cobra/validation", KnownType: "Violations"},
+	"verbose":     {ImportPath: "github.com/tetratelabs/protoc-gen-cobra/verbose", KnownType: "Logger"},
 	"x509":        {ImportPath: "crypto/x509", KnownType: "Certificate"},
 	"fmt":         {ImportPath: "fmt", KnownType: "Writer"},
 }
@@ -104,6 +123,12 @@ func (c *client) Generate(file *generator.FileDescriptor) {
 	for i, service := range file.FileDescriptorProto.Service {
 		c.generateService(file, service, i)
 	}
+
+	c.generateComments(file)
+	c.generateRules(file)
+	c.generateFieldBehaviors(file)
+	c.generateSchemas(file)
+	c.generateDescriptorSet(file)
 }
 
 // GenerateImports generates the import declaration for this file.
@@ -170,14 +195,20 @@ func (c *client) generateService(file *generator.FileDescriptor, service *pb.Ser
 		fullServName = pkg + "." + fullServName
 	}
 	servName := generator.CamelCase(origServName)
+	opts := serviceOptions(service)
+	if opts.GetSkip() {
+		return
+	}
 
 	c.P()
-	c.generateCommand(servName)
+	c.generateCommand(servName, fullServName, opts, methodCommands(service))
 	c.P()
 
-	subCommands := make([]string, len(service.Method))
-	for i, method := range service.Method {
-		subCommands[i] = c.generateSubcommand(servName, file, method)
+	subCommands := make([]string, 0, len(service.Method))
+	for _, method := range service.Method {
+		if subCommand := c.generateSubcommand(servName, fullServName, file, method); subCommand != "" {
+			subCommands = append(subCommands, subCommand)
+		}
 	}
 	c.P()
 
@@ -226,6 +257,17 @@ type _{{.Name}}ClientCommandConfig struct {
 	AuthTokenType      string
 	JWTKey             string
 	JWTKeyFile         string
+	SkipValidation     bool
+	LoadTest           loadtest.Options
+	Record             string
+	DryRun             bool
+	Verbose            bool
+	Trace              tracing.Options
+	Set                override.Options
+	Template           templating.Options
+	Edit               bool
+	Interactive        bool
+	Destructive        destructive.Options
 }
 
 func _New{{.Name}}ClientCommandConfig() *_{{.Name}}ClientCommandConfig {
@@ -255,25 +297,160 @@ func (o *_{{.Name}}ClientCommandConfig) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&o.AuthTokenType, "auth-token-type", o.AuthTokenType, "authorization token type")
 	fs.StringVar(&o.JWTKey, "jwt-key", o.JWTKey, "jwt key")
 	fs.StringVar(&o.JWTKeyFile, "jwt-key-file", o.JWTKeyFile, "jwt key file")
+	fs.BoolVar(&o.SkipValidation, "skip-validation", o.SkipValidation, "skip the client-side validation of requests")
+	o.LoadTest.AddFlags(fs)
+	fs.StringVar(&o.Record, "record", o.Record, "record the calls, with their metadata, messages, statuses and timings, to this session file")
+	fs.BoolVar(&o.DryRun, "dry-run", o.DryRun, "print the requests, where they would be sent and the metadata, with secrets redacted, instead of sending them")
+	fs.BoolVarP(&o.Verbose, "verbose", "v", o.Verbose, "log the connection, the tls handshake, and the metadata, message sizes and timings of the calls to stderr, with secrets redacted")
+	o.Trace.AddFlags(fs)
+	o.Set.AddFlags(fs)
+	o.Template.AddFlags(fs)
+	fs.BoolVar(&o.Edit, "edit", o.Edit, "open the request, or a sample of it without one, in $EDITOR before sending it")
+	fs.BoolVar(&o.Interactive, "interactive", o.Interactive, "when stdin is a terminal, prompt for the fields the request doesn't set, and for confirmation before sending it")
+	o.Destructive.AddFlags(fs)
 }
 
 func {{.Name}}ClientCommand() *cobra.Command {
 	cmd := &cobra.Command {
-		Use: "{{.UseName}}",
+		Use: "{{.Command.Use}}",{{ with .Command.Aliases }}
+		Aliases: {{ . }},{{ end }}{{ with .Command.Short }}
+		Short: {{ . }},{{ end }}{{ if .Command.Hidden }}
+		Hidden: true,{{ end }}
 	}
 	_Default{{.Name}}ClientCommandConfig.AddFlags(cmd.PersistentFlags())
 
 	for _, s := range _{{.Name}}ClientSubCommands {
 		cmd.AddCommand(s())
 	}
+	{{- if not (index .Taken "replay") }}
+	cmd.AddCommand(_{{.Name}}ReplayCommand())
+	{{- end }}
+	{{- if not (index .Taken "health") }}
+	cmd.AddCommand(_{{.Name}}HealthCommand())
+	{{- end }}
+	{{- if not (index .Taken "describe") }}
+	cmd.AddCommand(_{{.Name}}DescribeCommand())
+	{{- end }}
+	return cmd
+}
+
+func _{{.Name}}ReplayCommand() *cobra.Command {
+	var compare bool
+	cmd := &cobra.Command{
+		Use: "replay [file]",
+		Short: "Replay the calls of a session recorded with --record",
+		Args: cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			f, err := os.Open(args[0])
+			if err != nil {
+				log.Fatal(err)
+			}
+			defer f.Close()
+			err = _{{.Name}}RoundTrip(nil, nil, func(conn *grpc.ClientConn, cli {{.Name}}Client, in iocodec.Decoder, out iocodec.Encoder) error {
+				if _Default{{.Name}}ClientCommandConfig.DryRun {
+					reqs, err := recording.Requests(f)
+					if err != nil {
+						return err
+					}
+					return _{{.Name}}DryRun(out, reqs...)
+				}
+				return recording.Replay(conn, f, compare, out, os.Stderr)
+			})
+			if err != nil {
+				log.Fatal(err)
+			}
+		},
+	}
+	cmd.Flags().BoolVar(&compare, "compare", false, "compare the responses and statuses to the recorded ones, field by field, and fail if they differ")
+	return cmd
+}
+
+func _{{.Name}}HealthCommand() *cobra.Command {
+	opts := health.Options{Service: "{{.FullName}}"}
+	cmd := &cobra.Command{
+		Use: "health",{{ if not (index .Taken "ping") }}
+		Aliases: []string{"ping"},{{ end }}
+		Short: "Check the health of {{.FullName}} with the gRPC health checking protocol, and report how the connection is made",
+		Long: "Check the health of {{.FullName}} with the gRPC health checking protocol, and report how the connection is made: its TLS details, the certificate chain of the server and the latency of the check.\n\nExits with 0 if the service is serving, 2 if the connection fails, 3 if the check fails, and 4 if the service isn't serving.",
+		Args: cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			cfg := _Default{{.Name}}ClientCommandConfig
+			em, err := _{{.Name}}EncoderMaker()
+			if err != nil {
+				log.Fatal(err)
+			}
+			if cfg.DryRun {
+				if err := _{{.Name}}DryRun(em.NewEncoder(os.Stdout)); err != nil {
+					log.Fatal(err)
+				}
+				return
+			}
+			err = health.Check(opts, cfg.Timeout, func() (*grpc.ClientConn, error) {
+				conn, _, err := _Dial{{.Name}}()
+				return conn, err
+			}, em.NewEncoder(os.Stdout))
+			if err != nil {
+				log.Print(err)
+				os.Exit(health.ExitCode(err))
+			}
+		},
+	}
+	opts.AddFlags(cmd.Flags())
+	return cmd
+}
+
+func _{{.Name}}DescribeCommand() *cobra.Command {
+	var raw bool
+	cmd := &cobra.Command{
+		Use: "describe [name]",
+		Short: "Print the definition of {{.FullName}}, or of one of its methods, messages or enums, with its comments",
+		Long: "Print the definition of {{.FullName}}, or of one of its methods, messages or enums, with its comments, from the descriptors built into the command.\n\nThe name is a method, as named in the proto file or as its command, or the name of a message or enum, relative to the package of the service or in full. A method is followed by its request and response messages.",
+		Args: cobra.MaximumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			name := ""
+			if len(args) > 0 {
+				name = args[0]
+			}
+			defs, err := describe.Lookup("{{.FullName}}", name)
+			if err != nil {
+				log.Fatal(err)
+			}
+			if !raw {
+				if err := describe.Print(os.Stdout, defs); err != nil {
+					log.Fatal(err)
+				}
+				return
+			}
+			em, err := _{{.Name}}EncoderMaker()
+			if err != nil {
+				log.Fatal(err)
+			}
+			enc := em.NewEncoder(os.Stdout)
+			for _, d := range defs {
+				if err := enc.Encode(d.Descriptor); err != nil {
+					log.Fatal(err)
+				}
+			}
+		},
+	}
+	cmd.Flags().BoolVar(&raw, "descriptor", false, "print the descriptors of the definitions in the response format instead of proto source")
 	return cmd
 }
 
 func _Dial{{.Name}}() (*grpc.ClientConn, {{.Name}}Client, error) {
 	cfg := _Default{{.Name}}ClientCommandConfig
-	opts := []grpc.DialOption{
-		grpc.WithBlock(),
-		grpc.WithTimeout(cfg.Timeout),
+	var v *verbose.Logger
+	var opts []grpc.DialOption
+	if cfg.Trace.Enabled() {
+		t, err := tracing.Start(cfg.Trace)
+		if err != nil {
+			return nil, nil, fmt.Errorf("trace: %v", err)
+		}
+		opts = append(opts, t.DialOptions()...)
+	}
+	if cfg.Verbose {
+		v = verbose.New(os.Stderr)
+		opts = append(opts, v.DialOptions()...)
 	}
 	if cfg.TLS {
 		tlsConfig := &tls.Config{}
@@ -307,6 +484,9 @@ func _Dial{{.Name}}() (*grpc.ClientConn, {{.Name}}Client, error) {
 		}
 		//tlsConfig.BuildNameToCertificate()
 		cred := credentials.NewTLS(tlsConfig)
+		if v != nil {
+			cred = v.Credentials(cred)
+		}
 		opts = append(opts, grpc.WithTransportCredentials(cred))
 	} else {
 		opts = append(opts, grpc.WithInsecure())
@@ -332,34 +512,69 @@ func _Dial{{.Name}}() (*grpc.ClientConn, {{.Name}}Client, error) {
 		}
 		opts = append(opts, grpc.WithPerRPCCredentials(cred))
 	}
-	conn, err := grpc.Dial(cfg.ServerAddr, opts...)
+	if cfg.Record != "" {
+		rec, err := recording.Open(cfg.Record)
+		if err != nil {
+			return nil, nil, fmt.Errorf("record: %v", err)
+		}
+		opts = append(opts, rec.DialOptions()...)
+	}
+	var conn *grpc.ClientConn
+	var err error
+	if v != nil {
+		conn, err = v.Dial(cfg.ServerAddr, cfg.Timeout, opts...)
+	} else {
+		conn, err = grpc.Dial(cfg.ServerAddr, append(opts, grpc.WithBlock(), grpc.WithTimeout(cfg.Timeout))...)
+	}
 	if err != nil {
 		return nil, nil, err
 	}
 	return conn, New{{.Name}}Client(conn), nil
 }
 
-type _{{.Name}}RoundTripFunc func(cli {{.Name}}Client, in iocodec.Decoder, out iocodec.Encoder) error
+// _{{.Name}}EncoderMaker returns the encoder maker of the response format.
+func _{{.Name}}EncoderMaker() (iocodec.EncoderMaker, error) {
+	format := _Default{{.Name}}ClientCommandConfig.ResponseFormat
+	if format == "" {
+		format = "json"
+	}
+	em, ok := iocodec.DefaultEncoders[format]
+	if !ok {
+		return nil, fmt.Errorf("invalid response format: %q", format)
+	}
+	return em, nil
+}
+
+type _{{.Name}}RoundTripFunc func(conn *grpc.ClientConn, cli {{.Name}}Client, in iocodec.Decoder, out iocodec.Encoder) error
 
-func _{{.Name}}RoundTrip(sample interface{}, fn _{{.Name}}RoundTripFunc) error {
+// _{{.Name}}RoundTrip reads the request with prepare, when set, before it
+// dials the server and calls fn. Commands without a request have no sample.
+func _{{.Name}}RoundTrip(sample proto.Message, prepare func(in iocodec.Decoder) error, fn _{{.Name}}RoundTripFunc) error {
 	cfg := _Default{{.Name}}ClientCommandConfig
-	var em iocodec.EncoderMaker
-	var ok bool
-	if cfg.ResponseFormat == "" {
-		em = iocodec.DefaultEncoders["json"]
-	} else {
-		em, ok = iocodec.DefaultEncoders[cfg.ResponseFormat]
-		if !ok {
-			return fmt.Errorf("invalid response format: %q", cfg.ResponseFormat)
-		}
+	em, err := _{{.Name}}EncoderMaker()
+	if err != nil {
+		return err
 	}
 	if cfg.PrintSampleRequest {
-		return em.NewEncoder(os.Stdout).Encode(sample)
+		if sample == nil {
+			return fmt.Errorf("no sample request")
+		}
+		return em.NewEncoder(os.Stdout).Encode(iocodec.NewSample(sample))
 	}
 	// read the input request, first from stdin, then from a file, otherwise from args only
 	var d iocodec.Decoder
 	if cfg.Stdin || cfg.RequestFile == "-" {
-		d = iocodec.DefaultDecoders["json"].NewDecoder(os.Stdin)
+		if cfg.Edit {
+			// the editor needs the terminal
+			return fmt.Errorf("--edit can't be used with a request read from stdin")
+		}
+		var in io.Reader = os.Stdin
+		if cfg.Template.Stdin {
+			if in, err = cfg.Template.Reader("stdin", os.Stdin); err != nil {
+				return fmt.Errorf("request template: %v", err)
+			}
+		}
+		d = iocodec.DefaultDecoders["json"].NewDecoder(in)
 	} else if cfg.RequestFile != "" {
 		f, err := os.Open(cfg.RequestFile)
 		if err != nil {
@@ -374,29 +589,136 @@ func _{{.Name}}RoundTrip(sample interface{}, fn _{{.Name}}RoundTripFunc) error {
 		if !ok {
 			return fmt.Errorf("invalid request file format: %q", ext)
 		}
-		d = dm.NewDecoder(f)
+		in, err := cfg.Template.Reader(cfg.RequestFile, f)
+		if err != nil {
+			return fmt.Errorf("request template: %v", err)
+		}
+		d = dm.NewDecoder(in)
 	} else {
 		d = iocodec.DefaultDecoders["noop"].NewDecoder(os.Stdin)
 	}
+	d = cfg.Set.Decoder(d)
+	if prepare != nil {
+		if err := prepare(d); err != nil {
+			return err
+		}
+	}
+	if cfg.DryRun {
+		// fn prints what it would send instead
+		return fn(nil, nil, d, em.NewEncoder(os.Stdout))
+	}
 	conn, client, err := _Dial{{.Name}}()
 	if err != nil {
 		return err
 	}
 	defer conn.Close()
-	return fn(client, d, em.NewEncoder(os.Stdout))
+	return fn(conn, client, d, em.NewEncoder(os.Stdout))
+}
+
+// _{{.Name}}Prompter returns the prompter asking for the fields of requests,
+// or nil unless --interactive is given and stdin is a terminal.
+func _{{.Name}}Prompter() *prompting.Prompter {
+	if !_Default{{.Name}}ClientCommandConfig.Interactive || !prompting.IsTerminal(os.Stdin) {
+		return nil
+	}
+	return prompting.New(os.Stdin, os.Stderr)
+}
+
+// _{{.Name}}ConfirmDestructive asks for confirmation of a call of the
+// destructive method, showing req unless nil. It's called once the server is
+// dialed, so sample requests and dry runs, which send nothing, aren't asked
+// about.
+func _{{.Name}}ConfirmDestructive(command, method string, req proto.Message) error {
+	em, err := _{{.Name}}EncoderMaker()
+	if err != nil {
+		return err
+	}
+	return _Default{{.Name}}ClientCommandConfig.Destructive.Confirm(command, method, req, em)
+}
+
+// _{{.Name}}Edit opens the editor on m, or on a sample of it when it's empty,
+// in the format of the request file, or else the response format.
+func _{{.Name}}Edit(m proto.Message, check func(proto.Message) error) error {
+	cfg := _Default{{.Name}}ClientCommandConfig
+	format := cfg.ResponseFormat
+	if ext := filepath.Ext(cfg.RequestFile); len(ext) > 1 {
+		format = ext[1:]
+	}
+	var v interface{} = m
+	if proto.Size(m) == 0 {
+		v = iocodec.NewSample(m)
+	}
+	return editing.Edit(format, v, m, check)
+}
+
+// _{{.Name}}DryRun prints reqs, along with where and how they would be sent.
+func _{{.Name}}DryRun(out iocodec.Encoder, reqs ...proto.Message) error {
+	cfg := _Default{{.Name}}ClientCommandConfig
+	plan := dryrun.Plan{
+		Target: cfg.ServerAddr,
+		Timeout: cfg.Timeout,
+		Metadata: metadata.MD{},
+		Requests: reqs,
+	}
+	if cfg.TLS {
+		plan.TLS = &dryrun.TLS{
+			ServerName: cfg.ServerName,
+			InsecureSkipVerify: cfg.InsecureSkipVerify,
+			CACertFile: cfg.CACertFile,
+			CertFile: cfg.CertFile,
+			KeyFile: cfg.KeyFile,
+		}
+		if plan.TLS.ServerName == "" {
+			plan.TLS.ServerName, _, _ = net.SplitHostPort(cfg.ServerAddr)
+		}
+	}
+	if cfg.AuthToken != "" {
+		plan.Metadata.Append("authorization", dryrun.Redact(cfg.AuthTokenType+" "+cfg.AuthToken))
+	}
+	if cfg.JWTKey != "" || cfg.JWTKeyFile != "" {
+		plan.Metadata.Append("authorization", "Bearer "+dryrun.Redacted)
+	}
+	return dryrun.Print(plan, out)
+}
+
+// _{{.Name}}LoadTest makes calls with call, as many and as fast as the load
+// test flags say, over conn and the connections it dials, and prints a report
+// of them.
+func _{{.Name}}LoadTest(conn *grpc.ClientConn, call func(ctx context.Context, cli {{.Name}}Client) error) error {
+	dial := func() (*grpc.ClientConn, error) {
+		conn, _, err := _Dial{{.Name}}()
+		return conn, err
+	}
+	return loadtest.Run(_Default{{.Name}}ClientCommandConfig.LoadTest, conn, dial, func(ctx context.Context, conn *grpc.ClientConn) error {
+		return call(ctx, New{{.Name}}Client(conn))
+	}, os.Stdout)
+}
+
+func _{{.Name}}Validate(m proto.Message, flags *pflag.FlagSet) error {
+	if _Default{{.Name}}ClientCommandConfig.SkipValidation {
+		return nil
+	}
+	return validation.Validate(m, flags)
 }
 `
 
 var generateCommandTemplate = template.Must(template.New("cmd").Parse(generateCommandTemplateCode))
 
-func (c *client) generateCommand(servName string) {
+// generateCommand generates the command of the service, along with its
+// built-in commands, but those whose names are taken by the commands of its
+// methods.
+func (c *client) generateCommand(servName, fullServName string, opts *options.CommandOptions, taken map[string]bool) {
 	var b bytes.Buffer
 	err := generateCommandTemplate.Execute(&b, struct {
-		Name    string
-		UseName string
+		Name     string
+		FullName string
+		Command  command
+		Taken    map[string]bool
 	}{
-		Name:    servName,
-		UseName: strings.ToLower(servName),
+		Name:     servName,
+		FullName: fullServName,
+		Command:  newCommand(strings.ToLower(servName), opts),
+		Taken:    taken,
 	})
 	if err != nil {
 		c.gen.Error(err, "exec cmd template")
@@ -407,16 +729,139 @@ func (c *client) generateCommand(servName string) {
 
 var generateSubcommandTemplateCode = `
 func _{{.FullName}}ClientCommand() *cobra.Command {
-	reqArgs := {{ .InitializeRequestFlagsObj }}
+	reqArgs := {{ .InitializeRequestFlagsObj }}{{ if .LongRunning }}
+	var wait bool
+	var pollInterval, waitTimeout time.Duration{{ end }}{{ if .Paged }}
+	var all bool
+	var maxPages int{{ end }}{{ if and .ServerStream (not .ClientStream) }}
+	var streamOpts streaming.Options{{ end }}{{ if not (or .ClientStream .ServerStream) }}
+	var batchOpts batch.Options{{ end }}
 
 	cmd := &cobra.Command{
-		Use: "{{.UseName}}",
+		Use: "{{.Command.Use}}",{{ with .Command.Aliases }}
+		Aliases: {{ . }},{{ end }}{{ with .Command.Short }}
+		Short: {{ . }},{{ end }}{{ if .Command.Hidden }}
+		Hidden: true,{{ end }}{{ with .Positional }}
+		Args: cobra.MaximumNArgs({{ len . }}),{{ end }}
 		Long: "{{ .Name }} client; call by piping a request in to stdin (--stdin), reading a file (--file), or via flags per field",
 		Example: "TODO: print protobuf method comments here",
-		Run: func(cmd *cobra.Command, args []string) {
+		Run: func(cmd *cobra.Command, args []string) { {{- with .Positional }}
+			for i, name := range {{ printf "%#v" . }}[:len(args)] {
+				if cmd.Flags().Changed(name) {
+					log.Fatalf("argument %d sets --%s, which is already set", i+1, name)
+				}
+				if err := cmd.Flags().Set(name, args[i]); err != nil {
+					log.Fatalf("argument %d: %v", i+1, err)
+				}
+			}
+			{{ end }}
 			var v {{ with .InputPackage }}{{ . }}.{{ end }}{{.InputType}}
-			err := _{{.ServiceName}}RoundTrip(v, func(cli {{.ServiceName}}Client, in iocodec.Decoder, out iocodec.Encoder) error {
 	{{if .ClientStream}}
+			if _Default{{.ServiceName}}ClientCommandConfig.Edit {
+				log.Fatal("--edit can't be used with client streams")
+			}
+			err := _{{.ServiceName}}RoundTrip(&v, nil, func(conn *grpc.ClientConn, cli {{.ServiceName}}Client, in iocodec.Decoder, out iocodec.Encoder) error {
+				{{- with .Destructive }}
+				if !_Default{{$.ServiceName}}ClientCommandConfig.DryRun {
+					// the requests are streamed, so the stream is confirmed as a whole
+					err := _{{$.ServiceName}}ConfirmDestructive("{{.Command}}", "{{.Method}}", nil)
+					if err != nil {
+						return err
+					}
+					err = _Default{{$.ServiceName}}ClientCommandConfig.Destructive.Audit(_Default{{$.ServiceName}}ClientCommandConfig.ServerAddr, "{{.Method}}", nil)
+					if err != nil {
+						return err
+					}
+				}
+				{{- end }}
+				if _Default{{.ServiceName}}ClientCommandConfig.LoadTest.Enabled() || _Default{{.ServiceName}}ClientCommandConfig.DryRun {
+					// every request is read up front
+					var reqs []*{{ with .InputPackage }}{{ . }}.{{ end }}{{.InputType}}
+					for {
+						req := new({{ with .InputPackage }}{{ . }}.{{ end }}{{.InputType}})
+						err := in.Decode(req)
+						if err == io.EOF {
+							break
+						}
+						if err != nil {
+							return err
+						}
+						err = _{{.ServiceName}}Validate(req, cmd.Flags())
+						if err != nil {
+							return err
+						}
+						reqs = append(reqs, req)
+					}
+					if _Default{{.ServiceName}}ClientCommandConfig.DryRun {
+						msgs := make([]proto.Message, len(reqs))
+						for i, req := range reqs {
+							msgs[i] = req
+						}
+						return _{{.ServiceName}}DryRun(out, msgs...)
+					}
+					// every call sends the same requests
+					return _{{.ServiceName}}LoadTest(conn, func(ctx context.Context, cli {{.ServiceName}}Client) error {
+						stream, err := cli.{{.Name}}(ctx)
+						if err != nil {
+							return err
+						}
+						{{if .ServerStream}}
+						go func() {
+							for _, req := range reqs {
+								if stream.Send(req) != nil {
+									// Recv tells why
+									return
+								}
+							}
+							stream.CloseSend()
+						}()
+						for {
+							_, err := stream.Recv()
+							if err == io.EOF {
+								return nil
+							}
+							if err != nil {
+								return err
+							}
+						}
+						{{else}}
+						for _, req := range reqs {
+							err := stream.Send(req)
+							if err == io.EOF {
+								// CloseAndRecv tells why
+								break
+							}
+							if err != nil {
+								return err
+							}
+						}
+						_, err = stream.CloseAndRecv()
+						return err
+						{{end}}
+					})
+				}
+				{{if .ServerStream}}
+				// send and receive concurrently, so responses are printed as
+				// they arrive
+				return streaming.Bidi(func(ctx context.Context) (grpc.ClientStream, func() (proto.Message, error), error) {
+					stream, err := cli.{{.Name}}(ctx)
+					if err != nil {
+						return nil, nil, err
+					}
+					return stream, func() (proto.Message, error) { return stream.Recv() }, nil
+				}, func() (proto.Message, error) {
+					req := new({{ with .InputPackage }}{{ . }}.{{ end }}{{.InputType}})
+					err := in.Decode(req)
+					if err != nil {
+						return nil, err
+					}
+					err = _{{.ServiceName}}Validate(req, cmd.Flags())
+					if err != nil {
+						return nil, err
+					}
+					return req, nil
+				}, out)
+				{{else}}
 				stream, err := cli.{{.Name}}(context.Background())
 				if err != nil {
 					return err
@@ -430,47 +875,189 @@ func _{{.FullName}}ClientCommand() *cobra.Command {
 					if err != nil {
 						return err
 					}
+					err = _{{.ServiceName}}Validate(&v, cmd.Flags())
+					if err != nil {
+						return err
+					}
 					err = stream.Send(&v)
 					if err != nil {
 						return err
 					}
 				}
+				{{end}}
 	{{else}}
+			prepare := func(in iocodec.Decoder) error {
 				err := in.Decode(&v)
 				if err != nil {
 					return err
 				}
-				{{if .ServerStream}}
-				stream, err := cli.{{.Name}}(context.Background(), &v)
-				{{else}}
+				{{ range .RequestDefaults }}
+				{{ . }}{{ end }}
 				proto.Merge(&v, reqArgs)
-				resp, err := cli.{{.Name}}(context.Background(), &v)
-				{{end}}
+
+				prompter := _{{.ServiceName}}Prompter()
+				if prompter != nil {
+					err = prompter.Fields(&v)
+					if err != nil {
+						return err
+					}
+				}
+				if _Default{{.ServiceName}}ClientCommandConfig.Edit {
+					err = _{{.ServiceName}}Edit(&v, func(m proto.Message) error {
+						return _{{.ServiceName}}Validate(m, cmd.Flags())
+					})
+				} else {
+					err = _{{.ServiceName}}Validate(&v, cmd.Flags())
+				}
 				if err != nil {
 					return err
 				}
-	{{end}}
-	{{if .ServerStream}}
-				for {
-					v, err := stream.Recv()
-					if err == io.EOF {
-						break
+				{{- if .Destructive }}
+				// the call is confirmed once the server is dialed
+				return nil
+				{{- else }}
+				if prompter == nil || _Default{{.ServiceName}}ClientCommandConfig.DryRun {
+					return nil
+				}
+				em, err := _{{.ServiceName}}EncoderMaker()
+				if err != nil {
+					return err
+				}
+				return prompter.Confirm(&v, em)
+				{{- end }}
+			}
+			{{if not .ServerStream}}
+			if batchOpts.File != "" {
+				// the requests of the batch are read and checked one by one
+				prepare = nil
+			}
+			{{end}}
+			err := _{{.ServiceName}}RoundTrip(&v, prepare, func(conn *grpc.ClientConn, cli {{.ServiceName}}Client, in iocodec.Decoder, out iocodec.Encoder) error {
+				{{if not .ServerStream}}
+				if batchOpts.File != "" {
+					if _Default{{.ServiceName}}ClientCommandConfig.DryRun {
+						return fmt.Errorf("--dry-run can't be used with --batch")
+					}
+					if _Default{{.ServiceName}}ClientCommandConfig.Edit {
+						return fmt.Errorf("--edit can't be used with --batch")
 					}
+					{{- with .Destructive }}
+					// the batch is confirmed as a whole, and its calls audited one by one
+					err := _{{$.ServiceName}}ConfirmDestructive("{{.Command}}", "{{.Method}}", nil)
 					if err != nil {
 						return err
 					}
-					err = out.Encode(v)
-					if err != nil {
+					{{- end }}
+					return batch.Run(batchOpts, func(ctx context.Context, r *batch.Record) (proto.Message, error) {
+						var v {{ with .InputPackage }}{{ . }}.{{ end }}{{.InputType}}
+						err := r.Decode(&v)
+						if err != nil {
+							return nil, err
+						}
+						err = _Default{{.ServiceName}}ClientCommandConfig.Set.Apply(&v)
+						if err != nil {
+							return nil, batch.Invalid(err)
+						}
+						// the defaults of the record must not leak into
+						// the next ones
+						reqArgs := proto.Clone(reqArgs).(*{{ with .InputPackage }}{{ . }}.{{ end }}{{.InputType}})
+						{{ range .RequestDefaults }}
+						{{ . }}{{ end }}
+						proto.Merge(&v, reqArgs)
+						err = _{{.ServiceName}}Validate(&v, cmd.Flags())
+						if err != nil {
+							return nil, batch.Invalid(err)
+						}
+						{{- with .Destructive }}
+						err = _Default{{$.ServiceName}}ClientCommandConfig.Destructive.Audit(_Default{{$.ServiceName}}ClientCommandConfig.ServerAddr, "{{.Method}}", &v)
+						if err != nil {
+							return nil, err
+						}
+						{{- end }}
+						return cli.{{.Name}}(ctx, &v)
+					}, out)
+				}
+				{{end}}
+				if _Default{{.ServiceName}}ClientCommandConfig.DryRun {
+					return _{{.ServiceName}}DryRun(out, &v)
+				}
+				{{- with .Destructive }}
+				err := _{{$.ServiceName}}ConfirmDestructive("{{.Command}}", "{{.Method}}", &v)
+				if err != nil {
+					return err
+				}
+				err = _Default{{$.ServiceName}}ClientCommandConfig.Destructive.Audit(_Default{{$.ServiceName}}ClientCommandConfig.ServerAddr, "{{.Method}}", &v)
+				if err != nil {
+					return err
+				}
+				{{- end }}
+				if _Default{{.ServiceName}}ClientCommandConfig.LoadTest.Enabled() {
+					return _{{.ServiceName}}LoadTest(conn, func(ctx context.Context, cli {{.ServiceName}}Client) error {
+						{{- if .ServerStream}}
+						stream, err := cli.{{.Name}}(ctx, &v)
+						if err != nil {
+							return err
+						}
+						for {
+							_, err := stream.Recv()
+							if err == io.EOF {
+								return nil
+							}
+							if err != nil {
+								return err
+							}
+						}
+						{{- else}}
+						_, err := cli.{{.Name}}(ctx, &v)
 						return err
+						{{- end}}
+					})
+				}
+				{{if .ServerStream}}
+				return streaming.Receive(streamOpts, func(ctx context.Context) (func() (proto.Message, error), error) {
+					stream, err := cli.{{.Name}}(ctx, &v)
+					if err != nil {
+						return nil, err
 					}
+					return func() (proto.Message, error) { return stream.Recv() }, nil
+				}, out)
+				{{else}}{{with .Paged}}
+				if all || maxPages > 0 {
+					// stream the results of every page, or the first maxPages
+					return pagination.Follow(func(token string) ([]proto.Message, string, error) {
+						v.PageToken = token
+						resp, err := cli.{{ $.Name }}(context.Background(), &v)
+						if err != nil {
+							return nil, "", err
+						}
+						items := make([]proto.Message, len(resp.Get{{ .Items }}()))
+						for i, item := range resp.Get{{ .Items }}() {
+							items[i] = item
+						}
+						return items, resp.GetNextPageToken(), nil
+					}, v.PageToken, maxPages, out)
 				}
-				return nil
-	{{else}}
+				{{end}}
+				resp, err := cli.{{.Name}}(context.Background(), &v)
+				if err != nil {
+					return err
+				}
+				{{end}}
+	{{end}}
+	{{if not .ServerStream}}
 				{{if .ClientStream}}
 				resp, err := stream.CloseAndRecv()
 				if err != nil {
 					return err
 				}
+				{{end}}{{if .LongRunning}}
+				if wait {
+					res, err := operation.Wait(conn, resp, pollInterval, waitTimeout)
+					if err != nil {
+						return err
+					}
+					return out.Encode(res)
+				}
 				{{end}}
 				return out.Encode(resp)
 	{{end}}
@@ -482,7 +1069,14 @@ func _{{.FullName}}ClientCommand() *cobra.Command {
 	}
 
 	{{ range .RequestFlags }}
-	cmd{{ . }}{{ end }}
+	cmd{{ . }}{{ end }}{{ with .LongRunning }}
+	cmd.PersistentFlags().BoolVar(&wait, "wait", false, "wait for the operation to complete and print its result{{ with .ResponseType }} ({{ . }}){{ end }}")
+	cmd.PersistentFlags().DurationVar(&pollInterval, "poll-interval", time.Second, "interval between polls of the operation")
+	cmd.PersistentFlags().DurationVar(&waitTimeout, "wait-timeout", 0, "how long to wait for the operation, 0 for no limit"){{ end }}{{ if .Paged }}
+	cmd.PersistentFlags().BoolVar(&all, "all", false, "follow the page tokens and print the results of every page")
+	cmd.PersistentFlags().IntVar(&maxPages, "max-pages", 0, "follow the page tokens and print the results of at most this many pages"){{ end }}{{ if and .ServerStream (not .ClientStream) }}
+	streamOpts.AddFlags(cmd.PersistentFlags()){{ end }}{{ if not (or .ClientStream .ServerStream) }}
+	batchOpts.AddFlags(cmd.PersistentFlags()){{ end }}
 
 	return cmd
 }
@@ -491,12 +1085,16 @@ func _{{.FullName}}ClientCommand() *cobra.Command {
 var generateSubcommandTemplate = template.Must(template.New("subcmd").Parse(generateSubcommandTemplateCode))
 
 // writes the subcommand to c.P and returns a golang fragment which is a reference to the constructor for this method
-func (c *client) generateSubcommand(servName string, file *generator.FileDescriptor, method *pb.MethodDescriptorProto) string {
+func (c *client) generateSubcommand(servName, fullServName string, file *generator.FileDescriptor, method *pb.MethodDescriptorProto) string {
 	/*
 		if method.GetClientStreaming() || method.GetServerStreaming() {
 			return // TODO: handle streams correctly
 		}
 	*/
+	opts := methodOptions(method)
+	if opts.GetSkip() {
+		return ""
+	}
 	origMethName := method.GetName()
 	methName := generator.CamelCase(origMethName)
 	if reservedClientName[methName] {
@@ -513,29 +1111,54 @@ func (c *client) generateSubcommand(servName string, file *generator.FileDescrip
 
 	types := make(protoTypeCache)
 	inputDesc, _, _ := types.byName(file.MessageType, inputType, noop /*prefix("// ", c.P)*/)
-	obj, reqArgFlags := c.generateRequestFlags(file, inputDesc, types)
+	obj, reqArgFlags, reqArgDefaults := c.generateRequestFlags(file, inputDesc, types)
+
+	cmd := newCommand(strings.ToLower(methName), opts)
+	var destructive *destructiveMethod
+	if c.isDestructive(method, opts) {
+		destructive = &destructiveMethod{Command: cmd.Use, Method: "/" + fullServName + "/" + method.GetName()}
+	}
+	var positional []string
+	if !method.GetClientStreaming() && !method.GetServerStreaming() {
+		// only unary requests are merged with the flags that positional
+		// arguments set
+		positional = c.signatureFlags(method, inputDesc, file, types)
+	}
+	for _, name := range positional {
+		cmd.Use += " [" + name + "]"
+	}
 
 	var b bytes.Buffer
 	err := generateSubcommandTemplate.Execute(&b, struct {
 		Name                      string
-		UseName                   string
+		Command                   command
 		ServiceName               string
 		FullName                  string
 		InputPackage              string
 		InputType                 string
 		InitializeRequestFlagsObj string
 		RequestFlags              []string
+		RequestDefaults           []string
+		Positional                []string
+		LongRunning               *longRunning
+		Paged                     *paged
+		Destructive               *destructiveMethod
 		ClientStream              bool
 		ServerStream              bool
 	}{
 		Name:                      methName,
-		UseName:                   strings.ToLower(methName),
+		Command:                   cmd,
 		ServiceName:               servName,
 		FullName:                  servName + methName,
 		InputPackage:              "", /*importName TODO: fix - not needed for Tetrate's protos today*/
 		InputType:                 inputType,
 		InitializeRequestFlagsObj: obj,
 		RequestFlags:              reqArgFlags,
+		RequestDefaults:           reqArgDefaults,
+		Positional:                positional,
+		LongRunning:               c.longRunning(method),
+		Paged:                     c.paged(method),
+		Destructive:               destructive,
 		ClientStream:              method.GetClientStreaming(),
 		ServerStream:              method.GetServerStreaming(),
 	})
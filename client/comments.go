@@ -0,0 +1,111 @@
+package client
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+	"text/template"
+
+	pb "github.com/golang/protobuf/protoc-gen-go/descriptor"
+
+	"github.com/tetratelabs/protoc-gen-cobra/generator"
+)
+
+// The field number of DescriptorProto.field, used in SourceCodeInfo paths.
+const messageFieldPath = 2
+
+var generateCommentsTemplate = template.Must(template.New("comments").Parse(`
+func init() {
+	iocodec.RegisterComments(map[string]string{ {{ range .Comments }}
+		{{ printf "%q" .Name }}: {{ printf "%q" .Text }},{{ end }}
+	})
+}
+`))
+
+type comment struct {
+	Name string
+	Text string
+}
+
+// requestMessages returns the request messages of the file's services, and
+// the messages they refer to, in the order they are found.
+func (c *client) requestMessages(file *generator.FileDescriptor) []*generator.Descriptor {
+	var out []*generator.Descriptor
+	seen := map[string]bool{}
+	var visit func(typeName string)
+	visit = func(typeName string) {
+		if seen[typeName] {
+			return
+		}
+		seen[typeName] = true
+		d, ok := c.gen.ObjectNamed(typeName).(*generator.Descriptor)
+		if !ok {
+			return
+		}
+		out = append(out, d)
+		for _, f := range d.Field {
+			if f.GetType() == pb.FieldDescriptorProto_TYPE_MESSAGE {
+				visit(f.GetTypeName())
+			}
+		}
+	}
+	for _, service := range file.FileDescriptorProto.Service {
+		for _, method := range service.Method {
+			visit(method.GetInputType())
+		}
+	}
+	return out
+}
+
+// messageName returns the fully-qualified proto name of the message d.
+func messageName(d *generator.Descriptor) string {
+	name := strings.Join(d.TypeName(), ".")
+	if pkg := d.File().GetPackage(); pkg != "" {
+		name = pkg + "." + name
+	}
+	return name
+}
+
+// generateComments registers the proto comments of the request messages of the
+// file's services, and of the messages they refer to, for sample requests.
+func (c *client) generateComments(file *generator.FileDescriptor) {
+	comments := map[string]string{}
+	for _, d := range c.requestMessages(file) {
+		fd := c.gen.FileOf(d.File())
+		name := messageName(d)
+		if text := fd.Comments(d.Path()); text != "" {
+			comments[name] = text
+		}
+		for i, f := range d.Field {
+			if text := fd.Comments(fmt.Sprintf("%s,%d,%d", d.Path(), messageFieldPath, i)); text != "" {
+				comments[name+"."+f.GetName()] = text
+			}
+		}
+	}
+	if len(comments) == 0 {
+		return
+	}
+
+	names := make([]string, 0, len(comments))
+	for n := range comments {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+	list := make([]comment, len(names))
+	for i, n := range names {
+		list[i] = comment{n, comments[n]}
+	}
+
+	var b bytes.Buffer
+	err := generateCommentsTemplate.Execute(&b, struct {
+		Comments []comment
+	}{
+		Comments: list,
+	})
+	if err != nil {
+		c.gen.Error(err, "exec comments template")
+	}
+	c.P(b.String())
+	c.P()
+}
@@ -0,0 +1,113 @@
+package client
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	pb "github.com/golang/protobuf/protoc-gen-go/descriptor"
+
+	"github.com/tetratelabs/protoc-gen-cobra/generator"
+)
+
+// descriptorSet returns the descriptor set of the file, with its comments,
+// and of the files defining the messages and enums its services use,
+// transitively.
+func (c *client) descriptorSet(file *generator.FileDescriptor) *pb.FileDescriptorSet {
+	// the files and messages of the types, by full name; enums have no message
+	type typeDef struct {
+		file *pb.FileDescriptorProto
+		msg  *pb.DescriptorProto
+	}
+	defs := map[string]typeDef{}
+	var addMessages func(prefix string, f *pb.FileDescriptorProto, msgs []*pb.DescriptorProto, enums []*pb.EnumDescriptorProto)
+	addMessages = func(prefix string, f *pb.FileDescriptorProto, msgs []*pb.DescriptorProto, enums []*pb.EnumDescriptorProto) {
+		for _, m := range msgs {
+			defs[prefix+m.GetName()] = typeDef{f, m}
+			addMessages(prefix+m.GetName()+".", f, m.NestedType, m.EnumType)
+		}
+		for _, e := range enums {
+			defs[prefix+e.GetName()] = typeDef{f, nil}
+		}
+	}
+	for _, f := range c.gen.Request.ProtoFile {
+		prefix := "."
+		if f.GetPackage() != "" {
+			prefix += f.GetPackage() + "."
+		}
+		addMessages(prefix, f, f.MessageType, f.EnumType)
+	}
+
+	set := &pb.FileDescriptorSet{File: []*pb.FileDescriptorProto{file.FileDescriptorProto}}
+	files := map[*pb.FileDescriptorProto]bool{file.FileDescriptorProto: true}
+	types := map[string]bool{}
+	var visit func(typeName string)
+	visit = func(typeName string) {
+		if types[typeName] {
+			return
+		}
+		types[typeName] = true
+		d, ok := defs[typeName]
+		if !ok {
+			c.gen.Fail("can't find type", typeName)
+		}
+		if !files[d.file] {
+			files[d.file] = true
+			set.File = append(set.File, d.file)
+		}
+		for _, f := range d.msg.GetField() {
+			if f.TypeName != nil {
+				visit(f.GetTypeName())
+			}
+		}
+	}
+	for _, service := range file.FileDescriptorProto.Service {
+		for _, method := range service.Method {
+			visit(method.GetInputType())
+			visit(method.GetOutputType())
+		}
+	}
+	return set
+}
+
+// generateDescriptorSet embeds the gzipped descriptor set of the file, and
+// registers it for describe commands.
+func (c *client) generateDescriptorSet(file *generator.FileDescriptor) {
+	b, err := proto.Marshal(c.descriptorSet(file))
+	if err != nil {
+		c.gen.Error(err, "failed to marshal the descriptor set")
+	}
+	var buf bytes.Buffer
+	w, _ := gzip.NewWriterLevel(&buf, gzip.BestCompression)
+	w.Write(b)
+	w.Close()
+	b = buf.Bytes()
+
+	// named after the file, like the descriptor protoc-gen-go embeds
+	name := strings.TrimSuffix(path.Base(file.GetName()), ".proto")
+	sum := sha256.Sum256([]byte(file.GetName()))
+	v := fmt.Sprintf("_descriptorSet_%s_%s", generator.CamelCase(name), hex.EncodeToString(sum[:8]))
+
+	c.P("func init() { ", importPkgsByName["describe"].UniqueName, ".Register(", v, ") }")
+	c.P()
+	c.P("var ", v, " = []byte{")
+	c.P("// ", len(b), " bytes of a gzipped FileDescriptorSet")
+	for len(b) > 0 {
+		n := 16
+		if n > len(b) {
+			n = len(b)
+		}
+		s := ""
+		for _, x := range b[:n] {
+			s += fmt.Sprintf("0x%02x,", x)
+		}
+		c.P(s)
+		b = b[n:]
+	}
+	c.P("}")
+}
@@ -0,0 +1,28 @@
+package client
+
+import (
+	pb "github.com/golang/protobuf/protoc-gen-go/descriptor"
+	"google.golang.org/genproto/googleapis/longrunning"
+)
+
+// A longRunning method returns a google.longrunning.Operation, which the
+// generated command can wait for.
+type longRunning struct {
+	ResponseType string // from the (google.longrunning.operation_info), if any
+}
+
+// longRunning returns what the method's command needs to wait for the
+// operation it returns, or nil if it's not a unary method returning one.
+func (c *client) longRunning(method *pb.MethodDescriptorProto) *longRunning {
+	if method.GetOutputType() != ".google.longrunning.Operation" || method.GetClientStreaming() || method.GetServerStreaming() {
+		return nil
+	}
+	lr := &longRunning{}
+	if method.GetOptions() == nil {
+		return lr
+	}
+	if info, ok := extension(method.GetOptions(), longrunning.E_OperationInfo).(*longrunning.OperationInfo); ok {
+		lr.ResponseType = info.GetResponseType()
+	}
+	return lr
+}
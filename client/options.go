@@ -0,0 +1,188 @@
+package client
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	pb "github.com/golang/protobuf/protoc-gen-go/descriptor"
+
+	"github.com/tetratelabs/protoc-gen-cobra/generator"
+	"github.com/tetratelabs/protoc-gen-cobra/options"
+)
+
+// reservedShorthands are the flag shorthands of the generated commands, which
+// request flags can't use.
+var reservedShorthands = map[string]bool{"f": true, "h": true, "o": true, "p": true, "s": true, "v": true}
+
+// serviceOptions returns the (cobra.service) options of the service s, or
+// empty options if there are none.
+func serviceOptions(s *pb.ServiceDescriptorProto) *options.CommandOptions {
+	if s.GetOptions() == nil {
+		return &options.CommandOptions{}
+	}
+	return commandOptions(s.GetOptions(), options.E_Service)
+}
+
+// methodOptions returns the (cobra.method) options of the method m, or empty
+// options if there are none.
+func methodOptions(m *pb.MethodDescriptorProto) *options.CommandOptions {
+	if m.GetOptions() == nil {
+		return &options.CommandOptions{}
+	}
+	return commandOptions(m.GetOptions(), options.E_Method)
+}
+
+func commandOptions(opts proto.Message, ext *proto.ExtensionDesc) *options.CommandOptions {
+	if v, ok := extension(opts, ext).(*options.CommandOptions); ok {
+		return v
+	}
+	return &options.CommandOptions{}
+}
+
+// flagOptions returns the (cobra.flag) options of the field f, or empty
+// options if there are none.
+func flagOptions(f *pb.FieldDescriptorProto) *options.FlagOptions {
+	if f.GetOptions() == nil {
+		return &options.FlagOptions{}
+	}
+	if v, ok := extension(f.GetOptions(), options.E_Flag).(*options.FlagOptions); ok {
+		return v
+	}
+	return &options.FlagOptions{}
+}
+
+func extension(opts proto.Message, ext *proto.ExtensionDesc) interface{} {
+	if !proto.HasExtension(opts, ext) {
+		return nil
+	}
+	v, err := proto.GetExtension(opts, ext)
+	if err != nil {
+		return nil
+	}
+	return v
+}
+
+// A destructiveMethod is a method whose calls must be confirmed.
+type destructiveMethod struct {
+	Command string // the name of its command, which confirms a call when typed
+	Method  string // its full name, as in /pkg.Service/Method
+}
+
+// isDestructive reports whether the method m is destructive: marked so by its
+// (cobra.method) options, or named with one of the prefixes of the destructive
+// parameter, separated by "+", as in destructive=Delete+Purge.
+func (c *client) isDestructive(m *pb.MethodDescriptorProto, opts *options.CommandOptions) bool {
+	if opts.GetDestructive() {
+		return true
+	}
+	for _, prefix := range strings.Split(c.gen.Param["destructive"], "+") {
+		if prefix != "" && strings.HasPrefix(m.GetName(), prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// A command describes the cobra.Command fields shaped by CommandOptions.
+type command struct {
+	Use     string
+	Aliases string // a []string literal, or empty
+	Short   string // a quoted string, or empty
+	Hidden  bool
+}
+
+func newCommand(defaultName string, opts *options.CommandOptions) command {
+	cmd := command{Use: defaultName, Hidden: opts.GetHidden()}
+	if opts.GetName() != "" {
+		cmd.Use = opts.GetName()
+	}
+	if len(opts.GetAliases()) > 0 {
+		aliases := make([]string, len(opts.GetAliases()))
+		for i, a := range opts.GetAliases() {
+			aliases[i] = strconv.Quote(a)
+		}
+		cmd.Aliases = "[]string{" + strings.Join(aliases, ", ") + "}"
+	}
+	if opts.GetShort() != "" {
+		cmd.Short = strconv.Quote(opts.GetShort())
+	}
+	return cmd
+}
+
+// methodCommands returns the names and aliases of the commands of the methods
+// of service, which its built-in commands give way to.
+func methodCommands(service *pb.ServiceDescriptorProto) map[string]bool {
+	taken := map[string]bool{}
+	for _, m := range service.Method {
+		opts := methodOptions(m)
+		if opts.GetSkip() {
+			continue
+		}
+		name := strings.ToLower(generator.CamelCase(m.GetName()))
+		if opts.GetName() != "" {
+			name = opts.GetName()
+		}
+		taken[name] = true
+		for _, a := range opts.GetAliases() {
+			taken[a] = true
+		}
+	}
+	return taken
+}
+
+// defaultLiteral returns the Go literal of the flag default value v of a field
+// of type t, or an error if v isn't a valid value of that type.
+func defaultLiteral(t pb.FieldDescriptorProto_Type, v string) (string, error) {
+	var err error
+	switch t {
+	case pb.FieldDescriptorProto_TYPE_STRING:
+		return strconv.Quote(v), nil
+	case pb.FieldDescriptorProto_TYPE_BYTES:
+		var b []byte
+		if b, err = base64.StdEncoding.DecodeString(v); err == nil {
+			return fmt.Sprintf("[]byte(%q)", b), nil
+		}
+	case pb.FieldDescriptorProto_TYPE_BOOL:
+		var b bool
+		if b, err = strconv.ParseBool(v); err == nil {
+			return strconv.FormatBool(b), nil
+		}
+	case pb.FieldDescriptorProto_TYPE_FLOAT:
+		_, err = strconv.ParseFloat(v, 32)
+	case pb.FieldDescriptorProto_TYPE_DOUBLE:
+		_, err = strconv.ParseFloat(v, 64)
+	case pb.FieldDescriptorProto_TYPE_INT32, pb.FieldDescriptorProto_TYPE_SINT32, pb.FieldDescriptorProto_TYPE_SFIXED32,
+		pb.FieldDescriptorProto_TYPE_FIXED32:
+		_, err = strconv.ParseInt(v, 10, 32)
+	case pb.FieldDescriptorProto_TYPE_INT64, pb.FieldDescriptorProto_TYPE_SINT64, pb.FieldDescriptorProto_TYPE_SFIXED64,
+		pb.FieldDescriptorProto_TYPE_FIXED64:
+		_, err = strconv.ParseInt(v, 10, 64)
+	case pb.FieldDescriptorProto_TYPE_UINT32:
+		_, err = strconv.ParseUint(v, 10, 32)
+	case pb.FieldDescriptorProto_TYPE_UINT64:
+		_, err = strconv.ParseUint(v, 10, 64)
+	default:
+		return "", fmt.Errorf("fields of type %s can't have a default", t)
+	}
+	if err != nil {
+		return "", fmt.Errorf("bad default %q: %v", v, err)
+	}
+	return v, nil
+}
+
+// zeroCheck returns a condition that holds when the Go expression expr, the
+// value of a field of type t, isn't the zero value.
+func zeroCheck(t pb.FieldDescriptorProto_Type, expr string) string {
+	switch t {
+	case pb.FieldDescriptorProto_TYPE_STRING:
+		return expr + ` != ""`
+	case pb.FieldDescriptorProto_TYPE_BYTES:
+		return "len(" + expr + ") != 0"
+	case pb.FieldDescriptorProto_TYPE_BOOL:
+		return expr
+	}
+	return expr + " != 0"
+}
@@ -0,0 +1,67 @@
+package client
+
+import (
+	pb "github.com/golang/protobuf/protoc-gen-go/descriptor"
+
+	"github.com/tetratelabs/protoc-gen-cobra/generator"
+)
+
+// A paged method is an AIP-158 list method, which the generated command can
+// call for every page of results.
+type paged struct {
+	Items string // the Go name of the response's repeated field
+}
+
+// paged returns what the method's command needs to follow its page tokens,
+// or nil if it's not a unary method whose request has page_token and
+// page_size fields and whose response has a next_page_token field, along
+// with a repeated field of results.
+func (c *client) paged(method *pb.MethodDescriptorProto) *paged {
+	if method.GetClientStreaming() || method.GetServerStreaming() {
+		return nil
+	}
+	req, ok := c.gen.ObjectNamed(method.GetInputType()).(*generator.Descriptor)
+	if !ok || fieldNamed(req, "page_token", pb.FieldDescriptorProto_TYPE_STRING) == nil ||
+		!isInteger(fieldNamed(req, "page_size", -1)) {
+		return nil
+	}
+	resp, ok := c.gen.ObjectNamed(method.GetOutputType()).(*generator.Descriptor)
+	if !ok || fieldNamed(resp, "next_page_token", pb.FieldDescriptorProto_TYPE_STRING) == nil {
+		return nil
+	}
+	// the results are the first repeated field, which isn't a map
+	for _, f := range resp.Field {
+		if f.GetLabel() != pb.FieldDescriptorProto_LABEL_REPEATED {
+			continue
+		}
+		if f.GetType() == pb.FieldDescriptorProto_TYPE_MESSAGE {
+			if d, ok := c.gen.ObjectNamed(f.GetTypeName()).(*generator.Descriptor); ok && d.GetOptions().GetMapEntry() {
+				continue
+			}
+		}
+		return &paged{Items: goFieldName(f)}
+	}
+	return nil
+}
+
+// fieldNamed returns the field of d named name, of type t unless t is
+// negative, or nil if there's none.
+func fieldNamed(d *generator.Descriptor, name string, t pb.FieldDescriptorProto_Type) *pb.FieldDescriptorProto {
+	for _, f := range d.Field {
+		if f.GetName() == name && f.GetLabel() != pb.FieldDescriptorProto_LABEL_REPEATED && (t < 0 || f.GetType() == t) {
+			return f
+		}
+	}
+	return nil
+}
+
+func isInteger(f *pb.FieldDescriptorProto) bool {
+	switch f.GetType() {
+	case pb.FieldDescriptorProto_TYPE_INT32, pb.FieldDescriptorProto_TYPE_SINT32, pb.FieldDescriptorProto_TYPE_SFIXED32,
+		pb.FieldDescriptorProto_TYPE_UINT32, pb.FieldDescriptorProto_TYPE_FIXED32,
+		pb.FieldDescriptorProto_TYPE_INT64, pb.FieldDescriptorProto_TYPE_SINT64, pb.FieldDescriptorProto_TYPE_SFIXED64,
+		pb.FieldDescriptorProto_TYPE_UINT64, pb.FieldDescriptorProto_TYPE_FIXED64:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,385 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	pb "github.com/golang/protobuf/protoc-gen-go/descriptor"
+	"google.golang.org/genproto/googleapis/api/annotations"
+
+	"github.com/tetratelabs/protoc-gen-cobra/generator"
+)
+
+// The field numbers of FileDescriptorProto.enum_type and
+// DescriptorProto.enum_type, used in SourceCodeInfo paths.
+const (
+	fileEnumPath    = 5
+	messageEnumPath = 4
+)
+
+// A jsonSchema is a JSON Schema (draft-07) document. Its fields are written in
+// declaration order.
+type jsonSchema struct {
+	Schema               string        `json:"$schema,omitempty"`
+	Ref                  string        `json:"$ref,omitempty"`
+	Title                string        `json:"title,omitempty"`
+	Description          string        `json:"description,omitempty"`
+	ReadOnly             bool          `json:"readOnly,omitempty"`
+	Type                 interface{}   `json:"type,omitempty"`
+	Format               string        `json:"format,omitempty"`
+	ContentEncoding      string        `json:"contentEncoding,omitempty"`
+	Pattern              string        `json:"pattern,omitempty"`
+	Minimum              *int64        `json:"minimum,omitempty"`
+	Enum                 []interface{} `json:"enum,omitempty"`
+	Items                *jsonSchema   `json:"items,omitempty"`
+	Properties           schemaMap     `json:"properties,omitempty"`
+	PropertyNames        *jsonSchema   `json:"propertyNames,omitempty"`
+	AdditionalProperties interface{}   `json:"additionalProperties,omitempty"`
+	Required             []string      `json:"required,omitempty"`
+	AnyOf                []*jsonSchema `json:"anyOf,omitempty"`
+	OneOf                []*jsonSchema `json:"oneOf,omitempty"`
+	AllOf                []*jsonSchema `json:"allOf,omitempty"`
+	Not                  *jsonSchema   `json:"not,omitempty"`
+	Definitions          schemaMap     `json:"definitions,omitempty"`
+}
+
+// A schemaMap is a JSON object of schemas that keeps the order of its entries.
+type schemaMap []schemaEntry
+
+type schemaEntry struct {
+	name   string
+	schema *jsonSchema
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (m schemaMap) MarshalJSON() ([]byte, error) {
+	var b bytes.Buffer
+	b.WriteByte('{')
+	for i, e := range m {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		k, err := json.Marshal(e.name)
+		if err != nil {
+			return nil, err
+		}
+		v, err := json.Marshal(e.schema)
+		if err != nil {
+			return nil, err
+		}
+		b.Write(k)
+		b.WriteByte(':')
+		b.Write(v)
+	}
+	b.WriteByte('}')
+	return b.Bytes(), nil
+}
+
+// generateSchemas writes a JSON Schema for the request and response messages
+// of the file's methods when the "schema" parameter is set, one
+// <package>.<Message>.schema.json file per message. The schemas follow the
+// proto3 JSON mapping the request files are read with, so editors can validate
+// and complete request files as they are written.
+func (c *client) generateSchemas(file *generator.FileDescriptor) {
+	if v, ok := c.gen.Param["schema"]; !ok || v == "false" {
+		return
+	}
+	var typeNames []string
+	seen := map[string]bool{}
+	for _, service := range file.FileDescriptorProto.Service {
+		for _, method := range service.Method {
+			for _, typeName := range []string{method.GetInputType(), method.GetOutputType()} {
+				if !seen[typeName] {
+					seen[typeName] = true
+					typeNames = append(typeNames, typeName)
+				}
+			}
+		}
+	}
+	for _, typeName := range typeNames {
+		name := strings.TrimPrefix(typeName, ".")
+		if wellKnownSchema(name) != nil {
+			continue
+		}
+		b, err := json.MarshalIndent(c.messageSchema(typeName), "", "  ")
+		if err != nil {
+			c.gen.Error(err, "failed to marshal the schema of", name)
+		}
+		c.gen.AddFile(file, name+".schema.json", string(b)+"\n")
+	}
+}
+
+// messageSchema returns the schema document of the message typeName, with
+// every message it refers to in its definitions.
+func (c *client) messageSchema(typeName string) *jsonSchema {
+	name := strings.TrimPrefix(typeName, ".")
+	defs := map[string]*jsonSchema{}
+	var visit func(typeName string)
+	visit = func(typeName string) {
+		name := strings.TrimPrefix(typeName, ".")
+		if _, ok := defs[name]; ok {
+			return
+		}
+		d := c.gen.ObjectNamed(typeName).(*generator.Descriptor)
+		s, refs := c.definition(d)
+		defs[name] = s
+		for _, ref := range refs {
+			visit(ref)
+		}
+	}
+	visit(typeName)
+	root := defs[name]
+
+	names := make([]string, 0, len(defs))
+	for n := range defs {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+	definitions := make(schemaMap, len(names))
+	for i, n := range names {
+		definitions[i] = schemaEntry{n, defs[n]}
+	}
+	return &jsonSchema{
+		Schema:      "http://json-schema.org/draft-07/schema#",
+		Ref:         "#/definitions/" + name,
+		Title:       name,
+		Description: root.Description,
+		Definitions: definitions,
+	}
+}
+
+// definition returns the schema of the message d and the type names of the
+// messages it refers to.
+func (c *client) definition(d *generator.Descriptor) (*jsonSchema, []string) {
+	fd := c.gen.FileOf(d.File())
+	s := &jsonSchema{
+		Description:          description(fd.Comments(d.Path())),
+		Type:                 "object",
+		Properties:           schemaMap{},
+		AdditionalProperties: false,
+	}
+	var refs []string
+	oneofs := make([][]string, len(d.OneofDecl))
+	for i, f := range d.Field {
+		fs, ref := c.fieldSchema(f)
+		if text := fd.Comments(fmt.Sprintf("%s,%d,%d", d.Path(), messageFieldPath, i)); text != "" {
+			fs.Description = description(text)
+		}
+		if ref != "" {
+			refs = append(refs, ref)
+		}
+		name := f.GetJsonName()
+		if name == "" {
+			name = f.GetName()
+		}
+		s.Properties = append(s.Properties, schemaEntry{name, fs})
+		if hasBehavior(f, annotations.FieldBehavior_OUTPUT_ONLY) {
+			fs.ReadOnly = true
+		}
+		if f.GetLabel() == pb.FieldDescriptorProto_LABEL_REQUIRED || hasBehavior(f, annotations.FieldBehavior_REQUIRED) {
+			s.Required = append(s.Required, name)
+		}
+		if f.OneofIndex != nil {
+			oneofs[f.GetOneofIndex()] = append(oneofs[f.GetOneofIndex()], name)
+		}
+	}
+	// at most one member of each oneof may be set
+	var constraints []*jsonSchema
+	for _, members := range oneofs {
+		if len(members) == 0 {
+			continue
+		}
+		none := &jsonSchema{Not: &jsonSchema{}}
+		oneOf := &jsonSchema{OneOf: []*jsonSchema{none}}
+		for _, m := range members {
+			set := &jsonSchema{Required: []string{m}}
+			oneOf.OneOf = append(oneOf.OneOf, set)
+			none.Not.AnyOf = append(none.Not.AnyOf, set)
+		}
+		constraints = append(constraints, oneOf)
+	}
+	switch len(constraints) {
+	case 0:
+	case 1:
+		s.OneOf = constraints[0].OneOf
+	default:
+		s.AllOf = constraints
+	}
+	return s, refs
+}
+
+// fieldSchema returns the schema of the field f and the type name of the
+// message it refers to, if any.
+func (c *client) fieldSchema(f *pb.FieldDescriptorProto) (*jsonSchema, string) {
+	if f.GetType() == pb.FieldDescriptorProto_TYPE_MESSAGE {
+		d := c.gen.ObjectNamed(f.GetTypeName()).(*generator.Descriptor)
+		if d.GetOptions().GetMapEntry() {
+			value, ref := c.valueSchema(d.Field[1])
+			return &jsonSchema{
+				Type:                 "object",
+				PropertyNames:        mapKeySchema(d.Field[0]),
+				AdditionalProperties: value,
+			}, ref
+		}
+	}
+	s, ref := c.valueSchema(f)
+	if f.GetLabel() == pb.FieldDescriptorProto_LABEL_REPEATED {
+		return &jsonSchema{Type: "array", Items: s}, ref
+	}
+	return s, ref
+}
+
+// valueSchema returns the schema of a single value of the field f, ignoring
+// its label.
+func (c *client) valueSchema(f *pb.FieldDescriptorProto) (*jsonSchema, string) {
+	switch f.GetType() {
+	case pb.FieldDescriptorProto_TYPE_MESSAGE, pb.FieldDescriptorProto_TYPE_GROUP:
+		name := strings.TrimPrefix(f.GetTypeName(), ".")
+		if s := wellKnownSchema(name); s != nil {
+			return s, ""
+		}
+		return &jsonSchema{Ref: "#/definitions/" + name}, f.GetTypeName()
+	case pb.FieldDescriptorProto_TYPE_ENUM:
+		return c.enumSchema(f.GetTypeName()), ""
+	case pb.FieldDescriptorProto_TYPE_BOOL:
+		return &jsonSchema{Type: "boolean"}, ""
+	case pb.FieldDescriptorProto_TYPE_STRING:
+		return &jsonSchema{Type: "string"}, ""
+	case pb.FieldDescriptorProto_TYPE_BYTES:
+		return &jsonSchema{Type: "string", ContentEncoding: "base64"}, ""
+	case pb.FieldDescriptorProto_TYPE_FLOAT, pb.FieldDescriptorProto_TYPE_DOUBLE:
+		return numberSchema(), ""
+	case pb.FieldDescriptorProto_TYPE_INT64, pb.FieldDescriptorProto_TYPE_SINT64, pb.FieldDescriptorProto_TYPE_SFIXED64:
+		// 64-bit integers are strings in the JSON mapping, but numbers are accepted
+		return &jsonSchema{Type: []string{"integer", "string"}, Pattern: "^-?[0-9]+$"}, ""
+	case pb.FieldDescriptorProto_TYPE_UINT64, pb.FieldDescriptorProto_TYPE_FIXED64:
+		return &jsonSchema{Type: []string{"integer", "string"}, Pattern: "^[0-9]+$", Minimum: new(int64)}, ""
+	case pb.FieldDescriptorProto_TYPE_UINT32, pb.FieldDescriptorProto_TYPE_FIXED32:
+		return &jsonSchema{Type: "integer", Minimum: new(int64)}, ""
+	}
+	// int32, sint32 and sfixed32
+	return &jsonSchema{Type: "integer"}, ""
+}
+
+// description turns a proto comment into a schema description, dropping the
+// space that follows the comment markers.
+func description(comment string) string {
+	lines := strings.Split(comment, "\n")
+	for i, l := range lines {
+		lines[i] = strings.TrimPrefix(l, " ")
+	}
+	return strings.Join(lines, "\n")
+}
+
+// mapKeySchema returns the schema of the object keys of a map with key field
+// f, or nil if any string is a valid key.
+func mapKeySchema(f *pb.FieldDescriptorProto) *jsonSchema {
+	switch f.GetType() {
+	case pb.FieldDescriptorProto_TYPE_STRING:
+		return nil
+	case pb.FieldDescriptorProto_TYPE_BOOL:
+		return &jsonSchema{Enum: []interface{}{"true", "false"}}
+	case pb.FieldDescriptorProto_TYPE_UINT32, pb.FieldDescriptorProto_TYPE_FIXED32,
+		pb.FieldDescriptorProto_TYPE_UINT64, pb.FieldDescriptorProto_TYPE_FIXED64:
+		return &jsonSchema{Pattern: "^[0-9]+$"}
+	}
+	return &jsonSchema{Pattern: "^-?[0-9]+$"}
+}
+
+func numberSchema() *jsonSchema {
+	return &jsonSchema{AnyOf: []*jsonSchema{
+		{Type: "number"},
+		{Type: "string", Enum: []interface{}{"NaN", "Infinity", "-Infinity"}},
+	}}
+}
+
+// enumSchema returns the schema of the enum typeName, which accepts both the
+// names and the numbers of its values.
+func (c *client) enumSchema(typeName string) *jsonSchema {
+	e, fd, path := c.enumNamed(typeName)
+	if e == nil {
+		c.gen.Fail("can't find enum", typeName)
+	}
+	names := &jsonSchema{Type: "string"}
+	numbers := &jsonSchema{Type: "integer"}
+	for _, v := range e.Value {
+		names.Enum = append(names.Enum, v.GetName())
+		numbers.Enum = append(numbers.Enum, v.GetNumber())
+	}
+	return &jsonSchema{
+		Description: description(fd.Comments(path)),
+		AnyOf:       []*jsonSchema{names, numbers},
+	}
+}
+
+// enumNamed returns the enum with the fully-qualified name typeName, the file
+// it is declared in and its SourceCodeInfo path.
+func (c *client) enumNamed(typeName string) (*pb.EnumDescriptorProto, *generator.FileDescriptor, string) {
+	for _, f := range c.gen.Request.ProtoFile {
+		prefix := "."
+		if f.GetPackage() != "" {
+			prefix += f.GetPackage() + "."
+		}
+		for i, e := range f.EnumType {
+			if prefix+e.GetName() == typeName {
+				return e, c.gen.FileOf(f), fmt.Sprintf("%d,%d", fileEnumPath, i)
+			}
+		}
+	}
+	// not a top-level enum, so it is nested in a message
+	i := strings.LastIndex(typeName, ".")
+	d := c.gen.ObjectNamed(typeName[:i]).(*generator.Descriptor)
+	for j, e := range d.EnumType {
+		if e.GetName() == typeName[i+1:] {
+			return e, c.gen.FileOf(d.File()), fmt.Sprintf("%s,%d,%d", d.Path(), messageEnumPath, j)
+		}
+	}
+	return nil, nil, ""
+}
+
+// wellKnownSchema returns the schema of the JSON mapping of the well-known
+// type name, or nil if name isn't one.
+func wellKnownSchema(name string) *jsonSchema {
+	switch name {
+	case "google.protobuf.Timestamp":
+		return &jsonSchema{Type: "string", Format: "date-time"}
+	case "google.protobuf.Duration":
+		return &jsonSchema{Type: "string", Pattern: `^-?[0-9]+(\.[0-9]+)?s$`}
+	case "google.protobuf.FieldMask":
+		return &jsonSchema{Type: "string"}
+	case "google.protobuf.Struct":
+		return &jsonSchema{Type: "object"}
+	case "google.protobuf.ListValue":
+		return &jsonSchema{Type: "array"}
+	case "google.protobuf.Value":
+		return &jsonSchema{}
+	case "google.protobuf.Empty":
+		return &jsonSchema{Type: "object", AdditionalProperties: false}
+	case "google.protobuf.Any":
+		return &jsonSchema{
+			Type:       "object",
+			Properties: schemaMap{{"@type", &jsonSchema{Type: "string"}}},
+			Required:   []string{"@type"},
+		}
+	case "google.protobuf.DoubleValue", "google.protobuf.FloatValue":
+		return numberSchema()
+	case "google.protobuf.Int64Value":
+		return &jsonSchema{Type: []string{"integer", "string"}, Pattern: "^-?[0-9]+$"}
+	case "google.protobuf.UInt64Value":
+		return &jsonSchema{Type: []string{"integer", "string"}, Pattern: "^[0-9]+$", Minimum: new(int64)}
+	case "google.protobuf.Int32Value":
+		return &jsonSchema{Type: "integer"}
+	case "google.protobuf.UInt32Value":
+		return &jsonSchema{Type: "integer", Minimum: new(int64)}
+	case "google.protobuf.BoolValue":
+		return &jsonSchema{Type: "boolean"}
+	case "google.protobuf.StringValue":
+		return &jsonSchema{Type: "string"}
+	case "google.protobuf.BytesValue":
+		return &jsonSchema{Type: "string", ContentEncoding: "base64"}
+	}
+	return nil
+}
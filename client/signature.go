@@ -0,0 +1,72 @@
+package client
+
+import (
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	pb "github.com/golang/protobuf/protoc-gen-go/descriptor"
+	"google.golang.org/genproto/googleapis/api/annotations"
+
+	"github.com/tetratelabs/protoc-gen-cobra/generator"
+)
+
+// signatureFlags returns the names of the flags of the fields listed by the
+// first (google.api.method_signature) of the method whose fields all have
+// flags, in order, or nil if there's none. These fields are accepted as
+// positional arguments.
+func (c *client) signatureFlags(method *pb.MethodDescriptorProto, d *pb.DescriptorProto, file *generator.FileDescriptor, types protoTypeCache) []string {
+	if d == nil || method.GetOptions() == nil || !proto.HasExtension(method.GetOptions(), annotations.E_MethodSignature) {
+		return nil
+	}
+	v, err := proto.GetExtension(method.GetOptions(), annotations.E_MethodSignature)
+	if err != nil {
+		return nil
+	}
+	for _, signature := range v.([]string) {
+		if signature == "" {
+			continue
+		}
+		var names []string
+		for _, path := range strings.Split(signature, ",") {
+			name, ok := c.pathFlag(strings.Split(strings.TrimSpace(path), "."), d, file, types)
+			if !ok {
+				names = nil
+				break
+			}
+			names = append(names, name)
+		}
+		if names != nil {
+			return names
+		}
+	}
+	return nil
+}
+
+// pathFlag returns the name of the flag of the field of d at path, a list of
+// proto field names, and whether it has one.
+func (c *client) pathFlag(path []string, d *pb.DescriptorProto, file *generator.FileDescriptor, types protoTypeCache) (string, bool) {
+	for _, f := range d.Field {
+		if f.GetName() != path[0] {
+			continue
+		}
+		if flagOptions(f).GetSkip() || hasBehavior(f, annotations.FieldBehavior_OUTPUT_ONLY) ||
+			f.GetLabel() == pb.FieldDescriptorProto_LABEL_REPEATED {
+			return "", false
+		}
+		if len(path) == 1 {
+			_, ok := scalarFlags[f.GetType()]
+			return flagName(f), ok
+		}
+		if f.GetType() != pb.FieldDescriptorProto_TYPE_MESSAGE {
+			return "", false
+		}
+		_, _, ttype := inputNames(f.GetTypeName())
+		fdesc, found, _ := types.byName(file.MessageType, ttype, noop)
+		if !found || fdesc.GetOptions().GetMapEntry() {
+			return "", false
+		}
+		name, ok := c.pathFlag(path[1:], fdesc, file, types)
+		return flagName(f) + "-" + name, ok
+	}
+	return "", false
+}
@@ -0,0 +1,80 @@
+package client
+
+import (
+	"bytes"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/envoyproxy/protoc-gen-validate/validate"
+	"github.com/golang/protobuf/proto"
+
+	"github.com/tetratelabs/protoc-gen-cobra/generator"
+)
+
+var generateRulesTemplate = template.Must(template.New("rules").Parse(`
+func init() {
+	validation.RegisterRules(map[string]string{ {{ range .Rules }}
+		{{ printf "%q" .Name }}: {{ printf "%q" .Text }},{{ end }}
+	})
+}
+`))
+
+type rule struct {
+	Name string
+	Text string
+}
+
+// generateRules registers the protoc-gen-validate rules of the fields of the
+// request messages of the file's services, and of the messages they refer to,
+// so requests are validated before they are sent.
+func (c *client) generateRules(file *generator.FileDescriptor) {
+	var rules []rule
+	for _, d := range c.requestMessages(file) {
+		if d.GetOptions() != nil && boolExtension(d.GetOptions(), validate.E_Disabled) {
+			continue
+		}
+		name := messageName(d)
+		for _, f := range d.Field {
+			if f.GetOptions() == nil || !proto.HasExtension(f.GetOptions(), validate.E_Rules) {
+				continue
+			}
+			ext, err := proto.GetExtension(f.GetOptions(), validate.E_Rules)
+			if err != nil {
+				c.gen.Error(err, "bad validation rules of", name+"."+f.GetName())
+			}
+			text := strings.TrimSpace(proto.CompactTextString(ext.(*validate.FieldRules)))
+			rules = append(rules, rule{name + "." + f.GetName(), text})
+		}
+	}
+	if len(rules) == 0 {
+		return
+	}
+	sort.Slice(rules, func(i, j int) bool { return rules[i].Name < rules[j].Name })
+
+	var b bytes.Buffer
+	err := generateRulesTemplate.Execute(&b, struct {
+		Rules []rule
+	}{
+		Rules: rules,
+	})
+	if err != nil {
+		c.gen.Error(err, "exec rules template")
+	}
+	c.P(b.String())
+	c.P()
+}
+
+// boolExtension reports whether the boolean extension ext is set to true on
+// the options pb.
+func boolExtension(pb proto.Message, ext *proto.ExtensionDesc) bool {
+	if !proto.HasExtension(pb, ext) {
+		return false
+	}
+	v, err := proto.GetExtension(pb, ext)
+	if err != nil {
+		return false
+	}
+	b, ok := v.(*bool)
+	return ok && *b
+}
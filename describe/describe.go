@@ -0,0 +1,224 @@
+// Package describe prints the definitions of the services, methods, messages
+// and enums of generated commands, from the descriptors generated files
+// embed, with no need for server reflection.
+//
+// Generated files register a gzipped FileDescriptorSet holding their proto
+// file, with its comments, and the files defining the types their services
+// use. AnyResolver resolves the Any values of these types, with no need for
+// their Go types.
+package describe
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+	descpb "github.com/golang/protobuf/protoc-gen-go/descriptor"
+	"google.golang.org/genproto/googleapis/api/annotations"
+)
+
+// The field numbers of the descriptors, used in SourceCodeInfo paths.
+const (
+	fileMessagePath   = 4
+	fileEnumPath      = 5
+	fileServicePath   = 6
+	messageFieldPath  = 2
+	messageNestedPath = 3
+	messageEnumPath   = 4
+	messageOneofPath  = 8
+	enumValuePath     = 2
+	serviceMethodPath = 2
+)
+
+var (
+	mu    sync.Mutex
+	files = map[string]*descpb.FileDescriptorProto{}
+	index map[string]*Definition // by full name, nil when files change
+)
+
+// Register registers the files of the gzipped FileDescriptorSet b, keeping
+// the ones registered already. Generated files call it when initialized.
+func Register(b []byte) {
+	r, err := gzip.NewReader(bytes.NewReader(b))
+	if err != nil {
+		panic(fmt.Sprintf("describe: %v", err))
+	}
+	raw, err := ioutil.ReadAll(r)
+	if err != nil {
+		panic(fmt.Sprintf("describe: %v", err))
+	}
+	var set descpb.FileDescriptorSet
+	if err := proto.Unmarshal(raw, &set); err != nil {
+		panic(fmt.Sprintf("describe: %v", err))
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	for _, f := range set.File {
+		if _, ok := files[f.GetName()]; !ok {
+			files[f.GetName()] = f
+			index = nil
+		}
+	}
+}
+
+// Files returns the registered files, sorted by name.
+func Files() []*descpb.FileDescriptorProto {
+	mu.Lock()
+	defer mu.Unlock()
+	names := make([]string, 0, len(files))
+	for n := range files {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+	list := make([]*descpb.FileDescriptorProto, len(names))
+	for i, n := range names {
+		list[i] = files[n]
+	}
+	return list
+}
+
+// A Definition is the definition of a service, a method, a message or an
+// enum.
+type Definition struct {
+	// Name is the full name of the definition, such as "pb.Bank.Deposit".
+	Name string
+	// Descriptor is a *descpb.ServiceDescriptorProto,
+	// *descpb.MethodDescriptorProto, *descpb.DescriptorProto or
+	// *descpb.EnumDescriptorProto.
+	Descriptor proto.Message
+
+	file    *descpb.FileDescriptorProto
+	path    []int32
+	service *Definition // of a method
+}
+
+// definitions returns the definitions of the registered files by full name.
+func definitions() map[string]*Definition {
+	mu.Lock()
+	defer mu.Unlock()
+	if index != nil {
+		return index
+	}
+	index = map[string]*Definition{}
+	add := func(name string, desc proto.Message, f *descpb.FileDescriptorProto, path []int32) *Definition {
+		d := &Definition{Name: name, Descriptor: desc, file: f, path: path}
+		index[name] = d
+		return d
+	}
+	var addEnum func(prefix string, e *descpb.EnumDescriptorProto, f *descpb.FileDescriptorProto, path []int32)
+	addEnum = func(prefix string, e *descpb.EnumDescriptorProto, f *descpb.FileDescriptorProto, path []int32) {
+		add(prefix+e.GetName(), e, f, path)
+	}
+	var addMessage func(prefix string, m *descpb.DescriptorProto, f *descpb.FileDescriptorProto, path []int32)
+	addMessage = func(prefix string, m *descpb.DescriptorProto, f *descpb.FileDescriptorProto, path []int32) {
+		name := prefix + m.GetName()
+		add(name, m, f, path)
+		for i, n := range m.NestedType {
+			addMessage(name+".", n, f, appendPath(path, messageNestedPath, i))
+		}
+		for i, e := range m.EnumType {
+			addEnum(name+".", e, f, appendPath(path, messageEnumPath, i))
+		}
+	}
+	for _, f := range files {
+		prefix := ""
+		if f.GetPackage() != "" {
+			prefix = f.GetPackage() + "."
+		}
+		for i, m := range f.MessageType {
+			addMessage(prefix, m, f, []int32{fileMessagePath, int32(i)})
+		}
+		for i, e := range f.EnumType {
+			addEnum(prefix, e, f, []int32{fileEnumPath, int32(i)})
+		}
+		for i, s := range f.Service {
+			sd := add(prefix+s.GetName(), s, f, []int32{fileServicePath, int32(i)})
+			for j, m := range s.Method {
+				md := add(sd.Name+"."+m.GetName(), m, f, appendPath(sd.path, serviceMethodPath, j))
+				md.service = sd
+			}
+		}
+	}
+	return index
+}
+
+// Named returns the definition of the service, method, message or enum with
+// the full name, or nil if there's none.
+func Named(name string) *Definition {
+	return definitions()[strings.TrimPrefix(name, ".")]
+}
+
+func appendPath(path []int32, field, i int) []int32 {
+	return append(append(path[:len(path):len(path)], int32(field)), int32(i))
+}
+
+// Lookup returns the definition of name, or of service when name is empty.
+// The name is a full name, a name in the package of service, or the name of
+// a method of service, in any case. The definition of a method is followed by
+// the ones of its request and response messages.
+func Lookup(service, name string) ([]*Definition, error) {
+	defs := definitions()
+	d := defs[service]
+	if d == nil {
+		return nil, fmt.Errorf("describe: unknown service %q", service)
+	}
+	if name != "" {
+		d = lookup(defs, d, name)
+		if d == nil {
+			return nil, fmt.Errorf("describe: no service, method, message or enum named %q", name)
+		}
+	}
+	list := []*Definition{d}
+	if m, ok := d.Descriptor.(*descpb.MethodDescriptorProto); ok {
+		for _, typeName := range []string{m.GetInputType(), m.GetOutputType()} {
+			t := defs[strings.TrimPrefix(typeName, ".")]
+			if t != nil && t != list[len(list)-1] {
+				list = append(list, t)
+			}
+		}
+	}
+	return list, nil
+}
+
+func lookup(defs map[string]*Definition, service *Definition, name string) *Definition {
+	name = strings.Replace(strings.TrimPrefix(name, "."), "/", ".", -1)
+	candidates := []string{name, service.Name + "." + name}
+	if pkg := service.file.GetPackage(); pkg != "" {
+		candidates = append(candidates, pkg+"."+name)
+	}
+	for _, c := range candidates {
+		if d := defs[c]; d != nil {
+			return d
+		}
+	}
+	// the commands of the methods are named in lowercase, without underscores
+	for _, m := range service.Descriptor.(*descpb.ServiceDescriptorProto).Method {
+		if strings.EqualFold(m.GetName(), name) || strings.EqualFold(strings.Replace(m.GetName(), "_", "", -1), name) {
+			return defs[service.Name+"."+m.GetName()]
+		}
+	}
+	return nil
+}
+
+// HasBehavior reports whether the field f is annotated with the
+// (google.api.field_behavior) b.
+func HasBehavior(f *descpb.FieldDescriptorProto, b annotations.FieldBehavior) bool {
+	if f.GetOptions() == nil || !proto.HasExtension(f.GetOptions(), annotations.E_FieldBehavior) {
+		return false
+	}
+	v, err := proto.GetExtension(f.GetOptions(), annotations.E_FieldBehavior)
+	if err != nil {
+		return false
+	}
+	for _, fb := range v.([]annotations.FieldBehavior) {
+		if fb == b {
+			return true
+		}
+	}
+	return false
+}
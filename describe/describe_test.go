@@ -0,0 +1,231 @@
+package describe
+
+import (
+	"bytes"
+	"compress/gzip"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	descpb "github.com/golang/protobuf/protoc-gen-go/descriptor"
+	"google.golang.org/genproto/googleapis/api/annotations"
+)
+
+// register registers set as generated files do.
+func register(set *descpb.FileDescriptorSet) {
+	b, err := proto.Marshal(set)
+	if err != nil {
+		panic(err)
+	}
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	w.Write(b)
+	w.Close()
+	Register(buf.Bytes())
+}
+
+func field(name string, number int32, typ descpb.FieldDescriptorProto_Type, typeName string) *descpb.FieldDescriptorProto {
+	f := &descpb.FieldDescriptorProto{
+		Name:   proto.String(name),
+		Number: proto.Int32(number),
+		Label:  descpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+		Type:   typ.Enum(),
+	}
+	if typeName != "" {
+		f.TypeName = proto.String(typeName)
+	}
+	return f
+}
+
+func init() {
+	// the test files are registered once, as generated files register theirs
+	shop := &descpb.FileDescriptorProto{
+		Name:    proto.String("shop.proto"),
+		Package: proto.String("shop"),
+		Syntax:  proto.String("proto3"),
+		Service: []*descpb.ServiceDescriptorProto{{
+			Name: proto.String("Shop"),
+			Method: []*descpb.MethodDescriptorProto{{
+				Name:       proto.String("PlaceOrder"),
+				InputType:  proto.String(".shop.Order"),
+				OutputType: proto.String(".shop.Receipt"),
+			}, {
+				Name:            proto.String("Track"),
+				InputType:       proto.String(".shop.Receipt"),
+				OutputType:      proto.String(".shop.Order"),
+				ServerStreaming: proto.Bool(true),
+				Options:         &descpb.MethodOptions{Deprecated: proto.Bool(true)},
+			}},
+		}},
+		MessageType: []*descpb.DescriptorProto{{
+			Name: proto.String("Order"),
+			Field: []*descpb.FieldDescriptorProto{
+				field("id", 1, descpb.FieldDescriptorProto_TYPE_STRING, ""),
+				func() *descpb.FieldDescriptorProto {
+					f := field("items", 2, descpb.FieldDescriptorProto_TYPE_MESSAGE, ".shop.Order.ItemsEntry")
+					f.Label = descpb.FieldDescriptorProto_LABEL_REPEATED.Enum()
+					return f
+				}(),
+				func() *descpb.FieldDescriptorProto {
+					f := field("card", 3, descpb.FieldDescriptorProto_TYPE_STRING, "")
+					f.OneofIndex = proto.Int32(0)
+					return f
+				}(),
+				func() *descpb.FieldDescriptorProto {
+					f := field("voucher", 4, descpb.FieldDescriptorProto_TYPE_MESSAGE, ".shop.Order.Voucher")
+					f.OneofIndex = proto.Int32(0)
+					return f
+				}(),
+				field("placed", 5, descpb.FieldDescriptorProto_TYPE_MESSAGE, ".google.protobuf.Timestamp"),
+				field("priority", 6, descpb.FieldDescriptorProto_TYPE_ENUM, ".shop.Priority"),
+			},
+			NestedType: []*descpb.DescriptorProto{{
+				Name: proto.String("ItemsEntry"),
+				Field: []*descpb.FieldDescriptorProto{
+					field("key", 1, descpb.FieldDescriptorProto_TYPE_STRING, ""),
+					field("value", 2, descpb.FieldDescriptorProto_TYPE_INT32, ""),
+				},
+				Options: &descpb.MessageOptions{MapEntry: proto.Bool(true)},
+			}, {
+				Name:  proto.String("Voucher"),
+				Field: []*descpb.FieldDescriptorProto{field("code", 1, descpb.FieldDescriptorProto_TYPE_STRING, "")},
+			}},
+			OneofDecl:     []*descpb.OneofDescriptorProto{{Name: proto.String("payment")}},
+			ReservedRange: []*descpb.DescriptorProto_ReservedRange{{Start: proto.Int32(7), End: proto.Int32(8)}, {Start: proto.Int32(10), End: proto.Int32(13)}},
+			ReservedName:  []string{"coupon"},
+		}, {
+			Name:  proto.String("Receipt"),
+			Field: []*descpb.FieldDescriptorProto{field("order_id", 1, descpb.FieldDescriptorProto_TYPE_STRING, "")},
+		}},
+		EnumType: []*descpb.EnumDescriptorProto{{
+			Name: proto.String("Priority"),
+			Value: []*descpb.EnumValueDescriptorProto{
+				{Name: proto.String("STANDARD"), Number: proto.Int32(0)},
+				{Name: proto.String("EXPRESS"), Number: proto.Int32(1)},
+			},
+		}},
+		SourceCodeInfo: &descpb.SourceCodeInfo{Location: []*descpb.SourceCodeInfo_Location{
+			{Path: []int32{6, 0}, LeadingComments: proto.String(" Shop sells things.\n")},
+			{Path: []int32{6, 0, 2, 0}, LeadingComments: proto.String(" PlaceOrder places an order,\n and returns its receipt.\n")},
+			{Path: []int32{4, 0, 2, 0}, TrailingComments: proto.String(" unique\n")},
+			{Path: []int32{4, 0, 3, 1}, LeadingComments: proto.String(" A Voucher pays for orders.\n")},
+		}},
+	}
+	register(&descpb.FileDescriptorSet{File: []*descpb.FileDescriptorProto{shop}})
+}
+
+func printed(t *testing.T, service, name string) string {
+	defs, err := Lookup(service, name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var b bytes.Buffer
+	if err := Print(&b, defs); err != nil {
+		t.Fatal(err)
+	}
+	return b.String()
+}
+
+func TestPrintService(t *testing.T) {
+	want := `// Shop sells things.
+service Shop {
+  // PlaceOrder places an order,
+  // and returns its receipt.
+  rpc PlaceOrder(Order) returns (Receipt);
+  rpc Track(Receipt) returns (stream Order) { option deprecated = true; }
+}
+`
+	if got := printed(t, "shop.Shop", ""); got != want {
+		t.Errorf("got\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestPrintMethod(t *testing.T) {
+	want := `// Shop sells things.
+service Shop {
+  // PlaceOrder places an order,
+  // and returns its receipt.
+  rpc PlaceOrder(Order) returns (Receipt);
+}
+
+message Order {
+  string id = 1; // unique
+  map<string, int32> items = 2;
+  oneof payment {
+    string card = 3;
+    Order.Voucher voucher = 4;
+  }
+  google.protobuf.Timestamp placed = 5;
+  Priority priority = 6;
+  // A Voucher pays for orders.
+  message Voucher {
+    string code = 1;
+  }
+  reserved 7, 10 to 12;
+  reserved "coupon";
+}
+
+message Receipt {
+  string order_id = 1;
+}
+`
+	for _, name := range []string{"placeorder", "PlaceOrder", "shop.Shop.PlaceOrder", "Shop/PlaceOrder"} {
+		if got := printed(t, "shop.Shop", name); got != want {
+			t.Errorf("%s: got\n%s\nwant\n%s", name, got, want)
+		}
+	}
+}
+
+func TestLookup(t *testing.T) {
+	for _, tc := range []struct {
+		name, want string
+	}{
+		{"Priority", "shop.Priority"},
+		{"shop.Priority", "shop.Priority"},
+		{"Order.Voucher", "shop.Order.Voucher"},
+		{"track", "shop.Shop.Track"},
+	} {
+		defs, err := Lookup("shop.Shop", tc.name)
+		if err != nil {
+			t.Errorf("%s: %v", tc.name, err)
+			continue
+		}
+		if defs[0].Name != tc.want {
+			t.Errorf("%s: got %s, want %s", tc.name, defs[0].Name, tc.want)
+		}
+	}
+	if _, err := Lookup("shop.Shop", "Cart"); err == nil {
+		t.Error("got no error for an unknown name")
+	}
+	if _, err := Lookup("shop.Cart", ""); err == nil {
+		t.Error("got no error for an unknown service")
+	}
+}
+
+func TestRegisterKeepsFiles(t *testing.T) {
+	register(&descpb.FileDescriptorSet{File: []*descpb.FileDescriptorProto{{
+		Name:    proto.String("shop.proto"),
+		Package: proto.String("other"),
+	}}})
+	files := Files()
+	if len(files) != 1 || files[0].GetPackage() != "shop" {
+		t.Errorf("got %v, want the first shop.proto registered", files)
+	}
+}
+
+func TestHasBehavior(t *testing.T) {
+	f := field("id", 1, descpb.FieldDescriptorProto_TYPE_STRING, "")
+	if HasBehavior(f, annotations.FieldBehavior_REQUIRED) {
+		t.Error("a field without options has no behavior")
+	}
+	f.Options = &descpb.FieldOptions{}
+	behaviors := []annotations.FieldBehavior{annotations.FieldBehavior_IMMUTABLE, annotations.FieldBehavior_REQUIRED}
+	if err := proto.SetExtension(f.Options, annotations.E_FieldBehavior, behaviors); err != nil {
+		t.Fatal(err)
+	}
+	if !HasBehavior(f, annotations.FieldBehavior_REQUIRED) || !HasBehavior(f, annotations.FieldBehavior_IMMUTABLE) {
+		t.Error("missing behavior")
+	}
+	if HasBehavior(f, annotations.FieldBehavior_OUTPUT_ONLY) {
+		t.Error("unexpected OUTPUT_ONLY behavior")
+	}
+}
@@ -0,0 +1,633 @@
+package describe
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	descpb "github.com/golang/protobuf/protoc-gen-go/descriptor"
+)
+
+// AnyResolver resolves the type URLs of Any values for jsonpb: to the
+// generated type of the message when it's linked in, or else to a dynamic
+// message built from the registered descriptors, which marshals to and from
+// the same proto3 JSON mapping.
+var AnyResolver jsonpb.AnyResolver = anyResolver{}
+
+type anyResolver struct{}
+
+func (anyResolver) Resolve(typeURL string) (proto.Message, error) {
+	return Resolve(typeURL)
+}
+
+// Resolve returns a new message of the type named by the Any type URL, such as
+// "type.googleapis.com/pb.DepositRequest", as AnyResolver does.
+func Resolve(typeURL string) (proto.Message, error) {
+	name := strings.TrimPrefix(typeURL[strings.LastIndex(typeURL, "/")+1:], ".")
+	if t := proto.MessageType(name); t != nil {
+		return reflect.New(t.Elem()).Interface().(proto.Message), nil
+	}
+	if d := definitions()[name]; d != nil {
+		if _, ok := d.Descriptor.(*descpb.DescriptorProto); ok {
+			return &dynamicMessage{def: d}, nil
+		}
+	}
+	return nil, fmt.Errorf("unknown message type %q", name)
+}
+
+// IsDynamic reports whether m is a dynamic message returned by Resolve, with
+// no generated type.
+func IsDynamic(m proto.Message) bool {
+	_, ok := m.(*dynamicMessage)
+	return ok
+}
+
+// A dynamicMessage is a message of a type known from its descriptor only. It
+// keeps its wire encoding, and converts it to and from JSON with the
+// descriptor.
+type dynamicMessage struct {
+	def *Definition
+	raw []byte
+}
+
+func (m *dynamicMessage) Reset()        { m.raw = nil }
+func (m *dynamicMessage) ProtoMessage() {}
+
+// XXX_MessageName returns the full name of the type of m, for
+// proto.MessageName.
+func (m *dynamicMessage) XXX_MessageName() string { return m.def.Name }
+
+func (m *dynamicMessage) String() string {
+	s, err := (&jsonpb.Marshaler{AnyResolver: AnyResolver}).MarshalToString(m)
+	if err != nil {
+		return fmt.Sprintf("%s: %v", m.def.Name, err)
+	}
+	return s
+}
+
+// Marshal implements proto.Marshaler.
+func (m *dynamicMessage) Marshal() ([]byte, error) {
+	return m.raw, nil
+}
+
+// Unmarshal implements proto.Unmarshaler.
+func (m *dynamicMessage) Unmarshal(b []byte) error {
+	m.raw = append([]byte(nil), b...)
+	return nil
+}
+
+func (m *dynamicMessage) descriptor() *descpb.DescriptorProto {
+	return m.def.Descriptor.(*descpb.DescriptorProto)
+}
+
+// The wire types of the encoding.
+const (
+	wireVarint  = 0
+	wireFixed64 = 1
+	wireBytes   = 2
+	wireFixed32 = 5
+)
+
+func wireType(f *descpb.FieldDescriptorProto) int {
+	switch f.GetType() {
+	case descpb.FieldDescriptorProto_TYPE_FIXED64, descpb.FieldDescriptorProto_TYPE_SFIXED64, descpb.FieldDescriptorProto_TYPE_DOUBLE:
+		return wireFixed64
+	case descpb.FieldDescriptorProto_TYPE_FIXED32, descpb.FieldDescriptorProto_TYPE_SFIXED32, descpb.FieldDescriptorProto_TYPE_FLOAT:
+		return wireFixed32
+	case descpb.FieldDescriptorProto_TYPE_STRING, descpb.FieldDescriptorProto_TYPE_BYTES, descpb.FieldDescriptorProto_TYPE_MESSAGE:
+		return wireBytes
+	}
+	return wireVarint
+}
+
+// jsonName returns the name of f in JSON, its original one with orig.
+func jsonName(f *descpb.FieldDescriptorProto, orig bool) string {
+	if orig || f.GetJsonName() == "" {
+		return f.GetName()
+	}
+	return f.GetJsonName()
+}
+
+func (m *dynamicMessage) mapEntry(f *descpb.FieldDescriptorProto) *descpb.DescriptorProto {
+	if d := definitions()[strings.TrimPrefix(f.GetTypeName(), ".")]; d != nil {
+		if entry, ok := d.Descriptor.(*descpb.DescriptorProto); ok && entry.GetOptions().GetMapEntry() {
+			return entry
+		}
+	}
+	return nil
+}
+
+// MarshalJSONPB implements jsonpb.JSONPBMarshaler.
+func (m *dynamicMessage) MarshalJSONPB(jm *jsonpb.Marshaler) ([]byte, error) {
+	values := map[int32][]json.RawMessage{}
+	entries := map[int32][][2]json.RawMessage{} // of maps
+	desc := m.descriptor()
+	fields := map[int32]*descpb.FieldDescriptorProto{}
+	for _, f := range desc.Field {
+		fields[f.GetNumber()] = f
+	}
+	b := m.raw
+	for len(b) > 0 {
+		key, n := proto.DecodeVarint(b)
+		if n == 0 {
+			return nil, fmt.Errorf("%s: bad wire encoding", m.def.Name)
+		}
+		b = b[n:]
+		num, wt := int32(key>>3), int(key&7)
+		v, rest, err := readValue(b, wt)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", m.def.Name, err)
+		}
+		b = rest
+		f := fields[num]
+		if f == nil {
+			continue // unknown fields are dropped, as jsonpb does
+		}
+		if wt == wireBytes && wireType(f) != wireBytes {
+			// packed scalars
+			for len(v) > 0 {
+				var item []byte
+				if item, v, err = readValue(v, wireType(f)); err != nil {
+					return nil, fmt.Errorf("%s.%s: %v", m.def.Name, f.GetName(), err)
+				}
+				js, err := m.scalarJSON(jm, f, item, wireType(f))
+				if err != nil {
+					return nil, err
+				}
+				values[num] = append(values[num], js)
+			}
+			continue
+		}
+		if entry := m.mapEntry(f); entry != nil {
+			kv, err := m.entryJSON(jm, entry, v)
+			if err != nil {
+				return nil, fmt.Errorf("%s.%s: %v", m.def.Name, f.GetName(), err)
+			}
+			entries[num] = append(entries[num], kv)
+			continue
+		}
+		js, err := m.valueJSON(jm, f, v, wt)
+		if err != nil {
+			return nil, err
+		}
+		values[num] = append(values[num], js)
+	}
+
+	var out bytes.Buffer
+	out.WriteByte('{')
+	first := true
+	for _, f := range desc.Field {
+		vs, kvs := values[f.GetNumber()], entries[f.GetNumber()]
+		if len(vs) == 0 && len(kvs) == 0 {
+			continue
+		}
+		if !first {
+			out.WriteByte(',')
+		}
+		first = false
+		name, _ := json.Marshal(jsonName(f, jm.OrigName))
+		out.Write(name)
+		out.WriteByte(':')
+		switch {
+		case len(kvs) > 0:
+			out.WriteByte('{')
+			for i, kv := range kvs {
+				if i > 0 {
+					out.WriteByte(',')
+				}
+				out.Write(kv[0])
+				out.WriteByte(':')
+				out.Write(kv[1])
+			}
+			out.WriteByte('}')
+		case f.GetLabel() != descpb.FieldDescriptorProto_LABEL_REPEATED:
+			out.Write(vs[len(vs)-1])
+		default:
+			out.WriteByte('[')
+			for i, v := range vs {
+				if i > 0 {
+					out.WriteByte(',')
+				}
+				out.Write(v)
+			}
+			out.WriteByte(']')
+		}
+	}
+	out.WriteByte('}')
+	return out.Bytes(), nil
+}
+
+// entryJSON returns the JSON of the key, as a string, and of the value of
+// the map entry v.
+func (m *dynamicMessage) entryJSON(jm *jsonpb.Marshaler, entry *descpb.DescriptorProto, v []byte) ([2]json.RawMessage, error) {
+	e := &dynamicMessage{def: &Definition{Name: m.def.Name + "." + entry.GetName(), Descriptor: entry, file: m.def.file}, raw: v}
+	b, err := e.MarshalJSONPB(&jsonpb.Marshaler{OrigName: true, EnumsAsInts: jm.EnumsAsInts, AnyResolver: jm.AnyResolver})
+	if err != nil {
+		return [2]json.RawMessage{}, err
+	}
+	var kv struct {
+		Key   json.RawMessage `json:"key"`
+		Value json.RawMessage `json:"value"`
+	}
+	if err := json.Unmarshal(b, &kv); err != nil {
+		return [2]json.RawMessage{}, err
+	}
+	// zero keys and values may be left out
+	if len(kv.Key) == 0 {
+		kv.Key = zeroJSON(entry.Field[0])
+	}
+	if kv.Key[0] != '"' {
+		kv.Key, _ = json.Marshal(string(kv.Key))
+	}
+	if len(kv.Value) == 0 {
+		kv.Value = zeroJSON(entry.Field[1])
+	}
+	return [2]json.RawMessage{kv.Key, kv.Value}, nil
+}
+
+// zeroJSON returns the JSON of the zero value of the field f.
+func zeroJSON(f *descpb.FieldDescriptorProto) json.RawMessage {
+	switch f.GetType() {
+	case descpb.FieldDescriptorProto_TYPE_STRING, descpb.FieldDescriptorProto_TYPE_BYTES:
+		return json.RawMessage(`""`)
+	case descpb.FieldDescriptorProto_TYPE_INT64, descpb.FieldDescriptorProto_TYPE_SINT64, descpb.FieldDescriptorProto_TYPE_SFIXED64,
+		descpb.FieldDescriptorProto_TYPE_UINT64, descpb.FieldDescriptorProto_TYPE_FIXED64:
+		return json.RawMessage(`"0"`)
+	case descpb.FieldDescriptorProto_TYPE_BOOL:
+		return json.RawMessage("false")
+	case descpb.FieldDescriptorProto_TYPE_ENUM:
+		if name := enumName(f.GetTypeName(), 0); name != "" {
+			b, _ := json.Marshal(name)
+			return b
+		}
+	case descpb.FieldDescriptorProto_TYPE_MESSAGE:
+		return json.RawMessage("{}")
+	}
+	return json.RawMessage("0")
+}
+
+// readValue reads a value of wire type wt from b, and returns its encoding
+// and the rest of b.
+func readValue(b []byte, wt int) (v, rest []byte, err error) {
+	switch wt {
+	case wireVarint:
+		if _, n := proto.DecodeVarint(b); n > 0 {
+			return b[:n], b[n:], nil
+		}
+	case wireFixed64:
+		if len(b) >= 8 {
+			return b[:8], b[8:], nil
+		}
+	case wireFixed32:
+		if len(b) >= 4 {
+			return b[:4], b[4:], nil
+		}
+	case wireBytes:
+		if l, n := proto.DecodeVarint(b); n > 0 && uint64(len(b)-n) >= l {
+			return b[n : n+int(l)], b[n+int(l):], nil
+		}
+	default:
+		return nil, nil, fmt.Errorf("unsupported wire type %d", wt)
+	}
+	return nil, nil, errors.New("bad wire encoding")
+}
+
+// valueJSON returns the JSON of the value v of the field f, read with wire
+// type wt.
+func (m *dynamicMessage) valueJSON(jm *jsonpb.Marshaler, f *descpb.FieldDescriptorProto, v []byte, wt int) (json.RawMessage, error) {
+	if wt != wireType(f) {
+		return nil, fmt.Errorf("%s.%s: wire type %d, want %d", m.def.Name, f.GetName(), wt, wireType(f))
+	}
+	if f.GetType() != descpb.FieldDescriptorProto_TYPE_MESSAGE {
+		return m.scalarJSON(jm, f, v, wt)
+	}
+	msg, err := Resolve(f.GetTypeName())
+	if err != nil {
+		return nil, fmt.Errorf("%s.%s: %v", m.def.Name, f.GetName(), err)
+	}
+	if err := proto.Unmarshal(v, msg); err != nil {
+		return nil, fmt.Errorf("%s.%s: %v", m.def.Name, f.GetName(), err)
+	}
+	nested := *jm
+	nested.Indent = ""
+	s, err := nested.MarshalToString(msg)
+	return json.RawMessage(s), err
+}
+
+func (m *dynamicMessage) scalarJSON(jm *jsonpb.Marshaler, f *descpb.FieldDescriptorProto, v []byte, wt int) (json.RawMessage, error) {
+	var x uint64
+	switch wt {
+	case wireVarint:
+		x, _ = proto.DecodeVarint(v)
+	case wireFixed64:
+		x = binary.LittleEndian.Uint64(v)
+	case wireFixed32:
+		x = uint64(binary.LittleEndian.Uint32(v))
+	}
+	switch f.GetType() {
+	case descpb.FieldDescriptorProto_TYPE_INT32, descpb.FieldDescriptorProto_TYPE_SFIXED32:
+		return json.Marshal(int32(x))
+	case descpb.FieldDescriptorProto_TYPE_SINT32:
+		return json.Marshal(int32(x>>1) ^ -int32(x&1))
+	case descpb.FieldDescriptorProto_TYPE_UINT32, descpb.FieldDescriptorProto_TYPE_FIXED32:
+		return json.Marshal(uint32(x))
+	case descpb.FieldDescriptorProto_TYPE_INT64, descpb.FieldDescriptorProto_TYPE_SFIXED64:
+		return json.Marshal(strconv.FormatInt(int64(x), 10))
+	case descpb.FieldDescriptorProto_TYPE_SINT64:
+		return json.Marshal(strconv.FormatInt(int64(x>>1)^-int64(x&1), 10))
+	case descpb.FieldDescriptorProto_TYPE_UINT64, descpb.FieldDescriptorProto_TYPE_FIXED64:
+		return json.Marshal(strconv.FormatUint(x, 10))
+	case descpb.FieldDescriptorProto_TYPE_FLOAT:
+		return floatJSON(float64(math.Float32frombits(uint32(x))), 32)
+	case descpb.FieldDescriptorProto_TYPE_DOUBLE:
+		return floatJSON(math.Float64frombits(x), 64)
+	case descpb.FieldDescriptorProto_TYPE_BOOL:
+		return json.Marshal(x != 0)
+	case descpb.FieldDescriptorProto_TYPE_STRING:
+		return json.Marshal(string(v))
+	case descpb.FieldDescriptorProto_TYPE_BYTES:
+		return json.Marshal(base64.StdEncoding.EncodeToString(v))
+	case descpb.FieldDescriptorProto_TYPE_ENUM:
+		if !jm.EnumsAsInts {
+			if name := enumName(f.GetTypeName(), int32(x)); name != "" {
+				return json.Marshal(name)
+			}
+		}
+		return json.Marshal(int32(x))
+	}
+	return nil, fmt.Errorf("%s.%s: unsupported type %v", m.def.Name, f.GetName(), f.GetType())
+}
+
+func floatJSON(f float64, bits int) (json.RawMessage, error) {
+	switch {
+	case math.IsNaN(f):
+		return json.RawMessage(`"NaN"`), nil
+	case math.IsInf(f, 1):
+		return json.RawMessage(`"Infinity"`), nil
+	case math.IsInf(f, -1):
+		return json.RawMessage(`"-Infinity"`), nil
+	}
+	return json.RawMessage(strconv.FormatFloat(f, 'g', -1, bits)), nil
+}
+
+func enumDescriptor(typeName string) *descpb.EnumDescriptorProto {
+	if d := definitions()[strings.TrimPrefix(typeName, ".")]; d != nil {
+		e, _ := d.Descriptor.(*descpb.EnumDescriptorProto)
+		return e
+	}
+	return nil
+}
+
+func enumName(typeName string, n int32) string {
+	for _, v := range enumDescriptor(typeName).GetValue() {
+		if v.GetNumber() == n {
+			return v.GetName()
+		}
+	}
+	return ""
+}
+
+// UnmarshalJSONPB implements jsonpb.JSONPBUnmarshaler. Like the other codecs,
+// it accepts numbers and booleans quoted, and a single value for a repeated
+// field.
+func (m *dynamicMessage) UnmarshalJSONPB(u *jsonpb.Unmarshaler, b []byte) error {
+	var members map[string]json.RawMessage
+	if err := json.Unmarshal(b, &members); err != nil {
+		return fmt.Errorf("%s: %v", m.def.Name, err)
+	}
+	desc := m.descriptor()
+	var raw []byte
+	for _, f := range desc.Field {
+		v, ok := members[f.GetJsonName()]
+		if !ok {
+			v, ok = members[f.GetName()]
+		}
+		delete(members, f.GetJsonName())
+		delete(members, f.GetName())
+		if !ok || string(v) == "null" {
+			continue
+		}
+		var err error
+		if raw, err = m.appendField(u, raw, f, v); err != nil {
+			return fmt.Errorf("%s.%s: %v", m.def.Name, f.GetName(), err)
+		}
+	}
+	if len(members) > 0 && !u.AllowUnknownFields {
+		for k := range members {
+			return fmt.Errorf("unknown field %q in %s", k, m.def.Name)
+		}
+	}
+	m.raw = raw
+	return nil
+}
+
+func (m *dynamicMessage) appendField(u *jsonpb.Unmarshaler, b []byte, f *descpb.FieldDescriptorProto, v json.RawMessage) ([]byte, error) {
+	if f.GetLabel() != descpb.FieldDescriptorProto_LABEL_REPEATED {
+		return m.appendValue(u, b, f, v)
+	}
+	if entry := m.mapEntry(f); entry != nil {
+		var entries map[string]json.RawMessage
+		if err := json.Unmarshal(v, &entries); err != nil {
+			return nil, err
+		}
+		e := &dynamicMessage{def: &Definition{Name: m.def.Name + "." + entry.GetName(), Descriptor: entry, file: m.def.file}}
+		keys := make([]string, 0, len(entries))
+		for k := range entries {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			ev := entries[k]
+			key, _ := json.Marshal(k)
+			if err := e.UnmarshalJSONPB(u, []byte(fmt.Sprintf(`{"key":%s,"value":%s}`, key, ev))); err != nil {
+				return nil, fmt.Errorf("%s: %v", k, err)
+			}
+			b = appendTag(b, f.GetNumber(), wireBytes)
+			b = append(b, proto.EncodeVarint(uint64(len(e.raw)))...)
+			b = append(b, e.raw...)
+		}
+		return b, nil
+	}
+	var items []json.RawMessage
+	if err := json.Unmarshal(v, &items); err != nil {
+		items = []json.RawMessage{v}
+	}
+	if wireType(f) == wireBytes || !m.packed(f) {
+		for _, item := range items {
+			var err error
+			if b, err = m.appendValue(u, b, f, item); err != nil {
+				return nil, err
+			}
+		}
+		return b, nil
+	}
+	var packed []byte
+	for _, item := range items {
+		var err error
+		if packed, err = m.appendScalar(packed, f, item); err != nil {
+			return nil, err
+		}
+	}
+	b = appendTag(b, f.GetNumber(), wireBytes)
+	b = append(b, proto.EncodeVarint(uint64(len(packed)))...)
+	return append(b, packed...), nil
+}
+
+// packed reports whether the repeated scalar field f is packed: by default
+// in proto3, and with the packed option in proto2.
+func (m *dynamicMessage) packed(f *descpb.FieldDescriptorProto) bool {
+	if f.GetOptions() != nil && f.GetOptions().Packed != nil {
+		return f.GetOptions().GetPacked()
+	}
+	return m.def.file.GetSyntax() == "proto3"
+}
+
+func appendTag(b []byte, num int32, wt int) []byte {
+	return append(b, proto.EncodeVarint(uint64(num)<<3|uint64(wt))...)
+}
+
+func (m *dynamicMessage) appendValue(u *jsonpb.Unmarshaler, b []byte, f *descpb.FieldDescriptorProto, v json.RawMessage) ([]byte, error) {
+	if f.GetType() != descpb.FieldDescriptorProto_TYPE_MESSAGE {
+		b = appendTag(b, f.GetNumber(), wireType(f))
+		return m.appendScalar(b, f, v)
+	}
+	msg, err := Resolve(f.GetTypeName())
+	if err != nil {
+		return nil, err
+	}
+	if err := u.Unmarshal(bytes.NewReader(v), msg); err != nil {
+		return nil, err
+	}
+	enc, err := proto.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	b = appendTag(b, f.GetNumber(), wireBytes)
+	b = append(b, proto.EncodeVarint(uint64(len(enc)))...)
+	return append(b, enc...), nil
+}
+
+// appendScalar appends the encoding of the scalar v of the field f, without
+// its tag.
+func (m *dynamicMessage) appendScalar(b []byte, f *descpb.FieldDescriptorProto, v json.RawMessage) ([]byte, error) {
+	var s string
+	if err := json.Unmarshal(v, &s); err != nil {
+		s = string(v) // a number or a boolean
+	}
+	switch f.GetType() {
+	case descpb.FieldDescriptorProto_TYPE_STRING:
+		if err := json.Unmarshal(v, &s); err != nil {
+			return nil, err
+		}
+		b = append(b, proto.EncodeVarint(uint64(len(s)))...)
+		return append(b, s...), nil
+	case descpb.FieldDescriptorProto_TYPE_BYTES:
+		d, err := base64.StdEncoding.DecodeString(s)
+		if err != nil {
+			if d, err = base64.URLEncoding.DecodeString(s); err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, proto.EncodeVarint(uint64(len(d)))...)
+		return append(b, d...), nil
+	case descpb.FieldDescriptorProto_TYPE_BOOL:
+		x, err := strconv.ParseBool(s)
+		if err != nil {
+			return nil, err
+		}
+		if x {
+			return append(b, 1), nil
+		}
+		return append(b, 0), nil
+	case descpb.FieldDescriptorProto_TYPE_ENUM:
+		for _, ev := range enumDescriptor(f.GetTypeName()).GetValue() {
+			if ev.GetName() == s {
+				return append(b, proto.EncodeVarint(uint64(ev.GetNumber()))...), nil
+			}
+		}
+		n, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("unknown value %q of enum %s", s, strings.TrimPrefix(f.GetTypeName(), "."))
+		}
+		return append(b, proto.EncodeVarint(uint64(n))...), nil
+	case descpb.FieldDescriptorProto_TYPE_FLOAT, descpb.FieldDescriptorProto_TYPE_DOUBLE:
+		var x float64
+		switch s {
+		case "NaN":
+			x = math.NaN()
+		case "Infinity":
+			x = math.Inf(1)
+		case "-Infinity":
+			x = math.Inf(-1)
+		default:
+			var err error
+			if x, err = strconv.ParseFloat(s, 64); err != nil {
+				return nil, err
+			}
+		}
+		if f.GetType() == descpb.FieldDescriptorProto_TYPE_FLOAT {
+			return appendFixed32(b, math.Float32bits(float32(x))), nil
+		}
+		return appendFixed64(b, math.Float64bits(x)), nil
+	}
+
+	// integers, also accepted in exponent form as jsonpb does
+	var x uint64
+	switch f.GetType() {
+	case descpb.FieldDescriptorProto_TYPE_UINT32, descpb.FieldDescriptorProto_TYPE_FIXED32,
+		descpb.FieldDescriptorProto_TYPE_UINT64, descpb.FieldDescriptorProto_TYPE_FIXED64:
+		n, err := strconv.ParseUint(s, 10, 64)
+		if err != nil {
+			fl, ferr := strconv.ParseFloat(s, 64)
+			if ferr != nil || fl < 0 || fl != math.Trunc(fl) {
+				return nil, err
+			}
+			n = uint64(fl)
+		}
+		x = n
+	default:
+		n, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			fl, ferr := strconv.ParseFloat(s, 64)
+			if ferr != nil || fl != math.Trunc(fl) {
+				return nil, err
+			}
+			n = int64(fl)
+		}
+		switch f.GetType() {
+		case descpb.FieldDescriptorProto_TYPE_SINT32, descpb.FieldDescriptorProto_TYPE_SINT64:
+			x = uint64(n<<1) ^ uint64(n>>63)
+		default:
+			x = uint64(n)
+		}
+	}
+	switch wireType(f) {
+	case wireFixed32:
+		return appendFixed32(b, uint32(x)), nil
+	case wireFixed64:
+		return appendFixed64(b, x), nil
+	}
+	return append(b, proto.EncodeVarint(x)...), nil
+}
+
+func appendFixed32(b []byte, x uint32) []byte {
+	var buf [4]byte
+	binary.LittleEndian.PutUint32(buf[:], x)
+	return append(b, buf[:]...)
+}
+
+func appendFixed64(b []byte, x uint64) []byte {
+	var buf [8]byte
+	binary.LittleEndian.PutUint64(buf[:], x)
+	return append(b, buf[:]...)
+}
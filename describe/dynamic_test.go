@@ -0,0 +1,71 @@
+package describe
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	anypb "github.com/golang/protobuf/ptypes/any"
+	durpb "github.com/golang/protobuf/ptypes/duration"
+	_ "github.com/golang/protobuf/ptypes/timestamp" // a field of shop.Order
+)
+
+func TestResolve(t *testing.T) {
+	m, err := Resolve("type.googleapis.com/google.protobuf.Duration")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := m.(*durpb.Duration); !ok {
+		t.Errorf("got %T, want the generated type", m)
+	}
+	if m, err = Resolve("type.googleapis.com/shop.Order"); err != nil || !IsDynamic(m) {
+		t.Errorf("got %T, %v, want a dynamic message", m, err)
+	}
+	if _, err = Resolve("type.googleapis.com/shop.Cart"); err == nil {
+		t.Error("got no error for an unknown type")
+	}
+}
+
+func TestDynamicAny(t *testing.T) {
+	in := `{"@type":"type.googleapis.com/shop.Order","id":"42","items":{"apple":3,"pear":"1"},"voucher":{"code":"FREE"},"placed":"2020-01-01T00:00:00Z","priority":"EXPRESS"}`
+	var a anypb.Any
+	if err := (&jsonpb.Unmarshaler{AnyResolver: AnyResolver}).Unmarshal(strings.NewReader(in), &a); err != nil {
+		t.Fatal(err)
+	}
+	if a.TypeUrl != "type.googleapis.com/shop.Order" || len(a.Value) == 0 {
+		t.Fatalf("got %v", &a)
+	}
+
+	// decode the wire encoding with the generated types of the fields
+	var b proto.Buffer
+	b.SetBuf(a.Value)
+	for _, want := range []uint64{1<<3 | 2, 2<<3 | 2, 2<<3 | 2, 4<<3 | 2, 5<<3 | 2, 6 << 3} {
+		key, err := b.DecodeVarint()
+		if err != nil || key != want {
+			t.Fatalf("got key %d (%v), want %d", key, err, want)
+		}
+		if key&7 == 2 {
+			b.DecodeRawBytes(false)
+		} else {
+			b.DecodeVarint()
+		}
+	}
+
+	out, err := (&jsonpb.Marshaler{AnyResolver: AnyResolver}).MarshalToString(&a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"@type":"type.googleapis.com/shop.Order","id":"42","items":{"apple":3,"pear":1},"placed":"2020-01-01T00:00:00Z","priority":"EXPRESS","voucher":{"code":"FREE"}}`
+	if out != want {
+		t.Errorf("got  %s\nwant %s", out, want)
+	}
+}
+
+func TestDynamicUnknownField(t *testing.T) {
+	var a anypb.Any
+	err := (&jsonpb.Unmarshaler{AnyResolver: AnyResolver}).Unmarshal(strings.NewReader(`{"@type":"type.googleapis.com/shop.Receipt","total":1}`), &a)
+	if err == nil || !strings.Contains(err.Error(), `unknown field "total"`) {
+		t.Errorf("got %v, want an unknown field", err)
+	}
+}
@@ -0,0 +1,249 @@
+package describe
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	descpb "github.com/golang/protobuf/protoc-gen-go/descriptor"
+)
+
+// Print writes defs to w as proto source, with their comments, a blank line
+// apart. A method is written in its service.
+func Print(w io.Writer, defs []*Definition) error {
+	bw := bufio.NewWriter(w)
+	for i, d := range defs {
+		if i > 0 {
+			bw.WriteString("\n")
+		}
+		p := &printer{w: bw, file: d.file, comments: comments(d.file)}
+		switch desc := d.Descriptor.(type) {
+		case *descpb.ServiceDescriptorProto:
+			p.service(desc, d.path, desc.Method)
+		case *descpb.MethodDescriptorProto:
+			s := d.service
+			p.service(s.Descriptor.(*descpb.ServiceDescriptorProto), s.path, []*descpb.MethodDescriptorProto{desc})
+		case *descpb.DescriptorProto:
+			p.message(desc, d.path)
+		case *descpb.EnumDescriptorProto:
+			p.enum(desc, d.path)
+		}
+	}
+	return bw.Flush()
+}
+
+// comments returns the source locations with comments of f, by path.
+func comments(f *descpb.FileDescriptorProto) map[string]*descpb.SourceCodeInfo_Location {
+	m := map[string]*descpb.SourceCodeInfo_Location{}
+	for _, loc := range f.GetSourceCodeInfo().GetLocation() {
+		if loc.LeadingComments != nil || loc.TrailingComments != nil {
+			m[pathKey(loc.Path)] = loc
+		}
+	}
+	return m
+}
+
+func pathKey(path []int32) string {
+	s := make([]string, len(path))
+	for i, n := range path {
+		s[i] = strconv.Itoa(int(n))
+	}
+	return strings.Join(s, ",")
+}
+
+type printer struct {
+	w        *bufio.Writer
+	file     *descpb.FileDescriptorProto
+	comments map[string]*descpb.SourceCodeInfo_Location
+	indent   int
+}
+
+// line writes the declaration decl at path, preceded by its leading comments
+// and followed by its trailing ones. Declarations without a path have none.
+func (p *printer) line(path []int32, decl string) {
+	indent := strings.Repeat("  ", p.indent)
+	var loc *descpb.SourceCodeInfo_Location
+	if path != nil {
+		loc = p.comments[pathKey(path)]
+	}
+	for _, l := range commentLines(loc.GetLeadingComments()) {
+		p.w.WriteString(strings.TrimRight(indent+"//"+l, " ") + "\n")
+	}
+	p.w.WriteString(indent + decl)
+	if trailing := commentLines(loc.GetTrailingComments()); len(trailing) > 0 {
+		p.w.WriteString(" //" + strings.Join(trailing, " "))
+	}
+	p.w.WriteString("\n")
+}
+
+func commentLines(text string) []string {
+	text = strings.TrimSuffix(text, "\n")
+	if text == "" {
+		return nil
+	}
+	return strings.Split(text, "\n")
+}
+
+func (p *printer) close() {
+	p.indent--
+	p.w.WriteString(strings.Repeat("  ", p.indent) + "}\n")
+}
+
+func (p *printer) service(s *descpb.ServiceDescriptorProto, path []int32, methods []*descpb.MethodDescriptorProto) {
+	p.line(path, "service "+s.GetName()+" {")
+	p.indent++
+	for _, m := range methods {
+		for i, sm := range s.Method {
+			if sm != m {
+				continue
+			}
+			in, out := p.typeName(m.GetInputType()), p.typeName(m.GetOutputType())
+			if m.GetClientStreaming() {
+				in = "stream " + in
+			}
+			if m.GetServerStreaming() {
+				out = "stream " + out
+			}
+			decl := fmt.Sprintf("rpc %s(%s) returns (%s);", m.GetName(), in, out)
+			if m.GetOptions().GetDeprecated() {
+				decl = fmt.Sprintf("rpc %s(%s) returns (%s) { option deprecated = true; }", m.GetName(), in, out)
+			}
+			p.line(appendPath(path, serviceMethodPath, i), decl)
+		}
+	}
+	p.close()
+}
+
+func (p *printer) message(m *descpb.DescriptorProto, path []int32) {
+	p.line(path, "message "+m.GetName()+" {")
+	p.indent++
+	printed := map[int32]bool{} // oneofs
+	for i, f := range m.Field {
+		if f.OneofIndex == nil {
+			p.field(m, f, appendPath(path, messageFieldPath, i))
+			continue
+		}
+		o := f.GetOneofIndex()
+		if printed[o] {
+			continue
+		}
+		printed[o] = true
+		p.line(appendPath(path, messageOneofPath, int(o)), "oneof "+m.OneofDecl[o].GetName()+" {")
+		p.indent++
+		for j, of := range m.Field {
+			if of.OneofIndex != nil && of.GetOneofIndex() == o {
+				p.field(m, of, appendPath(path, messageFieldPath, j))
+			}
+		}
+		p.close()
+	}
+	for i, n := range m.NestedType {
+		if !n.GetOptions().GetMapEntry() {
+			p.message(n, appendPath(path, messageNestedPath, i))
+		}
+	}
+	for i, e := range m.EnumType {
+		p.enum(e, appendPath(path, messageEnumPath, i))
+	}
+	p.reserved(m.ReservedRange, m.ReservedName)
+	p.close()
+}
+
+func (p *printer) field(m *descpb.DescriptorProto, f *descpb.FieldDescriptorProto, path []int32) {
+	typ := p.fieldType(f)
+	if entry := mapEntry(m, f); entry != nil {
+		typ = fmt.Sprintf("map<%s, %s>", p.fieldType(entry.Field[0]), p.fieldType(entry.Field[1]))
+	} else if f.GetLabel() == descpb.FieldDescriptorProto_LABEL_REPEATED {
+		typ = "repeated " + typ
+	} else if p.file.GetSyntax() != "proto3" && f.OneofIndex == nil {
+		typ = strings.ToLower(strings.TrimPrefix(f.GetLabel().String(), "LABEL_")) + " " + typ
+	}
+	var opts []string
+	if f.DefaultValue != nil {
+		v := f.GetDefaultValue()
+		if f.GetType() == descpb.FieldDescriptorProto_TYPE_STRING || f.GetType() == descpb.FieldDescriptorProto_TYPE_BYTES {
+			v = strconv.Quote(v)
+		}
+		opts = append(opts, "default = "+v)
+	}
+	if f.GetOptions().GetDeprecated() {
+		opts = append(opts, "deprecated = true")
+	}
+	decl := fmt.Sprintf("%s %s = %d", typ, f.GetName(), f.GetNumber())
+	if len(opts) > 0 {
+		decl += " [" + strings.Join(opts, ", ") + "]"
+	}
+	p.line(path, decl+";")
+}
+
+// mapEntry returns the entry message of the map field f of m, if it's one.
+func mapEntry(m *descpb.DescriptorProto, f *descpb.FieldDescriptorProto) *descpb.DescriptorProto {
+	if f.GetType() != descpb.FieldDescriptorProto_TYPE_MESSAGE || f.GetLabel() != descpb.FieldDescriptorProto_LABEL_REPEATED {
+		return nil
+	}
+	name := f.GetTypeName()
+	for _, n := range m.NestedType {
+		if n.GetOptions().GetMapEntry() && strings.HasSuffix(name, "."+n.GetName()) {
+			return n
+		}
+	}
+	return nil
+}
+
+func (p *printer) fieldType(f *descpb.FieldDescriptorProto) string {
+	switch f.GetType() {
+	case descpb.FieldDescriptorProto_TYPE_MESSAGE, descpb.FieldDescriptorProto_TYPE_ENUM, descpb.FieldDescriptorProto_TYPE_GROUP:
+		return p.typeName(f.GetTypeName())
+	}
+	return strings.ToLower(strings.TrimPrefix(f.GetType().String(), "TYPE_"))
+}
+
+// typeName returns the full type name t relative to the package of the file,
+// if it's in it.
+func (p *printer) typeName(t string) string {
+	t = strings.TrimPrefix(t, ".")
+	if pkg := p.file.GetPackage(); pkg != "" && strings.HasPrefix(t, pkg+".") {
+		return t[len(pkg)+1:]
+	}
+	return t
+}
+
+func (p *printer) enum(e *descpb.EnumDescriptorProto, path []int32) {
+	p.line(path, "enum "+e.GetName()+" {")
+	p.indent++
+	for i, v := range e.Value {
+		decl := fmt.Sprintf("%s = %d", v.GetName(), v.GetNumber())
+		if v.GetOptions().GetDeprecated() {
+			decl += " [deprecated = true]"
+		}
+		p.line(appendPath(path, enumValuePath, i), decl+";")
+	}
+	p.close()
+}
+
+func (p *printer) reserved(ranges []*descpb.DescriptorProto_ReservedRange, names []string) {
+	if len(ranges) > 0 {
+		var rs []string
+		for _, r := range ranges {
+			// the ends of the ranges are exclusive
+			switch end := r.GetEnd() - 1; {
+			case end == r.GetStart():
+				rs = append(rs, strconv.Itoa(int(end)))
+			case end >= 536870911:
+				rs = append(rs, fmt.Sprintf("%d to max", r.GetStart()))
+			default:
+				rs = append(rs, fmt.Sprintf("%d to %d", r.GetStart(), end))
+			}
+		}
+		p.line(nil, "reserved "+strings.Join(rs, ", ")+";")
+	}
+	if len(names) > 0 {
+		quoted := make([]string, len(names))
+		for i, n := range names {
+			quoted[i] = strconv.Quote(n)
+		}
+		p.line(nil, "reserved "+strings.Join(quoted, ", ")+";")
+	}
+}
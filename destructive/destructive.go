@@ -0,0 +1,118 @@
+// Package destructive guards the calls of the methods generated commands mark
+// destructive, which can't be undone: the request is shown and the name of the
+// command must be typed before it's sent, unless --yes confirms it up front.
+// Without a terminal to ask on, calls without --yes are refused.
+//
+// Confirmed calls can be appended to a local audit log, a JSON object per
+// line with when, by whom and from where the call was made, to which server,
+// and its request.
+package destructive
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"os/user"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/spf13/pflag"
+
+	"github.com/tetratelabs/protoc-gen-cobra/iocodec"
+	"github.com/tetratelabs/protoc-gen-cobra/prompting"
+)
+
+// Options confirm the calls up front, and choose where they're audited.
+type Options struct {
+	Yes      bool
+	AuditLog string
+}
+
+// AddFlags adds the flags setting the options to fs.
+func (o *Options) AddFlags(fs *pflag.FlagSet) {
+	fs.BoolVar(&o.Yes, "yes", o.Yes, "confirm the calls of destructive methods without being asked, as needed when stdin isn't a terminal")
+	fs.StringVar(&o.AuditLog, "audit-log", o.AuditLog, "append every confirmed call of a destructive method, with its request, to this file")
+}
+
+// Confirm asks on the terminal for the command name to be typed before
+// method is called with req, which is shown unless nil, as for client streams.
+// It returns nil with --yes, and an error when stdin isn't a terminal.
+func (o *Options) Confirm(command, method string, req proto.Message, em iocodec.EncoderMaker) error {
+	if o.Yes {
+		return nil
+	}
+	if !prompting.IsTerminal(os.Stdin) {
+		return fmt.Errorf("%s is destructive; confirm the call with --yes", method)
+	}
+	return confirm(os.Stdin, os.Stderr, command, method, req, em)
+}
+
+func confirm(in io.Reader, out io.Writer, command, method string, req proto.Message, em iocodec.EncoderMaker) error {
+	if req != nil {
+		if err := em.NewEncoder(out).Encode(req); err != nil {
+			return err
+		}
+	}
+	fmt.Fprintf(out, "%s is destructive and can't be undone.\nType %q to call it: ", method, command)
+	answer, _ := bufio.NewReader(in).ReadString('\n')
+	if strings.TrimSpace(answer) != command {
+		return fmt.Errorf("%s wasn't confirmed; the call is aborted", method)
+	}
+	return nil
+}
+
+// An entry is a line of the audit log.
+type entry struct {
+	Time      string          `json:"time"`
+	User      string          `json:"user,omitempty"`
+	Host      string          `json:"host,omitempty"`
+	Target    string          `json:"target"`
+	Method    string          `json:"method"`
+	Confirmed string          `json:"confirmed"`
+	Request   json.RawMessage `json:"request,omitempty"`
+}
+
+// Audit appends the call of method on target with req, which may be nil, to
+// the audit log, if there's one.
+func (o *Options) Audit(target, method string, req proto.Message) error {
+	if o.AuditLog == "" {
+		return nil
+	}
+	e := entry{
+		Time:      time.Now().UTC().Format(time.RFC3339Nano),
+		Target:    target,
+		Method:    method,
+		Confirmed: "typed",
+	}
+	if o.Yes {
+		e.Confirmed = "--yes"
+	}
+	if u, err := user.Current(); err == nil {
+		e.User = u.Username
+	}
+	e.Host, _ = os.Hostname()
+	if req != nil {
+		var b bytes.Buffer
+		if err := iocodec.Marshaler().Marshal(&b, req); err != nil {
+			return err
+		}
+		e.Request = b.Bytes()
+	}
+	line, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	f, err := os.OpenFile(o.AuditLog, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0600)
+	if err != nil {
+		return fmt.Errorf("audit log: %v", err)
+	}
+	if _, err := f.Write(append(line, '\n')); err != nil {
+		f.Close()
+		return fmt.Errorf("audit log: %v", err)
+	}
+	return f.Close()
+}
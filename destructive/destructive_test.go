@@ -0,0 +1,93 @@
+package destructive
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes/wrappers"
+
+	"github.com/tetratelabs/protoc-gen-cobra/iocodec"
+)
+
+func TestConfirm(t *testing.T) {
+	req := &wrappers.StringValue{Value: "acct"}
+	for _, tc := range []struct {
+		answer string
+		ok     bool
+	}{
+		{"delete\n", true},
+		{"  delete \n", true},
+		{"y\n", false},
+		{"Delete\n", false},
+		{"", false},
+	} {
+		var out bytes.Buffer
+		err := confirm(strings.NewReader(tc.answer), &out, "delete", "/pb.CRUD/Delete", req, iocodec.DefaultEncoders["json"])
+		if (err == nil) != tc.ok {
+			t.Errorf("%q: got %v", tc.answer, err)
+		}
+		want := `"acct"` + "\n/pb.CRUD/Delete is destructive and can't be undone.\nType \"delete\" to call it: "
+		if out.String() != want {
+			t.Errorf("%q: got %q, want %q", tc.answer, out.String(), want)
+		}
+	}
+	if err := confirm(strings.NewReader(""), ioutil.Discard, "delete", "/pb.CRUD/Delete", nil, nil); err == nil || err.Error() != "/pb.CRUD/Delete wasn't confirmed; the call is aborted" {
+		t.Errorf("got %v", err)
+	}
+}
+
+func TestConfirmYes(t *testing.T) {
+	o := Options{Yes: true}
+	if err := o.Confirm("delete", "/pb.CRUD/Delete", nil, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestAudit(t *testing.T) {
+	dir, err := ioutil.TempDir("", "destructive")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	o := Options{AuditLog: filepath.Join(dir, "audit.log")}
+	if err := o.Audit("localhost:8080", "/pb.CRUD/Delete", &wrappers.StringValue{Value: "acct"}); err != nil {
+		t.Fatal(err)
+	}
+	o.Yes = true
+	if err := o.Audit("localhost:8080", "/pb.CRUD/Purge", nil); err != nil {
+		t.Fatal(err)
+	}
+
+	b, err := ioutil.ReadFile(o.AuditLog)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSuffix(string(b), "\n"), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("got %d lines, want 2:\n%s", len(lines), b)
+	}
+	for i, want := range []entry{
+		{Target: "localhost:8080", Method: "/pb.CRUD/Delete", Confirmed: "typed", Request: json.RawMessage(`"acct"`)},
+		{Target: "localhost:8080", Method: "/pb.CRUD/Purge", Confirmed: "--yes"},
+	} {
+		var got entry
+		if err := json.Unmarshal([]byte(lines[i]), &got); err != nil {
+			t.Fatal(err)
+		}
+		if got.Time == "" {
+			t.Errorf("line %d has no time", i+1)
+		}
+		if got.Target != want.Target || got.Method != want.Method || got.Confirmed != want.Confirmed || string(got.Request) != string(want.Request) {
+			t.Errorf("line %d: got %s", i+1, lines[i])
+		}
+	}
+
+	if err := (&Options{}).Audit("localhost:8080", "/pb.CRUD/Delete", nil); err != nil {
+		t.Errorf("got %v without an audit log", err)
+	}
+}
@@ -0,0 +1,80 @@
+// Package dryrun prints what generated commands would send, and where to,
+// instead of dialing the server.
+package dryrun
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/tetratelabs/protoc-gen-cobra/iocodec"
+)
+
+// Redacted replaces the secrets in metadata values.
+const Redacted = "REDACTED"
+
+// A Plan is what a command would send, and where to.
+type Plan struct {
+	Target   string
+	Timeout  time.Duration
+	TLS      *TLS // nil without TLS
+	Metadata metadata.MD
+	Requests []proto.Message
+}
+
+// TLS are the settings of the TLS connection to the server. The files are
+// named, not read.
+type TLS struct {
+	ServerName         string `json:"serverName,omitempty"`
+	InsecureSkipVerify bool   `json:"insecureSkipVerify,omitempty"`
+	CACertFile         string `json:"caCertFile,omitempty"`
+	CertFile           string `json:"certFile,omitempty"`
+	KeyFile            string `json:"keyFile,omitempty"`
+}
+
+// Redact returns value with its secret replaced by Redacted, keeping the
+// scheme of authorization values, such as "Bearer".
+func Redact(value string) string {
+	if i := strings.IndexByte(value, ' '); i > 0 {
+		return value[:i+1] + Redacted
+	}
+	return Redacted
+}
+
+// Print writes the plan p to out. The target is written as given, without
+// being resolved, so dry runs work offline.
+func Print(p Plan, out iocodec.Encoder) error {
+	report := map[string]interface{}{
+		"target":  p.Target,
+		"timeout": p.Timeout.String(),
+		"tls":     map[string]interface{}{"enabled": false},
+	}
+	if p.TLS != nil {
+		report["tls"] = struct {
+			Enabled bool `json:"enabled"`
+			*TLS
+		}{true, p.TLS}
+	}
+	if len(p.Metadata) > 0 {
+		report["metadata"] = p.Metadata
+	}
+	requests := make([]json.RawMessage, len(p.Requests))
+	for i, m := range p.Requests {
+		var b bytes.Buffer
+		if err := iocodec.Marshaler().Marshal(&b, m); err != nil {
+			return err
+		}
+		requests[i] = b.Bytes()
+	}
+	report["requests"] = requests
+
+	s, err := iocodec.Struct(report)
+	if err != nil {
+		return err
+	}
+	return out.Encode(s)
+}
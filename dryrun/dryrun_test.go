@@ -0,0 +1,54 @@
+package dryrun
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/tetratelabs/protoc-gen-cobra/iocodec"
+)
+
+func TestPrint(t *testing.T) {
+	for _, tc := range []struct {
+		plan Plan
+		want string
+	}{
+		{
+			Plan{
+				Target:   "example.com:443",
+				Timeout:  time.Second,
+				TLS:      &TLS{ServerName: "example.com", CACertFile: "ca.pem"},
+				Metadata: metadata.Pairs("authorization", Redact("Bearer s3cr3t")),
+				Requests: []proto.Message{&wrappers.StringValue{Value: "a"}, &wrappers.StringValue{Value: "b"}},
+			},
+			`{"metadata":{"authorization":["Bearer REDACTED"]},"requests":["a","b"],"target":"example.com:443","timeout":"1s","tls":{"caCertFile":"ca.pem","enabled":true,"serverName":"example.com"}}`,
+		},
+		{
+			Plan{Target: "nowhere:80", Requests: []proto.Message{&wrappers.Int32Value{Value: 1}}},
+			`{"requests":[1],"target":"nowhere:80","timeout":"0s","tls":{"enabled":false}}`,
+		},
+	} {
+		var b bytes.Buffer
+		if err := Print(tc.plan, iocodec.DefaultEncoders["json"].NewEncoder(&b)); err != nil {
+			t.Fatal(err)
+		}
+		if got := b.String(); got != tc.want+"\n" {
+			t.Errorf("got:\n%swant:\n%s", got, tc.want)
+		}
+	}
+}
+
+func TestRedact(t *testing.T) {
+	for in, want := range map[string]string{
+		"Bearer s3cr3t": "Bearer REDACTED",
+		"s3cr3t":        "REDACTED",
+	} {
+		if got := Redact(in); got != want {
+			t.Errorf("Redact(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
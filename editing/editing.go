@@ -0,0 +1,135 @@
+// Package editing opens requests in the user's editor before generated
+// commands send them, the way kubectl edit opens resources: the request is
+// written to a temporary file, in one of the iocodec formats, and read back
+// once the editor exits.
+//
+// When what's saved can't be read, or isn't a valid request, the editor opens
+// again on it, with the error in comments at the top of the file. Saving an
+// empty file aborts the call.
+package editing
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/tetratelabs/protoc-gen-cobra/iocodec"
+)
+
+// ErrEmpty is returned when the edited request is saved empty.
+var ErrEmpty = errors.New("the edited request is empty; the call is aborted")
+
+// Edit opens the editor on v, such as m or a sample of it, encoded in format,
+// and decodes what's saved into m, until it's read without errors and check
+// accepts it. The editor is $EDITOR, vi by default.
+func Edit(format string, v interface{}, m proto.Message, check func(proto.Message) error) error {
+	if format == "prettyjson" {
+		format = "json"
+	}
+	// JSON is indented, to be edited
+	encoding := format
+	if format == "json" {
+		encoding = "prettyjson"
+	}
+	em, ok := iocodec.DefaultEncoders[encoding]
+	if !ok {
+		return fmt.Errorf("can't edit requests in %s", format)
+	}
+	dm, ok := iocodec.DefaultDecoders[format]
+	if !ok {
+		return fmt.Errorf("can't edit requests in %s", format)
+	}
+	var b bytes.Buffer
+	if err := em.NewEncoder(&b).Encode(v); err != nil {
+		return err
+	}
+	// the extension lets the editor know the format
+	f, err := ioutil.TempFile("", "request-*."+format)
+	if err != nil {
+		return err
+	}
+	f.Close()
+	defer os.Remove(f.Name())
+
+	text := b.Bytes()
+	var failed error
+	for {
+		var file bytes.Buffer
+		if failed != nil {
+			file.WriteString("# The request can't be sent:\n")
+			for _, line := range strings.Split(failed.Error(), "\n") {
+				file.WriteString("#   " + line + "\n")
+			}
+			file.WriteString("# Fix it, or save an empty file to abort the call.\n#\n")
+		}
+		file.Write(text)
+		if err := ioutil.WriteFile(f.Name(), file.Bytes(), 0600); err != nil {
+			return err
+		}
+		if err := run(f.Name()); err != nil {
+			return err
+		}
+		saved, err := ioutil.ReadFile(f.Name())
+		if err != nil {
+			return err
+		}
+		saved = stripComments(saved)
+		if len(bytes.TrimSpace(saved)) == 0 {
+			return ErrEmpty
+		}
+		if failed != nil && bytes.Equal(saved, text) {
+			// nothing changed; the editor would open on the same error
+			return failed
+		}
+		m.Reset()
+		failed = dm.NewDecoder(bytes.NewReader(saved)).Decode(m)
+		if failed == nil {
+			failed = check(m)
+		}
+		if failed == nil {
+			return nil
+		}
+		text = saved
+	}
+}
+
+// run runs the editor on the file name, on the terminal of the command.
+func run(name string) error {
+	editor := os.Getenv("EDITOR")
+	if strings.TrimSpace(editor) == "" {
+		editor = "vi"
+	}
+	// the editor may come with arguments, as in "code --wait"
+	args := append(strings.Fields(editor), name)
+	cmd := exec.Command(args[0], args[1:]...)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("editor %s: %v", editor, err)
+	}
+	return nil
+}
+
+// stripComments removes the lines starting with # at the top of text, where
+// the errors are written, since JSON and XML have no such comments.
+func stripComments(text []byte) []byte {
+	r := bufio.NewReader(bytes.NewReader(text))
+	for {
+		line, err := r.ReadBytes('\n')
+		if !bytes.HasPrefix(bytes.TrimSpace(line), []byte("#")) {
+			rest, _ := ioutil.ReadAll(r)
+			return append(line, rest...)
+		}
+		if err != nil {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,111 @@
+package editing
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	descpb "github.com/golang/protobuf/protoc-gen-go/descriptor"
+)
+
+// editor sets $EDITOR to a script saving the texts one by one, each time it's
+// run, and returns what the script was given to edit each time.
+func editor(t *testing.T, texts ...string) (seen func() []string, done func()) {
+	dir, err := ioutil.TempDir("", "editing")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, text := range texts {
+		if err := ioutil.WriteFile(filepath.Join(dir, fmt.Sprint(i)), []byte(text), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	script := fmt.Sprintf(`n=$(ls %[1]s | grep -c seen); cp "$1" %[1]s/seen$n; cp %[1]s/$n "$1"`, dir)
+	if err := ioutil.WriteFile(filepath.Join(dir, "edit.sh"), []byte(script), 0700); err != nil {
+		t.Fatal(err)
+	}
+	old := os.Getenv("EDITOR")
+	os.Setenv("EDITOR", "sh "+filepath.Join(dir, "edit.sh"))
+	seen = func() []string {
+		var s []string
+		for i := 0; ; i++ {
+			b, err := ioutil.ReadFile(filepath.Join(dir, fmt.Sprint("seen", i)))
+			if err != nil {
+				return s
+			}
+			s = append(s, string(b))
+		}
+	}
+	return seen, func() {
+		os.Setenv("EDITOR", old)
+		os.RemoveAll(dir)
+	}
+}
+
+func named(m proto.Message) error {
+	if m.(*descpb.FileDescriptorProto).GetName() == "" {
+		return errors.New("name: value is required")
+	}
+	return nil
+}
+
+func TestEdit(t *testing.T) {
+	seen, done := editor(t, `{"name": "a.proto", "package": "a"}`)
+	defer done()
+	m := &descpb.FileDescriptorProto{Name: proto.String("old.proto")}
+	if err := Edit("prettyjson", m, m, named); err != nil {
+		t.Fatal(err)
+	}
+	if m.GetName() != "a.proto" || m.GetPackage() != "a" {
+		t.Errorf("got %v", m)
+	}
+	if s := seen(); len(s) != 1 || !strings.Contains(s[0], `"name": "old.proto"`) {
+		t.Errorf("the editor got %q", s)
+	}
+}
+
+func TestEditErrors(t *testing.T) {
+	seen, done := editor(t, `{"name": `, "# an error\n"+`{"package": "a"}`, `{"name": "a.proto"}`)
+	defer done()
+	var m descpb.FileDescriptorProto
+	if err := Edit("json", &m, &m, named); err != nil {
+		t.Fatal(err)
+	}
+	if m.GetName() != "a.proto" || m.GetPackage() != "" {
+		t.Errorf("got %v", &m)
+	}
+	s := seen()
+	if len(s) != 3 {
+		t.Fatalf("the editor ran %d times, want 3", len(s))
+	}
+	if !strings.HasPrefix(s[1], "# The request can't be sent:\n#   ") || !strings.HasSuffix(s[1], "#\n"+`{"name": `) {
+		t.Errorf("the editor got %q after a bad request", s[1])
+	}
+	if !strings.Contains(s[2], "#   name: value is required\n") || !strings.HasSuffix(s[2], "#\n"+`{"package": "a"}`) {
+		t.Errorf("the editor got %q after an invalid request", s[2])
+	}
+}
+
+func TestEditAborts(t *testing.T) {
+	_, done := editor(t, "# nothing\n \n")
+	defer done()
+	var m descpb.FileDescriptorProto
+	if err := Edit("yaml", &m, &m, named); err != ErrEmpty {
+		t.Errorf("got %v for an empty file, want ErrEmpty", err)
+	}
+
+	_, done = editor(t, `{}`, `{}`)
+	defer done()
+	if err := Edit("json", &m, &m, named); err == nil || err.Error() != "name: value is required" {
+		t.Errorf("got %v for an unchanged request, want its error", err)
+	}
+
+	if err := Edit("csv", &m, &m, named); err == nil {
+		t.Error("got no error for a format without an encoder")
+	}
+}
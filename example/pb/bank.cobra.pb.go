@@ -28,6 +28,7 @@ import (
 	tls "crypto/tls"
 	x509 "crypto/x509"
 	fmt "fmt"
+	io "io"
 	ioutil "io/ioutil"
 	log "log"
 	net "net"
@@ -38,12 +39,27 @@ import (
 	proto "github.com/golang/protobuf/proto"
 	cobra "github.com/spf13/cobra"
 	pflag "github.com/spf13/pflag"
+	batch "github.com/tetratelabs/protoc-gen-cobra/batch"
+	describe "github.com/tetratelabs/protoc-gen-cobra/describe"
+	destructive "github.com/tetratelabs/protoc-gen-cobra/destructive"
+	dryrun "github.com/tetratelabs/protoc-gen-cobra/dryrun"
+	editing "github.com/tetratelabs/protoc-gen-cobra/editing"
+	health "github.com/tetratelabs/protoc-gen-cobra/health"
 	iocodec "github.com/tetratelabs/protoc-gen-cobra/iocodec"
+	loadtest "github.com/tetratelabs/protoc-gen-cobra/loadtest"
+	override "github.com/tetratelabs/protoc-gen-cobra/override"
+	prompting "github.com/tetratelabs/protoc-gen-cobra/prompting"
+	recording "github.com/tetratelabs/protoc-gen-cobra/recording"
+	templating "github.com/tetratelabs/protoc-gen-cobra/templating"
+	tracing "github.com/tetratelabs/protoc-gen-cobra/tracing"
+	validation "github.com/tetratelabs/protoc-gen-cobra/validation"
+	verbose "github.com/tetratelabs/protoc-gen-cobra/verbose"
 	context "golang.org/x/net/context"
 	oauth2 "golang.org/x/oauth2"
 	grpc "google.golang.org/grpc"
 	credentials "google.golang.org/grpc/credentials"
 	oauth "google.golang.org/grpc/credentials/oauth"
+	metadata "google.golang.org/grpc/metadata"
 )
 
 // This is a compile-time assertion to ensure that this generated file
@@ -75,6 +91,17 @@ type _BankClientCommandConfig struct {
 	AuthTokenType      string
 	JWTKey             string
 	JWTKeyFile         string
+	SkipValidation     bool
+	LoadTest           loadtest.Options
+	Record             string
+	DryRun             bool
+	Verbose            bool
+	Trace              tracing.Options
+	Set                override.Options
+	Template           templating.Options
+	Edit               bool
+	Interactive        bool
+	Destructive        destructive.Options
 }
 
 func _NewBankClientCommandConfig() *_BankClientCommandConfig {
@@ -104,6 +131,17 @@ func (o *_BankClientCommandConfig) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&o.AuthTokenType, "auth-token-type", o.AuthTokenType, "authorization token type")
 	fs.StringVar(&o.JWTKey, "jwt-key", o.JWTKey, "jwt key")
 	fs.StringVar(&o.JWTKeyFile, "jwt-key-file", o.JWTKeyFile, "jwt key file")
+	fs.BoolVar(&o.SkipValidation, "skip-validation", o.SkipValidation, "skip the client-side validation of requests")
+	o.LoadTest.AddFlags(fs)
+	fs.StringVar(&o.Record, "record", o.Record, "record the calls, with their metadata, messages, statuses and timings, to this session file")
+	fs.BoolVar(&o.DryRun, "dry-run", o.DryRun, "print the requests, where they would be sent and the metadata, with secrets redacted, instead of sending them")
+	fs.BoolVarP(&o.Verbose, "verbose", "v", o.Verbose, "log the connection, the tls handshake, and the metadata, message sizes and timings of the calls to stderr, with secrets redacted")
+	o.Trace.AddFlags(fs)
+	o.Set.AddFlags(fs)
+	o.Template.AddFlags(fs)
+	fs.BoolVar(&o.Edit, "edit", o.Edit, "open the request, or a sample of it without one, in $EDITOR before sending it")
+	fs.BoolVar(&o.Interactive, "interactive", o.Interactive, "when stdin is a terminal, prompt for the fields the request doesn't set, and for confirmation before sending it")
+	o.Destructive.AddFlags(fs)
 }
 
 func BankClientCommand() *cobra.Command {
@@ -115,14 +153,129 @@ func BankClientCommand() *cobra.Command {
 	for _, s := range _BankClientSubCommands {
 		cmd.AddCommand(s())
 	}
+	cmd.AddCommand(_BankReplayCommand())
+	cmd.AddCommand(_BankHealthCommand())
+	cmd.AddCommand(_BankDescribeCommand())
+	return cmd
+}
+
+func _BankReplayCommand() *cobra.Command {
+	var compare bool
+	cmd := &cobra.Command{
+		Use:   "replay [file]",
+		Short: "Replay the calls of a session recorded with --record",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			f, err := os.Open(args[0])
+			if err != nil {
+				log.Fatal(err)
+			}
+			defer f.Close()
+			err = _BankRoundTrip(nil, nil, func(conn *grpc.ClientConn, cli BankClient, in iocodec.Decoder, out iocodec.Encoder) error {
+				if _DefaultBankClientCommandConfig.DryRun {
+					reqs, err := recording.Requests(f)
+					if err != nil {
+						return err
+					}
+					return _BankDryRun(out, reqs...)
+				}
+				return recording.Replay(conn, f, compare, out, os.Stderr)
+			})
+			if err != nil {
+				log.Fatal(err)
+			}
+		},
+	}
+	cmd.Flags().BoolVar(&compare, "compare", false, "compare the responses and statuses to the recorded ones, field by field, and fail if they differ")
+	return cmd
+}
+
+func _BankHealthCommand() *cobra.Command {
+	opts := health.Options{Service: "pb.Bank"}
+	cmd := &cobra.Command{
+		Use:     "health",
+		Aliases: []string{"ping"},
+		Short:   "Check the health of pb.Bank with the gRPC health checking protocol, and report how the connection is made",
+		Long:    "Check the health of pb.Bank with the gRPC health checking protocol, and report how the connection is made: its TLS details, the certificate chain of the server and the latency of the check.\n\nExits with 0 if the service is serving, 2 if the connection fails, 3 if the check fails, and 4 if the service isn't serving.",
+		Args:    cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			cfg := _DefaultBankClientCommandConfig
+			em, err := _BankEncoderMaker()
+			if err != nil {
+				log.Fatal(err)
+			}
+			if cfg.DryRun {
+				if err := _BankDryRun(em.NewEncoder(os.Stdout)); err != nil {
+					log.Fatal(err)
+				}
+				return
+			}
+			err = health.Check(opts, cfg.Timeout, func() (*grpc.ClientConn, error) {
+				conn, _, err := _DialBank()
+				return conn, err
+			}, em.NewEncoder(os.Stdout))
+			if err != nil {
+				log.Print(err)
+				os.Exit(health.ExitCode(err))
+			}
+		},
+	}
+	opts.AddFlags(cmd.Flags())
+	return cmd
+}
+
+func _BankDescribeCommand() *cobra.Command {
+	var raw bool
+	cmd := &cobra.Command{
+		Use:   "describe [name]",
+		Short: "Print the definition of pb.Bank, or of one of its methods, messages or enums, with its comments",
+		Long:  "Print the definition of pb.Bank, or of one of its methods, messages or enums, with its comments, from the descriptors built into the command.\n\nThe name is a method, as named in the proto file or as its command, or the name of a message or enum, relative to the package of the service or in full. A method is followed by its request and response messages.",
+		Args:  cobra.MaximumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			name := ""
+			if len(args) > 0 {
+				name = args[0]
+			}
+			defs, err := describe.Lookup("pb.Bank", name)
+			if err != nil {
+				log.Fatal(err)
+			}
+			if !raw {
+				if err := describe.Print(os.Stdout, defs); err != nil {
+					log.Fatal(err)
+				}
+				return
+			}
+			em, err := _BankEncoderMaker()
+			if err != nil {
+				log.Fatal(err)
+			}
+			enc := em.NewEncoder(os.Stdout)
+			for _, d := range defs {
+				if err := enc.Encode(d.Descriptor); err != nil {
+					log.Fatal(err)
+				}
+			}
+		},
+	}
+	cmd.Flags().BoolVar(&raw, "descriptor", false, "print the descriptors of the definitions in the response format instead of proto source")
 	return cmd
 }
 
 func _DialBank() (*grpc.ClientConn, BankClient, error) {
 	cfg := _DefaultBankClientCommandConfig
-	opts := []grpc.DialOption{
-		grpc.WithBlock(),
-		grpc.WithTimeout(cfg.Timeout),
+	var v *verbose.Logger
+	var opts []grpc.DialOption
+	if cfg.Trace.Enabled() {
+		t, err := tracing.Start(cfg.Trace)
+		if err != nil {
+			return nil, nil, fmt.Errorf("trace: %v", err)
+		}
+		opts = append(opts, t.DialOptions()...)
+	}
+	if cfg.Verbose {
+		v = verbose.New(os.Stderr)
+		opts = append(opts, v.DialOptions()...)
 	}
 	if cfg.TLS {
 		tlsConfig := &tls.Config{}
@@ -156,6 +309,9 @@ func _DialBank() (*grpc.ClientConn, BankClient, error) {
 		}
 		//tlsConfig.BuildNameToCertificate()
 		cred := credentials.NewTLS(tlsConfig)
+		if v != nil {
+			cred = v.Credentials(cred)
+		}
 		opts = append(opts, grpc.WithTransportCredentials(cred))
 	} else {
 		opts = append(opts, grpc.WithInsecure())
@@ -181,34 +337,69 @@ func _DialBank() (*grpc.ClientConn, BankClient, error) {
 		}
 		opts = append(opts, grpc.WithPerRPCCredentials(cred))
 	}
-	conn, err := grpc.Dial(cfg.ServerAddr, opts...)
+	if cfg.Record != "" {
+		rec, err := recording.Open(cfg.Record)
+		if err != nil {
+			return nil, nil, fmt.Errorf("record: %v", err)
+		}
+		opts = append(opts, rec.DialOptions()...)
+	}
+	var conn *grpc.ClientConn
+	var err error
+	if v != nil {
+		conn, err = v.Dial(cfg.ServerAddr, cfg.Timeout, opts...)
+	} else {
+		conn, err = grpc.Dial(cfg.ServerAddr, append(opts, grpc.WithBlock(), grpc.WithTimeout(cfg.Timeout))...)
+	}
 	if err != nil {
 		return nil, nil, err
 	}
 	return conn, NewBankClient(conn), nil
 }
 
-type _BankRoundTripFunc func(cli BankClient, in iocodec.Decoder, out iocodec.Encoder) error
+// _BankEncoderMaker returns the encoder maker of the response format.
+func _BankEncoderMaker() (iocodec.EncoderMaker, error) {
+	format := _DefaultBankClientCommandConfig.ResponseFormat
+	if format == "" {
+		format = "json"
+	}
+	em, ok := iocodec.DefaultEncoders[format]
+	if !ok {
+		return nil, fmt.Errorf("invalid response format: %q", format)
+	}
+	return em, nil
+}
+
+type _BankRoundTripFunc func(conn *grpc.ClientConn, cli BankClient, in iocodec.Decoder, out iocodec.Encoder) error
 
-func _BankRoundTrip(sample interface{}, fn _BankRoundTripFunc) error {
+// _BankRoundTrip reads the request with prepare, when set, before it
+// dials the server and calls fn. Commands without a request have no sample.
+func _BankRoundTrip(sample proto.Message, prepare func(in iocodec.Decoder) error, fn _BankRoundTripFunc) error {
 	cfg := _DefaultBankClientCommandConfig
-	var em iocodec.EncoderMaker
-	var ok bool
-	if cfg.ResponseFormat == "" {
-		em = iocodec.DefaultEncoders["json"]
-	} else {
-		em, ok = iocodec.DefaultEncoders[cfg.ResponseFormat]
-		if !ok {
-			return fmt.Errorf("invalid response format: %q", cfg.ResponseFormat)
-		}
+	em, err := _BankEncoderMaker()
+	if err != nil {
+		return err
 	}
 	if cfg.PrintSampleRequest {
-		return em.NewEncoder(os.Stdout).Encode(sample)
+		if sample == nil {
+			return fmt.Errorf("no sample request")
+		}
+		return em.NewEncoder(os.Stdout).Encode(iocodec.NewSample(sample))
 	}
 	// read the input request, first from stdin, then from a file, otherwise from args only
 	var d iocodec.Decoder
 	if cfg.Stdin || cfg.RequestFile == "-" {
-		d = iocodec.DefaultDecoders["json"].NewDecoder(os.Stdin)
+		if cfg.Edit {
+			// the editor needs the terminal
+			return fmt.Errorf("--edit can't be used with a request read from stdin")
+		}
+		var in io.Reader = os.Stdin
+		if cfg.Template.Stdin {
+			if in, err = cfg.Template.Reader("stdin", os.Stdin); err != nil {
+				return fmt.Errorf("request template: %v", err)
+			}
+		}
+		d = iocodec.DefaultDecoders["json"].NewDecoder(in)
 	} else if cfg.RequestFile != "" {
 		f, err := os.Open(cfg.RequestFile)
 		if err != nil {
@@ -223,37 +414,222 @@ func _BankRoundTrip(sample interface{}, fn _BankRoundTripFunc) error {
 		if !ok {
 			return fmt.Errorf("invalid request file format: %q", ext)
 		}
-		d = dm.NewDecoder(f)
+		in, err := cfg.Template.Reader(cfg.RequestFile, f)
+		if err != nil {
+			return fmt.Errorf("request template: %v", err)
+		}
+		d = dm.NewDecoder(in)
 	} else {
 		d = iocodec.DefaultDecoders["noop"].NewDecoder(os.Stdin)
 	}
+	d = cfg.Set.Decoder(d)
+	if prepare != nil {
+		if err := prepare(d); err != nil {
+			return err
+		}
+	}
+	if cfg.DryRun {
+		// fn prints what it would send instead
+		return fn(nil, nil, d, em.NewEncoder(os.Stdout))
+	}
 	conn, client, err := _DialBank()
 	if err != nil {
 		return err
 	}
 	defer conn.Close()
-	return fn(client, d, em.NewEncoder(os.Stdout))
+	return fn(conn, client, d, em.NewEncoder(os.Stdout))
+}
+
+// _BankPrompter returns the prompter asking for the fields of requests,
+// or nil unless --interactive is given and stdin is a terminal.
+func _BankPrompter() *prompting.Prompter {
+	if !_DefaultBankClientCommandConfig.Interactive || !prompting.IsTerminal(os.Stdin) {
+		return nil
+	}
+	return prompting.New(os.Stdin, os.Stderr)
+}
+
+// _BankConfirmDestructive asks for confirmation of a call of the
+// destructive method, showing req unless nil. It's called once the server is
+// dialed, so sample requests and dry runs, which send nothing, aren't asked
+// about.
+func _BankConfirmDestructive(command, method string, req proto.Message) error {
+	em, err := _BankEncoderMaker()
+	if err != nil {
+		return err
+	}
+	return _DefaultBankClientCommandConfig.Destructive.Confirm(command, method, req, em)
+}
+
+// _BankEdit opens the editor on m, or on a sample of it when it's empty,
+// in the format of the request file, or else the response format.
+func _BankEdit(m proto.Message, check func(proto.Message) error) error {
+	cfg := _DefaultBankClientCommandConfig
+	format := cfg.ResponseFormat
+	if ext := filepath.Ext(cfg.RequestFile); len(ext) > 1 {
+		format = ext[1:]
+	}
+	var v interface{} = m
+	if proto.Size(m) == 0 {
+		v = iocodec.NewSample(m)
+	}
+	return editing.Edit(format, v, m, check)
+}
+
+// _BankDryRun prints reqs, along with where and how they would be sent.
+func _BankDryRun(out iocodec.Encoder, reqs ...proto.Message) error {
+	cfg := _DefaultBankClientCommandConfig
+	plan := dryrun.Plan{
+		Target:   cfg.ServerAddr,
+		Timeout:  cfg.Timeout,
+		Metadata: metadata.MD{},
+		Requests: reqs,
+	}
+	if cfg.TLS {
+		plan.TLS = &dryrun.TLS{
+			ServerName:         cfg.ServerName,
+			InsecureSkipVerify: cfg.InsecureSkipVerify,
+			CACertFile:         cfg.CACertFile,
+			CertFile:           cfg.CertFile,
+			KeyFile:            cfg.KeyFile,
+		}
+		if plan.TLS.ServerName == "" {
+			plan.TLS.ServerName, _, _ = net.SplitHostPort(cfg.ServerAddr)
+		}
+	}
+	if cfg.AuthToken != "" {
+		plan.Metadata.Append("authorization", dryrun.Redact(cfg.AuthTokenType+" "+cfg.AuthToken))
+	}
+	if cfg.JWTKey != "" || cfg.JWTKeyFile != "" {
+		plan.Metadata.Append("authorization", "Bearer "+dryrun.Redacted)
+	}
+	return dryrun.Print(plan, out)
+}
+
+// _BankLoadTest makes calls with call, as many and as fast as the load
+// test flags say, over conn and the connections it dials, and prints a report
+// of them.
+func _BankLoadTest(conn *grpc.ClientConn, call func(ctx context.Context, cli BankClient) error) error {
+	dial := func() (*grpc.ClientConn, error) {
+		conn, _, err := _DialBank()
+		return conn, err
+	}
+	return loadtest.Run(_DefaultBankClientCommandConfig.LoadTest, conn, dial, func(ctx context.Context, conn *grpc.ClientConn) error {
+		return call(ctx, NewBankClient(conn))
+	}, os.Stdout)
+}
+
+func _BankValidate(m proto.Message, flags *pflag.FlagSet) error {
+	if _DefaultBankClientCommandConfig.SkipValidation {
+		return nil
+	}
+	return validation.Validate(m, flags)
 }
 
 func _BankDepositClientCommand() *cobra.Command {
 	reqArgs := &DepositRequest{}
+	var batchOpts batch.Options
 
 	cmd := &cobra.Command{
-		Use:     "deposit",
+		Use:     "deposit [account] [amount]",
+		Args:    cobra.MaximumNArgs(2),
 		Long:    "Deposit client; call by piping a request in to stdin (--stdin), reading a file (--file), or via flags per field",
 		Example: "TODO: print protobuf method comments here",
 		Run: func(cmd *cobra.Command, args []string) {
+			for i, name := range []string{"account", "amount"}[:len(args)] {
+				if cmd.Flags().Changed(name) {
+					log.Fatalf("argument %d sets --%s, which is already set", i+1, name)
+				}
+				if err := cmd.Flags().Set(name, args[i]); err != nil {
+					log.Fatalf("argument %d: %v", i+1, err)
+				}
+			}
+
 			var v DepositRequest
-			err := _BankRoundTrip(v, func(cli BankClient, in iocodec.Decoder, out iocodec.Encoder) error {
 
+			prepare := func(in iocodec.Decoder) error {
 				err := in.Decode(&v)
 				if err != nil {
 					return err
 				}
 
 				proto.Merge(&v, reqArgs)
-				resp, err := cli.Deposit(context.Background(), &v)
 
+				prompter := _BankPrompter()
+				if prompter != nil {
+					err = prompter.Fields(&v)
+					if err != nil {
+						return err
+					}
+				}
+				if _DefaultBankClientCommandConfig.Edit {
+					err = _BankEdit(&v, func(m proto.Message) error {
+						return _BankValidate(m, cmd.Flags())
+					})
+				} else {
+					err = _BankValidate(&v, cmd.Flags())
+				}
+				if err != nil {
+					return err
+				}
+				if prompter == nil || _DefaultBankClientCommandConfig.DryRun {
+					return nil
+				}
+				em, err := _BankEncoderMaker()
+				if err != nil {
+					return err
+				}
+				return prompter.Confirm(&v, em)
+			}
+
+			if batchOpts.File != "" {
+				// the requests of the batch are read and checked one by one
+				prepare = nil
+			}
+
+			err := _BankRoundTrip(&v, prepare, func(conn *grpc.ClientConn, cli BankClient, in iocodec.Decoder, out iocodec.Encoder) error {
+
+				if batchOpts.File != "" {
+					if _DefaultBankClientCommandConfig.DryRun {
+						return fmt.Errorf("--dry-run can't be used with --batch")
+					}
+					if _DefaultBankClientCommandConfig.Edit {
+						return fmt.Errorf("--edit can't be used with --batch")
+					}
+					return batch.Run(batchOpts, func(ctx context.Context, r *batch.Record) (proto.Message, error) {
+						var v DepositRequest
+						err := r.Decode(&v)
+						if err != nil {
+							return nil, err
+						}
+						err = _DefaultBankClientCommandConfig.Set.Apply(&v)
+						if err != nil {
+							return nil, batch.Invalid(err)
+						}
+						// the defaults of the record must not leak into
+						// the next ones
+						reqArgs := proto.Clone(reqArgs).(*DepositRequest)
+
+						proto.Merge(&v, reqArgs)
+						err = _BankValidate(&v, cmd.Flags())
+						if err != nil {
+							return nil, batch.Invalid(err)
+						}
+						return cli.Deposit(ctx, &v)
+					}, out)
+				}
+
+				if _DefaultBankClientCommandConfig.DryRun {
+					return _BankDryRun(out, &v)
+				}
+				if _DefaultBankClientCommandConfig.LoadTest.Enabled() {
+					return _BankLoadTest(conn, func(ctx context.Context, cli BankClient) error {
+						_, err := cli.Deposit(ctx, &v)
+						return err
+					})
+				}
+
+				resp, err := cli.Deposit(context.Background(), &v)
 				if err != nil {
 					return err
 				}
@@ -269,6 +645,7 @@ func _BankDepositClientCommand() *cobra.Command {
 
 	cmd.PersistentFlags().StringVar(&reqArgs.Account, "account", "", "get-comment-from-proto")
 	cmd.PersistentFlags().Float64Var(&reqArgs.Amount, "amount", 0, "get-comment-from-proto")
+	batchOpts.AddFlags(cmd.PersistentFlags())
 
 	return cmd
 }
@@ -276,3 +653,47 @@ func _BankDepositClientCommand() *cobra.Command {
 var _BankClientSubCommands = []func() *cobra.Command{
 	_BankDepositClientCommand,
 }
+
+func init() {
+	validation.RegisterRules(map[string]string{
+		"pb.DepositRequest.account": "string:<min_len:1 >",
+		"pb.DepositRequest.amount":  "double:<gt:0 >",
+	})
+}
+
+func init() { describe.Register(_descriptorSet_Bank_a6371916d5cb63b4) }
+
+var _descriptorSet_Bank_a6371916d5cb63b4 = []byte{
+	// 487 bytes of a gzipped FileDescriptorSet
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x92, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0x86, 0x77, 0x76, 0x36, 0xce, 0x7a, 0xe2, 0xda, 0xe9, 0xc4, 0x69, 0x13, 0x87, 0x43, 0x13,
+	0x2e, 0x39, 0x40, 0x22, 0xc1, 0x85, 0xc2, 0x01, 0x1a, 0x71, 0x40, 0x3d, 0xe6, 0x05, 0x90, 0x13,
+	0xac, 0x2a, 0xaa, 0x89, 0x4d, 0xeb, 0x22, 0xf5, 0x21, 0x38, 0x73, 0xe0, 0x19, 0x78, 0x01, 0x4e,
+	0x3c, 0x07, 0x6f, 0xc0, 0x89, 0x57, 0x40, 0xbb, 0xde, 0x0d, 0x2a, 0x3e, 0xed, 0xb7, 0xb3, 0xf3,
+	0xcd, 0xfc, 0x92, 0xe9, 0x7b, 0x40, 0xb4, 0xc9, 0xf7, 0xd7, 0x8b, 0xfa, 0xa6, 0x6a, 0x2a, 0x96,
+	0xf5, 0x26, 0x3b, 0xbd, 0xaa, 0xaa, 0xab, 0xb2, 0x58, 0xe6, 0xf5, 0x6e, 0xb9, 0x2d, 0x77, 0xc5,
+	0xbe, 0x69, 0x8b, 0xd9, 0xe9, 0xe7, 0xbc, 0xdc, 0x7d, 0xc8, 0x9b, 0x62, 0xe9, 0x0f, 0x6d, 0x61,
+	0xf6, 0x9e, 0xe2, 0xb7, 0x45, 0x5d, 0xdd, 0xee, 0x9a, 0x75, 0xf1, 0xe9, 0xae, 0xb8, 0x6d, 0xf8,
+	0x31, 0x75, 0xf3, 0xed, 0xb6, 0xba, 0xdb, 0x37, 0x23, 0x38, 0x83, 0x79, 0xb8, 0x0a, 0x7f, 0xfc,
+	0xfe, 0x89, 0xea, 0x46, 0xf6, 0x61, 0xed, 0x2b, 0x3c, 0xa7, 0x20, 0xff, 0x68, 0xdf, 0xc8, 0x33,
+	0x98, 0xc3, 0xaa, 0x6f, 0xde, 0xf4, 0x38, 0x9c, 0x0a, 0xf7, 0xad, 0x5d, 0x7d, 0xb6, 0xa2, 0xe8,
+	0x30, 0xa0, 0x2e, 0xef, 0x79, 0xf4, 0x9f, 0xfe, 0x9f, 0x73, 0x44, 0xdd, 0x4d, 0x5e, 0xe6, 0xfb,
+	0x6d, 0xd1, 0x4a, 0xd7, 0x1e, 0x9f, 0x5d, 0x92, 0x5a, 0xe5, 0xfb, 0x6b, 0x5e, 0x51, 0xd7, 0xb9,
+	0x98, 0x17, 0xf5, 0x66, 0xf1, 0x70, 0xf3, 0xac, 0xff, 0xe0, 0xae, 0x2e, 0xef, 0x67, 0xc7, 0xbf,
+	0x2e, 0x62, 0xa7, 0x7f, 0xd2, 0xee, 0x73, 0xf9, 0x07, 0x29, 0x60, 0x25, 0xc4, 0x10, 0x48, 0x13,
+	0x44, 0x8c, 0x42, 0xb0, 0x39, 0x49, 0x46, 0x29, 0x7a, 0x14, 0x92, 0x44, 0xc1, 0xa8, 0xc4, 0xd4,
+	0x1e, 0x81, 0xb1, 0x23, 0xa6, 0x44, 0x24, 0x03, 0xc1, 0xaa, 0x2b, 0x7a, 0x40, 0x44, 0x18, 0x08,
+	0x60, 0xec, 0xea, 0x88, 0x22, 0x52, 0x81, 0x90, 0x82, 0x95, 0xd6, 0x14, 0x52, 0x44, 0x1d, 0x43,
+	0xc0, 0xa8, 0xa3, 0x81, 0x27, 0xc9, 0xa8, 0xd3, 0x99, 0x27, 0x64, 0xd4, 0x4f, 0xcf, 0x3d, 0x29,
+	0xc6, 0xb0, 0xff, 0x8e, 0x12, 0xd2, 0x96, 0xbe, 0x69, 0xd1, 0x5e, 0x10, 0x49, 0x25, 0x58, 0x1d,
+	0x89, 0xbe, 0x1d, 0xa7, 0x8c, 0xf2, 0x48, 0x9f, 0x50, 0x8f, 0x94, 0x32, 0xe3, 0x30, 0xd6, 0x17,
+	0xc6, 0x61, 0xa0, 0x63, 0x28, 0xf6, 0x04, 0x8c, 0x71, 0x72, 0xe2, 0x09, 0x19, 0xe3, 0x71, 0xe6,
+	0x49, 0x33, 0xc6, 0x93, 0x37, 0x74, 0x4c, 0x64, 0xe9, 0xeb, 0x97, 0x17, 0xb1, 0x64, 0x8c, 0x1f,
+	0xbd, 0x76, 0x5e, 0x60, 0x4c, 0xf4, 0x2b, 0xf7, 0x1a, 0x3a, 0x86, 0xbc, 0x17, 0x4c, 0x2d, 0x19,
+	0x7a, 0x42, 0xc6, 0x64, 0x34, 0xf6, 0xa4, 0x19, 0x93, 0xec, 0xa5, 0xf3, 0x82, 0xf1, 0x4a, 0xc5,
+	0x98, 0x4c, 0xce, 0x6d, 0x0e, 0x60, 0xc5, 0x62, 0xd8, 0xe6, 0x30, 0x1a, 0xd6, 0xa9, 0x9d, 0x07,
+	0x26, 0xc7, 0x40, 0x4f, 0xac, 0x05, 0x6c, 0x8e, 0x81, 0x9b, 0x07, 0x36, 0xc7, 0xc0, 0xe5, 0x00,
+	0x9b, 0x63, 0x30, 0xce, 0x5c, 0x1b, 0x30, 0xa6, 0x87, 0x36, 0xb3, 0x66, 0x7a, 0x68, 0x33, 0xfe,
+	0xf4, 0xd0, 0x66, 0xd6, 0x4c, 0xc7, 0xd9, 0x26, 0xb0, 0x7f, 0xfa, 0xf3, 0xbf, 0x03, 0x00, 0xa4,
+	0x30, 0xfc, 0x9b, 0x30, 0x03, 0x00, 0x00,
+}
@@ -6,6 +6,8 @@ package pb
 import proto "github.com/golang/protobuf/proto"
 import fmt "fmt"
 import math "math"
+import _ "github.com/envoyproxy/protoc-gen-validate/validate"
+import _ "google.golang.org/genproto/googleapis/api/annotations"
 
 import (
 	context "golang.org/x/net/context"
@@ -39,7 +41,7 @@ func (m *DepositRequest) Reset()         { *m = DepositRequest{} }
 func (m *DepositRequest) String() string { return proto.CompactTextString(m) }
 func (*DepositRequest) ProtoMessage()    {}
 func (*DepositRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bank_24a687593763ef7d, []int{0}
+	return fileDescriptor_bank_3fc81b57dc370db5, []int{0}
 }
 func (m *DepositRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -94,7 +96,7 @@ func (m *DepositReply) Reset()         { *m = DepositReply{} }
 func (m *DepositReply) String() string { return proto.CompactTextString(m) }
 func (*DepositReply) ProtoMessage()    {}
 func (*DepositReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bank_24a687593763ef7d, []int{1}
+	return fileDescriptor_bank_3fc81b57dc370db5, []int{1}
 }
 func (m *DepositReply) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -627,19 +629,24 @@ var (
 	ErrIntOverflowBank   = fmt.Errorf("proto: integer overflow")
 )
 
-func init() { proto.RegisterFile("bank.proto", fileDescriptor_bank_24a687593763ef7d) }
+func init() { proto.RegisterFile("bank.proto", fileDescriptor_bank_3fc81b57dc370db5) }
 
-var fileDescriptor_bank_24a687593763ef7d = []byte{
-	// 173 bytes of a gzipped FileDescriptorProto
+var fileDescriptor_bank_3fc81b57dc370db5 = []byte{
+	// 243 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0x4a, 0x4a, 0xcc, 0xcb,
-	0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x62, 0x2a, 0x48, 0x52, 0x72, 0xe2, 0xe2, 0x73, 0x49,
-	0x2d, 0xc8, 0x2f, 0xce, 0x2c, 0x09, 0x4a, 0x2d, 0x2c, 0x4d, 0x2d, 0x2e, 0x11, 0x92, 0xe0, 0x62,
-	0x4f, 0x4c, 0x4e, 0xce, 0x2f, 0xcd, 0x2b, 0x91, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x0c, 0x82, 0x71,
-	0x85, 0xc4, 0xb8, 0xd8, 0x12, 0x73, 0xc1, 0x12, 0x4c, 0x0a, 0x8c, 0x1a, 0x8c, 0x41, 0x50, 0x9e,
-	0x92, 0x13, 0x17, 0x0f, 0xdc, 0x8c, 0x82, 0x9c, 0x4a, 0x3c, 0x26, 0x48, 0x70, 0xb1, 0x27, 0x25,
-	0xe6, 0x24, 0xe6, 0x25, 0xa7, 0x42, 0x8d, 0x80, 0x71, 0x8d, 0xcc, 0xb9, 0x58, 0x9c, 0x12, 0xf3,
-	0xb2, 0x85, 0xf4, 0xb9, 0xd8, 0xa1, 0x66, 0x09, 0x09, 0xe9, 0x15, 0x24, 0xe9, 0xa1, 0x3a, 0x4e,
-	0x4a, 0x00, 0x45, 0xac, 0x20, 0xa7, 0xd2, 0x49, 0xe0, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4,
-	0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf1, 0x58, 0x8e, 0x21, 0x89, 0x0d, 0xec, 0x3b, 0x63, 0x40,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0xa5, 0x6a, 0x33, 0xe8, 0xeb, 0x00, 0x00, 0x00,
+	0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x62, 0x2a, 0x48, 0x92, 0x12, 0x4f, 0xcf, 0xcf, 0x4f,
+	0xcf, 0x49, 0xd5, 0x4f, 0x2c, 0xc8, 0xd4, 0x4f, 0xce, 0xc9, 0x4c, 0xcd, 0x2b, 0x81, 0x48, 0x4a,
+	0x89, 0x97, 0x25, 0xe6, 0x64, 0xa6, 0x24, 0x96, 0xa4, 0xea, 0xc3, 0x18, 0x10, 0x09, 0xa5, 0x78,
+	0x2e, 0x3e, 0x97, 0xd4, 0x82, 0xfc, 0xe2, 0xcc, 0x92, 0xa0, 0xd4, 0xc2, 0xd2, 0xd4, 0xe2, 0x12,
+	0x21, 0x65, 0x2e, 0xf6, 0xc4, 0xe4, 0xe4, 0xfc, 0xd2, 0xbc, 0x12, 0x09, 0x46, 0x05, 0x46, 0x0d,
+	0x4e, 0x27, 0xce, 0x5d, 0x2f, 0x0f, 0x30, 0xb3, 0x14, 0x31, 0x09, 0x30, 0x06, 0xc1, 0x64, 0x84,
+	0x34, 0xb8, 0xd8, 0x12, 0x73, 0xc1, 0x6a, 0x98, 0x14, 0x18, 0x35, 0x18, 0x9d, 0x04, 0x40, 0x6a,
+	0xb8, 0x85, 0x38, 0x15, 0x19, 0xa0, 0x20, 0x08, 0x2a, 0xaf, 0xe4, 0xc4, 0xc5, 0x03, 0xb7, 0xa0,
+	0x20, 0xa7, 0x52, 0x48, 0x02, 0xcd, 0x78, 0x84, 0x99, 0x12, 0x5c, 0xec, 0x49, 0x89, 0x39, 0x89,
+	0x79, 0xc9, 0xa9, 0x10, 0x43, 0x83, 0x60, 0x5c, 0x23, 0x2f, 0x2e, 0x16, 0xa7, 0xc4, 0xbc, 0x6c,
+	0x21, 0x27, 0x2e, 0x76, 0xa8, 0x59, 0x42, 0x42, 0x7a, 0x05, 0x49, 0x7a, 0xa8, 0x2e, 0x97, 0x12,
+	0x40, 0x11, 0x2b, 0xc8, 0xa9, 0x54, 0x12, 0xbc, 0xe5, 0xc8, 0x07, 0x35, 0x5e, 0x07, 0xe2, 0x1e,
+	0x27, 0x81, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc6,
+	0x63, 0x39, 0x86, 0x24, 0x36, 0x70, 0x48, 0x18, 0x03, 0x06, 0x00, 0xf3, 0x97, 0x2c, 0xd9, 0x4d,
+	0x01, 0x00, 0x00,
 }
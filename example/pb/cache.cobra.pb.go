@@ -19,12 +19,28 @@ import (
 	proto "github.com/golang/protobuf/proto"
 	cobra "github.com/spf13/cobra"
 	pflag "github.com/spf13/pflag"
+	batch "github.com/tetratelabs/protoc-gen-cobra/batch"
+	describe "github.com/tetratelabs/protoc-gen-cobra/describe"
+	destructive "github.com/tetratelabs/protoc-gen-cobra/destructive"
+	dryrun "github.com/tetratelabs/protoc-gen-cobra/dryrun"
+	editing "github.com/tetratelabs/protoc-gen-cobra/editing"
+	health "github.com/tetratelabs/protoc-gen-cobra/health"
 	iocodec "github.com/tetratelabs/protoc-gen-cobra/iocodec"
+	loadtest "github.com/tetratelabs/protoc-gen-cobra/loadtest"
+	override "github.com/tetratelabs/protoc-gen-cobra/override"
+	prompting "github.com/tetratelabs/protoc-gen-cobra/prompting"
+	recording "github.com/tetratelabs/protoc-gen-cobra/recording"
+	streaming "github.com/tetratelabs/protoc-gen-cobra/streaming"
+	templating "github.com/tetratelabs/protoc-gen-cobra/templating"
+	tracing "github.com/tetratelabs/protoc-gen-cobra/tracing"
+	validation "github.com/tetratelabs/protoc-gen-cobra/validation"
+	verbose "github.com/tetratelabs/protoc-gen-cobra/verbose"
 	context "golang.org/x/net/context"
 	oauth2 "golang.org/x/oauth2"
 	grpc "google.golang.org/grpc"
 	credentials "google.golang.org/grpc/credentials"
 	oauth "google.golang.org/grpc/credentials/oauth"
+	metadata "google.golang.org/grpc/metadata"
 )
 
 // This is a compile-time assertion to ensure that this generated file
@@ -50,6 +66,17 @@ type _CacheClientCommandConfig struct {
 	AuthTokenType      string
 	JWTKey             string
 	JWTKeyFile         string
+	SkipValidation     bool
+	LoadTest           loadtest.Options
+	Record             string
+	DryRun             bool
+	Verbose            bool
+	Trace              tracing.Options
+	Set                override.Options
+	Template           templating.Options
+	Edit               bool
+	Interactive        bool
+	Destructive        destructive.Options
 }
 
 func _NewCacheClientCommandConfig() *_CacheClientCommandConfig {
@@ -79,6 +106,17 @@ func (o *_CacheClientCommandConfig) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&o.AuthTokenType, "auth-token-type", o.AuthTokenType, "authorization token type")
 	fs.StringVar(&o.JWTKey, "jwt-key", o.JWTKey, "jwt key")
 	fs.StringVar(&o.JWTKeyFile, "jwt-key-file", o.JWTKeyFile, "jwt key file")
+	fs.BoolVar(&o.SkipValidation, "skip-validation", o.SkipValidation, "skip the client-side validation of requests")
+	o.LoadTest.AddFlags(fs)
+	fs.StringVar(&o.Record, "record", o.Record, "record the calls, with their metadata, messages, statuses and timings, to this session file")
+	fs.BoolVar(&o.DryRun, "dry-run", o.DryRun, "print the requests, where they would be sent and the metadata, with secrets redacted, instead of sending them")
+	fs.BoolVarP(&o.Verbose, "verbose", "v", o.Verbose, "log the connection, the tls handshake, and the metadata, message sizes and timings of the calls to stderr, with secrets redacted")
+	o.Trace.AddFlags(fs)
+	o.Set.AddFlags(fs)
+	o.Template.AddFlags(fs)
+	fs.BoolVar(&o.Edit, "edit", o.Edit, "open the request, or a sample of it without one, in $EDITOR before sending it")
+	fs.BoolVar(&o.Interactive, "interactive", o.Interactive, "when stdin is a terminal, prompt for the fields the request doesn't set, and for confirmation before sending it")
+	o.Destructive.AddFlags(fs)
 }
 
 func CacheClientCommand() *cobra.Command {
@@ -90,14 +128,129 @@ func CacheClientCommand() *cobra.Command {
 	for _, s := range _CacheClientSubCommands {
 		cmd.AddCommand(s())
 	}
+	cmd.AddCommand(_CacheReplayCommand())
+	cmd.AddCommand(_CacheHealthCommand())
+	cmd.AddCommand(_CacheDescribeCommand())
+	return cmd
+}
+
+func _CacheReplayCommand() *cobra.Command {
+	var compare bool
+	cmd := &cobra.Command{
+		Use:   "replay [file]",
+		Short: "Replay the calls of a session recorded with --record",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			f, err := os.Open(args[0])
+			if err != nil {
+				log.Fatal(err)
+			}
+			defer f.Close()
+			err = _CacheRoundTrip(nil, nil, func(conn *grpc.ClientConn, cli CacheClient, in iocodec.Decoder, out iocodec.Encoder) error {
+				if _DefaultCacheClientCommandConfig.DryRun {
+					reqs, err := recording.Requests(f)
+					if err != nil {
+						return err
+					}
+					return _CacheDryRun(out, reqs...)
+				}
+				return recording.Replay(conn, f, compare, out, os.Stderr)
+			})
+			if err != nil {
+				log.Fatal(err)
+			}
+		},
+	}
+	cmd.Flags().BoolVar(&compare, "compare", false, "compare the responses and statuses to the recorded ones, field by field, and fail if they differ")
+	return cmd
+}
+
+func _CacheHealthCommand() *cobra.Command {
+	opts := health.Options{Service: "pb.Cache"}
+	cmd := &cobra.Command{
+		Use:     "health",
+		Aliases: []string{"ping"},
+		Short:   "Check the health of pb.Cache with the gRPC health checking protocol, and report how the connection is made",
+		Long:    "Check the health of pb.Cache with the gRPC health checking protocol, and report how the connection is made: its TLS details, the certificate chain of the server and the latency of the check.\n\nExits with 0 if the service is serving, 2 if the connection fails, 3 if the check fails, and 4 if the service isn't serving.",
+		Args:    cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			cfg := _DefaultCacheClientCommandConfig
+			em, err := _CacheEncoderMaker()
+			if err != nil {
+				log.Fatal(err)
+			}
+			if cfg.DryRun {
+				if err := _CacheDryRun(em.NewEncoder(os.Stdout)); err != nil {
+					log.Fatal(err)
+				}
+				return
+			}
+			err = health.Check(opts, cfg.Timeout, func() (*grpc.ClientConn, error) {
+				conn, _, err := _DialCache()
+				return conn, err
+			}, em.NewEncoder(os.Stdout))
+			if err != nil {
+				log.Print(err)
+				os.Exit(health.ExitCode(err))
+			}
+		},
+	}
+	opts.AddFlags(cmd.Flags())
+	return cmd
+}
+
+func _CacheDescribeCommand() *cobra.Command {
+	var raw bool
+	cmd := &cobra.Command{
+		Use:   "describe [name]",
+		Short: "Print the definition of pb.Cache, or of one of its methods, messages or enums, with its comments",
+		Long:  "Print the definition of pb.Cache, or of one of its methods, messages or enums, with its comments, from the descriptors built into the command.\n\nThe name is a method, as named in the proto file or as its command, or the name of a message or enum, relative to the package of the service or in full. A method is followed by its request and response messages.",
+		Args:  cobra.MaximumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			name := ""
+			if len(args) > 0 {
+				name = args[0]
+			}
+			defs, err := describe.Lookup("pb.Cache", name)
+			if err != nil {
+				log.Fatal(err)
+			}
+			if !raw {
+				if err := describe.Print(os.Stdout, defs); err != nil {
+					log.Fatal(err)
+				}
+				return
+			}
+			em, err := _CacheEncoderMaker()
+			if err != nil {
+				log.Fatal(err)
+			}
+			enc := em.NewEncoder(os.Stdout)
+			for _, d := range defs {
+				if err := enc.Encode(d.Descriptor); err != nil {
+					log.Fatal(err)
+				}
+			}
+		},
+	}
+	cmd.Flags().BoolVar(&raw, "descriptor", false, "print the descriptors of the definitions in the response format instead of proto source")
 	return cmd
 }
 
 func _DialCache() (*grpc.ClientConn, CacheClient, error) {
 	cfg := _DefaultCacheClientCommandConfig
-	opts := []grpc.DialOption{
-		grpc.WithBlock(),
-		grpc.WithTimeout(cfg.Timeout),
+	var v *verbose.Logger
+	var opts []grpc.DialOption
+	if cfg.Trace.Enabled() {
+		t, err := tracing.Start(cfg.Trace)
+		if err != nil {
+			return nil, nil, fmt.Errorf("trace: %v", err)
+		}
+		opts = append(opts, t.DialOptions()...)
+	}
+	if cfg.Verbose {
+		v = verbose.New(os.Stderr)
+		opts = append(opts, v.DialOptions()...)
 	}
 	if cfg.TLS {
 		tlsConfig := &tls.Config{}
@@ -131,6 +284,9 @@ func _DialCache() (*grpc.ClientConn, CacheClient, error) {
 		}
 		//tlsConfig.BuildNameToCertificate()
 		cred := credentials.NewTLS(tlsConfig)
+		if v != nil {
+			cred = v.Credentials(cred)
+		}
 		opts = append(opts, grpc.WithTransportCredentials(cred))
 	} else {
 		opts = append(opts, grpc.WithInsecure())
@@ -156,34 +312,69 @@ func _DialCache() (*grpc.ClientConn, CacheClient, error) {
 		}
 		opts = append(opts, grpc.WithPerRPCCredentials(cred))
 	}
-	conn, err := grpc.Dial(cfg.ServerAddr, opts...)
+	if cfg.Record != "" {
+		rec, err := recording.Open(cfg.Record)
+		if err != nil {
+			return nil, nil, fmt.Errorf("record: %v", err)
+		}
+		opts = append(opts, rec.DialOptions()...)
+	}
+	var conn *grpc.ClientConn
+	var err error
+	if v != nil {
+		conn, err = v.Dial(cfg.ServerAddr, cfg.Timeout, opts...)
+	} else {
+		conn, err = grpc.Dial(cfg.ServerAddr, append(opts, grpc.WithBlock(), grpc.WithTimeout(cfg.Timeout))...)
+	}
 	if err != nil {
 		return nil, nil, err
 	}
 	return conn, NewCacheClient(conn), nil
 }
 
-type _CacheRoundTripFunc func(cli CacheClient, in iocodec.Decoder, out iocodec.Encoder) error
+// _CacheEncoderMaker returns the encoder maker of the response format.
+func _CacheEncoderMaker() (iocodec.EncoderMaker, error) {
+	format := _DefaultCacheClientCommandConfig.ResponseFormat
+	if format == "" {
+		format = "json"
+	}
+	em, ok := iocodec.DefaultEncoders[format]
+	if !ok {
+		return nil, fmt.Errorf("invalid response format: %q", format)
+	}
+	return em, nil
+}
 
-func _CacheRoundTrip(sample interface{}, fn _CacheRoundTripFunc) error {
+type _CacheRoundTripFunc func(conn *grpc.ClientConn, cli CacheClient, in iocodec.Decoder, out iocodec.Encoder) error
+
+// _CacheRoundTrip reads the request with prepare, when set, before it
+// dials the server and calls fn. Commands without a request have no sample.
+func _CacheRoundTrip(sample proto.Message, prepare func(in iocodec.Decoder) error, fn _CacheRoundTripFunc) error {
 	cfg := _DefaultCacheClientCommandConfig
-	var em iocodec.EncoderMaker
-	var ok bool
-	if cfg.ResponseFormat == "" {
-		em = iocodec.DefaultEncoders["json"]
-	} else {
-		em, ok = iocodec.DefaultEncoders[cfg.ResponseFormat]
-		if !ok {
-			return fmt.Errorf("invalid response format: %q", cfg.ResponseFormat)
-		}
+	em, err := _CacheEncoderMaker()
+	if err != nil {
+		return err
 	}
 	if cfg.PrintSampleRequest {
-		return em.NewEncoder(os.Stdout).Encode(sample)
+		if sample == nil {
+			return fmt.Errorf("no sample request")
+		}
+		return em.NewEncoder(os.Stdout).Encode(iocodec.NewSample(sample))
 	}
 	// read the input request, first from stdin, then from a file, otherwise from args only
 	var d iocodec.Decoder
 	if cfg.Stdin || cfg.RequestFile == "-" {
-		d = iocodec.DefaultDecoders["json"].NewDecoder(os.Stdin)
+		if cfg.Edit {
+			// the editor needs the terminal
+			return fmt.Errorf("--edit can't be used with a request read from stdin")
+		}
+		var in io.Reader = os.Stdin
+		if cfg.Template.Stdin {
+			if in, err = cfg.Template.Reader("stdin", os.Stdin); err != nil {
+				return fmt.Errorf("request template: %v", err)
+			}
+		}
+		d = iocodec.DefaultDecoders["json"].NewDecoder(in)
 	} else if cfg.RequestFile != "" {
 		f, err := os.Open(cfg.RequestFile)
 		if err != nil {
@@ -198,20 +389,121 @@ func _CacheRoundTrip(sample interface{}, fn _CacheRoundTripFunc) error {
 		if !ok {
 			return fmt.Errorf("invalid request file format: %q", ext)
 		}
-		d = dm.NewDecoder(f)
+		in, err := cfg.Template.Reader(cfg.RequestFile, f)
+		if err != nil {
+			return fmt.Errorf("request template: %v", err)
+		}
+		d = dm.NewDecoder(in)
 	} else {
 		d = iocodec.DefaultDecoders["noop"].NewDecoder(os.Stdin)
 	}
+	d = cfg.Set.Decoder(d)
+	if prepare != nil {
+		if err := prepare(d); err != nil {
+			return err
+		}
+	}
+	if cfg.DryRun {
+		// fn prints what it would send instead
+		return fn(nil, nil, d, em.NewEncoder(os.Stdout))
+	}
 	conn, client, err := _DialCache()
 	if err != nil {
 		return err
 	}
 	defer conn.Close()
-	return fn(client, d, em.NewEncoder(os.Stdout))
+	return fn(conn, client, d, em.NewEncoder(os.Stdout))
+}
+
+// _CachePrompter returns the prompter asking for the fields of requests,
+// or nil unless --interactive is given and stdin is a terminal.
+func _CachePrompter() *prompting.Prompter {
+	if !_DefaultCacheClientCommandConfig.Interactive || !prompting.IsTerminal(os.Stdin) {
+		return nil
+	}
+	return prompting.New(os.Stdin, os.Stderr)
+}
+
+// _CacheConfirmDestructive asks for confirmation of a call of the
+// destructive method, showing req unless nil. It's called once the server is
+// dialed, so sample requests and dry runs, which send nothing, aren't asked
+// about.
+func _CacheConfirmDestructive(command, method string, req proto.Message) error {
+	em, err := _CacheEncoderMaker()
+	if err != nil {
+		return err
+	}
+	return _DefaultCacheClientCommandConfig.Destructive.Confirm(command, method, req, em)
+}
+
+// _CacheEdit opens the editor on m, or on a sample of it when it's empty,
+// in the format of the request file, or else the response format.
+func _CacheEdit(m proto.Message, check func(proto.Message) error) error {
+	cfg := _DefaultCacheClientCommandConfig
+	format := cfg.ResponseFormat
+	if ext := filepath.Ext(cfg.RequestFile); len(ext) > 1 {
+		format = ext[1:]
+	}
+	var v interface{} = m
+	if proto.Size(m) == 0 {
+		v = iocodec.NewSample(m)
+	}
+	return editing.Edit(format, v, m, check)
+}
+
+// _CacheDryRun prints reqs, along with where and how they would be sent.
+func _CacheDryRun(out iocodec.Encoder, reqs ...proto.Message) error {
+	cfg := _DefaultCacheClientCommandConfig
+	plan := dryrun.Plan{
+		Target:   cfg.ServerAddr,
+		Timeout:  cfg.Timeout,
+		Metadata: metadata.MD{},
+		Requests: reqs,
+	}
+	if cfg.TLS {
+		plan.TLS = &dryrun.TLS{
+			ServerName:         cfg.ServerName,
+			InsecureSkipVerify: cfg.InsecureSkipVerify,
+			CACertFile:         cfg.CACertFile,
+			CertFile:           cfg.CertFile,
+			KeyFile:            cfg.KeyFile,
+		}
+		if plan.TLS.ServerName == "" {
+			plan.TLS.ServerName, _, _ = net.SplitHostPort(cfg.ServerAddr)
+		}
+	}
+	if cfg.AuthToken != "" {
+		plan.Metadata.Append("authorization", dryrun.Redact(cfg.AuthTokenType+" "+cfg.AuthToken))
+	}
+	if cfg.JWTKey != "" || cfg.JWTKeyFile != "" {
+		plan.Metadata.Append("authorization", "Bearer "+dryrun.Redacted)
+	}
+	return dryrun.Print(plan, out)
+}
+
+// _CacheLoadTest makes calls with call, as many and as fast as the load
+// test flags say, over conn and the connections it dials, and prints a report
+// of them.
+func _CacheLoadTest(conn *grpc.ClientConn, call func(ctx context.Context, cli CacheClient) error) error {
+	dial := func() (*grpc.ClientConn, error) {
+		conn, _, err := _DialCache()
+		return conn, err
+	}
+	return loadtest.Run(_DefaultCacheClientCommandConfig.LoadTest, conn, dial, func(ctx context.Context, conn *grpc.ClientConn) error {
+		return call(ctx, NewCacheClient(conn))
+	}, os.Stdout)
+}
+
+func _CacheValidate(m proto.Message, flags *pflag.FlagSet) error {
+	if _DefaultCacheClientCommandConfig.SkipValidation {
+		return nil
+	}
+	return validation.Validate(m, flags)
 }
 
 func _CacheSetClientCommand() *cobra.Command {
 	reqArgs := &SetRequest{}
+	var batchOpts batch.Options
 
 	cmd := &cobra.Command{
 		Use:     "set",
@@ -219,16 +511,90 @@ func _CacheSetClientCommand() *cobra.Command {
 		Example: "TODO: print protobuf method comments here",
 		Run: func(cmd *cobra.Command, args []string) {
 			var v SetRequest
-			err := _CacheRoundTrip(v, func(cli CacheClient, in iocodec.Decoder, out iocodec.Encoder) error {
 
+			prepare := func(in iocodec.Decoder) error {
 				err := in.Decode(&v)
 				if err != nil {
 					return err
 				}
 
 				proto.Merge(&v, reqArgs)
-				resp, err := cli.Set(context.Background(), &v)
 
+				prompter := _CachePrompter()
+				if prompter != nil {
+					err = prompter.Fields(&v)
+					if err != nil {
+						return err
+					}
+				}
+				if _DefaultCacheClientCommandConfig.Edit {
+					err = _CacheEdit(&v, func(m proto.Message) error {
+						return _CacheValidate(m, cmd.Flags())
+					})
+				} else {
+					err = _CacheValidate(&v, cmd.Flags())
+				}
+				if err != nil {
+					return err
+				}
+				if prompter == nil || _DefaultCacheClientCommandConfig.DryRun {
+					return nil
+				}
+				em, err := _CacheEncoderMaker()
+				if err != nil {
+					return err
+				}
+				return prompter.Confirm(&v, em)
+			}
+
+			if batchOpts.File != "" {
+				// the requests of the batch are read and checked one by one
+				prepare = nil
+			}
+
+			err := _CacheRoundTrip(&v, prepare, func(conn *grpc.ClientConn, cli CacheClient, in iocodec.Decoder, out iocodec.Encoder) error {
+
+				if batchOpts.File != "" {
+					if _DefaultCacheClientCommandConfig.DryRun {
+						return fmt.Errorf("--dry-run can't be used with --batch")
+					}
+					if _DefaultCacheClientCommandConfig.Edit {
+						return fmt.Errorf("--edit can't be used with --batch")
+					}
+					return batch.Run(batchOpts, func(ctx context.Context, r *batch.Record) (proto.Message, error) {
+						var v SetRequest
+						err := r.Decode(&v)
+						if err != nil {
+							return nil, err
+						}
+						err = _DefaultCacheClientCommandConfig.Set.Apply(&v)
+						if err != nil {
+							return nil, batch.Invalid(err)
+						}
+						// the defaults of the record must not leak into
+						// the next ones
+						reqArgs := proto.Clone(reqArgs).(*SetRequest)
+
+						proto.Merge(&v, reqArgs)
+						err = _CacheValidate(&v, cmd.Flags())
+						if err != nil {
+							return nil, batch.Invalid(err)
+						}
+						return cli.Set(ctx, &v)
+					}, out)
+				}
+
+				if _DefaultCacheClientCommandConfig.DryRun {
+					return _CacheDryRun(out, &v)
+				}
+				if _DefaultCacheClientCommandConfig.LoadTest.Enabled() {
+					return _CacheLoadTest(conn, func(ctx context.Context, cli CacheClient) error {
+						_, err := cli.Set(ctx, &v)
+						return err
+					})
+				}
+
+				resp, err := cli.Set(context.Background(), &v)
 				if err != nil {
 					return err
 				}
@@ -244,12 +610,14 @@ func _CacheSetClientCommand() *cobra.Command {
 
 	cmd.PersistentFlags().StringVar(&reqArgs.Key, "key", "", "get-comment-from-proto")
 	cmd.PersistentFlags().StringVar(&reqArgs.Value, "value", "", "get-comment-from-proto")
+	batchOpts.AddFlags(cmd.PersistentFlags())
 
 	return cmd
 }
 
 func _CacheGetClientCommand() *cobra.Command {
 	reqArgs := &GetRequest{}
+	var batchOpts batch.Options
 
 	cmd := &cobra.Command{
 		Use:     "get",
@@ -257,16 +625,90 @@ func _CacheGetClientCommand() *cobra.Command {
 		Example: "TODO: print protobuf method comments here",
 		Run: func(cmd *cobra.Command, args []string) {
 			var v GetRequest
-			err := _CacheRoundTrip(v, func(cli CacheClient, in iocodec.Decoder, out iocodec.Encoder) error {
 
+			prepare := func(in iocodec.Decoder) error {
 				err := in.Decode(&v)
 				if err != nil {
 					return err
 				}
 
 				proto.Merge(&v, reqArgs)
-				resp, err := cli.Get(context.Background(), &v)
 
+				prompter := _CachePrompter()
+				if prompter != nil {
+					err = prompter.Fields(&v)
+					if err != nil {
+						return err
+					}
+				}
+				if _DefaultCacheClientCommandConfig.Edit {
+					err = _CacheEdit(&v, func(m proto.Message) error {
+						return _CacheValidate(m, cmd.Flags())
+					})
+				} else {
+					err = _CacheValidate(&v, cmd.Flags())
+				}
+				if err != nil {
+					return err
+				}
+				if prompter == nil || _DefaultCacheClientCommandConfig.DryRun {
+					return nil
+				}
+				em, err := _CacheEncoderMaker()
+				if err != nil {
+					return err
+				}
+				return prompter.Confirm(&v, em)
+			}
+
+			if batchOpts.File != "" {
+				// the requests of the batch are read and checked one by one
+				prepare = nil
+			}
+
+			err := _CacheRoundTrip(&v, prepare, func(conn *grpc.ClientConn, cli CacheClient, in iocodec.Decoder, out iocodec.Encoder) error {
+
+				if batchOpts.File != "" {
+					if _DefaultCacheClientCommandConfig.DryRun {
+						return fmt.Errorf("--dry-run can't be used with --batch")
+					}
+					if _DefaultCacheClientCommandConfig.Edit {
+						return fmt.Errorf("--edit can't be used with --batch")
+					}
+					return batch.Run(batchOpts, func(ctx context.Context, r *batch.Record) (proto.Message, error) {
+						var v GetRequest
+						err := r.Decode(&v)
+						if err != nil {
+							return nil, err
+						}
+						err = _DefaultCacheClientCommandConfig.Set.Apply(&v)
+						if err != nil {
+							return nil, batch.Invalid(err)
+						}
+						// the defaults of the record must not leak into
+						// the next ones
+						reqArgs := proto.Clone(reqArgs).(*GetRequest)
+
+						proto.Merge(&v, reqArgs)
+						err = _CacheValidate(&v, cmd.Flags())
+						if err != nil {
+							return nil, batch.Invalid(err)
+						}
+						return cli.Get(ctx, &v)
+					}, out)
+				}
+
+				if _DefaultCacheClientCommandConfig.DryRun {
+					return _CacheDryRun(out, &v)
+				}
+				if _DefaultCacheClientCommandConfig.LoadTest.Enabled() {
+					return _CacheLoadTest(conn, func(ctx context.Context, cli CacheClient) error {
+						_, err := cli.Get(ctx, &v)
+						return err
+					})
+				}
+
+				resp, err := cli.Get(context.Background(), &v)
 				if err != nil {
 					return err
 				}
@@ -281,6 +723,7 @@ func _CacheGetClientCommand() *cobra.Command {
 	}
 
 	cmd.PersistentFlags().StringVar(&reqArgs.Key, "key", "", "get-comment-from-proto")
+	batchOpts.AddFlags(cmd.PersistentFlags())
 
 	return cmd
 }
@@ -294,7 +737,58 @@ func _CacheMultiSetClientCommand() *cobra.Command {
 		Example: "TODO: print protobuf method comments here",
 		Run: func(cmd *cobra.Command, args []string) {
 			var v SetRequest
-			err := _CacheRoundTrip(v, func(cli CacheClient, in iocodec.Decoder, out iocodec.Encoder) error {
+
+			if _DefaultCacheClientCommandConfig.Edit {
+				log.Fatal("--edit can't be used with client streams")
+			}
+			err := _CacheRoundTrip(&v, nil, func(conn *grpc.ClientConn, cli CacheClient, in iocodec.Decoder, out iocodec.Encoder) error {
+				if _DefaultCacheClientCommandConfig.LoadTest.Enabled() || _DefaultCacheClientCommandConfig.DryRun {
+					// every request is read up front
+					var reqs []*SetRequest
+					for {
+						req := new(SetRequest)
+						err := in.Decode(req)
+						if err == io.EOF {
+							break
+						}
+						if err != nil {
+							return err
+						}
+						err = _CacheValidate(req, cmd.Flags())
+						if err != nil {
+							return err
+						}
+						reqs = append(reqs, req)
+					}
+					if _DefaultCacheClientCommandConfig.DryRun {
+						msgs := make([]proto.Message, len(reqs))
+						for i, req := range reqs {
+							msgs[i] = req
+						}
+						return _CacheDryRun(out, msgs...)
+					}
+					// every call sends the same requests
+					return _CacheLoadTest(conn, func(ctx context.Context, cli CacheClient) error {
+						stream, err := cli.MultiSet(ctx)
+						if err != nil {
+							return err
+						}
+
+						for _, req := range reqs {
+							err := stream.Send(req)
+							if err == io.EOF {
+								// CloseAndRecv tells why
+								break
+							}
+							if err != nil {
+								return err
+							}
+						}
+						_, err = stream.CloseAndRecv()
+						return err
+
+					})
+				}
 
 				stream, err := cli.MultiSet(context.Background())
 				if err != nil {
@@ -309,6 +803,10 @@ func _CacheMultiSetClientCommand() *cobra.Command {
 					if err != nil {
 						return err
 					}
+					err = _CacheValidate(&v, cmd.Flags())
+					if err != nil {
+						return err
+					}
 					err = stream.Send(&v)
 					if err != nil {
 						return err
@@ -344,41 +842,85 @@ func _CacheMultiGetClientCommand() *cobra.Command {
 		Example: "TODO: print protobuf method comments here",
 		Run: func(cmd *cobra.Command, args []string) {
 			var v GetRequest
-			err := _CacheRoundTrip(v, func(cli CacheClient, in iocodec.Decoder, out iocodec.Encoder) error {
 
-				stream, err := cli.MultiGet(context.Background())
-				if err != nil {
-					return err
-				}
-				for {
-					err = in.Decode(&v)
-					if err == io.EOF {
-						stream.CloseSend()
-						break
-					}
-					if err != nil {
-						return err
+			if _DefaultCacheClientCommandConfig.Edit {
+				log.Fatal("--edit can't be used with client streams")
+			}
+			err := _CacheRoundTrip(&v, nil, func(conn *grpc.ClientConn, cli CacheClient, in iocodec.Decoder, out iocodec.Encoder) error {
+				if _DefaultCacheClientCommandConfig.LoadTest.Enabled() || _DefaultCacheClientCommandConfig.DryRun {
+					// every request is read up front
+					var reqs []*GetRequest
+					for {
+						req := new(GetRequest)
+						err := in.Decode(req)
+						if err == io.EOF {
+							break
+						}
+						if err != nil {
+							return err
+						}
+						err = _CacheValidate(req, cmd.Flags())
+						if err != nil {
+							return err
+						}
+						reqs = append(reqs, req)
 					}
-					err = stream.Send(&v)
-					if err != nil {
-						return err
+					if _DefaultCacheClientCommandConfig.DryRun {
+						msgs := make([]proto.Message, len(reqs))
+						for i, req := range reqs {
+							msgs[i] = req
+						}
+						return _CacheDryRun(out, msgs...)
 					}
+					// every call sends the same requests
+					return _CacheLoadTest(conn, func(ctx context.Context, cli CacheClient) error {
+						stream, err := cli.MultiGet(ctx)
+						if err != nil {
+							return err
+						}
+
+						go func() {
+							for _, req := range reqs {
+								if stream.Send(req) != nil {
+									// Recv tells why
+									return
+								}
+							}
+							stream.CloseSend()
+						}()
+						for {
+							_, err := stream.Recv()
+							if err == io.EOF {
+								return nil
+							}
+							if err != nil {
+								return err
+							}
+						}
+
+					})
 				}
 
-				for {
-					v, err := stream.Recv()
-					if err == io.EOF {
-						break
+				// send and receive concurrently, so responses are printed as
+				// they arrive
+				return streaming.Bidi(func(ctx context.Context) (grpc.ClientStream, func() (proto.Message, error), error) {
+					stream, err := cli.MultiGet(ctx)
+					if err != nil {
+						return nil, nil, err
 					}
+					return stream, func() (proto.Message, error) { return stream.Recv() }, nil
+				}, func() (proto.Message, error) {
+					req := new(GetRequest)
+					err := in.Decode(req)
 					if err != nil {
-						return err
+						return nil, err
 					}
-					err = out.Encode(v)
+					err = _CacheValidate(req, cmd.Flags())
 					if err != nil {
-						return err
+						return nil, err
 					}
-				}
-				return nil
+					return req, nil
+				}, out)
 
 			})
 			if err != nil {
@@ -398,3 +940,39 @@ var _CacheClientSubCommands = []func() *cobra.Command{
 	_CacheMultiSetClientCommand,
 	_CacheMultiGetClientCommand,
 }
+
+func init() { describe.Register(_descriptorSet_Cache_5fca3b110c9bbf3a) }
+
+var _descriptorSet_Cache_5fca3b110c9bbf3a = []byte{
+	// 480 bytes of a gzipped FileDescriptorSet
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xd1, 0x6a, 0x13, 0x41,
+	0x14, 0x86, 0x67, 0xe6, 0x9f, 0x59, 0x67, 0x4f, 0x36, 0x49, 0x7b, 0x9a, 0x4d, 0x36, 0x51, 0x44,
+	0x22, 0x86, 0xdc, 0x18, 0xdb, 0x2a, 0xe8, 0x85, 0x78, 0x53, 0x24, 0x20, 0x78, 0xd3, 0x3e, 0x41,
+	0x53, 0x16, 0x14, 0x8b, 0x89, 0x66, 0x23, 0xf8, 0x64, 0xbe, 0x89, 0x8f, 0xe2, 0xb5, 0x9c, 0xc9,
+	0xcc, 0xa6, 0x78, 0x21, 0xbd, 0xdb, 0x6f, 0xe6, 0xfc, 0xdf, 0xfc, 0x1c, 0x58, 0xfa, 0xfd, 0x80,
+	0x3a, 0x37, 0xd7, 0x37, 0x9f, 0xea, 0xc5, 0xe6, 0xfb, 0xba, 0x59, 0xb3, 0xd9, 0xac, 0xa6, 0xaf,
+	0x88, 0xae, 0xea, 0xe6, 0xb2, 0xfe, 0xb6, 0xab, 0xb7, 0x0d, 0x1f, 0x11, 0xbe, 0xd4, 0x3f, 0x2b,
+	0xfd, 0x44, 0xcf, 0xf3, 0x4b, 0xf9, 0xe4, 0x01, 0xb9, 0x1f, 0xd7, 0xb7, 0xbb, 0xba, 0x32, 0xe1,
+	0x6c, 0x0f, 0xd3, 0x2e, 0x75, 0x42, 0x6a, 0xbb, 0x59, 0x7f, 0xdd, 0xd6, 0xd3, 0xc7, 0x44, 0xcb,
+	0xff, 0x48, 0xa6, 0x4f, 0xa9, 0xb3, 0x3c, 0x8c, 0x1f, 0x9c, 0xfa, 0x8e, 0xf3, 0xfc, 0x97, 0x26,
+	0x77, 0x21, 0xed, 0x78, 0x46, 0xb8, 0xaa, 0x1b, 0xee, 0x2d, 0x36, 0xab, 0xc5, 0xa1, 0xdc, 0xa4,
+	0xdf, 0x72, 0xf4, 0xcc, 0x08, 0xcb, 0x34, 0xb7, 0xfc, 0x67, 0xee, 0xee, 0x7b, 0xcf, 0xc9, 0x7f,
+	0xdc, 0xdd, 0x36, 0x9f, 0xef, 0x23, 0x9d, 0x6b, 0x7e, 0x11, 0xc7, 0xef, 0xe3, 0x9e, 0xeb, 0x53,
+	0xfd, 0xe1, 0x8f, 0xa5, 0x8c, 0xad, 0x52, 0x13, 0x4d, 0x9e, 0x74, 0xc1, 0x50, 0x8a, 0xe5, 0xcb,
+	0x30, 0x8c, 0xea, 0x10, 0x91, 0xc9, 0x14, 0x5b, 0xab, 0x48, 0x13, 0x11, 0x32, 0xa5, 0x19, 0xd6,
+	0x77, 0xa9, 0x43, 0x36, 0x53, 0x46, 0x31, 0x9c, 0x3f, 0xa7, 0x82, 0x9c, 0x80, 0x66, 0xb8, 0xa2,
+	0x9f, 0xc8, 0x30, 0xdc, 0xd1, 0x24, 0x11, 0x18, 0xee, 0xd9, 0x69, 0x8c, 0x69, 0x46, 0xd6, 0xc6,
+	0xb4, 0x50, 0x1b, 0x93, 0x77, 0xb3, 0x36, 0xa6, 0xc1, 0xc8, 0xda, 0x98, 0x61, 0x78, 0xff, 0x2e,
+	0x5e, 0x89, 0xc4, 0x17, 0x83, 0x44, 0x8e, 0xe1, 0xcb, 0x87, 0x89, 0x64, 0xf2, 0xd1, 0x2c, 0x11,
+	0x18, 0xfe, 0xec, 0x6d, 0x94, 0x80, 0x91, 0xfb, 0xf7, 0xf1, 0x0a, 0x9a, 0x91, 0xb7, 0x12, 0x38,
+	0x46, 0xde, 0x4a, 0x60, 0x18, 0x79, 0x2b, 0x41, 0xc6, 0xc8, 0xcf, 0x5e, 0x27, 0x12, 0xcb, 0x9b,
+	0x0b, 0x22, 0x32, 0x56, 0xb1, 0x2d, 0x54, 0x3f, 0x6c, 0xc7, 0xca, 0x0a, 0x0a, 0xcf, 0xf2, 0x94,
+	0x0d, 0xdb, 0xe9, 0xfa, 0x91, 0x44, 0x04, 0x9c, 0x50, 0x2f, 0x91, 0x66, 0x74, 0xfb, 0x9c, 0x08,
+	0x8c, 0x6e, 0x39, 0x8c, 0x31, 0xcd, 0xe8, 0xf9, 0x71, 0xbc, 0xd2, 0x4e, 0x28, 0xc5, 0x64, 0x57,
+	0xbd, 0xfe, 0x20, 0x11, 0x18, 0xbd, 0x51, 0x15, 0x5a, 0x68, 0xb6, 0xc7, 0x8a, 0xf7, 0x2d, 0x64,
+	0xea, 0xd8, 0x9f, 0x84, 0x73, 0xc3, 0x76, 0xa0, 0x86, 0xfb, 0x73, 0x31, 0x0f, 0x62, 0x3b, 0x23,
+	0xed, 0xca, 0xd8, 0xce, 0x84, 0x76, 0x65, 0x7c, 0xc6, 0x84, 0x76, 0x65, 0x6c, 0x67, 0x42, 0xbb,
+	0xb2, 0x1c, 0x06, 0x1d, 0xd8, 0x56, 0x6a, 0xb2, 0xd7, 0xc9, 0xf2, 0x2a, 0x7f, 0x12, 0x74, 0x10,
+	0xdd, 0x38, 0xb6, 0x46, 0xd0, 0x8d, 0xa3, 0x0e, 0x41, 0x37, 0x8e, 0xad, 0x11, 0x74, 0xe3, 0x51,
+	0xb5, 0xca, 0xc2, 0x7f, 0xfc, 0xf2, 0xef, 0x00, 0x84, 0x01, 0x5c, 0x5c, 0xd9, 0x03, 0x00, 0x00,
+}
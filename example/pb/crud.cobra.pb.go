@@ -8,6 +8,7 @@ import (
 	tls "crypto/tls"
 	x509 "crypto/x509"
 	fmt "fmt"
+	io "io"
 	ioutil "io/ioutil"
 	log "log"
 	net "net"
@@ -18,12 +19,28 @@ import (
 	proto "github.com/golang/protobuf/proto"
 	cobra "github.com/spf13/cobra"
 	pflag "github.com/spf13/pflag"
+	batch "github.com/tetratelabs/protoc-gen-cobra/batch"
+	describe "github.com/tetratelabs/protoc-gen-cobra/describe"
+	destructive "github.com/tetratelabs/protoc-gen-cobra/destructive"
+	dryrun "github.com/tetratelabs/protoc-gen-cobra/dryrun"
+	editing "github.com/tetratelabs/protoc-gen-cobra/editing"
+	health "github.com/tetratelabs/protoc-gen-cobra/health"
 	iocodec "github.com/tetratelabs/protoc-gen-cobra/iocodec"
+	loadtest "github.com/tetratelabs/protoc-gen-cobra/loadtest"
+	override "github.com/tetratelabs/protoc-gen-cobra/override"
+	pagination "github.com/tetratelabs/protoc-gen-cobra/pagination"
+	prompting "github.com/tetratelabs/protoc-gen-cobra/prompting"
+	recording "github.com/tetratelabs/protoc-gen-cobra/recording"
+	templating "github.com/tetratelabs/protoc-gen-cobra/templating"
+	tracing "github.com/tetratelabs/protoc-gen-cobra/tracing"
+	validation "github.com/tetratelabs/protoc-gen-cobra/validation"
+	verbose "github.com/tetratelabs/protoc-gen-cobra/verbose"
 	context "golang.org/x/net/context"
 	oauth2 "golang.org/x/oauth2"
 	grpc "google.golang.org/grpc"
 	credentials "google.golang.org/grpc/credentials"
 	oauth "google.golang.org/grpc/credentials/oauth"
+	metadata "google.golang.org/grpc/metadata"
 )
 
 // This is a compile-time assertion to ensure that this generated file
@@ -49,6 +66,17 @@ type _CRUDClientCommandConfig struct {
 	AuthTokenType      string
 	JWTKey             string
 	JWTKeyFile         string
+	SkipValidation     bool
+	LoadTest           loadtest.Options
+	Record             string
+	DryRun             bool
+	Verbose            bool
+	Trace              tracing.Options
+	Set                override.Options
+	Template           templating.Options
+	Edit               bool
+	Interactive        bool
+	Destructive        destructive.Options
 }
 
 func _NewCRUDClientCommandConfig() *_CRUDClientCommandConfig {
@@ -78,6 +106,17 @@ func (o *_CRUDClientCommandConfig) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&o.AuthTokenType, "auth-token-type", o.AuthTokenType, "authorization token type")
 	fs.StringVar(&o.JWTKey, "jwt-key", o.JWTKey, "jwt key")
 	fs.StringVar(&o.JWTKeyFile, "jwt-key-file", o.JWTKeyFile, "jwt key file")
+	fs.BoolVar(&o.SkipValidation, "skip-validation", o.SkipValidation, "skip the client-side validation of requests")
+	o.LoadTest.AddFlags(fs)
+	fs.StringVar(&o.Record, "record", o.Record, "record the calls, with their metadata, messages, statuses and timings, to this session file")
+	fs.BoolVar(&o.DryRun, "dry-run", o.DryRun, "print the requests, where they would be sent and the metadata, with secrets redacted, instead of sending them")
+	fs.BoolVarP(&o.Verbose, "verbose", "v", o.Verbose, "log the connection, the tls handshake, and the metadata, message sizes and timings of the calls to stderr, with secrets redacted")
+	o.Trace.AddFlags(fs)
+	o.Set.AddFlags(fs)
+	o.Template.AddFlags(fs)
+	fs.BoolVar(&o.Edit, "edit", o.Edit, "open the request, or a sample of it without one, in $EDITOR before sending it")
+	fs.BoolVar(&o.Interactive, "interactive", o.Interactive, "when stdin is a terminal, prompt for the fields the request doesn't set, and for confirmation before sending it")
+	o.Destructive.AddFlags(fs)
 }
 
 func CRUDClientCommand() *cobra.Command {
@@ -89,14 +128,129 @@ func CRUDClientCommand() *cobra.Command {
 	for _, s := range _CRUDClientSubCommands {
 		cmd.AddCommand(s())
 	}
+	cmd.AddCommand(_CRUDReplayCommand())
+	cmd.AddCommand(_CRUDHealthCommand())
+	cmd.AddCommand(_CRUDDescribeCommand())
+	return cmd
+}
+
+func _CRUDReplayCommand() *cobra.Command {
+	var compare bool
+	cmd := &cobra.Command{
+		Use:   "replay [file]",
+		Short: "Replay the calls of a session recorded with --record",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			f, err := os.Open(args[0])
+			if err != nil {
+				log.Fatal(err)
+			}
+			defer f.Close()
+			err = _CRUDRoundTrip(nil, nil, func(conn *grpc.ClientConn, cli CRUDClient, in iocodec.Decoder, out iocodec.Encoder) error {
+				if _DefaultCRUDClientCommandConfig.DryRun {
+					reqs, err := recording.Requests(f)
+					if err != nil {
+						return err
+					}
+					return _CRUDDryRun(out, reqs...)
+				}
+				return recording.Replay(conn, f, compare, out, os.Stderr)
+			})
+			if err != nil {
+				log.Fatal(err)
+			}
+		},
+	}
+	cmd.Flags().BoolVar(&compare, "compare", false, "compare the responses and statuses to the recorded ones, field by field, and fail if they differ")
+	return cmd
+}
+
+func _CRUDHealthCommand() *cobra.Command {
+	opts := health.Options{Service: "pb.CRUD"}
+	cmd := &cobra.Command{
+		Use:     "health",
+		Aliases: []string{"ping"},
+		Short:   "Check the health of pb.CRUD with the gRPC health checking protocol, and report how the connection is made",
+		Long:    "Check the health of pb.CRUD with the gRPC health checking protocol, and report how the connection is made: its TLS details, the certificate chain of the server and the latency of the check.\n\nExits with 0 if the service is serving, 2 if the connection fails, 3 if the check fails, and 4 if the service isn't serving.",
+		Args:    cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			cfg := _DefaultCRUDClientCommandConfig
+			em, err := _CRUDEncoderMaker()
+			if err != nil {
+				log.Fatal(err)
+			}
+			if cfg.DryRun {
+				if err := _CRUDDryRun(em.NewEncoder(os.Stdout)); err != nil {
+					log.Fatal(err)
+				}
+				return
+			}
+			err = health.Check(opts, cfg.Timeout, func() (*grpc.ClientConn, error) {
+				conn, _, err := _DialCRUD()
+				return conn, err
+			}, em.NewEncoder(os.Stdout))
+			if err != nil {
+				log.Print(err)
+				os.Exit(health.ExitCode(err))
+			}
+		},
+	}
+	opts.AddFlags(cmd.Flags())
+	return cmd
+}
+
+func _CRUDDescribeCommand() *cobra.Command {
+	var raw bool
+	cmd := &cobra.Command{
+		Use:   "describe [name]",
+		Short: "Print the definition of pb.CRUD, or of one of its methods, messages or enums, with its comments",
+		Long:  "Print the definition of pb.CRUD, or of one of its methods, messages or enums, with its comments, from the descriptors built into the command.\n\nThe name is a method, as named in the proto file or as its command, or the name of a message or enum, relative to the package of the service or in full. A method is followed by its request and response messages.",
+		Args:  cobra.MaximumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			name := ""
+			if len(args) > 0 {
+				name = args[0]
+			}
+			defs, err := describe.Lookup("pb.CRUD", name)
+			if err != nil {
+				log.Fatal(err)
+			}
+			if !raw {
+				if err := describe.Print(os.Stdout, defs); err != nil {
+					log.Fatal(err)
+				}
+				return
+			}
+			em, err := _CRUDEncoderMaker()
+			if err != nil {
+				log.Fatal(err)
+			}
+			enc := em.NewEncoder(os.Stdout)
+			for _, d := range defs {
+				if err := enc.Encode(d.Descriptor); err != nil {
+					log.Fatal(err)
+				}
+			}
+		},
+	}
+	cmd.Flags().BoolVar(&raw, "descriptor", false, "print the descriptors of the definitions in the response format instead of proto source")
 	return cmd
 }
 
 func _DialCRUD() (*grpc.ClientConn, CRUDClient, error) {
 	cfg := _DefaultCRUDClientCommandConfig
-	opts := []grpc.DialOption{
-		grpc.WithBlock(),
-		grpc.WithTimeout(cfg.Timeout),
+	var v *verbose.Logger
+	var opts []grpc.DialOption
+	if cfg.Trace.Enabled() {
+		t, err := tracing.Start(cfg.Trace)
+		if err != nil {
+			return nil, nil, fmt.Errorf("trace: %v", err)
+		}
+		opts = append(opts, t.DialOptions()...)
+	}
+	if cfg.Verbose {
+		v = verbose.New(os.Stderr)
+		opts = append(opts, v.DialOptions()...)
 	}
 	if cfg.TLS {
 		tlsConfig := &tls.Config{}
@@ -130,6 +284,9 @@ func _DialCRUD() (*grpc.ClientConn, CRUDClient, error) {
 		}
 		//tlsConfig.BuildNameToCertificate()
 		cred := credentials.NewTLS(tlsConfig)
+		if v != nil {
+			cred = v.Credentials(cred)
+		}
 		opts = append(opts, grpc.WithTransportCredentials(cred))
 	} else {
 		opts = append(opts, grpc.WithInsecure())
@@ -155,34 +312,69 @@ func _DialCRUD() (*grpc.ClientConn, CRUDClient, error) {
 		}
 		opts = append(opts, grpc.WithPerRPCCredentials(cred))
 	}
-	conn, err := grpc.Dial(cfg.ServerAddr, opts...)
+	if cfg.Record != "" {
+		rec, err := recording.Open(cfg.Record)
+		if err != nil {
+			return nil, nil, fmt.Errorf("record: %v", err)
+		}
+		opts = append(opts, rec.DialOptions()...)
+	}
+	var conn *grpc.ClientConn
+	var err error
+	if v != nil {
+		conn, err = v.Dial(cfg.ServerAddr, cfg.Timeout, opts...)
+	} else {
+		conn, err = grpc.Dial(cfg.ServerAddr, append(opts, grpc.WithBlock(), grpc.WithTimeout(cfg.Timeout))...)
+	}
 	if err != nil {
 		return nil, nil, err
 	}
 	return conn, NewCRUDClient(conn), nil
 }
 
-type _CRUDRoundTripFunc func(cli CRUDClient, in iocodec.Decoder, out iocodec.Encoder) error
+// _CRUDEncoderMaker returns the encoder maker of the response format.
+func _CRUDEncoderMaker() (iocodec.EncoderMaker, error) {
+	format := _DefaultCRUDClientCommandConfig.ResponseFormat
+	if format == "" {
+		format = "json"
+	}
+	em, ok := iocodec.DefaultEncoders[format]
+	if !ok {
+		return nil, fmt.Errorf("invalid response format: %q", format)
+	}
+	return em, nil
+}
 
-func _CRUDRoundTrip(sample interface{}, fn _CRUDRoundTripFunc) error {
+type _CRUDRoundTripFunc func(conn *grpc.ClientConn, cli CRUDClient, in iocodec.Decoder, out iocodec.Encoder) error
+
+// _CRUDRoundTrip reads the request with prepare, when set, before it
+// dials the server and calls fn. Commands without a request have no sample.
+func _CRUDRoundTrip(sample proto.Message, prepare func(in iocodec.Decoder) error, fn _CRUDRoundTripFunc) error {
 	cfg := _DefaultCRUDClientCommandConfig
-	var em iocodec.EncoderMaker
-	var ok bool
-	if cfg.ResponseFormat == "" {
-		em = iocodec.DefaultEncoders["json"]
-	} else {
-		em, ok = iocodec.DefaultEncoders[cfg.ResponseFormat]
-		if !ok {
-			return fmt.Errorf("invalid response format: %q", cfg.ResponseFormat)
-		}
+	em, err := _CRUDEncoderMaker()
+	if err != nil {
+		return err
 	}
 	if cfg.PrintSampleRequest {
-		return em.NewEncoder(os.Stdout).Encode(sample)
+		if sample == nil {
+			return fmt.Errorf("no sample request")
+		}
+		return em.NewEncoder(os.Stdout).Encode(iocodec.NewSample(sample))
 	}
 	// read the input request, first from stdin, then from a file, otherwise from args only
 	var d iocodec.Decoder
 	if cfg.Stdin || cfg.RequestFile == "-" {
-		d = iocodec.DefaultDecoders["json"].NewDecoder(os.Stdin)
+		if cfg.Edit {
+			// the editor needs the terminal
+			return fmt.Errorf("--edit can't be used with a request read from stdin")
+		}
+		var in io.Reader = os.Stdin
+		if cfg.Template.Stdin {
+			if in, err = cfg.Template.Reader("stdin", os.Stdin); err != nil {
+				return fmt.Errorf("request template: %v", err)
+			}
+		}
+		d = iocodec.DefaultDecoders["json"].NewDecoder(in)
 	} else if cfg.RequestFile != "" {
 		f, err := os.Open(cfg.RequestFile)
 		if err != nil {
@@ -197,20 +389,121 @@ func _CRUDRoundTrip(sample interface{}, fn _CRUDRoundTripFunc) error {
 		if !ok {
 			return fmt.Errorf("invalid request file format: %q", ext)
 		}
-		d = dm.NewDecoder(f)
+		in, err := cfg.Template.Reader(cfg.RequestFile, f)
+		if err != nil {
+			return fmt.Errorf("request template: %v", err)
+		}
+		d = dm.NewDecoder(in)
 	} else {
 		d = iocodec.DefaultDecoders["noop"].NewDecoder(os.Stdin)
 	}
+	d = cfg.Set.Decoder(d)
+	if prepare != nil {
+		if err := prepare(d); err != nil {
+			return err
+		}
+	}
+	if cfg.DryRun {
+		// fn prints what it would send instead
+		return fn(nil, nil, d, em.NewEncoder(os.Stdout))
+	}
 	conn, client, err := _DialCRUD()
 	if err != nil {
 		return err
 	}
 	defer conn.Close()
-	return fn(client, d, em.NewEncoder(os.Stdout))
+	return fn(conn, client, d, em.NewEncoder(os.Stdout))
+}
+
+// _CRUDPrompter returns the prompter asking for the fields of requests,
+// or nil unless --interactive is given and stdin is a terminal.
+func _CRUDPrompter() *prompting.Prompter {
+	if !_DefaultCRUDClientCommandConfig.Interactive || !prompting.IsTerminal(os.Stdin) {
+		return nil
+	}
+	return prompting.New(os.Stdin, os.Stderr)
+}
+
+// _CRUDConfirmDestructive asks for confirmation of a call of the
+// destructive method, showing req unless nil. It's called once the server is
+// dialed, so sample requests and dry runs, which send nothing, aren't asked
+// about.
+func _CRUDConfirmDestructive(command, method string, req proto.Message) error {
+	em, err := _CRUDEncoderMaker()
+	if err != nil {
+		return err
+	}
+	return _DefaultCRUDClientCommandConfig.Destructive.Confirm(command, method, req, em)
+}
+
+// _CRUDEdit opens the editor on m, or on a sample of it when it's empty,
+// in the format of the request file, or else the response format.
+func _CRUDEdit(m proto.Message, check func(proto.Message) error) error {
+	cfg := _DefaultCRUDClientCommandConfig
+	format := cfg.ResponseFormat
+	if ext := filepath.Ext(cfg.RequestFile); len(ext) > 1 {
+		format = ext[1:]
+	}
+	var v interface{} = m
+	if proto.Size(m) == 0 {
+		v = iocodec.NewSample(m)
+	}
+	return editing.Edit(format, v, m, check)
+}
+
+// _CRUDDryRun prints reqs, along with where and how they would be sent.
+func _CRUDDryRun(out iocodec.Encoder, reqs ...proto.Message) error {
+	cfg := _DefaultCRUDClientCommandConfig
+	plan := dryrun.Plan{
+		Target:   cfg.ServerAddr,
+		Timeout:  cfg.Timeout,
+		Metadata: metadata.MD{},
+		Requests: reqs,
+	}
+	if cfg.TLS {
+		plan.TLS = &dryrun.TLS{
+			ServerName:         cfg.ServerName,
+			InsecureSkipVerify: cfg.InsecureSkipVerify,
+			CACertFile:         cfg.CACertFile,
+			CertFile:           cfg.CertFile,
+			KeyFile:            cfg.KeyFile,
+		}
+		if plan.TLS.ServerName == "" {
+			plan.TLS.ServerName, _, _ = net.SplitHostPort(cfg.ServerAddr)
+		}
+	}
+	if cfg.AuthToken != "" {
+		plan.Metadata.Append("authorization", dryrun.Redact(cfg.AuthTokenType+" "+cfg.AuthToken))
+	}
+	if cfg.JWTKey != "" || cfg.JWTKeyFile != "" {
+		plan.Metadata.Append("authorization", "Bearer "+dryrun.Redacted)
+	}
+	return dryrun.Print(plan, out)
+}
+
+// _CRUDLoadTest makes calls with call, as many and as fast as the load
+// test flags say, over conn and the connections it dials, and prints a report
+// of them.
+func _CRUDLoadTest(conn *grpc.ClientConn, call func(ctx context.Context, cli CRUDClient) error) error {
+	dial := func() (*grpc.ClientConn, error) {
+		conn, _, err := _DialCRUD()
+		return conn, err
+	}
+	return loadtest.Run(_DefaultCRUDClientCommandConfig.LoadTest, conn, dial, func(ctx context.Context, conn *grpc.ClientConn) error {
+		return call(ctx, NewCRUDClient(conn))
+	}, os.Stdout)
+}
+
+func _CRUDValidate(m proto.Message, flags *pflag.FlagSet) error {
+	if _DefaultCRUDClientCommandConfig.SkipValidation {
+		return nil
+	}
+	return validation.Validate(m, flags)
 }
 
 func _CRUDCreateClientCommand() *cobra.Command {
 	reqArgs := &CreateCRUD{}
+	var batchOpts batch.Options
 
 	cmd := &cobra.Command{
 		Use:     "create",
@@ -218,16 +511,90 @@ func _CRUDCreateClientCommand() *cobra.Command {
 		Example: "TODO: print protobuf method comments here",
 		Run: func(cmd *cobra.Command, args []string) {
 			var v CreateCRUD
-			err := _CRUDRoundTrip(v, func(cli CRUDClient, in iocodec.Decoder, out iocodec.Encoder) error {
 
+			prepare := func(in iocodec.Decoder) error {
 				err := in.Decode(&v)
 				if err != nil {
 					return err
 				}
 
 				proto.Merge(&v, reqArgs)
-				resp, err := cli.Create(context.Background(), &v)
 
+				prompter := _CRUDPrompter()
+				if prompter != nil {
+					err = prompter.Fields(&v)
+					if err != nil {
+						return err
+					}
+				}
+				if _DefaultCRUDClientCommandConfig.Edit {
+					err = _CRUDEdit(&v, func(m proto.Message) error {
+						return _CRUDValidate(m, cmd.Flags())
+					})
+				} else {
+					err = _CRUDValidate(&v, cmd.Flags())
+				}
+				if err != nil {
+					return err
+				}
+				if prompter == nil || _DefaultCRUDClientCommandConfig.DryRun {
+					return nil
+				}
+				em, err := _CRUDEncoderMaker()
+				if err != nil {
+					return err
+				}
+				return prompter.Confirm(&v, em)
+			}
+
+			if batchOpts.File != "" {
+				// the requests of the batch are read and checked one by one
+				prepare = nil
+			}
+
+			err := _CRUDRoundTrip(&v, prepare, func(conn *grpc.ClientConn, cli CRUDClient, in iocodec.Decoder, out iocodec.Encoder) error {
+
+				if batchOpts.File != "" {
+					if _DefaultCRUDClientCommandConfig.DryRun {
+						return fmt.Errorf("--dry-run can't be used with --batch")
+					}
+					if _DefaultCRUDClientCommandConfig.Edit {
+						return fmt.Errorf("--edit can't be used with --batch")
+					}
+					return batch.Run(batchOpts, func(ctx context.Context, r *batch.Record) (proto.Message, error) {
+						var v CreateCRUD
+						err := r.Decode(&v)
+						if err != nil {
+							return nil, err
+						}
+						err = _DefaultCRUDClientCommandConfig.Set.Apply(&v)
+						if err != nil {
+							return nil, batch.Invalid(err)
+						}
+						// the defaults of the record must not leak into
+						// the next ones
+						reqArgs := proto.Clone(reqArgs).(*CreateCRUD)
+
+						proto.Merge(&v, reqArgs)
+						err = _CRUDValidate(&v, cmd.Flags())
+						if err != nil {
+							return nil, batch.Invalid(err)
+						}
+						return cli.Create(ctx, &v)
+					}, out)
+				}
+
+				if _DefaultCRUDClientCommandConfig.DryRun {
+					return _CRUDDryRun(out, &v)
+				}
+				if _DefaultCRUDClientCommandConfig.LoadTest.Enabled() {
+					return _CRUDLoadTest(conn, func(ctx context.Context, cli CRUDClient) error {
+						_, err := cli.Create(ctx, &v)
+						return err
+					})
+				}
+
+				resp, err := cli.Create(context.Background(), &v)
 				if err != nil {
 					return err
 				}
@@ -243,12 +610,14 @@ func _CRUDCreateClientCommand() *cobra.Command {
 
 	cmd.PersistentFlags().StringVar(&reqArgs.Name, "name", "", "get-comment-from-proto")
 	cmd.PersistentFlags().StringVar(&reqArgs.Value, "value", "", "get-comment-from-proto")
+	batchOpts.AddFlags(cmd.PersistentFlags())
 
 	return cmd
 }
 
 func _CRUDGetClientCommand() *cobra.Command {
 	reqArgs := &GetCRUD{}
+	var batchOpts batch.Options
 
 	cmd := &cobra.Command{
 		Use:     "get",
@@ -256,16 +625,90 @@ func _CRUDGetClientCommand() *cobra.Command {
 		Example: "TODO: print protobuf method comments here",
 		Run: func(cmd *cobra.Command, args []string) {
 			var v GetCRUD
-			err := _CRUDRoundTrip(v, func(cli CRUDClient, in iocodec.Decoder, out iocodec.Encoder) error {
 
+			prepare := func(in iocodec.Decoder) error {
 				err := in.Decode(&v)
 				if err != nil {
 					return err
 				}
 
 				proto.Merge(&v, reqArgs)
-				resp, err := cli.Get(context.Background(), &v)
 
+				prompter := _CRUDPrompter()
+				if prompter != nil {
+					err = prompter.Fields(&v)
+					if err != nil {
+						return err
+					}
+				}
+				if _DefaultCRUDClientCommandConfig.Edit {
+					err = _CRUDEdit(&v, func(m proto.Message) error {
+						return _CRUDValidate(m, cmd.Flags())
+					})
+				} else {
+					err = _CRUDValidate(&v, cmd.Flags())
+				}
+				if err != nil {
+					return err
+				}
+				if prompter == nil || _DefaultCRUDClientCommandConfig.DryRun {
+					return nil
+				}
+				em, err := _CRUDEncoderMaker()
+				if err != nil {
+					return err
+				}
+				return prompter.Confirm(&v, em)
+			}
+
+			if batchOpts.File != "" {
+				// the requests of the batch are read and checked one by one
+				prepare = nil
+			}
+
+			err := _CRUDRoundTrip(&v, prepare, func(conn *grpc.ClientConn, cli CRUDClient, in iocodec.Decoder, out iocodec.Encoder) error {
+
+				if batchOpts.File != "" {
+					if _DefaultCRUDClientCommandConfig.DryRun {
+						return fmt.Errorf("--dry-run can't be used with --batch")
+					}
+					if _DefaultCRUDClientCommandConfig.Edit {
+						return fmt.Errorf("--edit can't be used with --batch")
+					}
+					return batch.Run(batchOpts, func(ctx context.Context, r *batch.Record) (proto.Message, error) {
+						var v GetCRUD
+						err := r.Decode(&v)
+						if err != nil {
+							return nil, err
+						}
+						err = _DefaultCRUDClientCommandConfig.Set.Apply(&v)
+						if err != nil {
+							return nil, batch.Invalid(err)
+						}
+						// the defaults of the record must not leak into
+						// the next ones
+						reqArgs := proto.Clone(reqArgs).(*GetCRUD)
+
+						proto.Merge(&v, reqArgs)
+						err = _CRUDValidate(&v, cmd.Flags())
+						if err != nil {
+							return nil, batch.Invalid(err)
+						}
+						return cli.Get(ctx, &v)
+					}, out)
+				}
+
+				if _DefaultCRUDClientCommandConfig.DryRun {
+					return _CRUDDryRun(out, &v)
+				}
+				if _DefaultCRUDClientCommandConfig.LoadTest.Enabled() {
+					return _CRUDLoadTest(conn, func(ctx context.Context, cli CRUDClient) error {
+						_, err := cli.Get(ctx, &v)
+						return err
+					})
+				}
+
+				resp, err := cli.Get(context.Background(), &v)
 				if err != nil {
 					return err
 				}
@@ -280,12 +723,14 @@ func _CRUDGetClientCommand() *cobra.Command {
 	}
 
 	cmd.PersistentFlags().StringVar(&reqArgs.Name, "name", "", "get-comment-from-proto")
+	batchOpts.AddFlags(cmd.PersistentFlags())
 
 	return cmd
 }
 
 func _CRUDUpdateClientCommand() *cobra.Command {
 	reqArgs := &CRUDObject{}
+	var batchOpts batch.Options
 
 	cmd := &cobra.Command{
 		Use:     "update",
@@ -293,16 +738,90 @@ func _CRUDUpdateClientCommand() *cobra.Command {
 		Example: "TODO: print protobuf method comments here",
 		Run: func(cmd *cobra.Command, args []string) {
 			var v CRUDObject
-			err := _CRUDRoundTrip(v, func(cli CRUDClient, in iocodec.Decoder, out iocodec.Encoder) error {
 
+			prepare := func(in iocodec.Decoder) error {
 				err := in.Decode(&v)
 				if err != nil {
 					return err
 				}
 
 				proto.Merge(&v, reqArgs)
-				resp, err := cli.Update(context.Background(), &v)
 
+				prompter := _CRUDPrompter()
+				if prompter != nil {
+					err = prompter.Fields(&v)
+					if err != nil {
+						return err
+					}
+				}
+				if _DefaultCRUDClientCommandConfig.Edit {
+					err = _CRUDEdit(&v, func(m proto.Message) error {
+						return _CRUDValidate(m, cmd.Flags())
+					})
+				} else {
+					err = _CRUDValidate(&v, cmd.Flags())
+				}
+				if err != nil {
+					return err
+				}
+				if prompter == nil || _DefaultCRUDClientCommandConfig.DryRun {
+					return nil
+				}
+				em, err := _CRUDEncoderMaker()
+				if err != nil {
+					return err
+				}
+				return prompter.Confirm(&v, em)
+			}
+
+			if batchOpts.File != "" {
+				// the requests of the batch are read and checked one by one
+				prepare = nil
+			}
+
+			err := _CRUDRoundTrip(&v, prepare, func(conn *grpc.ClientConn, cli CRUDClient, in iocodec.Decoder, out iocodec.Encoder) error {
+
+				if batchOpts.File != "" {
+					if _DefaultCRUDClientCommandConfig.DryRun {
+						return fmt.Errorf("--dry-run can't be used with --batch")
+					}
+					if _DefaultCRUDClientCommandConfig.Edit {
+						return fmt.Errorf("--edit can't be used with --batch")
+					}
+					return batch.Run(batchOpts, func(ctx context.Context, r *batch.Record) (proto.Message, error) {
+						var v CRUDObject
+						err := r.Decode(&v)
+						if err != nil {
+							return nil, err
+						}
+						err = _DefaultCRUDClientCommandConfig.Set.Apply(&v)
+						if err != nil {
+							return nil, batch.Invalid(err)
+						}
+						// the defaults of the record must not leak into
+						// the next ones
+						reqArgs := proto.Clone(reqArgs).(*CRUDObject)
+
+						proto.Merge(&v, reqArgs)
+						err = _CRUDValidate(&v, cmd.Flags())
+						if err != nil {
+							return nil, batch.Invalid(err)
+						}
+						return cli.Update(ctx, &v)
+					}, out)
+				}
+
+				if _DefaultCRUDClientCommandConfig.DryRun {
+					return _CRUDDryRun(out, &v)
+				}
+				if _DefaultCRUDClientCommandConfig.LoadTest.Enabled() {
+					return _CRUDLoadTest(conn, func(ctx context.Context, cli CRUDClient) error {
+						_, err := cli.Update(ctx, &v)
+						return err
+					})
+				}
+
+				resp, err := cli.Update(context.Background(), &v)
 				if err != nil {
 					return err
 				}
@@ -318,12 +837,14 @@ func _CRUDUpdateClientCommand() *cobra.Command {
 
 	cmd.PersistentFlags().StringVar(&reqArgs.Name, "name", "", "get-comment-from-proto")
 	cmd.PersistentFlags().StringVar(&reqArgs.Value, "value", "", "get-comment-from-proto")
+	batchOpts.AddFlags(cmd.PersistentFlags())
 
 	return cmd
 }
 
 func _CRUDDeleteClientCommand() *cobra.Command {
 	reqArgs := &CRUDObject{}
+	var batchOpts batch.Options
 
 	cmd := &cobra.Command{
 		Use:     "delete",
@@ -331,16 +852,101 @@ func _CRUDDeleteClientCommand() *cobra.Command {
 		Example: "TODO: print protobuf method comments here",
 		Run: func(cmd *cobra.Command, args []string) {
 			var v CRUDObject
-			err := _CRUDRoundTrip(v, func(cli CRUDClient, in iocodec.Decoder, out iocodec.Encoder) error {
 
+			prepare := func(in iocodec.Decoder) error {
 				err := in.Decode(&v)
 				if err != nil {
 					return err
 				}
 
 				proto.Merge(&v, reqArgs)
-				resp, err := cli.Delete(context.Background(), &v)
 
+				prompter := _CRUDPrompter()
+				if prompter != nil {
+					err = prompter.Fields(&v)
+					if err != nil {
+						return err
+					}
+				}
+				if _DefaultCRUDClientCommandConfig.Edit {
+					err = _CRUDEdit(&v, func(m proto.Message) error {
+						return _CRUDValidate(m, cmd.Flags())
+					})
+				} else {
+					err = _CRUDValidate(&v, cmd.Flags())
+				}
+				if err != nil {
+					return err
+				}
+				// the call is confirmed once the server is dialed
+				return nil
+			}
+
+			if batchOpts.File != "" {
+				// the requests of the batch are read and checked one by one
+				prepare = nil
+			}
+
+			err := _CRUDRoundTrip(&v, prepare, func(conn *grpc.ClientConn, cli CRUDClient, in iocodec.Decoder, out iocodec.Encoder) error {
+
+				if batchOpts.File != "" {
+					if _DefaultCRUDClientCommandConfig.DryRun {
+						return fmt.Errorf("--dry-run can't be used with --batch")
+					}
+					if _DefaultCRUDClientCommandConfig.Edit {
+						return fmt.Errorf("--edit can't be used with --batch")
+					}
+					// the batch is confirmed as a whole, and its calls audited one by one
+					err := _CRUDConfirmDestructive("delete", "/pb.CRUD/Delete", nil)
+					if err != nil {
+						return err
+					}
+					return batch.Run(batchOpts, func(ctx context.Context, r *batch.Record) (proto.Message, error) {
+						var v CRUDObject
+						err := r.Decode(&v)
+						if err != nil {
+							return nil, err
+						}
+						err = _DefaultCRUDClientCommandConfig.Set.Apply(&v)
+						if err != nil {
+							return nil, batch.Invalid(err)
+						}
+						// the defaults of the record must not leak into
+						// the next ones
+						reqArgs := proto.Clone(reqArgs).(*CRUDObject)
+
+						proto.Merge(&v, reqArgs)
+						err = _CRUDValidate(&v, cmd.Flags())
+						if err != nil {
+							return nil, batch.Invalid(err)
+						}
+						err = _DefaultCRUDClientCommandConfig.Destructive.Audit(_DefaultCRUDClientCommandConfig.ServerAddr, "/pb.CRUD/Delete", &v)
+						if err != nil {
+							return nil, err
+						}
+						return cli.Delete(ctx, &v)
+					}, out)
+				}
+
+				if _DefaultCRUDClientCommandConfig.DryRun {
+					return _CRUDDryRun(out, &v)
+				}
+				err := _CRUDConfirmDestructive("delete", "/pb.CRUD/Delete", &v)
+				if err != nil {
+					return err
+				}
+				err = _DefaultCRUDClientCommandConfig.Destructive.Audit(_DefaultCRUDClientCommandConfig.ServerAddr, "/pb.CRUD/Delete", &v)
+				if err != nil {
+					return err
+				}
+				if _DefaultCRUDClientCommandConfig.LoadTest.Enabled() {
+					return _CRUDLoadTest(conn, func(ctx context.Context, cli CRUDClient) error {
+						_, err := cli.Delete(ctx, &v)
+						return err
+					})
+				}
+
+				resp, err := cli.Delete(context.Background(), &v)
 				if err != nil {
 					return err
 				}
@@ -356,6 +962,141 @@ func _CRUDDeleteClientCommand() *cobra.Command {
 
 	cmd.PersistentFlags().StringVar(&reqArgs.Name, "name", "", "get-comment-from-proto")
 	cmd.PersistentFlags().StringVar(&reqArgs.Value, "value", "", "get-comment-from-proto")
+	batchOpts.AddFlags(cmd.PersistentFlags())
+
+	return cmd
+}
+
+func _CRUDListClientCommand() *cobra.Command {
+	reqArgs := &ListCRUD{}
+	var all bool
+	var maxPages int
+	var batchOpts batch.Options
+
+	cmd := &cobra.Command{
+		Use:     "list",
+		Long:    "List client; call by piping a request in to stdin (--stdin), reading a file (--file), or via flags per field",
+		Example: "TODO: print protobuf method comments here",
+		Run: func(cmd *cobra.Command, args []string) {
+			var v ListCRUD
+
+			prepare := func(in iocodec.Decoder) error {
+				err := in.Decode(&v)
+				if err != nil {
+					return err
+				}
+
+				proto.Merge(&v, reqArgs)
+
+				prompter := _CRUDPrompter()
+				if prompter != nil {
+					err = prompter.Fields(&v)
+					if err != nil {
+						return err
+					}
+				}
+				if _DefaultCRUDClientCommandConfig.Edit {
+					err = _CRUDEdit(&v, func(m proto.Message) error {
+						return _CRUDValidate(m, cmd.Flags())
+					})
+				} else {
+					err = _CRUDValidate(&v, cmd.Flags())
+				}
+				if err != nil {
+					return err
+				}
+				if prompter == nil || _DefaultCRUDClientCommandConfig.DryRun {
+					return nil
+				}
+				em, err := _CRUDEncoderMaker()
+				if err != nil {
+					return err
+				}
+				return prompter.Confirm(&v, em)
+			}
+
+			if batchOpts.File != "" {
+				// the requests of the batch are read and checked one by one
+				prepare = nil
+			}
+
+			err := _CRUDRoundTrip(&v, prepare, func(conn *grpc.ClientConn, cli CRUDClient, in iocodec.Decoder, out iocodec.Encoder) error {
+
+				if batchOpts.File != "" {
+					if _DefaultCRUDClientCommandConfig.DryRun {
+						return fmt.Errorf("--dry-run can't be used with --batch")
+					}
+					if _DefaultCRUDClientCommandConfig.Edit {
+						return fmt.Errorf("--edit can't be used with --batch")
+					}
+					return batch.Run(batchOpts, func(ctx context.Context, r *batch.Record) (proto.Message, error) {
+						var v ListCRUD
+						err := r.Decode(&v)
+						if err != nil {
+							return nil, err
+						}
+						err = _DefaultCRUDClientCommandConfig.Set.Apply(&v)
+						if err != nil {
+							return nil, batch.Invalid(err)
+						}
+						// the defaults of the record must not leak into
+						// the next ones
+						reqArgs := proto.Clone(reqArgs).(*ListCRUD)
+
+						proto.Merge(&v, reqArgs)
+						err = _CRUDValidate(&v, cmd.Flags())
+						if err != nil {
+							return nil, batch.Invalid(err)
+						}
+						return cli.List(ctx, &v)
+					}, out)
+				}
+
+				if _DefaultCRUDClientCommandConfig.DryRun {
+					return _CRUDDryRun(out, &v)
+				}
+				if _DefaultCRUDClientCommandConfig.LoadTest.Enabled() {
+					return _CRUDLoadTest(conn, func(ctx context.Context, cli CRUDClient) error {
+						_, err := cli.List(ctx, &v)
+						return err
+					})
+				}
+
+				if all || maxPages > 0 {
+					// stream the results of every page, or the first maxPages
+					return pagination.Follow(func(token string) ([]proto.Message, string, error) {
+						v.PageToken = token
+						resp, err := cli.List(context.Background(), &v)
+						if err != nil {
+							return nil, "", err
+						}
+						items := make([]proto.Message, len(resp.GetObjects()))
+						for i, item := range resp.GetObjects() {
+							items[i] = item
+						}
+						return items, resp.GetNextPageToken(), nil
+					}, v.PageToken, maxPages, out)
+				}
+
+				resp, err := cli.List(context.Background(), &v)
+				if err != nil {
+					return err
+				}
+
+				return out.Encode(resp)
+
+			})
+			if err != nil {
+				log.Fatal(err)
+			}
+		},
+	}
+
+	cmd.PersistentFlags().Int32Var(&reqArgs.PageSize, "pagesize", 0, "get-comment-from-proto")
+	cmd.PersistentFlags().StringVar(&reqArgs.PageToken, "pagetoken", "", "get-comment-from-proto")
+	cmd.PersistentFlags().BoolVar(&all, "all", false, "follow the page tokens and print the results of every page")
+	cmd.PersistentFlags().IntVar(&maxPages, "max-pages", 0, "follow the page tokens and print the results of at most this many pages")
+	batchOpts.AddFlags(cmd.PersistentFlags())
 
 	return cmd
 }
@@ -365,4 +1106,57 @@ var _CRUDClientSubCommands = []func() *cobra.Command{
 	_CRUDGetClientCommand,
 	_CRUDUpdateClientCommand,
 	_CRUDDeleteClientCommand,
+	_CRUDListClientCommand,
+}
+
+func init() { describe.Register(_descriptorSet_Crud_478bbe1b22b2e995) }
+
+var _descriptorSet_Crud_478bbe1b22b2e995 = []byte{
+	// 732 bytes of a gzipped FileDescriptorSet
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xdf, 0x8e, 0xdb, 0x54,
+	0x10, 0xc6, 0xcf, 0x39, 0x33, 0x76, 0xec, 0xb1, 0xf3, 0xa7, 0xe3, 0xec, 0xc6, 0xcd, 0x9f, 0x6e,
+	0x92, 0x46, 0x28, 0x14, 0x29, 0xaa, 0x16, 0x89, 0x17, 0xa0, 0x50, 0x09, 0x21, 0x81, 0x02, 0xbd,
+	0xae, 0x92, 0xae, 0x85, 0x16, 0xda, 0x24, 0xda, 0xb8, 0x08, 0xfa, 0x00, 0xbc, 0x1e, 0x17, 0xbc,
+	0x10, 0x9a, 0xf1, 0x39, 0x5e, 0xc4, 0xc2, 0x45, 0xef, 0xfc, 0xcd, 0xcc, 0xf7, 0xcd, 0xef, 0xcc,
+	0xae, 0x42, 0x7f, 0xe4, 0x44, 0x6f, 0xee, 0xde, 0xdf, 0x6c, 0x4e, 0x77, 0xc7, 0xfa, 0xc8, 0xee,
+	0xb4, 0x5f, 0x7e, 0x41, 0xf4, 0xe5, 0xf6, 0xd5, 0x8b, 0xef, 0xf6, 0x3f, 0x57, 0x6f, 0x6a, 0x66,
+	0xc2, 0xc3, 0xee, 0x5d, 0x55, 0xda, 0xb9, 0x5d, 0xa7, 0x5b, 0xfd, 0xe6, 0x21, 0x45, 0xbf, 0xee,
+	0xde, 0xbe, 0xaf, 0x4a, 0xa7, 0xc5, 0x46, 0xa8, 0xef, 0xae, 0xda, 0xd5, 0x95, 0xb8, 0x3f, 0xc2,
+	0x37, 0xa3, 0xce, 0xcb, 0xaa, 0xfe, 0x3f, 0xd3, 0xf2, 0x6b, 0x4a, 0xbe, 0xbd, 0x3d, 0x37, 0xfd,
+	0x09, 0xa5, 0xa7, 0xdd, 0x4f, 0xd5, 0xeb, 0xf3, 0xed, 0x87, 0x66, 0x28, 0xda, 0x26, 0x52, 0xf8,
+	0xe1, 0xf6, 0x43, 0xc5, 0x33, 0x22, 0x6d, 0xd6, 0xc7, 0x5f, 0xaa, 0x83, 0x5f, 0xa1, 0xe3, 0x3f,
+	0x4a, 0x61, 0x79, 0x43, 0x83, 0x90, 0xb3, 0xad, 0xce, 0xa7, 0xe3, 0xe1, 0x5c, 0xf1, 0x9a, 0x3a,
+	0x47, 0x7d, 0xe6, 0xb9, 0xb4, 0x73, 0x58, 0x67, 0xd7, 0xbd, 0xcd, 0x69, 0xbf, 0xb9, 0x7f, 0xfd,
+	0x36, 0xb4, 0xf9, 0x13, 0xea, 0x1f, 0xaa, 0xdf, 0xea, 0xd7, 0x0f, 0x36, 0x74, 0xa5, 0xfc, 0x7d,
+	0xbb, 0xa5, 0x43, 0xd1, 0x57, 0xef, 0x4e, 0xf5, 0xef, 0xd7, 0x7f, 0x5a, 0x42, 0x65, 0x5e, 0x53,
+	0xdc, 0x9c, 0x85, 0x9b, 0xf0, 0xf6, 0x44, 0xe3, 0x7f, 0x2d, 0xe3, 0x25, 0xc1, 0xcb, 0xaa, 0xe6,
+	0x4c, 0xca, 0xfe, 0x22, 0x0f, 0x66, 0xd6, 0x14, 0xbf, 0x3a, 0xdd, 0xdc, 0xa7, 0xb5, 0x9d, 0x07,
+	0x93, 0x4f, 0x29, 0x7e, 0x51, 0xbd, 0xad, 0xfe, 0x63, 0x32, 0x15, 0xad, 0x94, 0xfc, 0x8c, 0x50,
+	0x8e, 0xc2, 0xb9, 0x94, 0xc2, 0x79, 0xc6, 0xc3, 0x7f, 0xaa, 0x70, 0xac, 0x6f, 0xfe, 0xea, 0x50,
+	0xcc, 0x68, 0xcc, 0x6a, 0x40, 0x09, 0xd9, 0x9c, 0xc1, 0x18, 0x96, 0x2f, 0xc7, 0xe0, 0x4c, 0x46,
+	0x44, 0x2e, 0x36, 0x8c, 0x68, 0xc8, 0x12, 0x11, 0xc4, 0xc6, 0x32, 0x60, 0x92, 0x53, 0x46, 0x18,
+	0x1b, 0x67, 0x18, 0x22, 0x7c, 0x4e, 0x39, 0x45, 0x22, 0x2c, 0x43, 0x94, 0xf4, 0x82, 0x72, 0x0c,
+	0x51, 0xff, 0x71, 0x50, 0xc0, 0x10, 0xad, 0x36, 0xde, 0x66, 0x19, 0x62, 0x7c, 0xe6, 0x5b, 0x56,
+	0x54, 0x92, 0x05, 0xe5, 0x18, 0xe2, 0xbc, 0x08, 0x0a, 0x18, 0xe2, 0x27, 0x6b, 0x6f, 0x73, 0x0c,
+	0x9d, 0x76, 0x9b, 0x84, 0x74, 0xda, 0x6d, 0xda, 0x6b, 0xb7, 0x39, 0x60, 0xe8, 0xb4, 0xdb, 0x80,
+	0x21, 0xc1, 0xcf, 0x7c, 0x0b, 0x2c, 0x43, 0xd2, 0xda, 0xc0, 0x31, 0x24, 0xad, 0x0d, 0x64, 0x72,
+	0xf5, 0xa9, 0xb7, 0x21, 0x43, 0x8a, 0xd7, 0xbe, 0x85, 0x96, 0x21, 0x4d, 0xf2, 0xa0, 0x1c, 0x43,
+	0xda, 0xbd, 0x08, 0x0a, 0x18, 0xd2, 0xf9, 0x73, 0x22, 0x72, 0x68, 0x18, 0x73, 0xd3, 0xd7, 0x53,
+	0xa1, 0xdc, 0x23, 0x4f, 0x58, 0xe2, 0x50, 0x4f, 0xd5, 0xc5, 0xa1, 0x58, 0x44, 0x44, 0xa2, 0x28,
+	0x28, 0xcb, 0xd0, 0xcd, 0xfa, 0x41, 0x01, 0x43, 0x97, 0x0b, 0x6f, 0xb3, 0x0c, 0x3d, 0xbc, 0xf0,
+	0x2d, 0x1b, 0x89, 0x0a, 0x36, 0x39, 0x5c, 0x2f, 0x1b, 0x04, 0x05, 0x0c, 0xbd, 0x62, 0xa8, 0x14,
+	0x96, 0xf1, 0x91, 0x19, 0x36, 0x14, 0x32, 0xf5, 0xc8, 0x53, 0x58, 0xa1, 0x60, 0x4f, 0x61, 0x95,
+	0x82, 0x7d, 0x9c, 0x55, 0x0a, 0xf6, 0x14, 0x56, 0x29, 0xd8, 0x53, 0x58, 0xa1, 0x28, 0x3c, 0x85,
+	0x55, 0x8a, 0xa2, 0xb5, 0x49, 0x7e, 0xe1, 0x29, 0xac, 0x52, 0x14, 0x9e, 0xc2, 0x31, 0x5e, 0x9a,
+	0xb2, 0xa1, 0x90, 0x84, 0xcb, 0xa4, 0xaf, 0x71, 0x4e, 0x28, 0x46, 0x9e, 0xc2, 0x29, 0xc5, 0xc8,
+	0xc7, 0x39, 0xa5, 0x18, 0x79, 0x0a, 0xa7, 0x14, 0x23, 0x2e, 0x34, 0x0e, 0x18, 0xc7, 0x66, 0xd6,
+	0xc4, 0xc9, 0x5f, 0x71, 0x9c, 0x0c, 0x34, 0x0e, 0x24, 0x6e, 0x82, 0xa5, 0x5a, 0x40, 0xe3, 0x26,
+	0x98, 0x06, 0x65, 0x19, 0x26, 0x54, 0x04, 0x05, 0x0c, 0x93, 0xcb, 0x91, 0xb7, 0x59, 0x86, 0x29,
+	0x8e, 0x7d, 0x4b, 0x1e, 0x35, 0xf5, 0x14, 0xa0, 0x8f, 0x9a, 0x66, 0x17, 0x41, 0x01, 0xc3, 0xb4,
+	0x7c, 0xac, 0x14, 0xc8, 0x78, 0x65, 0x96, 0x0d, 0x85, 0xfc, 0x53, 0x5c, 0x25, 0xa5, 0xc6, 0xa1,
+	0x50, 0xcc, 0x71, 0xa5, 0x16, 0x74, 0x06, 0x45, 0xe5, 0x41, 0xc5, 0x0c, 0xf3, 0xee, 0x28, 0x28,
+	0xcb, 0x30, 0x2f, 0xaf, 0x82, 0x02, 0x86, 0xf9, 0xf2, 0xa9, 0x0f, 0xb1, 0x0c, 0x0b, 0x0c, 0x2d,
+	0x61, 0x5a, 0x78, 0x26, 0x54, 0xa6, 0x45, 0x36, 0x0e, 0x0a, 0x18, 0x16, 0xb3, 0x27, 0x94, 0x92,
+	0xc3, 0x88, 0x61, 0x65, 0x06, 0x8a, 0x14, 0x59, 0x86, 0x55, 0xd2, 0xdd, 0xc7, 0xfa, 0xc3, 0xff,
+	0xf9, 0xdf, 0x03, 0x00, 0xbd, 0x16, 0x49, 0xb7, 0x09, 0x06, 0x00, 0x00,
 }
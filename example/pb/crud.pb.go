@@ -37,7 +37,7 @@ func (m *CRUDObject) Reset()         { *m = CRUDObject{} }
 func (m *CRUDObject) String() string { return proto.CompactTextString(m) }
 func (*CRUDObject) ProtoMessage()    {}
 func (*CRUDObject) Descriptor() ([]byte, []int) {
-	return fileDescriptor_crud_69aa095156113a77, []int{0}
+	return fileDescriptor_crud_ea56b08c376fbebe, []int{0}
 }
 func (m *CRUDObject) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -92,7 +92,7 @@ func (m *CreateCRUD) Reset()         { *m = CreateCRUD{} }
 func (m *CreateCRUD) String() string { return proto.CompactTextString(m) }
 func (*CreateCRUD) ProtoMessage()    {}
 func (*CreateCRUD) Descriptor() ([]byte, []int) {
-	return fileDescriptor_crud_69aa095156113a77, []int{1}
+	return fileDescriptor_crud_ea56b08c376fbebe, []int{1}
 }
 func (m *CreateCRUD) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -146,7 +146,7 @@ func (m *GetCRUD) Reset()         { *m = GetCRUD{} }
 func (m *GetCRUD) String() string { return proto.CompactTextString(m) }
 func (*GetCRUD) ProtoMessage()    {}
 func (*GetCRUD) Descriptor() ([]byte, []int) {
-	return fileDescriptor_crud_69aa095156113a77, []int{2}
+	return fileDescriptor_crud_ea56b08c376fbebe, []int{2}
 }
 func (m *GetCRUD) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -182,6 +182,116 @@ func (m *GetCRUD) GetName() string {
 	return ""
 }
 
+type ListCRUD struct {
+	PageSize             int32    `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken            string   `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListCRUD) Reset()         { *m = ListCRUD{} }
+func (m *ListCRUD) String() string { return proto.CompactTextString(m) }
+func (*ListCRUD) ProtoMessage()    {}
+func (*ListCRUD) Descriptor() ([]byte, []int) {
+	return fileDescriptor_crud_ea56b08c376fbebe, []int{3}
+}
+func (m *ListCRUD) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListCRUD) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListCRUD.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *ListCRUD) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListCRUD.Merge(dst, src)
+}
+func (m *ListCRUD) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListCRUD) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListCRUD.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListCRUD proto.InternalMessageInfo
+
+func (m *ListCRUD) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *ListCRUD) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+type ListCRUDResponse struct {
+	Objects              []*CRUDObject `protobuf:"bytes,1,rep,name=objects,proto3" json:"objects,omitempty"`
+	NextPageToken        string        `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ListCRUDResponse) Reset()         { *m = ListCRUDResponse{} }
+func (m *ListCRUDResponse) String() string { return proto.CompactTextString(m) }
+func (*ListCRUDResponse) ProtoMessage()    {}
+func (*ListCRUDResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_crud_ea56b08c376fbebe, []int{4}
+}
+func (m *ListCRUDResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListCRUDResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListCRUDResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *ListCRUDResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListCRUDResponse.Merge(dst, src)
+}
+func (m *ListCRUDResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListCRUDResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListCRUDResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListCRUDResponse proto.InternalMessageInfo
+
+func (m *ListCRUDResponse) GetObjects() []*CRUDObject {
+	if m != nil {
+		return m.Objects
+	}
+	return nil
+}
+
+func (m *ListCRUDResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
 type Empty struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -192,7 +302,7 @@ func (m *Empty) Reset()         { *m = Empty{} }
 func (m *Empty) String() string { return proto.CompactTextString(m) }
 func (*Empty) ProtoMessage()    {}
 func (*Empty) Descriptor() ([]byte, []int) {
-	return fileDescriptor_crud_69aa095156113a77, []int{3}
+	return fileDescriptor_crud_ea56b08c376fbebe, []int{5}
 }
 func (m *Empty) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -225,6 +335,8 @@ func init() {
 	proto.RegisterType((*CRUDObject)(nil), "pb.CRUDObject")
 	proto.RegisterType((*CreateCRUD)(nil), "pb.CreateCRUD")
 	proto.RegisterType((*GetCRUD)(nil), "pb.GetCRUD")
+	proto.RegisterType((*ListCRUD)(nil), "pb.ListCRUD")
+	proto.RegisterType((*ListCRUDResponse)(nil), "pb.ListCRUDResponse")
 	proto.RegisterType((*Empty)(nil), "pb.Empty")
 }
 
@@ -244,6 +356,7 @@ type CRUDClient interface {
 	Get(ctx context.Context, in *GetCRUD, opts ...grpc.CallOption) (*CRUDObject, error)
 	Update(ctx context.Context, in *CRUDObject, opts ...grpc.CallOption) (*CRUDObject, error)
 	Delete(ctx context.Context, in *CRUDObject, opts ...grpc.CallOption) (*Empty, error)
+	List(ctx context.Context, in *ListCRUD, opts ...grpc.CallOption) (*ListCRUDResponse, error)
 }
 
 type cRUDClient struct {
@@ -290,12 +403,22 @@ func (c *cRUDClient) Delete(ctx context.Context, in *CRUDObject, opts ...grpc.Ca
 	return out, nil
 }
 
+func (c *cRUDClient) List(ctx context.Context, in *ListCRUD, opts ...grpc.CallOption) (*ListCRUDResponse, error) {
+	out := new(ListCRUDResponse)
+	err := c.cc.Invoke(ctx, "/pb.CRUD/List", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CRUDServer is the server API for CRUD service.
 type CRUDServer interface {
 	Create(context.Context, *CreateCRUD) (*CRUDObject, error)
 	Get(context.Context, *GetCRUD) (*CRUDObject, error)
 	Update(context.Context, *CRUDObject) (*CRUDObject, error)
 	Delete(context.Context, *CRUDObject) (*Empty, error)
+	List(context.Context, *ListCRUD) (*ListCRUDResponse, error)
 }
 
 func RegisterCRUDServer(s *grpc.Server, srv CRUDServer) {
@@ -374,6 +497,24 @@ func _CRUD_Delete_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CRUD_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCRUD)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CRUDServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.CRUD/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CRUDServer).List(ctx, req.(*ListCRUD))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _CRUD_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "pb.CRUD",
 	HandlerType: (*CRUDServer)(nil),
@@ -394,6 +535,10 @@ var _CRUD_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _CRUD_Delete_Handler,
 		},
+		{
+			MethodName: "List",
+			Handler:    _CRUD_List_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "crud.proto",
@@ -492,6 +637,77 @@ func (m *GetCRUD) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *ListCRUD) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListCRUD) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.PageSize != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintCrud(dAtA, i, uint64(m.PageSize))
+	}
+	if len(m.PageToken) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCrud(dAtA, i, uint64(len(m.PageToken)))
+		i += copy(dAtA[i:], m.PageToken)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ListCRUDResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListCRUDResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Objects) > 0 {
+		for _, msg := range m.Objects {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintCrud(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.NextPageToken) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCrud(dAtA, i, uint64(len(m.NextPageToken)))
+		i += copy(dAtA[i:], m.NextPageToken)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
 func (m *Empty) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -578,6 +794,47 @@ func (m *GetCRUD) Size() (n int) {
 	return n
 }
 
+func (m *ListCRUD) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PageSize != 0 {
+		n += 1 + sovCrud(uint64(m.PageSize))
+	}
+	l = len(m.PageToken)
+	if l > 0 {
+		n += 1 + l + sovCrud(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListCRUDResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Objects) > 0 {
+		for _, e := range m.Objects {
+			l = e.Size()
+			n += 1 + l + sovCrud(uint64(l))
+		}
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovCrud(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *Empty) Size() (n int) {
 	if m == nil {
 		return 0
@@ -901,6 +1158,216 @@ func (m *GetCRUD) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ListCRUD) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCrud
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListCRUD: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListCRUD: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
+			}
+			m.PageSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrud
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PageSize |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrud
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCrud
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PageToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCrud(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCrud
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListCRUDResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCrud
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListCRUDResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListCRUDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Objects", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrud
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCrud
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Objects = append(m.Objects, &CRUDObject{})
+			if err := m.Objects[len(m.Objects)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrud
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCrud
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextPageToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCrud(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCrud
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Empty) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1057,21 +1524,28 @@ var (
 	ErrIntOverflowCrud   = fmt.Errorf("proto: integer overflow")
 )
 
-func init() { proto.RegisterFile("crud.proto", fileDescriptor_crud_69aa095156113a77) }
-
-var fileDescriptor_crud_69aa095156113a77 = []byte{
-	// 204 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0x4a, 0x2e, 0x2a, 0x4d,
-	0xd1, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x62, 0x2a, 0x48, 0x52, 0x32, 0xe3, 0xe2, 0x72, 0x0e,
-	0x0a, 0x75, 0xf1, 0x4f, 0xca, 0x4a, 0x4d, 0x2e, 0x11, 0x12, 0xe2, 0x62, 0xc9, 0x4b, 0xcc, 0x4d,
-	0x95, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x0c, 0x02, 0xb3, 0x85, 0x44, 0xb8, 0x58, 0xcb, 0x12, 0x73,
-	0x4a, 0x53, 0x25, 0x98, 0xc0, 0x82, 0x10, 0x0e, 0x58, 0x5f, 0x51, 0x6a, 0x62, 0x49, 0x2a, 0x48,
-	0x37, 0x09, 0xfa, 0x64, 0xb9, 0xd8, 0xdd, 0x53, 0x4b, 0x70, 0x69, 0x52, 0x62, 0xe7, 0x62, 0x75,
-	0xcd, 0x2d, 0x28, 0xa9, 0x34, 0x5a, 0xcc, 0xc8, 0xc5, 0x02, 0x56, 0xa5, 0xc1, 0xc5, 0x06, 0xb1,
-	0x48, 0x88, 0x4f, 0xaf, 0x20, 0x49, 0x0f, 0x61, 0xa9, 0x14, 0x84, 0x8f, 0x70, 0xbc, 0x12, 0x17,
-	0xb3, 0x7b, 0x6a, 0x89, 0x10, 0x37, 0x48, 0x18, 0x6a, 0x07, 0x86, 0x1a, 0x0d, 0x2e, 0xb6, 0xd0,
-	0x82, 0x14, 0x84, 0x69, 0x70, 0x19, 0x0c, 0x95, 0xca, 0x5c, 0x6c, 0x2e, 0xa9, 0x39, 0xa9, 0x58,
-	0x54, 0x72, 0x82, 0xf8, 0x60, 0x57, 0x3a, 0x09, 0x9c, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c,
-	0xe3, 0x83, 0x47, 0x72, 0x8c, 0x33, 0x1e, 0xcb, 0x31, 0x24, 0xb1, 0x81, 0x83, 0xd6, 0x18, 0x10,
-	0x00, 0x00, 0xff, 0xff, 0xcf, 0xb9, 0x4b, 0xa9, 0x68, 0x01, 0x00, 0x00,
+func init() { proto.RegisterFile("crud.proto", fileDescriptor_crud_ea56b08c376fbebe) }
+
+var fileDescriptor_crud_ea56b08c376fbebe = []byte{
+	// 317 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0xcd, 0x4a, 0xc3, 0x40,
+	0x10, 0x76, 0xfb, 0x93, 0xb6, 0x53, 0x7f, 0xca, 0xd0, 0x43, 0xa8, 0x34, 0x94, 0x08, 0x12, 0x3c,
+	0xe4, 0x50, 0xc1, 0x07, 0xd0, 0x6a, 0x2f, 0x82, 0x12, 0xed, 0xb9, 0x24, 0xcd, 0x20, 0xd1, 0x36,
+	0x59, 0x92, 0xad, 0x68, 0x9f, 0xc4, 0x47, 0xf2, 0xa6, 0x8f, 0x20, 0xf1, 0x45, 0x64, 0x37, 0x4d,
+	0x2a, 0x46, 0x0f, 0xde, 0x32, 0xdf, 0xcf, 0x7c, 0x3b, 0x1f, 0x01, 0x98, 0xc5, 0x4b, 0xdf, 0xe6,
+	0x71, 0x24, 0x22, 0xac, 0x70, 0xcf, 0x3c, 0x01, 0x38, 0x73, 0x26, 0xa3, 0x2b, 0xef, 0x9e, 0x66,
+	0x02, 0x11, 0x6a, 0xa1, 0xbb, 0x20, 0x9d, 0x0d, 0x98, 0xd5, 0x72, 0xd4, 0x37, 0x76, 0xa1, 0xfe,
+	0xe8, 0xce, 0x97, 0xa4, 0x57, 0x14, 0x98, 0x0d, 0xca, 0x17, 0x93, 0x2b, 0x48, 0xba, 0xff, 0xe1,
+	0xeb, 0x43, 0x63, 0x4c, 0xe2, 0x2f, 0x93, 0x79, 0x01, 0xcd, 0xcb, 0x20, 0xc9, 0xf8, 0x7d, 0x68,
+	0x71, 0xf7, 0x8e, 0xa6, 0x49, 0xb0, 0xca, 0x44, 0x75, 0xa7, 0x29, 0x81, 0x9b, 0x60, 0x45, 0xd8,
+	0x07, 0x50, 0xa4, 0x88, 0x1e, 0x28, 0x5c, 0x47, 0x28, 0xf9, 0xad, 0x04, 0x4c, 0x1f, 0x3a, 0xf9,
+	0x1e, 0x87, 0x12, 0x1e, 0x85, 0x09, 0xa1, 0x05, 0x8d, 0x48, 0x9d, 0x99, 0xe8, 0x6c, 0x50, 0xb5,
+	0xda, 0xc3, 0x5d, 0x9b, 0x7b, 0xf6, 0xe6, 0x7a, 0x27, 0xa7, 0xf1, 0x10, 0xf6, 0x42, 0x7a, 0x12,
+	0xd3, 0x52, 0xc2, 0x8e, 0x84, 0xaf, 0x8b, 0x94, 0x06, 0xd4, 0xcf, 0x17, 0x5c, 0x3c, 0x0f, 0xdf,
+	0x18, 0xd4, 0xd4, 0x9b, 0x2d, 0xd0, 0xb2, 0x5a, 0x30, 0x5b, 0x5e, 0x54, 0xd4, 0xfb, 0x11, 0x86,
+	0x26, 0x54, 0xc7, 0x24, 0xb0, 0x2d, 0xe1, 0x75, 0x23, 0x25, 0x8d, 0x05, 0xda, 0x84, 0xfb, 0x9b,
+	0x6d, 0x05, 0x53, 0x52, 0x1e, 0x80, 0x36, 0xa2, 0x39, 0xfd, 0xa2, 0x6c, 0xc9, 0x59, 0xbd, 0x12,
+	0x8f, 0xa0, 0x26, 0x4b, 0xc1, 0x6d, 0x09, 0xe5, 0xf5, 0xf4, 0xba, 0xdf, 0xa7, 0xbc, 0xac, 0xd3,
+	0xce, 0x6b, 0x6a, 0xb0, 0xf7, 0xd4, 0x60, 0x1f, 0xa9, 0xc1, 0x5e, 0x3e, 0x8d, 0x2d, 0x4f, 0x53,
+	0x3f, 0xcd, 0xf1, 0xd7, 0x00, 0xf3, 0x1b, 0x0c, 0x0e, 0x42, 0x02, 0x00, 0x00,
 }
@@ -8,6 +8,7 @@ import (
 	tls "crypto/tls"
 	x509 "crypto/x509"
 	fmt "fmt"
+	io "io"
 	ioutil "io/ioutil"
 	log "log"
 	net "net"
@@ -18,12 +19,27 @@ import (
 	proto "github.com/golang/protobuf/proto"
 	cobra "github.com/spf13/cobra"
 	pflag "github.com/spf13/pflag"
+	batch "github.com/tetratelabs/protoc-gen-cobra/batch"
+	describe "github.com/tetratelabs/protoc-gen-cobra/describe"
+	destructive "github.com/tetratelabs/protoc-gen-cobra/destructive"
+	dryrun "github.com/tetratelabs/protoc-gen-cobra/dryrun"
+	editing "github.com/tetratelabs/protoc-gen-cobra/editing"
+	health "github.com/tetratelabs/protoc-gen-cobra/health"
 	iocodec "github.com/tetratelabs/protoc-gen-cobra/iocodec"
+	loadtest "github.com/tetratelabs/protoc-gen-cobra/loadtest"
+	override "github.com/tetratelabs/protoc-gen-cobra/override"
+	prompting "github.com/tetratelabs/protoc-gen-cobra/prompting"
+	recording "github.com/tetratelabs/protoc-gen-cobra/recording"
+	templating "github.com/tetratelabs/protoc-gen-cobra/templating"
+	tracing "github.com/tetratelabs/protoc-gen-cobra/tracing"
+	validation "github.com/tetratelabs/protoc-gen-cobra/validation"
+	verbose "github.com/tetratelabs/protoc-gen-cobra/verbose"
 	context "golang.org/x/net/context"
 	oauth2 "golang.org/x/oauth2"
 	grpc "google.golang.org/grpc"
 	credentials "google.golang.org/grpc/credentials"
 	oauth "google.golang.org/grpc/credentials/oauth"
+	metadata "google.golang.org/grpc/metadata"
 )
 
 // This is a compile-time assertion to ensure that this generated file
@@ -49,6 +65,17 @@ type _MapListClientCommandConfig struct {
 	AuthTokenType      string
 	JWTKey             string
 	JWTKeyFile         string
+	SkipValidation     bool
+	LoadTest           loadtest.Options
+	Record             string
+	DryRun             bool
+	Verbose            bool
+	Trace              tracing.Options
+	Set                override.Options
+	Template           templating.Options
+	Edit               bool
+	Interactive        bool
+	Destructive        destructive.Options
 }
 
 func _NewMapListClientCommandConfig() *_MapListClientCommandConfig {
@@ -78,6 +105,17 @@ func (o *_MapListClientCommandConfig) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&o.AuthTokenType, "auth-token-type", o.AuthTokenType, "authorization token type")
 	fs.StringVar(&o.JWTKey, "jwt-key", o.JWTKey, "jwt key")
 	fs.StringVar(&o.JWTKeyFile, "jwt-key-file", o.JWTKeyFile, "jwt key file")
+	fs.BoolVar(&o.SkipValidation, "skip-validation", o.SkipValidation, "skip the client-side validation of requests")
+	o.LoadTest.AddFlags(fs)
+	fs.StringVar(&o.Record, "record", o.Record, "record the calls, with their metadata, messages, statuses and timings, to this session file")
+	fs.BoolVar(&o.DryRun, "dry-run", o.DryRun, "print the requests, where they would be sent and the metadata, with secrets redacted, instead of sending them")
+	fs.BoolVarP(&o.Verbose, "verbose", "v", o.Verbose, "log the connection, the tls handshake, and the metadata, message sizes and timings of the calls to stderr, with secrets redacted")
+	o.Trace.AddFlags(fs)
+	o.Set.AddFlags(fs)
+	o.Template.AddFlags(fs)
+	fs.BoolVar(&o.Edit, "edit", o.Edit, "open the request, or a sample of it without one, in $EDITOR before sending it")
+	fs.BoolVar(&o.Interactive, "interactive", o.Interactive, "when stdin is a terminal, prompt for the fields the request doesn't set, and for confirmation before sending it")
+	o.Destructive.AddFlags(fs)
 }
 
 func MapListClientCommand() *cobra.Command {
@@ -89,14 +127,129 @@ func MapListClientCommand() *cobra.Command {
 	for _, s := range _MapListClientSubCommands {
 		cmd.AddCommand(s())
 	}
+	cmd.AddCommand(_MapListReplayCommand())
+	cmd.AddCommand(_MapListHealthCommand())
+	cmd.AddCommand(_MapListDescribeCommand())
+	return cmd
+}
+
+func _MapListReplayCommand() *cobra.Command {
+	var compare bool
+	cmd := &cobra.Command{
+		Use:   "replay [file]",
+		Short: "Replay the calls of a session recorded with --record",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			f, err := os.Open(args[0])
+			if err != nil {
+				log.Fatal(err)
+			}
+			defer f.Close()
+			err = _MapListRoundTrip(nil, nil, func(conn *grpc.ClientConn, cli MapListClient, in iocodec.Decoder, out iocodec.Encoder) error {
+				if _DefaultMapListClientCommandConfig.DryRun {
+					reqs, err := recording.Requests(f)
+					if err != nil {
+						return err
+					}
+					return _MapListDryRun(out, reqs...)
+				}
+				return recording.Replay(conn, f, compare, out, os.Stderr)
+			})
+			if err != nil {
+				log.Fatal(err)
+			}
+		},
+	}
+	cmd.Flags().BoolVar(&compare, "compare", false, "compare the responses and statuses to the recorded ones, field by field, and fail if they differ")
+	return cmd
+}
+
+func _MapListHealthCommand() *cobra.Command {
+	opts := health.Options{Service: "pb.MapList"}
+	cmd := &cobra.Command{
+		Use:     "health",
+		Aliases: []string{"ping"},
+		Short:   "Check the health of pb.MapList with the gRPC health checking protocol, and report how the connection is made",
+		Long:    "Check the health of pb.MapList with the gRPC health checking protocol, and report how the connection is made: its TLS details, the certificate chain of the server and the latency of the check.\n\nExits with 0 if the service is serving, 2 if the connection fails, 3 if the check fails, and 4 if the service isn't serving.",
+		Args:    cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			cfg := _DefaultMapListClientCommandConfig
+			em, err := _MapListEncoderMaker()
+			if err != nil {
+				log.Fatal(err)
+			}
+			if cfg.DryRun {
+				if err := _MapListDryRun(em.NewEncoder(os.Stdout)); err != nil {
+					log.Fatal(err)
+				}
+				return
+			}
+			err = health.Check(opts, cfg.Timeout, func() (*grpc.ClientConn, error) {
+				conn, _, err := _DialMapList()
+				return conn, err
+			}, em.NewEncoder(os.Stdout))
+			if err != nil {
+				log.Print(err)
+				os.Exit(health.ExitCode(err))
+			}
+		},
+	}
+	opts.AddFlags(cmd.Flags())
+	return cmd
+}
+
+func _MapListDescribeCommand() *cobra.Command {
+	var raw bool
+	cmd := &cobra.Command{
+		Use:   "describe [name]",
+		Short: "Print the definition of pb.MapList, or of one of its methods, messages or enums, with its comments",
+		Long:  "Print the definition of pb.MapList, or of one of its methods, messages or enums, with its comments, from the descriptors built into the command.\n\nThe name is a method, as named in the proto file or as its command, or the name of a message or enum, relative to the package of the service or in full. A method is followed by its request and response messages.",
+		Args:  cobra.MaximumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			name := ""
+			if len(args) > 0 {
+				name = args[0]
+			}
+			defs, err := describe.Lookup("pb.MapList", name)
+			if err != nil {
+				log.Fatal(err)
+			}
+			if !raw {
+				if err := describe.Print(os.Stdout, defs); err != nil {
+					log.Fatal(err)
+				}
+				return
+			}
+			em, err := _MapListEncoderMaker()
+			if err != nil {
+				log.Fatal(err)
+			}
+			enc := em.NewEncoder(os.Stdout)
+			for _, d := range defs {
+				if err := enc.Encode(d.Descriptor); err != nil {
+					log.Fatal(err)
+				}
+			}
+		},
+	}
+	cmd.Flags().BoolVar(&raw, "descriptor", false, "print the descriptors of the definitions in the response format instead of proto source")
 	return cmd
 }
 
 func _DialMapList() (*grpc.ClientConn, MapListClient, error) {
 	cfg := _DefaultMapListClientCommandConfig
-	opts := []grpc.DialOption{
-		grpc.WithBlock(),
-		grpc.WithTimeout(cfg.Timeout),
+	var v *verbose.Logger
+	var opts []grpc.DialOption
+	if cfg.Trace.Enabled() {
+		t, err := tracing.Start(cfg.Trace)
+		if err != nil {
+			return nil, nil, fmt.Errorf("trace: %v", err)
+		}
+		opts = append(opts, t.DialOptions()...)
+	}
+	if cfg.Verbose {
+		v = verbose.New(os.Stderr)
+		opts = append(opts, v.DialOptions()...)
 	}
 	if cfg.TLS {
 		tlsConfig := &tls.Config{}
@@ -130,6 +283,9 @@ func _DialMapList() (*grpc.ClientConn, MapListClient, error) {
 		}
 		//tlsConfig.BuildNameToCertificate()
 		cred := credentials.NewTLS(tlsConfig)
+		if v != nil {
+			cred = v.Credentials(cred)
+		}
 		opts = append(opts, grpc.WithTransportCredentials(cred))
 	} else {
 		opts = append(opts, grpc.WithInsecure())
@@ -155,34 +311,69 @@ func _DialMapList() (*grpc.ClientConn, MapListClient, error) {
 		}
 		opts = append(opts, grpc.WithPerRPCCredentials(cred))
 	}
-	conn, err := grpc.Dial(cfg.ServerAddr, opts...)
+	if cfg.Record != "" {
+		rec, err := recording.Open(cfg.Record)
+		if err != nil {
+			return nil, nil, fmt.Errorf("record: %v", err)
+		}
+		opts = append(opts, rec.DialOptions()...)
+	}
+	var conn *grpc.ClientConn
+	var err error
+	if v != nil {
+		conn, err = v.Dial(cfg.ServerAddr, cfg.Timeout, opts...)
+	} else {
+		conn, err = grpc.Dial(cfg.ServerAddr, append(opts, grpc.WithBlock(), grpc.WithTimeout(cfg.Timeout))...)
+	}
 	if err != nil {
 		return nil, nil, err
 	}
 	return conn, NewMapListClient(conn), nil
 }
 
-type _MapListRoundTripFunc func(cli MapListClient, in iocodec.Decoder, out iocodec.Encoder) error
+// _MapListEncoderMaker returns the encoder maker of the response format.
+func _MapListEncoderMaker() (iocodec.EncoderMaker, error) {
+	format := _DefaultMapListClientCommandConfig.ResponseFormat
+	if format == "" {
+		format = "json"
+	}
+	em, ok := iocodec.DefaultEncoders[format]
+	if !ok {
+		return nil, fmt.Errorf("invalid response format: %q", format)
+	}
+	return em, nil
+}
+
+type _MapListRoundTripFunc func(conn *grpc.ClientConn, cli MapListClient, in iocodec.Decoder, out iocodec.Encoder) error
 
-func _MapListRoundTrip(sample interface{}, fn _MapListRoundTripFunc) error {
+// _MapListRoundTrip reads the request with prepare, when set, before it
+// dials the server and calls fn. Commands without a request have no sample.
+func _MapListRoundTrip(sample proto.Message, prepare func(in iocodec.Decoder) error, fn _MapListRoundTripFunc) error {
 	cfg := _DefaultMapListClientCommandConfig
-	var em iocodec.EncoderMaker
-	var ok bool
-	if cfg.ResponseFormat == "" {
-		em = iocodec.DefaultEncoders["json"]
-	} else {
-		em, ok = iocodec.DefaultEncoders[cfg.ResponseFormat]
-		if !ok {
-			return fmt.Errorf("invalid response format: %q", cfg.ResponseFormat)
-		}
+	em, err := _MapListEncoderMaker()
+	if err != nil {
+		return err
 	}
 	if cfg.PrintSampleRequest {
-		return em.NewEncoder(os.Stdout).Encode(sample)
+		if sample == nil {
+			return fmt.Errorf("no sample request")
+		}
+		return em.NewEncoder(os.Stdout).Encode(iocodec.NewSample(sample))
 	}
 	// read the input request, first from stdin, then from a file, otherwise from args only
 	var d iocodec.Decoder
 	if cfg.Stdin || cfg.RequestFile == "-" {
-		d = iocodec.DefaultDecoders["json"].NewDecoder(os.Stdin)
+		if cfg.Edit {
+			// the editor needs the terminal
+			return fmt.Errorf("--edit can't be used with a request read from stdin")
+		}
+		var in io.Reader = os.Stdin
+		if cfg.Template.Stdin {
+			if in, err = cfg.Template.Reader("stdin", os.Stdin); err != nil {
+				return fmt.Errorf("request template: %v", err)
+			}
+		}
+		d = iocodec.DefaultDecoders["json"].NewDecoder(in)
 	} else if cfg.RequestFile != "" {
 		f, err := os.Open(cfg.RequestFile)
 		if err != nil {
@@ -197,20 +388,121 @@ func _MapListRoundTrip(sample interface{}, fn _MapListRoundTripFunc) error {
 		if !ok {
 			return fmt.Errorf("invalid request file format: %q", ext)
 		}
-		d = dm.NewDecoder(f)
+		in, err := cfg.Template.Reader(cfg.RequestFile, f)
+		if err != nil {
+			return fmt.Errorf("request template: %v", err)
+		}
+		d = dm.NewDecoder(in)
 	} else {
 		d = iocodec.DefaultDecoders["noop"].NewDecoder(os.Stdin)
 	}
+	d = cfg.Set.Decoder(d)
+	if prepare != nil {
+		if err := prepare(d); err != nil {
+			return err
+		}
+	}
+	if cfg.DryRun {
+		// fn prints what it would send instead
+		return fn(nil, nil, d, em.NewEncoder(os.Stdout))
+	}
 	conn, client, err := _DialMapList()
 	if err != nil {
 		return err
 	}
 	defer conn.Close()
-	return fn(client, d, em.NewEncoder(os.Stdout))
+	return fn(conn, client, d, em.NewEncoder(os.Stdout))
+}
+
+// _MapListPrompter returns the prompter asking for the fields of requests,
+// or nil unless --interactive is given and stdin is a terminal.
+func _MapListPrompter() *prompting.Prompter {
+	if !_DefaultMapListClientCommandConfig.Interactive || !prompting.IsTerminal(os.Stdin) {
+		return nil
+	}
+	return prompting.New(os.Stdin, os.Stderr)
+}
+
+// _MapListConfirmDestructive asks for confirmation of a call of the
+// destructive method, showing req unless nil. It's called once the server is
+// dialed, so sample requests and dry runs, which send nothing, aren't asked
+// about.
+func _MapListConfirmDestructive(command, method string, req proto.Message) error {
+	em, err := _MapListEncoderMaker()
+	if err != nil {
+		return err
+	}
+	return _DefaultMapListClientCommandConfig.Destructive.Confirm(command, method, req, em)
+}
+
+// _MapListEdit opens the editor on m, or on a sample of it when it's empty,
+// in the format of the request file, or else the response format.
+func _MapListEdit(m proto.Message, check func(proto.Message) error) error {
+	cfg := _DefaultMapListClientCommandConfig
+	format := cfg.ResponseFormat
+	if ext := filepath.Ext(cfg.RequestFile); len(ext) > 1 {
+		format = ext[1:]
+	}
+	var v interface{} = m
+	if proto.Size(m) == 0 {
+		v = iocodec.NewSample(m)
+	}
+	return editing.Edit(format, v, m, check)
+}
+
+// _MapListDryRun prints reqs, along with where and how they would be sent.
+func _MapListDryRun(out iocodec.Encoder, reqs ...proto.Message) error {
+	cfg := _DefaultMapListClientCommandConfig
+	plan := dryrun.Plan{
+		Target:   cfg.ServerAddr,
+		Timeout:  cfg.Timeout,
+		Metadata: metadata.MD{},
+		Requests: reqs,
+	}
+	if cfg.TLS {
+		plan.TLS = &dryrun.TLS{
+			ServerName:         cfg.ServerName,
+			InsecureSkipVerify: cfg.InsecureSkipVerify,
+			CACertFile:         cfg.CACertFile,
+			CertFile:           cfg.CertFile,
+			KeyFile:            cfg.KeyFile,
+		}
+		if plan.TLS.ServerName == "" {
+			plan.TLS.ServerName, _, _ = net.SplitHostPort(cfg.ServerAddr)
+		}
+	}
+	if cfg.AuthToken != "" {
+		plan.Metadata.Append("authorization", dryrun.Redact(cfg.AuthTokenType+" "+cfg.AuthToken))
+	}
+	if cfg.JWTKey != "" || cfg.JWTKeyFile != "" {
+		plan.Metadata.Append("authorization", "Bearer "+dryrun.Redacted)
+	}
+	return dryrun.Print(plan, out)
+}
+
+// _MapListLoadTest makes calls with call, as many and as fast as the load
+// test flags say, over conn and the connections it dials, and prints a report
+// of them.
+func _MapListLoadTest(conn *grpc.ClientConn, call func(ctx context.Context, cli MapListClient) error) error {
+	dial := func() (*grpc.ClientConn, error) {
+		conn, _, err := _DialMapList()
+		return conn, err
+	}
+	return loadtest.Run(_DefaultMapListClientCommandConfig.LoadTest, conn, dial, func(ctx context.Context, conn *grpc.ClientConn) error {
+		return call(ctx, NewMapListClient(conn))
+	}, os.Stdout)
+}
+
+func _MapListValidate(m proto.Message, flags *pflag.FlagSet) error {
+	if _DefaultMapListClientCommandConfig.SkipValidation {
+		return nil
+	}
+	return validation.Validate(m, flags)
 }
 
 func _MapListMethodClientCommand() *cobra.Command {
 	reqArgs := &MapListRequest{}
+	var batchOpts batch.Options
 
 	cmd := &cobra.Command{
 		Use:     "method",
@@ -218,16 +510,90 @@ func _MapListMethodClientCommand() *cobra.Command {
 		Example: "TODO: print protobuf method comments here",
 		Run: func(cmd *cobra.Command, args []string) {
 			var v MapListRequest
-			err := _MapListRoundTrip(v, func(cli MapListClient, in iocodec.Decoder, out iocodec.Encoder) error {
 
+			prepare := func(in iocodec.Decoder) error {
 				err := in.Decode(&v)
 				if err != nil {
 					return err
 				}
 
 				proto.Merge(&v, reqArgs)
-				resp, err := cli.Method(context.Background(), &v)
 
+				prompter := _MapListPrompter()
+				if prompter != nil {
+					err = prompter.Fields(&v)
+					if err != nil {
+						return err
+					}
+				}
+				if _DefaultMapListClientCommandConfig.Edit {
+					err = _MapListEdit(&v, func(m proto.Message) error {
+						return _MapListValidate(m, cmd.Flags())
+					})
+				} else {
+					err = _MapListValidate(&v, cmd.Flags())
+				}
+				if err != nil {
+					return err
+				}
+				if prompter == nil || _DefaultMapListClientCommandConfig.DryRun {
+					return nil
+				}
+				em, err := _MapListEncoderMaker()
+				if err != nil {
+					return err
+				}
+				return prompter.Confirm(&v, em)
+			}
+
+			if batchOpts.File != "" {
+				// the requests of the batch are read and checked one by one
+				prepare = nil
+			}
+
+			err := _MapListRoundTrip(&v, prepare, func(conn *grpc.ClientConn, cli MapListClient, in iocodec.Decoder, out iocodec.Encoder) error {
+
+				if batchOpts.File != "" {
+					if _DefaultMapListClientCommandConfig.DryRun {
+						return fmt.Errorf("--dry-run can't be used with --batch")
+					}
+					if _DefaultMapListClientCommandConfig.Edit {
+						return fmt.Errorf("--edit can't be used with --batch")
+					}
+					return batch.Run(batchOpts, func(ctx context.Context, r *batch.Record) (proto.Message, error) {
+						var v MapListRequest
+						err := r.Decode(&v)
+						if err != nil {
+							return nil, err
+						}
+						err = _DefaultMapListClientCommandConfig.Set.Apply(&v)
+						if err != nil {
+							return nil, batch.Invalid(err)
+						}
+						// the defaults of the record must not leak into
+						// the next ones
+						reqArgs := proto.Clone(reqArgs).(*MapListRequest)
+
+						proto.Merge(&v, reqArgs)
+						err = _MapListValidate(&v, cmd.Flags())
+						if err != nil {
+							return nil, batch.Invalid(err)
+						}
+						return cli.Method(ctx, &v)
+					}, out)
+				}
+
+				if _DefaultMapListClientCommandConfig.DryRun {
+					return _MapListDryRun(out, &v)
+				}
+				if _DefaultMapListClientCommandConfig.LoadTest.Enabled() {
+					return _MapListLoadTest(conn, func(ctx context.Context, cli MapListClient) error {
+						_, err := cli.Method(ctx, &v)
+						return err
+					})
+				}
+
+				resp, err := cli.Method(context.Background(), &v)
 				if err != nil {
 					return err
 				}
@@ -243,6 +609,7 @@ func _MapListMethodClientCommand() *cobra.Command {
 
 	cmd.PersistentFlags() // Warning: list flags are not yet supported (field "MapField")
 	cmd.PersistentFlags() // Warning: list flags are not yet supported (field "ListField")
+	batchOpts.AddFlags(cmd.PersistentFlags())
 
 	return cmd
 }
@@ -250,3 +617,36 @@ func _MapListMethodClientCommand() *cobra.Command {
 var _MapListClientSubCommands = []func() *cobra.Command{
 	_MapListMethodClientCommand,
 }
+
+func init() { describe.Register(_descriptorSet_Maplist_dabfc44e55bcc3b4) }
+
+var _descriptorSet_Maplist_dabfc44e55bcc3b4 = []byte{
+	// 418 bytes of a gzipped FileDescriptorSet
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0xd3, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0xc0, 0xf1, 0x9d, 0x9d, 0xf5, 0xb2, 0x9e, 0xc4, 0xde, 0x68, 0x5a, 0xd1, 0xa8, 0x52, 0x51,
+	0x12, 0x3e, 0xe4, 0x53, 0x24, 0xca, 0x81, 0x6f, 0x6e, 0x70, 0x40, 0xf4, 0xe2, 0x17, 0x40, 0x8e,
+	0x6a, 0x44, 0x44, 0x52, 0x9b, 0xda, 0x45, 0xea, 0x73, 0xf0, 0x18, 0x88, 0x47, 0xe1, 0x9d, 0xd0,
+	0x6c, 0xd6, 0x48, 0x51, 0xb8, 0xf7, 0xe6, 0xbf, 0x3d, 0x33, 0xfd, 0xa9, 0x52, 0xe8, 0x8f, 0xa5,
+	0x6c, 0x5b, 0xb5, 0x9b, 0x75, 0xd7, 0x2f, 0xdb, 0xeb, 0xa6, 0x6f, 0x58, 0xb7, 0xab, 0xc5, 0x2f,
+	0xa0, 0xfc, 0xa2, 0x6a, 0x3f, 0xad, 0xbb, 0xbe, 0xac, 0xbf, 0xdf, 0xd4, 0x5d, 0xcf, 0x6f, 0x29,
+	0xdd, 0x56, 0xed, 0xe7, 0x2f, 0xeb, 0x7a, 0x73, 0x39, 0x85, 0x19, 0x16, 0xa3, 0xf3, 0xd9, 0xb2,
+	0x5d, 0x2d, 0xf7, 0xc7, 0x24, 0x3f, 0xc8, 0xc8, 0xfb, 0xab, 0xfe, 0xfa, 0xb6, 0x74, 0xdb, 0x98,
+	0x7c, 0x46, 0x24, 0x7f, 0x23, 0xee, 0xeb, 0x19, 0x16, 0x69, 0x99, 0xca, 0x9b, 0xf0, 0xf9, 0xf4,
+	0x35, 0x65, 0x7b, 0x9b, 0x3c, 0x21, 0xfc, 0x56, 0xdf, 0x4e, 0x61, 0x06, 0x45, 0x5a, 0xca, 0x23,
+	0x1f, 0x53, 0xf2, 0xa3, 0xda, 0xdc, 0xd4, 0x53, 0x1d, 0xde, 0xed, 0xe2, 0x95, 0x7e, 0x01, 0x8b,
+	0xdf, 0x40, 0xfe, 0x1f, 0xa3, 0x6b, 0x9b, 0xab, 0xae, 0xe6, 0x77, 0x87, 0xdc, 0xf9, 0x1e, 0x77,
+	0x37, 0x77, 0x17, 0xde, 0xf3, 0x37, 0x74, 0x2f, 0x32, 0xf8, 0x29, 0xd9, 0x8b, 0xba, 0xff, 0xda,
+	0x5c, 0x32, 0x1f, 0xfe, 0x33, 0x4f, 0x8f, 0xfe, 0x23, 0xfe, 0xf8, 0x13, 0xc9, 0xb2, 0x51, 0x6a,
+	0x02, 0xe4, 0x08, 0xc6, 0x8c, 0x4a, 0xb1, 0x3c, 0x69, 0x46, 0xad, 0x46, 0x44, 0xa4, 0xad, 0x62,
+	0x63, 0x94, 0x05, 0x22, 0x42, 0xab, 0x80, 0xd1, 0x38, 0x4f, 0x23, 0x32, 0x56, 0x69, 0xc5, 0x98,
+	0x98, 0x97, 0x34, 0xa6, 0x44, 0x02, 0x18, 0x13, 0x97, 0x0f, 0xa5, 0x19, 0x13, 0x7f, 0x36, 0x14,
+	0x32, 0x26, 0xc5, 0x73, 0x39, 0x67, 0x14, 0x1b, 0xa7, 0x46, 0xe1, 0x9c, 0x91, 0x1d, 0xe7, 0xee,
+	0xcb, 0x39, 0x13, 0xce, 0xa5, 0xe6, 0x89, 0xac, 0x48, 0x58, 0xa9, 0x93, 0xa1, 0x80, 0x31, 0x9d,
+	0xce, 0x87, 0x42, 0xc6, 0xf4, 0xd1, 0xe3, 0xb8, 0x06, 0x8c, 0x64, 0x1e, 0xc6, 0x4f, 0x60, 0xa4,
+	0xc6, 0x43, 0x25, 0x8c, 0x94, 0x1d, 0x0d, 0x25, 0x93, 0xc7, 0x0f, 0x86, 0x42, 0x46, 0x9a, 0x2f,
+	0x82, 0x09, 0xd8, 0x64, 0x6a, 0xb2, 0x33, 0xc9, 0x54, 0xe6, 0x4e, 0xc2, 0x71, 0x10, 0x53, 0x1e,
+	0x4d, 0x10, 0x4c, 0x79, 0x34, 0x41, 0x30, 0xe5, 0xd1, 0x04, 0xc1, 0x94, 0x47, 0x13, 0x88, 0xc9,
+	0x47, 0x13, 0x04, 0x93, 0x8f, 0x26, 0x08, 0x26, 0x1f, 0x4d, 0x10, 0x4c, 0x3e, 0x9a, 0x20, 0x98,
+	0xfc, 0x7c, 0xb1, 0xb2, 0xe1, 0xc7, 0xf3, 0xec, 0xef, 0x00, 0xed, 0xab, 0x16, 0x7d, 0x50, 0x03,
+	0x00, 0x00,
+}
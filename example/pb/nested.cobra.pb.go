@@ -8,6 +8,7 @@ import (
 	tls "crypto/tls"
 	x509 "crypto/x509"
 	fmt "fmt"
+	io "io"
 	ioutil "io/ioutil"
 	log "log"
 	net "net"
@@ -18,12 +19,27 @@ import (
 	proto "github.com/golang/protobuf/proto"
 	cobra "github.com/spf13/cobra"
 	pflag "github.com/spf13/pflag"
+	batch "github.com/tetratelabs/protoc-gen-cobra/batch"
+	describe "github.com/tetratelabs/protoc-gen-cobra/describe"
+	destructive "github.com/tetratelabs/protoc-gen-cobra/destructive"
+	dryrun "github.com/tetratelabs/protoc-gen-cobra/dryrun"
+	editing "github.com/tetratelabs/protoc-gen-cobra/editing"
+	health "github.com/tetratelabs/protoc-gen-cobra/health"
 	iocodec "github.com/tetratelabs/protoc-gen-cobra/iocodec"
+	loadtest "github.com/tetratelabs/protoc-gen-cobra/loadtest"
+	override "github.com/tetratelabs/protoc-gen-cobra/override"
+	prompting "github.com/tetratelabs/protoc-gen-cobra/prompting"
+	recording "github.com/tetratelabs/protoc-gen-cobra/recording"
+	templating "github.com/tetratelabs/protoc-gen-cobra/templating"
+	tracing "github.com/tetratelabs/protoc-gen-cobra/tracing"
+	validation "github.com/tetratelabs/protoc-gen-cobra/validation"
+	verbose "github.com/tetratelabs/protoc-gen-cobra/verbose"
 	context "golang.org/x/net/context"
 	oauth2 "golang.org/x/oauth2"
 	grpc "google.golang.org/grpc"
 	credentials "google.golang.org/grpc/credentials"
 	oauth "google.golang.org/grpc/credentials/oauth"
+	metadata "google.golang.org/grpc/metadata"
 )
 
 // This is a compile-time assertion to ensure that this generated file
@@ -49,6 +65,17 @@ type _NestedMessagesClientCommandConfig struct {
 	AuthTokenType      string
 	JWTKey             string
 	JWTKeyFile         string
+	SkipValidation     bool
+	LoadTest           loadtest.Options
+	Record             string
+	DryRun             bool
+	Verbose            bool
+	Trace              tracing.Options
+	Set                override.Options
+	Template           templating.Options
+	Edit               bool
+	Interactive        bool
+	Destructive        destructive.Options
 }
 
 func _NewNestedMessagesClientCommandConfig() *_NestedMessagesClientCommandConfig {
@@ -78,6 +105,17 @@ func (o *_NestedMessagesClientCommandConfig) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&o.AuthTokenType, "auth-token-type", o.AuthTokenType, "authorization token type")
 	fs.StringVar(&o.JWTKey, "jwt-key", o.JWTKey, "jwt key")
 	fs.StringVar(&o.JWTKeyFile, "jwt-key-file", o.JWTKeyFile, "jwt key file")
+	fs.BoolVar(&o.SkipValidation, "skip-validation", o.SkipValidation, "skip the client-side validation of requests")
+	o.LoadTest.AddFlags(fs)
+	fs.StringVar(&o.Record, "record", o.Record, "record the calls, with their metadata, messages, statuses and timings, to this session file")
+	fs.BoolVar(&o.DryRun, "dry-run", o.DryRun, "print the requests, where they would be sent and the metadata, with secrets redacted, instead of sending them")
+	fs.BoolVarP(&o.Verbose, "verbose", "v", o.Verbose, "log the connection, the tls handshake, and the metadata, message sizes and timings of the calls to stderr, with secrets redacted")
+	o.Trace.AddFlags(fs)
+	o.Set.AddFlags(fs)
+	o.Template.AddFlags(fs)
+	fs.BoolVar(&o.Edit, "edit", o.Edit, "open the request, or a sample of it without one, in $EDITOR before sending it")
+	fs.BoolVar(&o.Interactive, "interactive", o.Interactive, "when stdin is a terminal, prompt for the fields the request doesn't set, and for confirmation before sending it")
+	o.Destructive.AddFlags(fs)
 }
 
 func NestedMessagesClientCommand() *cobra.Command {
@@ -89,14 +127,129 @@ func NestedMessagesClientCommand() *cobra.Command {
 	for _, s := range _NestedMessagesClientSubCommands {
 		cmd.AddCommand(s())
 	}
+	cmd.AddCommand(_NestedMessagesReplayCommand())
+	cmd.AddCommand(_NestedMessagesHealthCommand())
+	cmd.AddCommand(_NestedMessagesDescribeCommand())
+	return cmd
+}
+
+func _NestedMessagesReplayCommand() *cobra.Command {
+	var compare bool
+	cmd := &cobra.Command{
+		Use:   "replay [file]",
+		Short: "Replay the calls of a session recorded with --record",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			f, err := os.Open(args[0])
+			if err != nil {
+				log.Fatal(err)
+			}
+			defer f.Close()
+			err = _NestedMessagesRoundTrip(nil, nil, func(conn *grpc.ClientConn, cli NestedMessagesClient, in iocodec.Decoder, out iocodec.Encoder) error {
+				if _DefaultNestedMessagesClientCommandConfig.DryRun {
+					reqs, err := recording.Requests(f)
+					if err != nil {
+						return err
+					}
+					return _NestedMessagesDryRun(out, reqs...)
+				}
+				return recording.Replay(conn, f, compare, out, os.Stderr)
+			})
+			if err != nil {
+				log.Fatal(err)
+			}
+		},
+	}
+	cmd.Flags().BoolVar(&compare, "compare", false, "compare the responses and statuses to the recorded ones, field by field, and fail if they differ")
+	return cmd
+}
+
+func _NestedMessagesHealthCommand() *cobra.Command {
+	opts := health.Options{Service: "pb.NestedMessages"}
+	cmd := &cobra.Command{
+		Use:     "health",
+		Aliases: []string{"ping"},
+		Short:   "Check the health of pb.NestedMessages with the gRPC health checking protocol, and report how the connection is made",
+		Long:    "Check the health of pb.NestedMessages with the gRPC health checking protocol, and report how the connection is made: its TLS details, the certificate chain of the server and the latency of the check.\n\nExits with 0 if the service is serving, 2 if the connection fails, 3 if the check fails, and 4 if the service isn't serving.",
+		Args:    cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			cfg := _DefaultNestedMessagesClientCommandConfig
+			em, err := _NestedMessagesEncoderMaker()
+			if err != nil {
+				log.Fatal(err)
+			}
+			if cfg.DryRun {
+				if err := _NestedMessagesDryRun(em.NewEncoder(os.Stdout)); err != nil {
+					log.Fatal(err)
+				}
+				return
+			}
+			err = health.Check(opts, cfg.Timeout, func() (*grpc.ClientConn, error) {
+				conn, _, err := _DialNestedMessages()
+				return conn, err
+			}, em.NewEncoder(os.Stdout))
+			if err != nil {
+				log.Print(err)
+				os.Exit(health.ExitCode(err))
+			}
+		},
+	}
+	opts.AddFlags(cmd.Flags())
+	return cmd
+}
+
+func _NestedMessagesDescribeCommand() *cobra.Command {
+	var raw bool
+	cmd := &cobra.Command{
+		Use:   "describe [name]",
+		Short: "Print the definition of pb.NestedMessages, or of one of its methods, messages or enums, with its comments",
+		Long:  "Print the definition of pb.NestedMessages, or of one of its methods, messages or enums, with its comments, from the descriptors built into the command.\n\nThe name is a method, as named in the proto file or as its command, or the name of a message or enum, relative to the package of the service or in full. A method is followed by its request and response messages.",
+		Args:  cobra.MaximumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			name := ""
+			if len(args) > 0 {
+				name = args[0]
+			}
+			defs, err := describe.Lookup("pb.NestedMessages", name)
+			if err != nil {
+				log.Fatal(err)
+			}
+			if !raw {
+				if err := describe.Print(os.Stdout, defs); err != nil {
+					log.Fatal(err)
+				}
+				return
+			}
+			em, err := _NestedMessagesEncoderMaker()
+			if err != nil {
+				log.Fatal(err)
+			}
+			enc := em.NewEncoder(os.Stdout)
+			for _, d := range defs {
+				if err := enc.Encode(d.Descriptor); err != nil {
+					log.Fatal(err)
+				}
+			}
+		},
+	}
+	cmd.Flags().BoolVar(&raw, "descriptor", false, "print the descriptors of the definitions in the response format instead of proto source")
 	return cmd
 }
 
 func _DialNestedMessages() (*grpc.ClientConn, NestedMessagesClient, error) {
 	cfg := _DefaultNestedMessagesClientCommandConfig
-	opts := []grpc.DialOption{
-		grpc.WithBlock(),
-		grpc.WithTimeout(cfg.Timeout),
+	var v *verbose.Logger
+	var opts []grpc.DialOption
+	if cfg.Trace.Enabled() {
+		t, err := tracing.Start(cfg.Trace)
+		if err != nil {
+			return nil, nil, fmt.Errorf("trace: %v", err)
+		}
+		opts = append(opts, t.DialOptions()...)
+	}
+	if cfg.Verbose {
+		v = verbose.New(os.Stderr)
+		opts = append(opts, v.DialOptions()...)
 	}
 	if cfg.TLS {
 		tlsConfig := &tls.Config{}
@@ -130,6 +283,9 @@ func _DialNestedMessages() (*grpc.ClientConn, NestedMessagesClient, error) {
 		}
 		//tlsConfig.BuildNameToCertificate()
 		cred := credentials.NewTLS(tlsConfig)
+		if v != nil {
+			cred = v.Credentials(cred)
+		}
 		opts = append(opts, grpc.WithTransportCredentials(cred))
 	} else {
 		opts = append(opts, grpc.WithInsecure())
@@ -155,34 +311,69 @@ func _DialNestedMessages() (*grpc.ClientConn, NestedMessagesClient, error) {
 		}
 		opts = append(opts, grpc.WithPerRPCCredentials(cred))
 	}
-	conn, err := grpc.Dial(cfg.ServerAddr, opts...)
+	if cfg.Record != "" {
+		rec, err := recording.Open(cfg.Record)
+		if err != nil {
+			return nil, nil, fmt.Errorf("record: %v", err)
+		}
+		opts = append(opts, rec.DialOptions()...)
+	}
+	var conn *grpc.ClientConn
+	var err error
+	if v != nil {
+		conn, err = v.Dial(cfg.ServerAddr, cfg.Timeout, opts...)
+	} else {
+		conn, err = grpc.Dial(cfg.ServerAddr, append(opts, grpc.WithBlock(), grpc.WithTimeout(cfg.Timeout))...)
+	}
 	if err != nil {
 		return nil, nil, err
 	}
 	return conn, NewNestedMessagesClient(conn), nil
 }
 
-type _NestedMessagesRoundTripFunc func(cli NestedMessagesClient, in iocodec.Decoder, out iocodec.Encoder) error
+// _NestedMessagesEncoderMaker returns the encoder maker of the response format.
+func _NestedMessagesEncoderMaker() (iocodec.EncoderMaker, error) {
+	format := _DefaultNestedMessagesClientCommandConfig.ResponseFormat
+	if format == "" {
+		format = "json"
+	}
+	em, ok := iocodec.DefaultEncoders[format]
+	if !ok {
+		return nil, fmt.Errorf("invalid response format: %q", format)
+	}
+	return em, nil
+}
+
+type _NestedMessagesRoundTripFunc func(conn *grpc.ClientConn, cli NestedMessagesClient, in iocodec.Decoder, out iocodec.Encoder) error
 
-func _NestedMessagesRoundTrip(sample interface{}, fn _NestedMessagesRoundTripFunc) error {
+// _NestedMessagesRoundTrip reads the request with prepare, when set, before it
+// dials the server and calls fn. Commands without a request have no sample.
+func _NestedMessagesRoundTrip(sample proto.Message, prepare func(in iocodec.Decoder) error, fn _NestedMessagesRoundTripFunc) error {
 	cfg := _DefaultNestedMessagesClientCommandConfig
-	var em iocodec.EncoderMaker
-	var ok bool
-	if cfg.ResponseFormat == "" {
-		em = iocodec.DefaultEncoders["json"]
-	} else {
-		em, ok = iocodec.DefaultEncoders[cfg.ResponseFormat]
-		if !ok {
-			return fmt.Errorf("invalid response format: %q", cfg.ResponseFormat)
-		}
+	em, err := _NestedMessagesEncoderMaker()
+	if err != nil {
+		return err
 	}
 	if cfg.PrintSampleRequest {
-		return em.NewEncoder(os.Stdout).Encode(sample)
+		if sample == nil {
+			return fmt.Errorf("no sample request")
+		}
+		return em.NewEncoder(os.Stdout).Encode(iocodec.NewSample(sample))
 	}
 	// read the input request, first from stdin, then from a file, otherwise from args only
 	var d iocodec.Decoder
 	if cfg.Stdin || cfg.RequestFile == "-" {
-		d = iocodec.DefaultDecoders["json"].NewDecoder(os.Stdin)
+		if cfg.Edit {
+			// the editor needs the terminal
+			return fmt.Errorf("--edit can't be used with a request read from stdin")
+		}
+		var in io.Reader = os.Stdin
+		if cfg.Template.Stdin {
+			if in, err = cfg.Template.Reader("stdin", os.Stdin); err != nil {
+				return fmt.Errorf("request template: %v", err)
+			}
+		}
+		d = iocodec.DefaultDecoders["json"].NewDecoder(in)
 	} else if cfg.RequestFile != "" {
 		f, err := os.Open(cfg.RequestFile)
 		if err != nil {
@@ -197,16 +388,116 @@ func _NestedMessagesRoundTrip(sample interface{}, fn _NestedMessagesRoundTripFun
 		if !ok {
 			return fmt.Errorf("invalid request file format: %q", ext)
 		}
-		d = dm.NewDecoder(f)
+		in, err := cfg.Template.Reader(cfg.RequestFile, f)
+		if err != nil {
+			return fmt.Errorf("request template: %v", err)
+		}
+		d = dm.NewDecoder(in)
 	} else {
 		d = iocodec.DefaultDecoders["noop"].NewDecoder(os.Stdin)
 	}
+	d = cfg.Set.Decoder(d)
+	if prepare != nil {
+		if err := prepare(d); err != nil {
+			return err
+		}
+	}
+	if cfg.DryRun {
+		// fn prints what it would send instead
+		return fn(nil, nil, d, em.NewEncoder(os.Stdout))
+	}
 	conn, client, err := _DialNestedMessages()
 	if err != nil {
 		return err
 	}
 	defer conn.Close()
-	return fn(client, d, em.NewEncoder(os.Stdout))
+	return fn(conn, client, d, em.NewEncoder(os.Stdout))
+}
+
+// _NestedMessagesPrompter returns the prompter asking for the fields of requests,
+// or nil unless --interactive is given and stdin is a terminal.
+func _NestedMessagesPrompter() *prompting.Prompter {
+	if !_DefaultNestedMessagesClientCommandConfig.Interactive || !prompting.IsTerminal(os.Stdin) {
+		return nil
+	}
+	return prompting.New(os.Stdin, os.Stderr)
+}
+
+// _NestedMessagesConfirmDestructive asks for confirmation of a call of the
+// destructive method, showing req unless nil. It's called once the server is
+// dialed, so sample requests and dry runs, which send nothing, aren't asked
+// about.
+func _NestedMessagesConfirmDestructive(command, method string, req proto.Message) error {
+	em, err := _NestedMessagesEncoderMaker()
+	if err != nil {
+		return err
+	}
+	return _DefaultNestedMessagesClientCommandConfig.Destructive.Confirm(command, method, req, em)
+}
+
+// _NestedMessagesEdit opens the editor on m, or on a sample of it when it's empty,
+// in the format of the request file, or else the response format.
+func _NestedMessagesEdit(m proto.Message, check func(proto.Message) error) error {
+	cfg := _DefaultNestedMessagesClientCommandConfig
+	format := cfg.ResponseFormat
+	if ext := filepath.Ext(cfg.RequestFile); len(ext) > 1 {
+		format = ext[1:]
+	}
+	var v interface{} = m
+	if proto.Size(m) == 0 {
+		v = iocodec.NewSample(m)
+	}
+	return editing.Edit(format, v, m, check)
+}
+
+// _NestedMessagesDryRun prints reqs, along with where and how they would be sent.
+func _NestedMessagesDryRun(out iocodec.Encoder, reqs ...proto.Message) error {
+	cfg := _DefaultNestedMessagesClientCommandConfig
+	plan := dryrun.Plan{
+		Target:   cfg.ServerAddr,
+		Timeout:  cfg.Timeout,
+		Metadata: metadata.MD{},
+		Requests: reqs,
+	}
+	if cfg.TLS {
+		plan.TLS = &dryrun.TLS{
+			ServerName:         cfg.ServerName,
+			InsecureSkipVerify: cfg.InsecureSkipVerify,
+			CACertFile:         cfg.CACertFile,
+			CertFile:           cfg.CertFile,
+			KeyFile:            cfg.KeyFile,
+		}
+		if plan.TLS.ServerName == "" {
+			plan.TLS.ServerName, _, _ = net.SplitHostPort(cfg.ServerAddr)
+		}
+	}
+	if cfg.AuthToken != "" {
+		plan.Metadata.Append("authorization", dryrun.Redact(cfg.AuthTokenType+" "+cfg.AuthToken))
+	}
+	if cfg.JWTKey != "" || cfg.JWTKeyFile != "" {
+		plan.Metadata.Append("authorization", "Bearer "+dryrun.Redacted)
+	}
+	return dryrun.Print(plan, out)
+}
+
+// _NestedMessagesLoadTest makes calls with call, as many and as fast as the load
+// test flags say, over conn and the connections it dials, and prints a report
+// of them.
+func _NestedMessagesLoadTest(conn *grpc.ClientConn, call func(ctx context.Context, cli NestedMessagesClient) error) error {
+	dial := func() (*grpc.ClientConn, error) {
+		conn, _, err := _DialNestedMessages()
+		return conn, err
+	}
+	return loadtest.Run(_DefaultNestedMessagesClientCommandConfig.LoadTest, conn, dial, func(ctx context.Context, conn *grpc.ClientConn) error {
+		return call(ctx, NewNestedMessagesClient(conn))
+	}, os.Stdout)
+}
+
+func _NestedMessagesValidate(m proto.Message, flags *pflag.FlagSet) error {
+	if _DefaultNestedMessagesClientCommandConfig.SkipValidation {
+		return nil
+	}
+	return validation.Validate(m, flags)
 }
 
 func _NestedMessagesGetClientCommand() *cobra.Command {
@@ -214,6 +505,7 @@ func _NestedMessagesGetClientCommand() *cobra.Command {
 		Inner:    &NestedRequest_InnerNestedType{},
 		TopLevel: &TopLevelNestedType{},
 	}
+	var batchOpts batch.Options
 
 	cmd := &cobra.Command{
 		Use:     "get",
@@ -221,16 +513,90 @@ func _NestedMessagesGetClientCommand() *cobra.Command {
 		Example: "TODO: print protobuf method comments here",
 		Run: func(cmd *cobra.Command, args []string) {
 			var v NestedRequest
-			err := _NestedMessagesRoundTrip(v, func(cli NestedMessagesClient, in iocodec.Decoder, out iocodec.Encoder) error {
 
+			prepare := func(in iocodec.Decoder) error {
 				err := in.Decode(&v)
 				if err != nil {
 					return err
 				}
 
 				proto.Merge(&v, reqArgs)
-				resp, err := cli.Get(context.Background(), &v)
 
+				prompter := _NestedMessagesPrompter()
+				if prompter != nil {
+					err = prompter.Fields(&v)
+					if err != nil {
+						return err
+					}
+				}
+				if _DefaultNestedMessagesClientCommandConfig.Edit {
+					err = _NestedMessagesEdit(&v, func(m proto.Message) error {
+						return _NestedMessagesValidate(m, cmd.Flags())
+					})
+				} else {
+					err = _NestedMessagesValidate(&v, cmd.Flags())
+				}
+				if err != nil {
+					return err
+				}
+				if prompter == nil || _DefaultNestedMessagesClientCommandConfig.DryRun {
+					return nil
+				}
+				em, err := _NestedMessagesEncoderMaker()
+				if err != nil {
+					return err
+				}
+				return prompter.Confirm(&v, em)
+			}
+
+			if batchOpts.File != "" {
+				// the requests of the batch are read and checked one by one
+				prepare = nil
+			}
+
+			err := _NestedMessagesRoundTrip(&v, prepare, func(conn *grpc.ClientConn, cli NestedMessagesClient, in iocodec.Decoder, out iocodec.Encoder) error {
+
+				if batchOpts.File != "" {
+					if _DefaultNestedMessagesClientCommandConfig.DryRun {
+						return fmt.Errorf("--dry-run can't be used with --batch")
+					}
+					if _DefaultNestedMessagesClientCommandConfig.Edit {
+						return fmt.Errorf("--edit can't be used with --batch")
+					}
+					return batch.Run(batchOpts, func(ctx context.Context, r *batch.Record) (proto.Message, error) {
+						var v NestedRequest
+						err := r.Decode(&v)
+						if err != nil {
+							return nil, err
+						}
+						err = _DefaultNestedMessagesClientCommandConfig.Set.Apply(&v)
+						if err != nil {
+							return nil, batch.Invalid(err)
+						}
+						// the defaults of the record must not leak into
+						// the next ones
+						reqArgs := proto.Clone(reqArgs).(*NestedRequest)
+
+						proto.Merge(&v, reqArgs)
+						err = _NestedMessagesValidate(&v, cmd.Flags())
+						if err != nil {
+							return nil, batch.Invalid(err)
+						}
+						return cli.Get(ctx, &v)
+					}, out)
+				}
+
+				if _DefaultNestedMessagesClientCommandConfig.DryRun {
+					return _NestedMessagesDryRun(out, &v)
+				}
+				if _DefaultNestedMessagesClientCommandConfig.LoadTest.Enabled() {
+					return _NestedMessagesLoadTest(conn, func(ctx context.Context, cli NestedMessagesClient) error {
+						_, err := cli.Get(ctx, &v)
+						return err
+					})
+				}
+
+				resp, err := cli.Get(context.Background(), &v)
 				if err != nil {
 					return err
 				}
@@ -246,6 +612,7 @@ func _NestedMessagesGetClientCommand() *cobra.Command {
 
 	cmd.PersistentFlags().StringVar(&reqArgs.Inner.Value, "inner-value", "", "get-comment-from-proto")
 	cmd.PersistentFlags().StringVar(&reqArgs.TopLevel.Value, "toplevel-value", "", "get-comment-from-proto")
+	batchOpts.AddFlags(cmd.PersistentFlags())
 
 	return cmd
 }
@@ -258,6 +625,7 @@ func _NestedMessagesGetDeeplyNestedClientCommand() *cobra.Command {
 			},
 		},
 	}
+	var batchOpts batch.Options
 
 	cmd := &cobra.Command{
 		Use:     "getdeeplynested",
@@ -265,16 +633,90 @@ func _NestedMessagesGetDeeplyNestedClientCommand() *cobra.Command {
 		Example: "TODO: print protobuf method comments here",
 		Run: func(cmd *cobra.Command, args []string) {
 			var v DeeplyNested
-			err := _NestedMessagesRoundTrip(v, func(cli NestedMessagesClient, in iocodec.Decoder, out iocodec.Encoder) error {
 
+			prepare := func(in iocodec.Decoder) error {
 				err := in.Decode(&v)
 				if err != nil {
 					return err
 				}
 
 				proto.Merge(&v, reqArgs)
-				resp, err := cli.GetDeeplyNested(context.Background(), &v)
 
+				prompter := _NestedMessagesPrompter()
+				if prompter != nil {
+					err = prompter.Fields(&v)
+					if err != nil {
+						return err
+					}
+				}
+				if _DefaultNestedMessagesClientCommandConfig.Edit {
+					err = _NestedMessagesEdit(&v, func(m proto.Message) error {
+						return _NestedMessagesValidate(m, cmd.Flags())
+					})
+				} else {
+					err = _NestedMessagesValidate(&v, cmd.Flags())
+				}
+				if err != nil {
+					return err
+				}
+				if prompter == nil || _DefaultNestedMessagesClientCommandConfig.DryRun {
+					return nil
+				}
+				em, err := _NestedMessagesEncoderMaker()
+				if err != nil {
+					return err
+				}
+				return prompter.Confirm(&v, em)
+			}
+
+			if batchOpts.File != "" {
+				// the requests of the batch are read and checked one by one
+				prepare = nil
+			}
+
+			err := _NestedMessagesRoundTrip(&v, prepare, func(conn *grpc.ClientConn, cli NestedMessagesClient, in iocodec.Decoder, out iocodec.Encoder) error {
+
+				if batchOpts.File != "" {
+					if _DefaultNestedMessagesClientCommandConfig.DryRun {
+						return fmt.Errorf("--dry-run can't be used with --batch")
+					}
+					if _DefaultNestedMessagesClientCommandConfig.Edit {
+						return fmt.Errorf("--edit can't be used with --batch")
+					}
+					return batch.Run(batchOpts, func(ctx context.Context, r *batch.Record) (proto.Message, error) {
+						var v DeeplyNested
+						err := r.Decode(&v)
+						if err != nil {
+							return nil, err
+						}
+						err = _DefaultNestedMessagesClientCommandConfig.Set.Apply(&v)
+						if err != nil {
+							return nil, batch.Invalid(err)
+						}
+						// the defaults of the record must not leak into
+						// the next ones
+						reqArgs := proto.Clone(reqArgs).(*DeeplyNested)
+
+						proto.Merge(&v, reqArgs)
+						err = _NestedMessagesValidate(&v, cmd.Flags())
+						if err != nil {
+							return nil, batch.Invalid(err)
+						}
+						return cli.GetDeeplyNested(ctx, &v)
+					}, out)
+				}
+
+				if _DefaultNestedMessagesClientCommandConfig.DryRun {
+					return _NestedMessagesDryRun(out, &v)
+				}
+				if _DefaultNestedMessagesClientCommandConfig.LoadTest.Enabled() {
+					return _NestedMessagesLoadTest(conn, func(ctx context.Context, cli NestedMessagesClient) error {
+						_, err := cli.GetDeeplyNested(ctx, &v)
+						return err
+					})
+				}
+
+				resp, err := cli.GetDeeplyNested(context.Background(), &v)
 				if err != nil {
 					return err
 				}
@@ -289,6 +731,7 @@ func _NestedMessagesGetDeeplyNestedClientCommand() *cobra.Command {
 	}
 
 	cmd.PersistentFlags().StringVar(&reqArgs.L0.L1.L2.L3, "l0-l1-l2-l3", "", "get-comment-from-proto")
+	batchOpts.AddFlags(cmd.PersistentFlags())
 
 	return cmd
 }
@@ -297,3 +740,58 @@ var _NestedMessagesClientSubCommands = []func() *cobra.Command{
 	_NestedMessagesGetClientCommand,
 	_NestedMessagesGetDeeplyNestedClientCommand,
 }
+
+func init() { describe.Register(_descriptorSet_Nested_2a0036ddd0f0b972) }
+
+var _descriptorSet_Nested_2a0036ddd0f0b972 = []byte{
+	// 769 bytes of a gzipped FileDescriptorSet
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x95, 0xdf, 0x8a, 0xdb, 0x46,
+	0x14, 0xc6, 0x67, 0xe6, 0x8c, 0xb4, 0xf2, 0x91, 0xfc, 0xef, 0xac, 0x2d, 0xdb, 0x5a, 0x7b, 0xbd,
+	0xb2, 0x5d, 0x76, 0x53, 0xca, 0x36, 0xb1, 0x03, 0xb9, 0x2a, 0xbd, 0x09, 0x84, 0x86, 0xfe, 0x01,
+	0x93, 0xab, 0xde, 0x94, 0x2c, 0x1d, 0x4a, 0x41, 0xb5, 0x55, 0x4b, 0x4e, 0xc9, 0x9b, 0xf4, 0x05,
+	0xda, 0x77, 0xe8, 0x7b, 0x95, 0x5e, 0x97, 0x33, 0xd2, 0x60, 0x7b, 0x9d, 0x52, 0xc8, 0x9d, 0x7f,
+	0xe7, 0xcc, 0xf7, 0xcd, 0x77, 0xce, 0x08, 0x8c, 0xff, 0x44, 0x18, 0x6d, 0x4c, 0x51, 0x9a, 0x1f,
+	0xef, 0xf3, 0xdd, 0xb6, 0xdc, 0x92, 0xca, 0x1f, 0x66, 0x9f, 0x22, 0xbd, 0xd9, 0xe6, 0x5f, 0x9b,
+	0x77, 0x26, 0xfb, 0xd6, 0xf6, 0xde, 0xbc, 0xcf, 0x0d, 0xf5, 0xd0, 0x7b, 0xf7, 0x36, 0xdb, 0x9b,
+	0xa1, 0xbc, 0x91, 0x77, 0x8d, 0x75, 0x05, 0xb3, 0x3f, 0x25, 0x36, 0xab, 0x43, 0x6b, 0xf3, 0xeb,
+	0xde, 0x14, 0x25, 0xbd, 0x40, 0xef, 0xe7, 0xcd, 0xc6, 0xec, 0xec, 0xb9, 0x70, 0x99, 0xde, 0xe7,
+	0x0f, 0xf7, 0x27, 0x27, 0xee, 0xbf, 0xe2, 0xf6, 0xc1, 0x79, 0x5d, 0x9d, 0xa7, 0x15, 0x36, 0xca,
+	0x6d, 0xfe, 0x43, 0xc6, 0xf7, 0x0e, 0x95, 0x15, 0xc7, 0x2c, 0x3e, 0xcf, 0xb2, 0x0e, 0xca, 0xba,
+	0x96, 0xdc, 0x62, 0xfb, 0x91, 0xdd, 0x7f, 0x04, 0xbd, 0xc3, 0x96, 0x4b, 0x51, 0xe4, 0xdb, 0x4d,
+	0x61, 0x28, 0x46, 0x7f, 0x67, 0xca, 0xfd, 0x6e, 0x53, 0x1f, 0xac, 0x69, 0xf6, 0x97, 0xc2, 0xe8,
+	0xa5, 0x31, 0x79, 0xf6, 0xbe, 0x12, 0xd0, 0x12, 0x55, 0xf6, 0xb4, 0x1e, 0x67, 0xc6, 0x89, 0x8e,
+	0xbb, 0x27, 0xf0, 0xdd, 0xbe, 0x34, 0xbb, 0xb5, 0xca, 0x9e, 0x26, 0x7f, 0x4b, 0xec, 0x9e, 0x75,
+	0xe8, 0x25, 0xaa, 0xec, 0x59, 0xed, 0xf4, 0xfc, 0xff, 0x9d, 0x4e, 0x2a, 0x76, 0xcc, 0xb5, 0xca,
+	0x9e, 0x25, 0xbf, 0x3f, 0xf2, 0xb6, 0x1d, 0xfa, 0x1e, 0x55, 0xb6, 0xac, 0xbd, 0x5f, 0x7f, 0x8c,
+	0xf7, 0x79, 0xe5, 0x97, 0x6d, 0x51, 0xae, 0x55, 0xb6, 0x4c, 0x6e, 0xb1, 0xff, 0xc1, 0x26, 0xb5,
+	0x50, 0x65, 0xab, 0x7a, 0x7f, 0x2a, 0x5b, 0x2d, 0x7f, 0x73, 0x5b, 0xfe, 0xc6, 0x14, 0xc5, 0xdb,
+	0x9f, 0x4c, 0x41, 0x9f, 0x21, 0xbc, 0x32, 0x25, 0x75, 0xcf, 0x3e, 0x83, 0x84, 0x8e, 0x4b, 0xf5,
+	0x9b, 0xbc, 0xc0, 0xf6, 0x2b, 0x53, 0x9e, 0x6c, 0xbf, 0xf3, 0x78, 0x96, 0x0f, 0x09, 0x5f, 0xff,
+	0x71, 0x81, 0x3e, 0x69, 0x21, 0x16, 0x12, 0x03, 0x94, 0x11, 0x81, 0x10, 0xc4, 0xbf, 0x14, 0x81,
+	0x12, 0x21, 0x22, 0x2a, 0x5f, 0x90, 0xd6, 0xe2, 0x42, 0x22, 0x22, 0xf8, 0x42, 0x12, 0xe8, 0x20,
+	0xc6, 0x10, 0xb5, 0x2f, 0x94, 0x20, 0xf0, 0xf4, 0x73, 0x8c, 0xd0, 0x63, 0x90, 0x04, 0x5e, 0x10,
+	0x3a, 0x52, 0x04, 0x5e, 0x34, 0x72, 0x04, 0x04, 0xde, 0x62, 0x59, 0xcb, 0x24, 0x81, 0xaf, 0xbf,
+	0xac, 0x5b, 0x92, 0x29, 0x18, 0x38, 0x52, 0x04, 0xfe, 0x70, 0xe1, 0x08, 0x08, 0xfc, 0xcf, 0xbf,
+	0xe0, 0x14, 0x5a, 0x90, 0x6e, 0x88, 0xd0, 0xa6, 0xd0, 0x7c, 0x55, 0x23, 0x48, 0xd8, 0x4e, 0xdb,
+	0x14, 0xa8, 0xfb, 0x2c, 0x61, 0xf0, 0x98, 0xd0, 0x91, 0x24, 0xc0, 0xb0, 0xe3, 0x08, 0x08, 0xf0,
+	0xb2, 0x67, 0xed, 0x24, 0xe9, 0xa6, 0xb8, 0xac, 0xec, 0x38, 0x42, 0x33, 0x60, 0x07, 0xad, 0x25,
+	0x08, 0xd2, 0x2d, 0xdd, 0xf1, 0xac, 0x46, 0x02, 0x3b, 0xb4, 0xa2, 0x2b, 0x6c, 0xa2, 0xcf, 0xc4,
+	0x97, 0xb5, 0x83, 0x11, 0xb6, 0xf0, 0xa2, 0x42, 0x8f, 0xb9, 0x75, 0x60, 0x49, 0xd0, 0x6e, 0xf7,
+	0x0e, 0x0c, 0x04, 0xed, 0xc1, 0xd0, 0x26, 0x95, 0x2c, 0xee, 0xea, 0xeb, 0xca, 0x59, 0x09, 0x9f,
+	0xe9, 0xd2, 0x91, 0x24, 0xe8, 0xf6, 0x46, 0x8e, 0x80, 0xa0, 0x3b, 0x9e, 0xd4, 0x32, 0x49, 0x40,
+	0xfa, 0x93, 0xba, 0x25, 0x7d, 0xa6, 0xd8, 0x11, 0xf7, 0x06, 0x37, 0x8e, 0x80, 0x80, 0xe6, 0x0b,
+	0x3b, 0xa0, 0x22, 0xdd, 0x17, 0x83, 0x6a, 0x40, 0x76, 0xe8, 0x57, 0xaf, 0xa6, 0x15, 0xa7, 0x88,
+	0x6b, 0x03, 0x65, 0x07, 0x88, 0xeb, 0x7d, 0x29, 0x9b, 0x22, 0x0e, 0xbb, 0x8e, 0x80, 0x20, 0xee,
+	0xf5, 0xad, 0x1d, 0x90, 0x1e, 0x89, 0x45, 0x65, 0x07, 0x92, 0x60, 0x14, 0xf4, 0xec, 0xbe, 0x80,
+	0xf7, 0x95, 0xe8, 0x59, 0xb5, 0x2f, 0xb0, 0xfb, 0x4a, 0xa2, 0x09, 0xb6, 0xd0, 0x67, 0xe2, 0xee,
+	0x55, 0x70, 0xd3, 0xb0, 0x0b, 0xb1, 0x2c, 0x09, 0xae, 0x3a, 0x29, 0x76, 0x30, 0xa8, 0x98, 0x4f,
+	0x8c, 0xa3, 0xeb, 0x26, 0x76, 0xb0, 0xe1, 0x2a, 0x92, 0x60, 0xdc, 0x7b, 0x82, 0x5d, 0x44, 0x57,
+	0xe1, 0xd0, 0x93, 0xce, 0x35, 0x12, 0x86, 0x87, 0x92, 0xc7, 0xb5, 0xf8, 0xb4, 0x26, 0x09, 0x26,
+	0x83, 0xd1, 0x69, 0x0d, 0x08, 0x26, 0xe3, 0x09, 0xb6, 0xdd, 0x95, 0x6c, 0x36, 0x8d, 0x9e, 0x1c,
+	0x6e, 0xb4, 0x6f, 0x31, 0x8d, 0xd2, 0xe3, 0x8a, 0x24, 0x98, 0xce, 0x16, 0xc7, 0x15, 0x20, 0x98,
+	0xde, 0xde, 0xd9, 0x2f, 0x01, 0x2a, 0x93, 0x34, 0x48, 0xdd, 0x60, 0xd6, 0x22, 0x0d, 0x46, 0x07,
+	0x96, 0x04, 0x69, 0x32, 0x3e, 0x30, 0x10, 0xa4, 0xd3, 0x1b, 0xfb, 0x06, 0xc0, 0xe2, 0xb9, 0x9e,
+	0x54, 0x3b, 0xb3, 0xd2, 0x79, 0xfd, 0x05, 0x83, 0x15, 0xce, 0xe3, 0xa1, 0x23, 0x20, 0x98, 0x5f,
+	0x8d, 0x1f, 0x7c, 0xfb, 0x37, 0xb3, 0xfa, 0x77, 0x00, 0xf2, 0xd9, 0x60, 0x85, 0x79, 0x06, 0x00,
+	0x00,
+}
@@ -16,14 +16,30 @@ import (
 	filepath "path/filepath"
 	time "time"
 
+	proto "github.com/golang/protobuf/proto"
 	cobra "github.com/spf13/cobra"
 	pflag "github.com/spf13/pflag"
+	describe "github.com/tetratelabs/protoc-gen-cobra/describe"
+	destructive "github.com/tetratelabs/protoc-gen-cobra/destructive"
+	dryrun "github.com/tetratelabs/protoc-gen-cobra/dryrun"
+	editing "github.com/tetratelabs/protoc-gen-cobra/editing"
+	health "github.com/tetratelabs/protoc-gen-cobra/health"
 	iocodec "github.com/tetratelabs/protoc-gen-cobra/iocodec"
+	loadtest "github.com/tetratelabs/protoc-gen-cobra/loadtest"
+	override "github.com/tetratelabs/protoc-gen-cobra/override"
+	prompting "github.com/tetratelabs/protoc-gen-cobra/prompting"
+	recording "github.com/tetratelabs/protoc-gen-cobra/recording"
+	streaming "github.com/tetratelabs/protoc-gen-cobra/streaming"
+	templating "github.com/tetratelabs/protoc-gen-cobra/templating"
+	tracing "github.com/tetratelabs/protoc-gen-cobra/tracing"
+	validation "github.com/tetratelabs/protoc-gen-cobra/validation"
+	verbose "github.com/tetratelabs/protoc-gen-cobra/verbose"
 	context "golang.org/x/net/context"
 	oauth2 "golang.org/x/oauth2"
 	grpc "google.golang.org/grpc"
 	credentials "google.golang.org/grpc/credentials"
 	oauth "google.golang.org/grpc/credentials/oauth"
+	metadata "google.golang.org/grpc/metadata"
 )
 
 // This is a compile-time assertion to ensure that this generated file
@@ -49,6 +65,17 @@ type _TimerClientCommandConfig struct {
 	AuthTokenType      string
 	JWTKey             string
 	JWTKeyFile         string
+	SkipValidation     bool
+	LoadTest           loadtest.Options
+	Record             string
+	DryRun             bool
+	Verbose            bool
+	Trace              tracing.Options
+	Set                override.Options
+	Template           templating.Options
+	Edit               bool
+	Interactive        bool
+	Destructive        destructive.Options
 }
 
 func _NewTimerClientCommandConfig() *_TimerClientCommandConfig {
@@ -78,6 +105,17 @@ func (o *_TimerClientCommandConfig) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&o.AuthTokenType, "auth-token-type", o.AuthTokenType, "authorization token type")
 	fs.StringVar(&o.JWTKey, "jwt-key", o.JWTKey, "jwt key")
 	fs.StringVar(&o.JWTKeyFile, "jwt-key-file", o.JWTKeyFile, "jwt key file")
+	fs.BoolVar(&o.SkipValidation, "skip-validation", o.SkipValidation, "skip the client-side validation of requests")
+	o.LoadTest.AddFlags(fs)
+	fs.StringVar(&o.Record, "record", o.Record, "record the calls, with their metadata, messages, statuses and timings, to this session file")
+	fs.BoolVar(&o.DryRun, "dry-run", o.DryRun, "print the requests, where they would be sent and the metadata, with secrets redacted, instead of sending them")
+	fs.BoolVarP(&o.Verbose, "verbose", "v", o.Verbose, "log the connection, the tls handshake, and the metadata, message sizes and timings of the calls to stderr, with secrets redacted")
+	o.Trace.AddFlags(fs)
+	o.Set.AddFlags(fs)
+	o.Template.AddFlags(fs)
+	fs.BoolVar(&o.Edit, "edit", o.Edit, "open the request, or a sample of it without one, in $EDITOR before sending it")
+	fs.BoolVar(&o.Interactive, "interactive", o.Interactive, "when stdin is a terminal, prompt for the fields the request doesn't set, and for confirmation before sending it")
+	o.Destructive.AddFlags(fs)
 }
 
 func TimerClientCommand() *cobra.Command {
@@ -89,14 +127,129 @@ func TimerClientCommand() *cobra.Command {
 	for _, s := range _TimerClientSubCommands {
 		cmd.AddCommand(s())
 	}
+	cmd.AddCommand(_TimerReplayCommand())
+	cmd.AddCommand(_TimerHealthCommand())
+	cmd.AddCommand(_TimerDescribeCommand())
+	return cmd
+}
+
+func _TimerReplayCommand() *cobra.Command {
+	var compare bool
+	cmd := &cobra.Command{
+		Use:   "replay [file]",
+		Short: "Replay the calls of a session recorded with --record",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			f, err := os.Open(args[0])
+			if err != nil {
+				log.Fatal(err)
+			}
+			defer f.Close()
+			err = _TimerRoundTrip(nil, nil, func(conn *grpc.ClientConn, cli TimerClient, in iocodec.Decoder, out iocodec.Encoder) error {
+				if _DefaultTimerClientCommandConfig.DryRun {
+					reqs, err := recording.Requests(f)
+					if err != nil {
+						return err
+					}
+					return _TimerDryRun(out, reqs...)
+				}
+				return recording.Replay(conn, f, compare, out, os.Stderr)
+			})
+			if err != nil {
+				log.Fatal(err)
+			}
+		},
+	}
+	cmd.Flags().BoolVar(&compare, "compare", false, "compare the responses and statuses to the recorded ones, field by field, and fail if they differ")
+	return cmd
+}
+
+func _TimerHealthCommand() *cobra.Command {
+	opts := health.Options{Service: "pb.Timer"}
+	cmd := &cobra.Command{
+		Use:     "health",
+		Aliases: []string{"ping"},
+		Short:   "Check the health of pb.Timer with the gRPC health checking protocol, and report how the connection is made",
+		Long:    "Check the health of pb.Timer with the gRPC health checking protocol, and report how the connection is made: its TLS details, the certificate chain of the server and the latency of the check.\n\nExits with 0 if the service is serving, 2 if the connection fails, 3 if the check fails, and 4 if the service isn't serving.",
+		Args:    cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			cfg := _DefaultTimerClientCommandConfig
+			em, err := _TimerEncoderMaker()
+			if err != nil {
+				log.Fatal(err)
+			}
+			if cfg.DryRun {
+				if err := _TimerDryRun(em.NewEncoder(os.Stdout)); err != nil {
+					log.Fatal(err)
+				}
+				return
+			}
+			err = health.Check(opts, cfg.Timeout, func() (*grpc.ClientConn, error) {
+				conn, _, err := _DialTimer()
+				return conn, err
+			}, em.NewEncoder(os.Stdout))
+			if err != nil {
+				log.Print(err)
+				os.Exit(health.ExitCode(err))
+			}
+		},
+	}
+	opts.AddFlags(cmd.Flags())
+	return cmd
+}
+
+func _TimerDescribeCommand() *cobra.Command {
+	var raw bool
+	cmd := &cobra.Command{
+		Use:   "describe [name]",
+		Short: "Print the definition of pb.Timer, or of one of its methods, messages or enums, with its comments",
+		Long:  "Print the definition of pb.Timer, or of one of its methods, messages or enums, with its comments, from the descriptors built into the command.\n\nThe name is a method, as named in the proto file or as its command, or the name of a message or enum, relative to the package of the service or in full. A method is followed by its request and response messages.",
+		Args:  cobra.MaximumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			name := ""
+			if len(args) > 0 {
+				name = args[0]
+			}
+			defs, err := describe.Lookup("pb.Timer", name)
+			if err != nil {
+				log.Fatal(err)
+			}
+			if !raw {
+				if err := describe.Print(os.Stdout, defs); err != nil {
+					log.Fatal(err)
+				}
+				return
+			}
+			em, err := _TimerEncoderMaker()
+			if err != nil {
+				log.Fatal(err)
+			}
+			enc := em.NewEncoder(os.Stdout)
+			for _, d := range defs {
+				if err := enc.Encode(d.Descriptor); err != nil {
+					log.Fatal(err)
+				}
+			}
+		},
+	}
+	cmd.Flags().BoolVar(&raw, "descriptor", false, "print the descriptors of the definitions in the response format instead of proto source")
 	return cmd
 }
 
 func _DialTimer() (*grpc.ClientConn, TimerClient, error) {
 	cfg := _DefaultTimerClientCommandConfig
-	opts := []grpc.DialOption{
-		grpc.WithBlock(),
-		grpc.WithTimeout(cfg.Timeout),
+	var v *verbose.Logger
+	var opts []grpc.DialOption
+	if cfg.Trace.Enabled() {
+		t, err := tracing.Start(cfg.Trace)
+		if err != nil {
+			return nil, nil, fmt.Errorf("trace: %v", err)
+		}
+		opts = append(opts, t.DialOptions()...)
+	}
+	if cfg.Verbose {
+		v = verbose.New(os.Stderr)
+		opts = append(opts, v.DialOptions()...)
 	}
 	if cfg.TLS {
 		tlsConfig := &tls.Config{}
@@ -130,6 +283,9 @@ func _DialTimer() (*grpc.ClientConn, TimerClient, error) {
 		}
 		//tlsConfig.BuildNameToCertificate()
 		cred := credentials.NewTLS(tlsConfig)
+		if v != nil {
+			cred = v.Credentials(cred)
+		}
 		opts = append(opts, grpc.WithTransportCredentials(cred))
 	} else {
 		opts = append(opts, grpc.WithInsecure())
@@ -155,34 +311,69 @@ func _DialTimer() (*grpc.ClientConn, TimerClient, error) {
 		}
 		opts = append(opts, grpc.WithPerRPCCredentials(cred))
 	}
-	conn, err := grpc.Dial(cfg.ServerAddr, opts...)
+	if cfg.Record != "" {
+		rec, err := recording.Open(cfg.Record)
+		if err != nil {
+			return nil, nil, fmt.Errorf("record: %v", err)
+		}
+		opts = append(opts, rec.DialOptions()...)
+	}
+	var conn *grpc.ClientConn
+	var err error
+	if v != nil {
+		conn, err = v.Dial(cfg.ServerAddr, cfg.Timeout, opts...)
+	} else {
+		conn, err = grpc.Dial(cfg.ServerAddr, append(opts, grpc.WithBlock(), grpc.WithTimeout(cfg.Timeout))...)
+	}
 	if err != nil {
 		return nil, nil, err
 	}
 	return conn, NewTimerClient(conn), nil
 }
 
-type _TimerRoundTripFunc func(cli TimerClient, in iocodec.Decoder, out iocodec.Encoder) error
+// _TimerEncoderMaker returns the encoder maker of the response format.
+func _TimerEncoderMaker() (iocodec.EncoderMaker, error) {
+	format := _DefaultTimerClientCommandConfig.ResponseFormat
+	if format == "" {
+		format = "json"
+	}
+	em, ok := iocodec.DefaultEncoders[format]
+	if !ok {
+		return nil, fmt.Errorf("invalid response format: %q", format)
+	}
+	return em, nil
+}
+
+type _TimerRoundTripFunc func(conn *grpc.ClientConn, cli TimerClient, in iocodec.Decoder, out iocodec.Encoder) error
 
-func _TimerRoundTrip(sample interface{}, fn _TimerRoundTripFunc) error {
+// _TimerRoundTrip reads the request with prepare, when set, before it
+// dials the server and calls fn. Commands without a request have no sample.
+func _TimerRoundTrip(sample proto.Message, prepare func(in iocodec.Decoder) error, fn _TimerRoundTripFunc) error {
 	cfg := _DefaultTimerClientCommandConfig
-	var em iocodec.EncoderMaker
-	var ok bool
-	if cfg.ResponseFormat == "" {
-		em = iocodec.DefaultEncoders["json"]
-	} else {
-		em, ok = iocodec.DefaultEncoders[cfg.ResponseFormat]
-		if !ok {
-			return fmt.Errorf("invalid response format: %q", cfg.ResponseFormat)
-		}
+	em, err := _TimerEncoderMaker()
+	if err != nil {
+		return err
 	}
 	if cfg.PrintSampleRequest {
-		return em.NewEncoder(os.Stdout).Encode(sample)
+		if sample == nil {
+			return fmt.Errorf("no sample request")
+		}
+		return em.NewEncoder(os.Stdout).Encode(iocodec.NewSample(sample))
 	}
 	// read the input request, first from stdin, then from a file, otherwise from args only
 	var d iocodec.Decoder
 	if cfg.Stdin || cfg.RequestFile == "-" {
-		d = iocodec.DefaultDecoders["json"].NewDecoder(os.Stdin)
+		if cfg.Edit {
+			// the editor needs the terminal
+			return fmt.Errorf("--edit can't be used with a request read from stdin")
+		}
+		var in io.Reader = os.Stdin
+		if cfg.Template.Stdin {
+			if in, err = cfg.Template.Reader("stdin", os.Stdin); err != nil {
+				return fmt.Errorf("request template: %v", err)
+			}
+		}
+		d = iocodec.DefaultDecoders["json"].NewDecoder(in)
 	} else if cfg.RequestFile != "" {
 		f, err := os.Open(cfg.RequestFile)
 		if err != nil {
@@ -197,20 +388,121 @@ func _TimerRoundTrip(sample interface{}, fn _TimerRoundTripFunc) error {
 		if !ok {
 			return fmt.Errorf("invalid request file format: %q", ext)
 		}
-		d = dm.NewDecoder(f)
+		in, err := cfg.Template.Reader(cfg.RequestFile, f)
+		if err != nil {
+			return fmt.Errorf("request template: %v", err)
+		}
+		d = dm.NewDecoder(in)
 	} else {
 		d = iocodec.DefaultDecoders["noop"].NewDecoder(os.Stdin)
 	}
+	d = cfg.Set.Decoder(d)
+	if prepare != nil {
+		if err := prepare(d); err != nil {
+			return err
+		}
+	}
+	if cfg.DryRun {
+		// fn prints what it would send instead
+		return fn(nil, nil, d, em.NewEncoder(os.Stdout))
+	}
 	conn, client, err := _DialTimer()
 	if err != nil {
 		return err
 	}
 	defer conn.Close()
-	return fn(client, d, em.NewEncoder(os.Stdout))
+	return fn(conn, client, d, em.NewEncoder(os.Stdout))
+}
+
+// _TimerPrompter returns the prompter asking for the fields of requests,
+// or nil unless --interactive is given and stdin is a terminal.
+func _TimerPrompter() *prompting.Prompter {
+	if !_DefaultTimerClientCommandConfig.Interactive || !prompting.IsTerminal(os.Stdin) {
+		return nil
+	}
+	return prompting.New(os.Stdin, os.Stderr)
+}
+
+// _TimerConfirmDestructive asks for confirmation of a call of the
+// destructive method, showing req unless nil. It's called once the server is
+// dialed, so sample requests and dry runs, which send nothing, aren't asked
+// about.
+func _TimerConfirmDestructive(command, method string, req proto.Message) error {
+	em, err := _TimerEncoderMaker()
+	if err != nil {
+		return err
+	}
+	return _DefaultTimerClientCommandConfig.Destructive.Confirm(command, method, req, em)
+}
+
+// _TimerEdit opens the editor on m, or on a sample of it when it's empty,
+// in the format of the request file, or else the response format.
+func _TimerEdit(m proto.Message, check func(proto.Message) error) error {
+	cfg := _DefaultTimerClientCommandConfig
+	format := cfg.ResponseFormat
+	if ext := filepath.Ext(cfg.RequestFile); len(ext) > 1 {
+		format = ext[1:]
+	}
+	var v interface{} = m
+	if proto.Size(m) == 0 {
+		v = iocodec.NewSample(m)
+	}
+	return editing.Edit(format, v, m, check)
+}
+
+// _TimerDryRun prints reqs, along with where and how they would be sent.
+func _TimerDryRun(out iocodec.Encoder, reqs ...proto.Message) error {
+	cfg := _DefaultTimerClientCommandConfig
+	plan := dryrun.Plan{
+		Target:   cfg.ServerAddr,
+		Timeout:  cfg.Timeout,
+		Metadata: metadata.MD{},
+		Requests: reqs,
+	}
+	if cfg.TLS {
+		plan.TLS = &dryrun.TLS{
+			ServerName:         cfg.ServerName,
+			InsecureSkipVerify: cfg.InsecureSkipVerify,
+			CACertFile:         cfg.CACertFile,
+			CertFile:           cfg.CertFile,
+			KeyFile:            cfg.KeyFile,
+		}
+		if plan.TLS.ServerName == "" {
+			plan.TLS.ServerName, _, _ = net.SplitHostPort(cfg.ServerAddr)
+		}
+	}
+	if cfg.AuthToken != "" {
+		plan.Metadata.Append("authorization", dryrun.Redact(cfg.AuthTokenType+" "+cfg.AuthToken))
+	}
+	if cfg.JWTKey != "" || cfg.JWTKeyFile != "" {
+		plan.Metadata.Append("authorization", "Bearer "+dryrun.Redacted)
+	}
+	return dryrun.Print(plan, out)
+}
+
+// _TimerLoadTest makes calls with call, as many and as fast as the load
+// test flags say, over conn and the connections it dials, and prints a report
+// of them.
+func _TimerLoadTest(conn *grpc.ClientConn, call func(ctx context.Context, cli TimerClient) error) error {
+	dial := func() (*grpc.ClientConn, error) {
+		conn, _, err := _DialTimer()
+		return conn, err
+	}
+	return loadtest.Run(_DefaultTimerClientCommandConfig.LoadTest, conn, dial, func(ctx context.Context, conn *grpc.ClientConn) error {
+		return call(ctx, NewTimerClient(conn))
+	}, os.Stdout)
+}
+
+func _TimerValidate(m proto.Message, flags *pflag.FlagSet) error {
+	if _DefaultTimerClientCommandConfig.SkipValidation {
+		return nil
+	}
+	return validation.Validate(m, flags)
 }
 
 func _TimerTickClientCommand() *cobra.Command {
 	reqArgs := &TickRequest{}
+	var streamOpts streaming.Options
 
 	cmd := &cobra.Command{
 		Use:     "tick",
@@ -218,33 +510,72 @@ func _TimerTickClientCommand() *cobra.Command {
 		Example: "TODO: print protobuf method comments here",
 		Run: func(cmd *cobra.Command, args []string) {
 			var v TickRequest
-			err := _TimerRoundTrip(v, func(cli TimerClient, in iocodec.Decoder, out iocodec.Encoder) error {
 
+			prepare := func(in iocodec.Decoder) error {
 				err := in.Decode(&v)
 				if err != nil {
 					return err
 				}
 
-				stream, err := cli.Tick(context.Background(), &v)
+				proto.Merge(&v, reqArgs)
 
+				prompter := _TimerPrompter()
+				if prompter != nil {
+					err = prompter.Fields(&v)
+					if err != nil {
+						return err
+					}
+				}
+				if _DefaultTimerClientCommandConfig.Edit {
+					err = _TimerEdit(&v, func(m proto.Message) error {
+						return _TimerValidate(m, cmd.Flags())
+					})
+				} else {
+					err = _TimerValidate(&v, cmd.Flags())
+				}
+				if err != nil {
+					return err
+				}
+				if prompter == nil || _DefaultTimerClientCommandConfig.DryRun {
+					return nil
+				}
+				em, err := _TimerEncoderMaker()
 				if err != nil {
 					return err
 				}
+				return prompter.Confirm(&v, em)
+			}
 
-				for {
-					v, err := stream.Recv()
-					if err == io.EOF {
-						break
-					}
-					if err != nil {
-						return err
-					}
-					err = out.Encode(v)
+			err := _TimerRoundTrip(&v, prepare, func(conn *grpc.ClientConn, cli TimerClient, in iocodec.Decoder, out iocodec.Encoder) error {
+
+				if _DefaultTimerClientCommandConfig.DryRun {
+					return _TimerDryRun(out, &v)
+				}
+				if _DefaultTimerClientCommandConfig.LoadTest.Enabled() {
+					return _TimerLoadTest(conn, func(ctx context.Context, cli TimerClient) error {
+						stream, err := cli.Tick(ctx, &v)
+						if err != nil {
+							return err
+						}
+						for {
+							_, err := stream.Recv()
+							if err == io.EOF {
+								return nil
+							}
+							if err != nil {
+								return err
+							}
+						}
+					})
+				}
+
+				return streaming.Receive(streamOpts, func(ctx context.Context) (func() (proto.Message, error), error) {
+					stream, err := cli.Tick(ctx, &v)
 					if err != nil {
-						return err
+						return nil, err
 					}
-				}
-				return nil
+					return func() (proto.Message, error) { return stream.Recv() }, nil
+				}, out)
 
 			})
 			if err != nil {
@@ -254,6 +585,7 @@ func _TimerTickClientCommand() *cobra.Command {
 	}
 
 	cmd.PersistentFlags().Int32Var(&reqArgs.Interval, "interval", 0, "get-comment-from-proto")
+	streamOpts.AddFlags(cmd.PersistentFlags())
 
 	return cmd
 }
@@ -261,3 +593,28 @@ func _TimerTickClientCommand() *cobra.Command {
 var _TimerClientSubCommands = []func() *cobra.Command{
 	_TimerTickClientCommand,
 }
+
+func init() { describe.Register(_descriptorSet_Timer_ad0307ee16b652d2) }
+
+var _descriptorSet_Timer_ad0307ee16b652d2 = []byte{
+	// 291 bytes of a gzipped FileDescriptorSet
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0xd1, 0xc1, 0x4e, 0xf3, 0x30,
+	0x0c, 0xc0, 0x71, 0x3b, 0x4e, 0xa2, 0xd4, 0x6d, 0xba, 0x7d, 0xde, 0xa7, 0x31, 0x06, 0x07, 0xb4,
+	0x0b, 0x20, 0x44, 0x85, 0x80, 0x13, 0xe2, 0x09, 0x38, 0x4e, 0x7b, 0x01, 0x86, 0x7a, 0x98, 0x80,
+	0xad, 0x6c, 0x85, 0x57, 0xe1, 0x55, 0x78, 0x3c, 0xe4, 0x4c, 0x01, 0x6e, 0xf9, 0x57, 0xf6, 0xcf,
+	0x87, 0xf2, 0x17, 0x71, 0xd9, 0xaf, 0x5e, 0xdb, 0x6d, 0xd3, 0x6d, 0x37, 0xfd, 0x46, 0x4c, 0xb7,
+	0x9c, 0x9d, 0x73, 0xb9, 0x58, 0x3d, 0x3d, 0xcf, 0xdb, 0xb7, 0xf7, 0x76, 0xd7, 0xcb, 0x94, 0xc3,
+	0x6a, 0xdd, 0xb7, 0xdb, 0x8f, 0xc7, 0x97, 0x09, 0x9e, 0xe0, 0x99, 0x9b, 0xff, 0xf4, 0x6c, 0xc6,
+	0xd5, 0x7e, 0x74, 0xd7, 0x6d, 0xd6, 0xbb, 0x56, 0x84, 0xad, 0x6a, 0x69, 0xae, 0x98, 0xa7, 0xf7,
+	0xf5, 0x2d, 0xbb, 0x85, 0x5e, 0x90, 0x0b, 0xb6, 0x3a, 0x2c, 0x83, 0xa6, 0x5b, 0x36, 0x7f, 0x2e,
+	0x4c, 0x87, 0xbf, 0x1f, 0xf6, 0xce, 0x15, 0x3e, 0x7c, 0x1a, 0xf6, 0x62, 0x01, 0x6a, 0xe4, 0xc0,
+	0x58, 0x09, 0x01, 0x88, 0xbe, 0x8c, 0x90, 0x81, 0x92, 0x99, 0x8d, 0x07, 0xb1, 0x16, 0x3c, 0x32,
+	0x33, 0x79, 0x40, 0x21, 0x1b, 0x22, 0x97, 0x6c, 0x3d, 0x18, 0x10, 0x72, 0xe1, 0x9e, 0x2b, 0x76,
+	0x1a, 0x28, 0xe4, 0xaa, 0x61, 0x2e, 0x23, 0xe4, 0xfe, 0x1d, 0xe7, 0xf2, 0x42, 0xee, 0xf4, 0x32,
+	0x17, 0x09, 0xb9, 0xe6, 0x4e, 0x71, 0x0b, 0x62, 0x03, 0x70, 0xc2, 0xad, 0x0a, 0x21, 0x8c, 0x14,
+	0xb7, 0x09, 0x2f, 0xc2, 0x91, 0xae, 0x68, 0x38, 0xad, 0x98, 0x0b, 0x85, 0x8a, 0x7a, 0x9c, 0x8b,
+	0x84, 0x8a, 0xc3, 0x69, 0xe2, 0x50, 0x6c, 0x05, 0xf5, 0x9e, 0x43, 0x14, 0xaa, 0xc2, 0xff, 0xc4,
+	0xa1, 0x72, 0x31, 0x4c, 0xd2, 0x0a, 0x26, 0x2e, 0x86, 0x3a, 0x17, 0x0a, 0xc5, 0xc1, 0x28, 0x17,
+	0x09, 0xc5, 0xf1, 0xc1, 0xd2, 0xa7, 0x3f, 0x75, 0xf3, 0x3d, 0x00, 0xfe, 0xa8, 0x19, 0xdc, 0xbb,
+	0x01, 0x00, 0x00,
+}
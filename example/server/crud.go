@@ -1,6 +1,7 @@
 package main
 
 import (
+	"sort"
 	"sync"
 
 	"google.golang.org/grpc/status"
@@ -45,3 +46,30 @@ func (c *CRUD) Delete(_ context.Context, req *pb.CRUDObject) (*pb.Empty, error)
 	c.kv.Delete(req.Name)
 	return &pb.Empty{}, nil
 }
+
+// List returns the objects in name order, after the page token, which is the
+// name of the last object of the previous page.
+func (c *CRUD) List(_ context.Context, req *pb.ListCRUD) (*pb.ListCRUDResponse, error) {
+	size := int(req.PageSize)
+	if size <= 0 {
+		size = 10
+	}
+	var names []string
+	c.kv.Range(func(k, _ interface{}) bool {
+		if name := k.(string); name > req.PageToken {
+			names = append(names, name)
+		}
+		return true
+	})
+	sort.Strings(names)
+	resp := &pb.ListCRUDResponse{}
+	for i, name := range names {
+		if i == size {
+			resp.NextPageToken = names[i-1]
+			break
+		}
+		val, _ := c.kv.Load(name)
+		resp.Objects = append(resp.Objects, &pb.CRUDObject{Name: name, Value: val.(string)})
+	}
+	return resp, nil
+}
@@ -5,6 +5,8 @@ import (
 	"net"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 
 	"github.com/tetratelabs/protoc-gen-cobra/example/pb"
 )
@@ -21,6 +23,11 @@ func main() {
 	pb.RegisterTimerServer(srv, NewTimer())
 	pb.RegisterCRUDServer(srv, NewCRUD())
 	pb.RegisterNestedMessagesServer(srv, NestedMessage{})
+	hs := health.NewServer()
+	for name := range srv.GetServiceInfo() {
+		hs.SetServingStatus(name, healthpb.HealthCheckResponse_SERVING)
+	}
+	healthpb.RegisterHealthServer(srv, hs)
 	err = srv.Serve(ln)
 	if err != nil {
 		log.Fatal(err)
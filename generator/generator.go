@@ -31,6 +31,8 @@ import (
 
 	"github.com/golang/protobuf/protoc-gen-go/descriptor"
 	plugin "github.com/golang/protobuf/protoc-gen-go/plugin"
+
+	"github.com/tetratelabs/protoc-gen-cobra/options"
 )
 
 // generatedCodeVersion indicates a version of the generated code.
@@ -98,6 +100,9 @@ type Descriptor struct {
 	group    bool
 }
 
+// Path returns the SourceCodeInfo path of the message as comma-separated integers.
+func (d *Descriptor) Path() string { return d.path }
+
 // TypeName returns the elements of the dotted type name.
 // The package name is not part of this name.
 func (d *Descriptor) TypeName() []string {
@@ -541,6 +546,13 @@ func (g *Generator) SetPackageNames() {
 		"proto": RegisterUniquePackageName("proto", nil),
 	}
 
+	// Initialize the plugins, so the names of the packages they import take
+	// precedence over the names of the proto packages we depend on, which
+	// the generated code doesn't refer to.
+	for _, p := range plugins {
+		p.Init(g)
+	}
+
 AllFiles:
 	for _, f := range g.allFiles {
 		for _, genf := range g.genFiles {
@@ -597,6 +609,36 @@ func (g *Generator) WrapTypes() {
 	}
 }
 
+// BuildTypeNameMap builds the map from fully qualified type names to objects.
+// The key names for the map come from the input data, which puts a period at the beginning.
+// It should be called after SetPackageNames and before GenerateAllFiles.
+func (g *Generator) BuildTypeNameMap() {
+	g.typeNameToObject = make(map[string]Object)
+	for _, f := range g.allFiles {
+		// The names in this loop are defined by the proto world, not us, so the
+		// package name may be empty.  If so, the dotted package name of X will
+		// be ".X"; otherwise it will be ".pkg.X".
+		dottedPkg := "." + f.GetPackage()
+		if dottedPkg != "." {
+			dottedPkg += "."
+		}
+		for _, desc := range f.desc {
+			name := dottedPkg + dottedSlice(desc.TypeName())
+			g.typeNameToObject[name] = desc
+		}
+	}
+}
+
+// ObjectNamed, given a fully-qualified input type name as it appears in the input data,
+// returns the descriptor for the message with that name.
+func (g *Generator) ObjectNamed(typeName string) Object {
+	o, ok := g.typeNameToObject[typeName]
+	if !ok {
+		g.Fail("can't find object with type", typeName)
+	}
+	return o
+}
+
 // Scan the descriptors in this file.  For each one, build the slice of nested descriptors
 func (g *Generator) buildNestedDescriptors(descs []*Descriptor) {
 	for _, desc := range descs {
@@ -761,10 +803,6 @@ func (g *Generator) Out() {
 
 // GenerateAllFiles generates the output for all the files we're outputting.
 func (g *Generator) GenerateAllFiles() {
-	// Initialize the plugins
-	for _, p := range plugins {
-		p.Init(g)
-	}
 	// Generate the output. The generator runs for every file, even the files
 	// that we don't generate output for, so that we can collate the full list
 	// of exported symbols to support public imports.
@@ -804,6 +842,19 @@ func (g *Generator) FileOf(fd *descriptor.FileDescriptorProto) *FileDescriptor {
 	return nil
 }
 
+// AddFile adds a file with the given name and content to the response, in the
+// directory of the Go output of file. It does nothing for files we're not
+// generating output for.
+func (g *Generator) AddFile(file *FileDescriptor, name, content string) {
+	if !g.writeOutput {
+		return
+	}
+	g.Response.File = append(g.Response.File, &plugin.CodeGeneratorResponse_File{
+		Name:    proto.String(path.Join(path.Dir(file.goFileName(g.pathType)), name)),
+		Content: proto.String(content),
+	})
+}
+
 // Fill the response protocol buffer with the generated output for all the files we're
 // supposed to generate.
 func (g *Generator) generate(file *FileDescriptor) {
@@ -908,6 +959,9 @@ func (g *Generator) generateHeader() {
 						}
 					}
 					for _, service := range f.Service {
+						if skipped(service) {
+							continue
+						}
 						origServName := service.GetName()
 						servName := CamelCase(origServName)
 						cmds = append(cmds, servName+"ClientCommand")
@@ -930,6 +984,32 @@ func (g *Generator) generateHeader() {
 	g.P()
 }
 
+// skipped reports whether the (cobra.service) options of service skip its command.
+func skipped(service *descriptor.ServiceDescriptorProto) bool {
+	if service.GetOptions() == nil || !proto.HasExtension(service.GetOptions(), options.E_Service) {
+		return false
+	}
+	opts, err := proto.GetExtension(service.GetOptions(), options.E_Service)
+	return err == nil && opts.(*options.CommandOptions).GetSkip()
+}
+
+// Comments returns any comments from the source .proto file and empty string if comments not found.
+// The path is a comma-separated list of integers.
+// See descriptor.proto for its format.
+func (g *Generator) Comments(path string) string {
+	return g.file.Comments(path)
+}
+
+// Comments returns the leading comments of the element at path in this file,
+// or the empty string if it has none.
+func (d *FileDescriptor) Comments(path string) string {
+	loc, ok := d.comments[path]
+	if !ok {
+		return ""
+	}
+	return strings.TrimSuffix(loc.GetLeadingComments(), "\n")
+}
+
 // PrintComments prints any comments from the source .proto file.
 // The path is a comma-separated list of integers.
 // It returns an indication of whether any comments were printed.
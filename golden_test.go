@@ -45,7 +45,7 @@ func TestGolden(t *testing.T) {
 
 	// Compile each package, using this binary as protoc-gen-cobra.
 	for _, sources := range packages {
-		args := []string{"-Itestdata", "--cobra_out=plugins=client,paths=source_relative:" + workdir}
+		args := []string{"-Itestdata", "-Ithird_party", "-I.", "--cobra_out=plugins=client,paths=source_relative,schema=true:" + workdir}
 		args = append(args, sources...)
 		t.Log(args)
 		protoc(t, args)
@@ -0,0 +1,209 @@
+// Package health checks the health of the servers of generated commands with
+// the gRPC health checking protocol, and reports how the connection to them
+// is made: its TLS details, the certificate chain of the server, and the
+// round-trip latency of the check.
+//
+// Its exit codes, those of grpc_health_probe, suit readiness scripts.
+package health
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/spf13/pflag"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/tetratelabs/protoc-gen-cobra/iocodec"
+	"github.com/tetratelabs/protoc-gen-cobra/verbose"
+)
+
+// The exit codes of the checks.
+const (
+	ExitServing           = 0
+	ExitFailure           = 1 // such as invalid options
+	ExitConnectionFailure = 2
+	ExitRPCFailure        = 3
+	ExitUnhealthy         = 4
+)
+
+// An Error is a failed check, and the code the command exits with.
+type Error struct {
+	Code int
+	Err  error
+}
+
+func (e *Error) Error() string {
+	return e.Err.Error()
+}
+
+// ExitCode returns the code to exit with after a check failing with err.
+func ExitCode(err error) int {
+	if err == nil {
+		return ExitServing
+	}
+	if e, ok := err.(*Error); ok {
+		return e.Code
+	}
+	return ExitFailure
+}
+
+// Options choose the service to check, and whether to watch its health.
+type Options struct {
+	Service string
+	Watch   bool
+}
+
+// AddFlags adds the flags setting the options to fs.
+func (o *Options) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&o.Service, "service", o.Service, "name of the service to check; empty for the server as a whole")
+	fs.BoolVar(&o.Watch, "watch", o.Watch, "after the check, report every change of the health of the service until the server ends the watch")
+}
+
+// Check connects to the server with dial, checks the health of o.Service
+// within timeout, and writes a report of the connection and the check to
+// out. With o.Watch, it then writes the health of the service every time it
+// changes. It fails with an *Error unless the service is serving.
+func Check(o Options, timeout time.Duration, dial func() (*grpc.ClientConn, error), out iocodec.Encoder) error {
+	report := map[string]interface{}{"service": o.Service}
+	t := time.Now()
+	conn, err := dial()
+	report["connectTime"] = duration(time.Since(t))
+	if err != nil {
+		report["error"] = map[string]string{"message": err.Error()}
+		return fail(out, report, ExitConnectionFailure, fmt.Errorf("health: connecting: %v", err))
+	}
+	defer conn.Close()
+
+	ctx := context.Background()
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	cli := healthpb.NewHealthClient(conn)
+	var p peer.Peer
+	t = time.Now()
+	resp, err := cli.Check(ctx, &healthpb.HealthCheckRequest{Service: o.Service}, grpc.Peer(&p))
+	report["latency"] = duration(time.Since(t))
+	if p.Addr != nil {
+		report["address"] = p.Addr.String()
+	}
+	report["tls"] = tlsReport(p.AuthInfo)
+	if err != nil {
+		st := status.Convert(err)
+		report["error"] = map[string]string{"code": st.Code().String(), "message": st.Message()}
+		switch st.Code() {
+		case codes.Unimplemented:
+			err = errors.New("health: the server doesn't implement grpc.health.v1.Health")
+		case codes.NotFound:
+			err = fmt.Errorf("health: the server doesn't know service %q", o.Service)
+		default:
+			err = fmt.Errorf("health: %v", err)
+		}
+		return fail(out, report, ExitRPCFailure, err)
+	}
+	report["status"] = resp.GetStatus().String()
+	if err := encode(out, report); err != nil {
+		return err
+	}
+	if o.Watch {
+		return watch(cli, o.Service, out)
+	}
+	return serving(o.Service, resp.GetStatus())
+}
+
+// watch writes the health of service every time it changes, until the
+// server ends the watch, and fails unless the service is then serving.
+func watch(cli healthpb.HealthClient, service string, out iocodec.Encoder) error {
+	stream, err := cli.Watch(context.Background(), &healthpb.HealthCheckRequest{Service: service})
+	if err != nil {
+		return &Error{ExitRPCFailure, fmt.Errorf("health: watch: %v", err)}
+	}
+	last := healthpb.HealthCheckResponse_UNKNOWN
+	for {
+		resp, err := stream.Recv()
+		if err == io.EOF {
+			return serving(service, last)
+		}
+		if err != nil {
+			return &Error{ExitRPCFailure, fmt.Errorf("health: watch: %v", err)}
+		}
+		last = resp.GetStatus()
+		err = encode(out, map[string]interface{}{
+			"service": service,
+			"status":  last.String(),
+			"time":    time.Now().Format(time.RFC3339Nano),
+		})
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func serving(service string, s healthpb.HealthCheckResponse_ServingStatus) error {
+	if s != healthpb.HealthCheckResponse_SERVING {
+		return &Error{ExitUnhealthy, fmt.Errorf("health: service %q is %v", service, s)}
+	}
+	return nil
+}
+
+// tlsReport returns the report of the TLS connection of auth.
+func tlsReport(auth credentials.AuthInfo) map[string]interface{} {
+	info, ok := auth.(credentials.TLSInfo)
+	if !ok {
+		return map[string]interface{}{"enabled": false}
+	}
+	s := info.State
+	var certs []map[string]interface{}
+	for _, c := range s.PeerCertificates {
+		left := time.Until(c.NotAfter)
+		certs = append(certs, map[string]interface{}{
+			"subject":   c.Subject.String(),
+			"issuer":    c.Issuer.String(),
+			"dnsNames":  c.DNSNames,
+			"notBefore": c.NotBefore.UTC().Format(time.RFC3339),
+			"notAfter":  c.NotAfter.UTC().Format(time.RFC3339),
+			"daysLeft":  int(left.Hours() / 24),
+			"expired":   left < 0,
+		})
+	}
+	return map[string]interface{}{
+		"enabled":      true,
+		"version":      verbose.TLSVersion(s.Version),
+		"cipherSuite":  verbose.CipherSuite(s.CipherSuite),
+		"alpn":         s.NegotiatedProtocol,
+		"serverName":   s.ServerName,
+		"verified":     len(s.VerifiedChains) > 0,
+		"certificates": certs,
+	}
+}
+
+func duration(d time.Duration) string {
+	return d.Round(time.Microsecond).String()
+}
+
+// fail writes report to out, and returns err with the exit code.
+func fail(out iocodec.Encoder, report map[string]interface{}, code int, err error) error {
+	if encErr := encode(out, report); encErr != nil {
+		return encErr
+	}
+	return &Error{code, err}
+}
+
+// encode writes report to out, as a Struct, so every response format can
+// encode it.
+func encode(out iocodec.Encoder, report map[string]interface{}) error {
+	s, err := iocodec.Struct(report)
+	if err != nil {
+		return err
+	}
+	return out.Encode(s)
+}
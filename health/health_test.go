@@ -0,0 +1,156 @@
+package health
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"errors"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/tetratelabs/protoc-gen-cobra/iocodec"
+)
+
+// serve serves the health of srv in process, unless it's nil, and returns a
+// function dialing it and another one stopping it.
+func serve(srv *health.Server) (func() (*grpc.ClientConn, error), func()) {
+	lis := bufconn.Listen(1 << 20)
+	s := grpc.NewServer()
+	if srv != nil {
+		healthpb.RegisterHealthServer(s, srv)
+	}
+	go s.Serve(lis)
+	return func() (*grpc.ClientConn, error) {
+		return grpc.Dial("bufnet", grpc.WithInsecure(), grpc.WithBlock(), grpc.WithDialer(func(string, time.Duration) (net.Conn, error) {
+			return lis.Dial()
+		}))
+	}, s.Stop
+}
+
+func TestCheck(t *testing.T) {
+	srv := health.NewServer()
+	srv.SetServingStatus("pb.Bank", healthpb.HealthCheckResponse_SERVING)
+	srv.SetServingStatus("pb.Cache", healthpb.HealthCheckResponse_NOT_SERVING)
+	dial, stop := serve(srv)
+	defer stop()
+	noHealth, stopNoHealth := serve(nil)
+	defer stopNoHealth()
+	unreachable := func() (*grpc.ClientConn, error) { return nil, errors.New("connection refused") }
+
+	for _, tc := range []struct {
+		service string
+		dial    func() (*grpc.ClientConn, error)
+		code    int
+		report  string
+	}{
+		{"pb.Bank", dial, ExitServing, `"status":"SERVING"`},
+		{"", dial, ExitServing, `"status":"SERVING"`},
+		{"pb.Cache", dial, ExitUnhealthy, `"status":"NOT_SERVING"`},
+		{"pb.Timer", dial, ExitRPCFailure, `"error":{"code":"NotFound","message":"unknown service"}`},
+		{"pb.Bank", noHealth, ExitRPCFailure, `"error":{"code":"Unimplemented"`},
+		{"pb.Bank", unreachable, ExitConnectionFailure, `"error":{"message":"connection refused"}`},
+	} {
+		var out bytes.Buffer
+		err := Check(Options{Service: tc.service}, time.Second, tc.dial, iocodec.DefaultEncoders["json"].NewEncoder(&out))
+		if code := ExitCode(err); code != tc.code {
+			t.Errorf("%s: got exit code %d (%v), want %d", tc.service, code, err, tc.code)
+		}
+		if !strings.Contains(out.String(), tc.report) {
+			t.Errorf("%s: got report %s, want it to contain %s", tc.service, out.String(), tc.report)
+		}
+		if tc.code != ExitConnectionFailure && !strings.Contains(out.String(), `"tls":{"enabled":false}`) {
+			t.Errorf("%s: got report %s, want no TLS", tc.service, out.String())
+		}
+	}
+}
+
+// syncBuffer is a buffer the test reads while the watch writes to it.
+type syncBuffer struct {
+	mu sync.Mutex
+	b  bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.b.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.b.String()
+}
+
+func TestWatch(t *testing.T) {
+	srv := health.NewServer()
+	srv.SetServingStatus("pb.Bank", healthpb.HealthCheckResponse_SERVING)
+	dial, stop := serve(srv)
+	var out syncBuffer
+	done := make(chan error)
+	go func() {
+		done <- Check(Options{Service: "pb.Bank", Watch: true}, time.Second, dial, iocodec.DefaultEncoders["json"].NewEncoder(&out))
+	}()
+	for !strings.Contains(out.String(), `"status":"SERVING","time"`) {
+		time.Sleep(time.Millisecond)
+	}
+	srv.SetServingStatus("pb.Bank", healthpb.HealthCheckResponse_NOT_SERVING)
+	for !strings.Contains(out.String(), `"status":"NOT_SERVING"`) {
+		time.Sleep(time.Millisecond)
+	}
+	stop()
+	if err := <-done; ExitCode(err) != ExitRPCFailure {
+		t.Errorf("got %v, want an RPC failure once the server stops", err)
+	}
+	if n := strings.Count(out.String(), "\n"); n != 3 {
+		t.Errorf("got %d reports, want the check and 2 changes:\n%s", n, out.String())
+	}
+}
+
+func TestTLSReport(t *testing.T) {
+	cert := &x509.Certificate{
+		Subject:   pkix.Name{CommonName: "example.com"},
+		Issuer:    pkix.Name{CommonName: "Test CA"},
+		DNSNames:  []string{"example.com"},
+		NotBefore: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+		NotAfter:  time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+	report := tlsReport(credentials.TLSInfo{State: tls.ConnectionState{
+		Version:            tls.VersionTLS12,
+		CipherSuite:        tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256,
+		NegotiatedProtocol: "h2",
+		ServerName:         "example.com",
+		PeerCertificates:   []*x509.Certificate{cert},
+	}})
+	b, err := json.Marshal(report)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := string(b)
+	for _, want := range []string{
+		`"alpn":"h2"`,
+		`"cipherSuite":"TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256"`,
+		`"version":"TLS 1.2"`,
+		`"serverName":"example.com"`,
+		`"verified":false`,
+		`"subject":"CN=example.com"`,
+		`"issuer":"CN=Test CA"`,
+		`"notAfter":"2021-01-01T00:00:00Z"`,
+		`"expired":true`,
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("got %s, want it to contain %s", got, want)
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package iocodec
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+)
+
+// CSV documents hold one message per row. The first row names the field of
+// each column, by its JSON or proto name, with dots separating the fields of
+// nested messages (e.g. "address.city"). Empty cells leave their fields unset,
+// and a cell of a repeated field holds a single value.
+
+type csvDecoder struct {
+	r      *csv.Reader
+	header [][]string
+}
+
+func newCSVDecoder(r io.Reader) Decoder {
+	cr := csv.NewReader(r)
+	cr.TrimLeadingSpace = true
+	return &csvDecoder{r: cr}
+}
+
+func (cd *csvDecoder) Decode(v interface{}) error {
+	m, ok := v.(proto.Message)
+	if !ok {
+		return fmt.Errorf("csv: cannot decode into %T, only into messages", v)
+	}
+	if cd.header == nil {
+		names, err := cd.r.Read()
+		if err != nil {
+			return err
+		}
+		for _, name := range names {
+			cd.header = append(cd.header, strings.Split(strings.TrimSpace(name), "."))
+		}
+	}
+	row, err := cd.r.Read()
+	if err != nil {
+		return err
+	}
+	doc := map[string]interface{}{}
+	for i, cell := range row {
+		if cell == "" {
+			continue
+		}
+		if err := setPath(doc, cd.header[i], cell); err != nil {
+			return fmt.Errorf("csv: column %d: %v", i+1, err)
+		}
+	}
+	tree, err := coerceMessage(doc, reflect.TypeOf(m))
+	if err != nil {
+		return err
+	}
+	return unmarshalTree(tree, m)
+}
+
+// setPath sets the value at path in doc, creating the nested mappings on the
+// way.
+func setPath(doc map[string]interface{}, path []string, value string) error {
+	for _, name := range path[:len(path)-1] {
+		next, ok := doc[name].(map[string]interface{})
+		if !ok {
+			if _, set := doc[name]; set {
+				return fmt.Errorf("%s is both a value and a message", name)
+			}
+			next = map[string]interface{}{}
+			doc[name] = next
+		}
+		doc = next
+	}
+	name := path[len(path)-1]
+	if _, set := doc[name]; set {
+		return fmt.Errorf("%s is set twice", strings.Join(path, "."))
+	}
+	doc[name] = value
+	return nil
+}
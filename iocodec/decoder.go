@@ -4,16 +4,18 @@ import (
 	"encoding/json"
 	"encoding/xml"
 	"io"
-	"io/ioutil"
+	"reflect"
 
+	"github.com/golang/protobuf/proto"
 	"gopkg.in/yaml.v2"
 )
 
 // DefaultDecoders contains the default list of decoders per MIME type.
 var DefaultDecoders = DecoderGroup{
-	"xml":  DecoderMakerFunc(func(r io.Reader) Decoder { return xml.NewDecoder(r) }),
-	"json": DecoderMakerFunc(func(r io.Reader) Decoder { return json.NewDecoder(r) }),
-	"yaml": DecoderMakerFunc(func(r io.Reader) Decoder { return &yamlDecoder{r} }),
+	"xml":  DecoderMakerFunc(func(r io.Reader) Decoder { return &xmlDecoder{xml.NewDecoder(r)} }),
+	"json": DecoderMakerFunc(func(r io.Reader) Decoder { return &jsonDecoder{json.NewDecoder(r)} }),
+	"yaml": DecoderMakerFunc(func(r io.Reader) Decoder { return &yamlDecoder{yaml.NewDecoder(r)} }),
+	"csv":  DecoderMakerFunc(newCSVDecoder),
 	"noop": DecoderMakerFunc(func(r io.Reader) Decoder { return noop{} }),
 }
 
@@ -43,16 +45,59 @@ func (f DecoderMakerFunc) NewDecoder(r io.Reader) Decoder {
 	return f(r)
 }
 
+type xmlDecoder struct {
+	d *xml.Decoder
+}
+
+func (xd *xmlDecoder) Decode(v interface{}) error {
+	m, ok := v.(proto.Message)
+	if !ok {
+		return xd.d.Decode(v)
+	}
+	n, err := readXMLNode(xd.d)
+	if err != nil {
+		return err
+	}
+	t := reflect.TypeOf(m)
+	tree, err := xmlMessage(n, t)
+	if err != nil {
+		return err
+	}
+	if tree, err = coerceMessage(tree, t); err != nil {
+		return err
+	}
+	return unmarshalTree(tree, m)
+}
+
+type jsonDecoder struct {
+	d *json.Decoder
+}
+
+func (jd *jsonDecoder) Decode(v interface{}) error {
+	if m, ok := v.(proto.Message); ok {
+		return Unmarshaler().UnmarshalNext(jd.d, m)
+	}
+	return jd.d.Decode(v)
+}
+
 type yamlDecoder struct {
-	r io.Reader
+	d *yaml.Decoder
 }
 
 func (yd *yamlDecoder) Decode(v interface{}) error {
-	b, err := ioutil.ReadAll(yd.r)
+	m, ok := v.(proto.Message)
+	if !ok {
+		return yd.d.Decode(v)
+	}
+	var doc interface{}
+	if err := yd.d.Decode(&doc); err != nil {
+		return err
+	}
+	tree, err := coerceMessage(doc, reflect.TypeOf(m))
 	if err != nil {
 		return err
 	}
-	return yaml.Unmarshal(b, v)
+	return unmarshalTree(tree, m)
 }
 
 func (noop) Decode(v interface{}) error {
@@ -1,2 +1,8 @@
 // Package iocodec provides multiple input decoders and output encoders.
+//
+// Protocol buffer messages are encoded and decoded following the proto3 JSON
+// mapping in every format, so field names, maps, oneofs and well-known types
+// are represented the same way in JSON, YAML and XML. Messages can also be
+// decoded from CSV rows. Other values fall back to the standard library and
+// yaml.v2 codecs.
 package iocodec
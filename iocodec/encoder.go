@@ -6,6 +6,7 @@ import (
 	"encoding/xml"
 	"io"
 
+	"github.com/golang/protobuf/proto"
 	"gopkg.in/yaml.v2"
 )
 
@@ -50,6 +51,12 @@ func (xe *xmlEncoder) Encode(v interface{}) error {
 	defer xe.w.Write([]byte("\n"))
 	e := xml.NewEncoder(xe.w)
 	e.Indent("", "\t")
+	switch t := v.(type) {
+	case *Sample:
+		return t.encodeXML(e)
+	case proto.Message:
+		return encodeXML(e, t)
+	}
 	return e.Encode(v)
 }
 
@@ -59,6 +66,31 @@ type jsonEncoder struct {
 }
 
 func (je *jsonEncoder) Encode(v interface{}) error {
+	if s, ok := v.(*Sample); ok {
+		v = s.tree()
+	}
+	if m, ok := v.(proto.Message); ok {
+		if !je.pretty {
+			if err := Marshaler().Marshal(je.w, m); err != nil {
+				return err
+			}
+			_, err := je.w.Write([]byte("\n"))
+			return err
+		}
+		// jsonpb indents the values of Struct fields twice, so the
+		// output is indented afterwards
+		var b bytes.Buffer
+		if err := Marshaler().Marshal(&b, m); err != nil {
+			return err
+		}
+		var out bytes.Buffer
+		if err := json.Indent(&out, b.Bytes(), "", "\t"); err != nil {
+			return err
+		}
+		out.WriteByte('\n')
+		_, err := io.Copy(je.w, &out)
+		return err
+	}
 	if je.pretty {
 		b, err := json.Marshal(v)
 		if err != nil {
@@ -84,6 +116,21 @@ type yamlEncoder struct {
 }
 
 func (ye *yamlEncoder) Encode(v interface{}) error {
+	if s, ok := v.(*Sample); ok {
+		b, err := s.encodeYAML()
+		if err != nil {
+			return err
+		}
+		_, err = ye.w.Write(b)
+		return err
+	}
+	if m, ok := v.(proto.Message); ok {
+		tree, err := marshalTree(m)
+		if err != nil {
+			return err
+		}
+		v = yamlTree(tree)
+	}
 	b, err := yaml.Marshal(v)
 	if err != nil {
 		return err
@@ -91,3 +138,31 @@ func (ye *yamlEncoder) Encode(v interface{}) error {
 	_, err = ye.w.Write(b)
 	return err
 }
+
+// yamlTree converts an ordered JSON tree into values yaml.Marshal renders in
+// the same order, keeping numbers unquoted.
+func yamlTree(v interface{}) interface{} {
+	switch t := v.(type) {
+	case object:
+		ms := make(yaml.MapSlice, len(t))
+		for i, m := range t {
+			ms[i] = yaml.MapItem{Key: m.Name, Value: yamlTree(m.Value)}
+		}
+		return ms
+	case []interface{}:
+		l := make([]interface{}, len(t))
+		for i, item := range t {
+			l[i] = yamlTree(item)
+		}
+		return l
+	case json.Number:
+		if i, err := t.Int64(); err == nil {
+			return i
+		}
+		if f, err := t.Float64(); err == nil {
+			return f
+		}
+		return string(t)
+	}
+	return v
+}
@@ -0,0 +1,454 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: iocodec/internal/testpb/test.proto
+
+package testpb
+
+import (
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	any "github.com/golang/protobuf/ptypes/any"
+	duration "github.com/golang/protobuf/ptypes/duration"
+	_struct "github.com/golang/protobuf/ptypes/struct"
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
+	wrappers "github.com/golang/protobuf/ptypes/wrappers"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type Kitchen_Color int32
+
+const (
+	Kitchen_COLOR_UNSPECIFIED Kitchen_Color = 0
+	Kitchen_RED               Kitchen_Color = 1
+	Kitchen_GREEN             Kitchen_Color = 2
+)
+
+var Kitchen_Color_name = map[int32]string{
+	0: "COLOR_UNSPECIFIED",
+	1: "RED",
+	2: "GREEN",
+}
+
+var Kitchen_Color_value = map[string]int32{
+	"COLOR_UNSPECIFIED": 0,
+	"RED":               1,
+	"GREEN":             2,
+}
+
+func (x Kitchen_Color) String() string {
+	return proto.EnumName(Kitchen_Color_name, int32(x))
+}
+
+func (Kitchen_Color) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_d0b4bc5ba20a1ca0, []int{0, 0}
+}
+
+type Item_Color int32
+
+const (
+	Item_COLOR_UNSPECIFIED Item_Color = 0
+	Item_BLUE              Item_Color = 1
+)
+
+var Item_Color_name = map[int32]string{
+	0: "COLOR_UNSPECIFIED",
+	1: "BLUE",
+}
+
+var Item_Color_value = map[string]int32{
+	"COLOR_UNSPECIFIED": 0,
+	"BLUE":              1,
+}
+
+func (x Item_Color) String() string {
+	return proto.EnumName(Item_Color_name, int32(x))
+}
+
+func (Item_Color) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_d0b4bc5ba20a1ca0, []int{1, 0}
+}
+
+// Kitchen exercises every field shape the codecs need to handle.
+type Kitchen struct {
+	Name      string            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Count     int32             `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Big       int64             `protobuf:"varint,3,opt,name=big,proto3" json:"big,omitempty"`
+	Ratio     float64           `protobuf:"fixed64,4,opt,name=ratio,proto3" json:"ratio,omitempty"`
+	Enabled   bool              `protobuf:"varint,5,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Blob      []byte            `protobuf:"bytes,6,opt,name=blob,proto3" json:"blob,omitempty"`
+	Color     Kitchen_Color     `protobuf:"varint,7,opt,name=color,proto3,enum=testpb.Kitchen_Color" json:"color,omitempty"`
+	Tags      []string          `protobuf:"bytes,8,rep,name=tags,proto3" json:"tags,omitempty"`
+	Labels    map[string]string `protobuf:"bytes,9,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	ItemsById map[int32]*Item   `protobuf:"bytes,10,rep,name=items_by_id,json=itemsById,proto3" json:"items_by_id,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Item      *Item             `protobuf:"bytes,11,opt,name=item,proto3" json:"item,omitempty"`
+	Items     []*Item           `protobuf:"bytes,12,rep,name=items,proto3" json:"items,omitempty"`
+	// Types that are valid to be assigned to Choice:
+	//	*Kitchen_Text
+	//	*Kitchen_ChoiceItem
+	Choice               isKitchen_Choice      `protobuf_oneof:"choice"`
+	CreatedAt            *timestamp.Timestamp  `protobuf:"bytes,15,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Ttl                  *duration.Duration    `protobuf:"bytes,16,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	Nickname             *wrappers.StringValue `protobuf:"bytes,17,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	Extra                *_struct.Struct       `protobuf:"bytes,18,opt,name=extra,proto3" json:"extra,omitempty"`
+	Detail               *any.Any              `protobuf:"bytes,19,opt,name=detail,proto3" json:"detail,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *Kitchen) Reset()         { *m = Kitchen{} }
+func (m *Kitchen) String() string { return proto.CompactTextString(m) }
+func (*Kitchen) ProtoMessage()    {}
+func (*Kitchen) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d0b4bc5ba20a1ca0, []int{0}
+}
+
+func (m *Kitchen) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Kitchen.Unmarshal(m, b)
+}
+func (m *Kitchen) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Kitchen.Marshal(b, m, deterministic)
+}
+func (m *Kitchen) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Kitchen.Merge(m, src)
+}
+func (m *Kitchen) XXX_Size() int {
+	return xxx_messageInfo_Kitchen.Size(m)
+}
+func (m *Kitchen) XXX_DiscardUnknown() {
+	xxx_messageInfo_Kitchen.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Kitchen proto.InternalMessageInfo
+
+func (m *Kitchen) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Kitchen) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *Kitchen) GetBig() int64 {
+	if m != nil {
+		return m.Big
+	}
+	return 0
+}
+
+func (m *Kitchen) GetRatio() float64 {
+	if m != nil {
+		return m.Ratio
+	}
+	return 0
+}
+
+func (m *Kitchen) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *Kitchen) GetBlob() []byte {
+	if m != nil {
+		return m.Blob
+	}
+	return nil
+}
+
+func (m *Kitchen) GetColor() Kitchen_Color {
+	if m != nil {
+		return m.Color
+	}
+	return Kitchen_COLOR_UNSPECIFIED
+}
+
+func (m *Kitchen) GetTags() []string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *Kitchen) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+func (m *Kitchen) GetItemsById() map[int32]*Item {
+	if m != nil {
+		return m.ItemsById
+	}
+	return nil
+}
+
+func (m *Kitchen) GetItem() *Item {
+	if m != nil {
+		return m.Item
+	}
+	return nil
+}
+
+func (m *Kitchen) GetItems() []*Item {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+type isKitchen_Choice interface {
+	isKitchen_Choice()
+}
+
+type Kitchen_Text struct {
+	Text string `protobuf:"bytes,13,opt,name=text,proto3,oneof"`
+}
+
+type Kitchen_ChoiceItem struct {
+	ChoiceItem *Item `protobuf:"bytes,14,opt,name=choice_item,json=choiceItem,proto3,oneof"`
+}
+
+func (*Kitchen_Text) isKitchen_Choice() {}
+
+func (*Kitchen_ChoiceItem) isKitchen_Choice() {}
+
+func (m *Kitchen) GetChoice() isKitchen_Choice {
+	if m != nil {
+		return m.Choice
+	}
+	return nil
+}
+
+func (m *Kitchen) GetText() string {
+	if x, ok := m.GetChoice().(*Kitchen_Text); ok {
+		return x.Text
+	}
+	return ""
+}
+
+func (m *Kitchen) GetChoiceItem() *Item {
+	if x, ok := m.GetChoice().(*Kitchen_ChoiceItem); ok {
+		return x.ChoiceItem
+	}
+	return nil
+}
+
+func (m *Kitchen) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+func (m *Kitchen) GetTtl() *duration.Duration {
+	if m != nil {
+		return m.Ttl
+	}
+	return nil
+}
+
+func (m *Kitchen) GetNickname() *wrappers.StringValue {
+	if m != nil {
+		return m.Nickname
+	}
+	return nil
+}
+
+func (m *Kitchen) GetExtra() *_struct.Struct {
+	if m != nil {
+		return m.Extra
+	}
+	return nil
+}
+
+func (m *Kitchen) GetDetail() *any.Any {
+	if m != nil {
+		return m.Detail
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*Kitchen) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*Kitchen_Text)(nil),
+		(*Kitchen_ChoiceItem)(nil),
+	}
+}
+
+// Item is a nested message.
+type Item struct {
+	Id                   string       `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Colors               []Item_Color `protobuf:"varint,2,rep,packed,name=colors,proto3,enum=testpb.Item_Color" json:"colors,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *Item) Reset()         { *m = Item{} }
+func (m *Item) String() string { return proto.CompactTextString(m) }
+func (*Item) ProtoMessage()    {}
+func (*Item) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d0b4bc5ba20a1ca0, []int{1}
+}
+
+func (m *Item) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Item.Unmarshal(m, b)
+}
+func (m *Item) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Item.Marshal(b, m, deterministic)
+}
+func (m *Item) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Item.Merge(m, src)
+}
+func (m *Item) XXX_Size() int {
+	return xxx_messageInfo_Item.Size(m)
+}
+func (m *Item) XXX_DiscardUnknown() {
+	xxx_messageInfo_Item.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Item proto.InternalMessageInfo
+
+func (m *Item) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Item) GetColors() []Item_Color {
+	if m != nil {
+		return m.Colors
+	}
+	return nil
+}
+
+// Tree is a recursive message.
+type Tree struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Children             []*Tree  `protobuf:"bytes,2,rep,name=children,proto3" json:"children,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Tree) Reset()         { *m = Tree{} }
+func (m *Tree) String() string { return proto.CompactTextString(m) }
+func (*Tree) ProtoMessage()    {}
+func (*Tree) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d0b4bc5ba20a1ca0, []int{2}
+}
+
+func (m *Tree) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Tree.Unmarshal(m, b)
+}
+func (m *Tree) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Tree.Marshal(b, m, deterministic)
+}
+func (m *Tree) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Tree.Merge(m, src)
+}
+func (m *Tree) XXX_Size() int {
+	return xxx_messageInfo_Tree.Size(m)
+}
+func (m *Tree) XXX_DiscardUnknown() {
+	xxx_messageInfo_Tree.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Tree proto.InternalMessageInfo
+
+func (m *Tree) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Tree) GetChildren() []*Tree {
+	if m != nil {
+		return m.Children
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("testpb.Kitchen_Color", Kitchen_Color_name, Kitchen_Color_value)
+	proto.RegisterEnum("testpb.Item_Color", Item_Color_name, Item_Color_value)
+	proto.RegisterType((*Kitchen)(nil), "testpb.Kitchen")
+	proto.RegisterMapType((map[int32]*Item)(nil), "testpb.Kitchen.ItemsByIdEntry")
+	proto.RegisterMapType((map[string]string)(nil), "testpb.Kitchen.LabelsEntry")
+	proto.RegisterType((*Item)(nil), "testpb.Item")
+	proto.RegisterType((*Tree)(nil), "testpb.Tree")
+}
+
+func init() {
+	proto.RegisterFile("iocodec/internal/testpb/test.proto", fileDescriptor_d0b4bc5ba20a1ca0)
+}
+
+var fileDescriptor_d0b4bc5ba20a1ca0 = []byte{
+	// 701 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x94, 0x5f, 0x4f, 0xdb, 0x3a,
+	0x18, 0xc6, 0x49, 0xd3, 0xf4, 0xcf, 0x5b, 0x4e, 0x4f, 0xf1, 0x01, 0x1d, 0xd3, 0x83, 0x38, 0x51,
+	0xae, 0xa2, 0x31, 0x52, 0xa9, 0xdc, 0xc0, 0x2e, 0x36, 0x51, 0xda, 0x8d, 0x6e, 0x08, 0x26, 0x03,
+	0xbb, 0xd8, 0x4d, 0xe5, 0x24, 0x5e, 0x6b, 0x91, 0x26, 0x95, 0xe3, 0x6e, 0xf4, 0x5b, 0xec, 0x23,
+	0x4f, 0xb6, 0x53, 0xd4, 0xb5, 0xa0, 0x5d, 0xd5, 0x6f, 0x9e, 0xdf, 0xe3, 0xf7, 0x6d, 0xfc, 0xc4,
+	0xe0, 0xf1, 0x2c, 0xca, 0x62, 0x16, 0x75, 0x78, 0x2a, 0x99, 0x48, 0x69, 0xd2, 0x91, 0x2c, 0x97,
+	0xb3, 0x50, 0xff, 0x04, 0x33, 0x91, 0xc9, 0x0c, 0x55, 0xcc, 0xa3, 0xf6, 0xfe, 0x38, 0xcb, 0xc6,
+	0x09, 0xeb, 0xe8, 0xa7, 0xe1, 0xfc, 0x5b, 0x87, 0xa6, 0x0b, 0x83, 0xb4, 0x0f, 0xd7, 0xa5, 0x78,
+	0x2e, 0xa8, 0xe4, 0x59, 0x5a, 0xe8, 0x07, 0xeb, 0x7a, 0x2e, 0xc5, 0x3c, 0x2a, 0x1a, 0xb4, 0xff,
+	0x5f, 0x57, 0x25, 0x9f, 0xb2, 0x5c, 0xd2, 0xe9, 0xec, 0xa5, 0xed, 0x7f, 0x08, 0x3a, 0x9b, 0x31,
+	0x91, 0x1b, 0xdd, 0xfb, 0x59, 0x85, 0xea, 0x27, 0x2e, 0xa3, 0x09, 0x4b, 0x11, 0x82, 0x72, 0x4a,
+	0xa7, 0x0c, 0x5b, 0xae, 0xe5, 0xd7, 0x89, 0x5e, 0xa3, 0x5d, 0x70, 0xa2, 0x6c, 0x9e, 0x4a, 0x5c,
+	0x72, 0x2d, 0xdf, 0x21, 0xa6, 0x40, 0x2d, 0xb0, 0x43, 0x3e, 0xc6, 0xb6, 0x6b, 0xf9, 0x36, 0x51,
+	0x4b, 0xc5, 0xe9, 0xb1, 0x71, 0xd9, 0xb5, 0x7c, 0x8b, 0x98, 0x02, 0x61, 0xa8, 0xb2, 0x94, 0x86,
+	0x09, 0x8b, 0xb1, 0xe3, 0x5a, 0x7e, 0x8d, 0x2c, 0x4b, 0xd5, 0x2b, 0x4c, 0xb2, 0x10, 0x57, 0x5c,
+	0xcb, 0xdf, 0x26, 0x7a, 0x8d, 0x8e, 0x54, 0xaf, 0x24, 0x13, 0xb8, 0xea, 0x5a, 0x7e, 0xb3, 0xbb,
+	0x17, 0x98, 0xb7, 0x17, 0x14, 0xf3, 0x05, 0x17, 0x4a, 0x24, 0x86, 0x51, 0x1b, 0x48, 0x3a, 0xce,
+	0x71, 0xcd, 0xb5, 0xd5, 0xb0, 0x6a, 0x8d, 0x4e, 0xa0, 0x92, 0xd0, 0x90, 0x25, 0x39, 0xae, 0xbb,
+	0xb6, 0xdf, 0xe8, 0xfe, 0xb7, 0xbe, 0xc3, 0x95, 0x56, 0x07, 0xa9, 0x14, 0x0b, 0x52, 0xa0, 0xe8,
+	0x2d, 0x34, 0xb8, 0x64, 0xd3, 0x7c, 0x14, 0x2e, 0x46, 0x3c, 0xc6, 0xa0, 0x9d, 0x87, 0xeb, 0xce,
+	0xa1, 0x42, 0x7a, 0x8b, 0x61, 0x6c, 0xcc, 0x75, 0xbe, 0xac, 0x91, 0x0b, 0x65, 0x55, 0xe0, 0x86,
+	0x6b, 0xf9, 0x8d, 0xee, 0xf6, 0xd2, 0xa8, 0x0c, 0x44, 0x2b, 0xc8, 0x03, 0x47, 0xe3, 0x78, 0xdb,
+	0xb5, 0x37, 0x10, 0x23, 0xa1, 0x5d, 0x28, 0x4b, 0xf6, 0x28, 0xf1, 0x5f, 0xea, 0xdd, 0x5f, 0x6e,
+	0x11, 0x5d, 0xa1, 0x0e, 0x34, 0xa2, 0x49, 0xc6, 0x23, 0x36, 0xd2, 0x2d, 0x9a, 0x9b, 0x2d, 0x2e,
+	0xb7, 0x08, 0x18, 0x44, 0x55, 0xe8, 0x0c, 0x20, 0x12, 0x8c, 0x4a, 0x16, 0x8f, 0xa8, 0xc4, 0x7f,
+	0x6b, 0xbe, 0x1d, 0x98, 0x0c, 0x04, 0xcb, 0x0c, 0x04, 0x77, 0xcb, 0x90, 0x90, 0x7a, 0x41, 0x9f,
+	0x4b, 0x74, 0x04, 0xb6, 0x94, 0x09, 0x6e, 0x69, 0xcf, 0xfe, 0x86, 0xa7, 0x5f, 0xc4, 0x92, 0x28,
+	0x0a, 0x9d, 0x42, 0x2d, 0xe5, 0xd1, 0x83, 0x8e, 0xcb, 0x8e, 0x76, 0x1c, 0x6c, 0x38, 0x6e, 0xa5,
+	0xe0, 0xe9, 0xf8, 0x0b, 0x4d, 0xe6, 0x8c, 0x3c, 0xd1, 0xe8, 0x18, 0x1c, 0xf6, 0x28, 0x05, 0xc5,
+	0x48, 0xdb, 0xfe, 0x7d, 0xce, 0x36, 0x8f, 0x24, 0x31, 0x14, 0x7a, 0x0d, 0x95, 0x98, 0x49, 0xca,
+	0x13, 0xfc, 0x8f, 0xe6, 0x77, 0x37, 0xf8, 0xf3, 0x74, 0x41, 0x0a, 0xa6, 0x7d, 0x06, 0x8d, 0x95,
+	0x23, 0x56, 0x31, 0x7d, 0x60, 0x8b, 0x22, 0xcf, 0x6a, 0xa9, 0x62, 0xfa, 0x5d, 0x0d, 0xa4, 0xe3,
+	0x5c, 0x27, 0xa6, 0x78, 0x53, 0x3a, 0xb5, 0xda, 0x1f, 0xa1, 0xf9, 0xfb, 0x19, 0xaf, 0xba, 0x1d,
+	0xe3, 0xf6, 0x56, 0xdd, 0x1b, 0x07, 0xf9, 0xb4, 0x97, 0xd7, 0x05, 0x47, 0x67, 0x15, 0xed, 0xc1,
+	0xce, 0xc5, 0xcd, 0xd5, 0x0d, 0x19, 0xdd, 0x5f, 0xdf, 0x7e, 0x1e, 0x5c, 0x0c, 0xdf, 0x0f, 0x07,
+	0xfd, 0xd6, 0x16, 0xaa, 0x82, 0x4d, 0x06, 0xfd, 0x96, 0x85, 0xea, 0xe0, 0x7c, 0x20, 0x83, 0xc1,
+	0x75, 0xab, 0xd4, 0xab, 0x41, 0xc5, 0x9c, 0xa3, 0x97, 0x40, 0x59, 0x9f, 0x65, 0x13, 0x4a, 0x3c,
+	0x2e, 0x86, 0x2f, 0xf1, 0x18, 0xbd, 0x82, 0x8a, 0x8e, 0x7e, 0x8e, 0x4b, 0xae, 0xed, 0x37, 0xbb,
+	0x68, 0xb5, 0x7d, 0xf1, 0x71, 0x14, 0x84, 0xe7, 0xff, 0x61, 0x82, 0x1a, 0x94, 0x7b, 0x57, 0xf7,
+	0x83, 0x96, 0xe5, 0xf5, 0xa1, 0x7c, 0x27, 0x18, 0x7b, 0xf6, 0xe3, 0xf7, 0xa1, 0x16, 0x4d, 0x78,
+	0x12, 0x0b, 0x96, 0xea, 0x9e, 0x2b, 0x7f, 0x59, 0x79, 0xc8, 0x93, 0xda, 0x3b, 0xff, 0xfa, 0x6e,
+	0xcc, 0xe5, 0x64, 0x1e, 0x06, 0x51, 0x36, 0xed, 0x48, 0x26, 0x05, 0x95, 0x2c, 0xa1, 0x61, 0x6e,
+	0x2e, 0x9e, 0xe8, 0x78, 0xcc, 0xd2, 0xe3, 0x28, 0x0b, 0x05, 0xed, 0xbc, 0x70, 0x6d, 0x86, 0x15,
+	0x4d, 0x9e, 0xfc, 0x1a, 0x00, 0x75, 0xa9, 0xba, 0xe2, 0x58, 0x05, 0x00, 0x00,
+}
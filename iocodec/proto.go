@@ -0,0 +1,424 @@
+package iocodec
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	structpb "github.com/golang/protobuf/ptypes/struct"
+
+	"github.com/tetratelabs/protoc-gen-cobra/describe"
+)
+
+// The proto-aware codecs share the proto3 JSON mapping implemented by jsonpb:
+// a message is first marshaled to JSON and decoded into an ordered tree, which
+// the YAML and XML encoders then render with the help of the message's field
+// properties. Decoding goes the other way: YAML and XML documents are mapped
+// to the JSON form of the message, guided by the same properties, and handed
+// to jsonpb. Field names, maps, oneofs and well-known types therefore look the
+// same in every format.
+
+// A member is a single name/value pair of an object, in the order jsonpb wrote it.
+type member struct {
+	Name  string
+	Value interface{}
+}
+
+// An object is a JSON object whose members keep their order.
+type object []member
+
+// MarshalJSON implements the json.Marshaler interface.
+func (o object) MarshalJSON() ([]byte, error) {
+	var b bytes.Buffer
+	b.WriteByte('{')
+	for i, m := range o {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		k, err := json.Marshal(m.Name)
+		if err != nil {
+			return nil, err
+		}
+		v, err := json.Marshal(m.Value)
+		if err != nil {
+			return nil, err
+		}
+		b.Write(k)
+		b.WriteByte(':')
+		b.Write(v)
+	}
+	b.WriteByte('}')
+	return b.Bytes(), nil
+}
+
+// marshalTree returns the proto3 JSON mapping of m as an ordered tree made of
+// objects, []interface{}, json.Number, string, bool and nil values.
+func marshalTree(m proto.Message) (interface{}, error) {
+	var b bytes.Buffer
+	if err := Marshaler().Marshal(&b, m); err != nil {
+		return nil, err
+	}
+	dec := json.NewDecoder(&b)
+	dec.UseNumber()
+	return decodeTree(dec)
+}
+
+func decodeTree(dec *json.Decoder) (interface{}, error) {
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+	switch t := tok.(type) {
+	case json.Delim:
+		switch t {
+		case '{':
+			o := object{}
+			for dec.More() {
+				k, err := dec.Token()
+				if err != nil {
+					return nil, err
+				}
+				v, err := decodeTree(dec)
+				if err != nil {
+					return nil, err
+				}
+				o = append(o, member{k.(string), v})
+			}
+			_, err := dec.Token()
+			return o, err
+		case '[':
+			a := []interface{}{}
+			for dec.More() {
+				v, err := decodeTree(dec)
+				if err != nil {
+					return nil, err
+				}
+				a = append(a, v)
+			}
+			_, err := dec.Token()
+			return a, err
+		}
+		return nil, fmt.Errorf("unexpected delimiter %q", t)
+	default:
+		return t, nil
+	}
+}
+
+// unmarshalTree sets m from v, the JSON mapping of m as built by the YAML and
+// XML decoders.
+func unmarshalTree(v interface{}, m proto.Message) error {
+	if v == nil {
+		return nil
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	return Unmarshaler().Unmarshal(bytes.NewReader(b), m)
+}
+
+// A field describes a message field: its properties and the Go type of its value.
+type field struct {
+	prop *proto.Properties
+	typ  reflect.Type
+}
+
+var fieldCache sync.Map // map[reflect.Type]map[string]field
+
+// fieldsOf returns the fields of the generated message type t (a pointer to a
+// struct), keyed by both their JSON and their original proto names. Oneof
+// members are listed as regular fields.
+func fieldsOf(t reflect.Type) map[string]field {
+	if fs, ok := fieldCache.Load(t); ok {
+		return fs.(map[string]field)
+	}
+	st := t.Elem()
+	sp := proto.GetProperties(st)
+	fs := make(map[string]field, 2*len(sp.Prop))
+	add := func(p *proto.Properties, typ reflect.Type) {
+		f := field{p, typ}
+		fs[p.OrigName] = f
+		if p.JSONName != "" {
+			fs[p.JSONName] = f
+		}
+	}
+	for i, p := range sp.Prop {
+		if strings.HasPrefix(p.Name, "XXX_") || p.Tag == 0 {
+			continue
+		}
+		add(p, st.Field(i).Type)
+	}
+	for _, oop := range sp.OneofTypes {
+		add(oop.Prop, oop.Type.Elem().Field(0).Type)
+	}
+	fieldCache.Store(t, fs)
+	return fs
+}
+
+// isMessage reports whether t is the Go type of a generated message.
+func isMessage(t reflect.Type) bool {
+	return t.Kind() == reflect.Ptr && t.Elem().Kind() == reflect.Struct && t.Implements(messageType)
+}
+
+var messageType = reflect.TypeOf((*proto.Message)(nil)).Elem()
+
+// wellKnownType returns the name of the well-known type t implements, if any.
+func wellKnownType(t reflect.Type) string {
+	if w, ok := reflect.Zero(t).Interface().(interface{ XXX_WellKnownType() string }); ok {
+		return w.XXX_WellKnownType()
+	}
+	return ""
+}
+
+// messageName returns the short proto name of the message m.
+func messageName(m proto.Message) string {
+	name := proto.MessageName(m)
+	if name == "" {
+		return reflect.TypeOf(m).Elem().Name()
+	}
+	return name[strings.LastIndex(name, ".")+1:]
+}
+
+// resolveAny returns a new message of the type of the Any type URL: of its
+// generated type, or a dynamic message built from the descriptors generated
+// files embed, which the codecs handle as arbitrary JSON.
+func resolveAny(typeURL string) (proto.Message, error) {
+	return describe.Resolve(typeURL)
+}
+
+// Marshaler returns the jsonpb marshaler of the codecs, which resolves Any
+// values with describe.AnyResolver. The messages written outside of the codecs
+// are marshaled with it too, so they look the same.
+func Marshaler() *jsonpb.Marshaler {
+	return &jsonpb.Marshaler{AnyResolver: describe.AnyResolver}
+}
+
+// Unmarshaler returns the jsonpb unmarshaler of the codecs, which resolves Any
+// values with describe.AnyResolver. The messages read outside of the codecs
+// are unmarshaled with it too, so they're read the same way.
+func Unmarshaler() *jsonpb.Unmarshaler {
+	return &jsonpb.Unmarshaler{AnyResolver: describe.AnyResolver}
+}
+
+// Struct returns v, as encoding/json marshals it, as a Struct, which every
+// format can encode, for the reports commands write that aren't messages.
+func Struct(v interface{}) (*structpb.Struct, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var s structpb.Struct
+	if err := Unmarshaler().Unmarshal(bytes.NewReader(b), &s); err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+// coerceMessage maps the generic value v, as decoded from YAML or XML, to the
+// JSON mapping of the message type t so jsonpb accepts it.
+func coerceMessage(v interface{}, t reflect.Type) (interface{}, error) {
+	if v == nil {
+		return nil, nil
+	}
+	if wkt := wellKnownType(t); wkt != "" {
+		return coerceWellKnown(v, wkt)
+	}
+	m, ok := stringMap(v)
+	if !ok {
+		return nil, fmt.Errorf("expected a mapping for message %s, got %T", t.Elem().Name(), v)
+	}
+	fs := fieldsOf(t)
+	for k, fv := range m {
+		f, ok := fs[k]
+		if !ok {
+			// leave it to jsonpb to report the unknown field
+			m[k] = normalize(fv)
+			continue
+		}
+		c, err := coerceField(fv, f)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", k, err)
+		}
+		m[k] = c
+	}
+	return m, nil
+}
+
+func coerceField(v interface{}, f field) (interface{}, error) {
+	if v == nil {
+		return nil, nil
+	}
+	switch {
+	case f.typ.Kind() == reflect.Map:
+		m, ok := stringMap(v)
+		if !ok {
+			return nil, fmt.Errorf("expected a mapping, got %T", v)
+		}
+		for k, mv := range m {
+			c, err := coerceValue(mv, f.prop.MapValProp, f.typ.Elem())
+			if err != nil {
+				return nil, fmt.Errorf("%s: %v", k, err)
+			}
+			m[k] = c
+		}
+		return m, nil
+	case f.typ.Kind() == reflect.Slice && f.typ.Elem().Kind() != reflect.Uint8:
+		l, ok := v.([]interface{})
+		if !ok {
+			l = []interface{}{v}
+		}
+		out := make([]interface{}, len(l))
+		for i, lv := range l {
+			c, err := coerceValue(lv, f.prop, f.typ.Elem())
+			if err != nil {
+				return nil, fmt.Errorf("[%d]: %v", i, err)
+			}
+			out[i] = c
+		}
+		return out, nil
+	}
+	return coerceValue(v, f.prop, f.typ)
+}
+
+func coerceValue(v interface{}, prop *proto.Properties, t reflect.Type) (interface{}, error) {
+	if v == nil {
+		return nil, nil
+	}
+	if isMessage(t) {
+		return coerceMessage(v, t)
+	}
+	if prop != nil && prop.Enum != "" {
+		if s, ok := v.(string); ok {
+			if _, err := strconv.ParseInt(s, 10, 32); err != nil {
+				return s, nil
+			}
+		}
+		return coerceNumber(v)
+	}
+	switch t.Kind() {
+	case reflect.Bool:
+		return coerceBool(v)
+	case reflect.Int32, reflect.Int64, reflect.Uint32, reflect.Uint64, reflect.Float32, reflect.Float64:
+		return coerceNumber(v)
+	}
+	return coerceString(v)
+}
+
+func coerceWellKnown(v interface{}, wkt string) (interface{}, error) {
+	switch wkt {
+	case "DoubleValue", "FloatValue", "Int64Value", "UInt64Value", "Int32Value", "UInt32Value":
+		return coerceNumber(v)
+	case "BoolValue":
+		return coerceBool(v)
+	case "StringValue", "BytesValue", "Timestamp", "Duration":
+		return coerceString(v)
+	case "Any":
+		m, ok := stringMap(v)
+		if !ok {
+			return nil, fmt.Errorf("expected a mapping for Any, got %T", v)
+		}
+		typeURL, ok := m["@type"].(string)
+		if !ok {
+			return nil, fmt.Errorf("Any is missing '@type'")
+		}
+		msg, err := resolveAny(typeURL)
+		if err != nil {
+			return nil, err
+		}
+		if describe.IsDynamic(msg) {
+			return normalize(m), nil
+		}
+		t := reflect.TypeOf(msg)
+		if inner := wellKnownType(t); inner != "" {
+			c, err := coerceWellKnown(m["value"], inner)
+			if err != nil {
+				return nil, err
+			}
+			return map[string]interface{}{"@type": typeURL, "value": c}, nil
+		}
+		delete(m, "@type")
+		c, err := coerceMessage(m, t)
+		if err != nil {
+			return nil, err
+		}
+		c.(map[string]interface{})["@type"] = typeURL
+		return c, nil
+	}
+	// Struct, Value, ListValue and Empty take arbitrary JSON.
+	return normalize(v), nil
+}
+
+func coerceBool(v interface{}) (interface{}, error) {
+	switch b := v.(type) {
+	case bool:
+		return b, nil
+	case string:
+		return strconv.ParseBool(strings.TrimSpace(b))
+	}
+	return nil, fmt.Errorf("expected a boolean, got %v", v)
+}
+
+func coerceNumber(v interface{}) (interface{}, error) {
+	switch n := v.(type) {
+	case int, int64, uint64, float64, json.Number:
+		return json.Number(fmt.Sprint(n)), nil
+	case string:
+		s := strings.TrimSpace(n)
+		if _, err := strconv.ParseFloat(s, 64); err != nil {
+			// jsonpb accepts "NaN", "Infinity" and "-Infinity" as strings
+			return s, nil
+		}
+		return json.Number(s), nil
+	}
+	return nil, fmt.Errorf("expected a number, got %v", v)
+}
+
+func coerceString(v interface{}) (interface{}, error) {
+	switch s := v.(type) {
+	case string:
+		return s, nil
+	case map[interface{}]interface{}, map[string]interface{}, []interface{}:
+		return nil, fmt.Errorf("expected a scalar, got %T", v)
+	}
+	return fmt.Sprint(v), nil
+}
+
+// stringMap returns v as a map with string keys, converting the maps produced
+// by the YAML decoder.
+func stringMap(v interface{}) (map[string]interface{}, bool) {
+	switch m := v.(type) {
+	case map[string]interface{}:
+		return m, true
+	case map[interface{}]interface{}:
+		out := make(map[string]interface{}, len(m))
+		for k, mv := range m {
+			out[fmt.Sprint(k)] = mv
+		}
+		return out, true
+	}
+	return nil, false
+}
+
+// normalize converts v, recursively, into a value encoding/json can marshal.
+func normalize(v interface{}) interface{} {
+	switch t := v.(type) {
+	case map[interface{}]interface{}, map[string]interface{}:
+		m, _ := stringMap(t)
+		for k, mv := range m {
+			m[k] = normalize(mv)
+		}
+		return m
+	case []interface{}:
+		for i, lv := range t {
+			t[i] = normalize(lv)
+		}
+		return t
+	}
+	return v
+}
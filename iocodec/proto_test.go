@@ -0,0 +1,312 @@
+package iocodec
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	descpb "github.com/golang/protobuf/protoc-gen-go/descriptor"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/golang/protobuf/ptypes/duration"
+	_struct "github.com/golang/protobuf/ptypes/struct"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/golang/protobuf/ptypes/wrappers"
+
+	"github.com/tetratelabs/protoc-gen-cobra/describe"
+	"github.com/tetratelabs/protoc-gen-cobra/iocodec/internal/testpb"
+)
+
+func kitchen(t *testing.T) *testpb.Kitchen {
+	detail, err := ptypes.MarshalAny(&testpb.Item{Id: "inner", Colors: []testpb.Item_Color{testpb.Item_BLUE}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &testpb.Kitchen{
+		Name:      "sink",
+		Count:     3,
+		Big:       1 << 40,
+		Ratio:     0.5,
+		Enabled:   true,
+		Blob:      []byte("hello"),
+		Color:     testpb.Kitchen_GREEN,
+		Tags:      []string{"a", "b"},
+		Labels:    map[string]string{"with space": "x", "1": "y"},
+		ItemsById: map[int32]*testpb.Item{7: {Id: "seven"}},
+		Item:      &testpb.Item{Id: "one", Colors: []testpb.Item_Color{testpb.Item_BLUE, testpb.Item_COLOR_UNSPECIFIED}},
+		Items:     []*testpb.Item{{Id: "x"}, {Id: "y"}},
+		Choice:    &testpb.Kitchen_ChoiceItem{ChoiceItem: &testpb.Item{Id: "chosen"}},
+		CreatedAt: &timestamp.Timestamp{Seconds: 1571443200, Nanos: 5},
+		Ttl:       &duration.Duration{Seconds: 90},
+		Nickname:  &wrappers.StringValue{Value: "kit"},
+		Extra: &_struct.Struct{Fields: map[string]*_struct.Value{
+			"n": {Kind: &_struct.Value_NumberValue{NumberValue: 1}},
+			"l": {Kind: &_struct.Value_ListValue{ListValue: &_struct.ListValue{Values: []*_struct.Value{
+				{Kind: &_struct.Value_StringValue{StringValue: "s"}},
+			}}}},
+		}},
+		Detail: detail,
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	for _, format := range []string{"json", "prettyjson", "yaml", "xml"} {
+		t.Run(format, func(t *testing.T) {
+			want := kitchen(t)
+			var b bytes.Buffer
+			if err := DefaultEncoders[format].NewEncoder(&b).Encode(want); err != nil {
+				t.Fatal(err)
+			}
+			decoderFormat := format
+			if format == "prettyjson" {
+				decoderFormat = "json"
+			}
+			got := &testpb.Kitchen{}
+			if err := DefaultDecoders[decoderFormat].NewDecoder(&b).Decode(got); err != nil {
+				t.Fatalf("decode: %v\n%s", err, b.String())
+			}
+			if !proto.Equal(got, want) {
+				t.Errorf("round trip through %s:\ngot  %v\nwant %v", format, got, want)
+			}
+		})
+	}
+}
+
+func TestYAMLEncoderUsesJSONMapping(t *testing.T) {
+	var b bytes.Buffer
+	m := &testpb.Kitchen{
+		Name:      "sink",
+		ItemsById: map[int32]*testpb.Item{1: {Id: "one"}},
+		CreatedAt: &timestamp.Timestamp{Seconds: 1571443200},
+		Choice:    &testpb.Kitchen_Text{Text: "t"},
+	}
+	if err := DefaultEncoders["yaml"].NewEncoder(&b).Encode(m); err != nil {
+		t.Fatal(err)
+	}
+	want := `name: sink
+itemsById:
+  "1":
+    id: one
+text: t
+createdAt: "2019-10-19T00:00:00Z"
+`
+	if got := b.String(); got != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestXMLEncoderMapField(t *testing.T) {
+	var b bytes.Buffer
+	m := &testpb.Kitchen{Labels: map[string]string{"a b": "c"}, Tags: []string{"x", "y"}}
+	if err := DefaultEncoders["xml"].NewEncoder(&b).Encode(m); err != nil {
+		t.Fatal(err)
+	}
+	want := `<?xml version="1.0" encoding="UTF-8"?>
+<Kitchen>
+	<tags>x</tags>
+	<tags>y</tags>
+	<labels>
+		<entry key="a b">c</entry>
+	</labels>
+</Kitchen>
+`
+	if got := b.String(); got != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestDecodeLooseScalars(t *testing.T) {
+	for format, in := range map[string]string{
+		"yaml": "name: 42\ncount: \"7\"\nenabled: \"true\"\ncolor: 2\ntags: single\nitem:\n  colors: [BLUE]\nnickname: 5\n",
+		"xml":  "<Kitchen><name>42</name><count>7</count><enabled>true</enabled><color>2</color><tags>single</tags><item><colors>BLUE</colors></item><nickname>5</nickname></Kitchen>",
+	} {
+		t.Run(format, func(t *testing.T) {
+			got := &testpb.Kitchen{}
+			if err := DefaultDecoders[format].NewDecoder(strings.NewReader(in)).Decode(got); err != nil {
+				t.Fatal(err)
+			}
+			want := &testpb.Kitchen{
+				Name:     "42",
+				Count:    7,
+				Enabled:  true,
+				Color:    testpb.Kitchen_GREEN,
+				Tags:     []string{"single"},
+				Item:     &testpb.Item{Colors: []testpb.Item_Color{testpb.Item_BLUE}},
+				Nickname: &wrappers.StringValue{Value: "5"},
+			}
+			if !proto.Equal(got, want) {
+				t.Errorf("got %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestDecodeStream(t *testing.T) {
+	for format, in := range map[string]string{
+		"json": `{"name":"a"}` + "\n" + `{"name":"b"}`,
+		"yaml": "name: a\n---\nname: b\n",
+		"xml":  "<Kitchen><name>a</name></Kitchen>\n<Kitchen><name>b</name></Kitchen>",
+		"csv":  "name\na\nb\n",
+	} {
+		t.Run(format, func(t *testing.T) {
+			d := DefaultDecoders[format].NewDecoder(strings.NewReader(in))
+			var names []string
+			for {
+				m := &testpb.Kitchen{}
+				err := d.Decode(m)
+				if err == io.EOF {
+					break
+				}
+				if err != nil {
+					t.Fatal(err)
+				}
+				names = append(names, m.Name)
+			}
+			if strings.Join(names, ",") != "a,b" {
+				t.Errorf("got %v, want [a b]", names)
+			}
+		})
+	}
+}
+
+func TestDecodeCSV(t *testing.T) {
+	in := "name,count,big,ratio,enabled,color,tags,item.id,created_at,nickname,text\n" +
+		`sink,3,1099511627776,0.5,true,GREEN,a,one,2019-10-19T00:00:00.000000005Z,kit,""` + "\n"
+	got := &testpb.Kitchen{}
+	if err := DefaultDecoders["csv"].NewDecoder(strings.NewReader(in)).Decode(got); err != nil {
+		t.Fatal(err)
+	}
+	want := &testpb.Kitchen{
+		Name:      "sink",
+		Count:     3,
+		Big:       1 << 40,
+		Ratio:     0.5,
+		Enabled:   true,
+		Color:     testpb.Kitchen_GREEN,
+		Tags:      []string{"a"},
+		Item:      &testpb.Item{Id: "one"},
+		CreatedAt: &timestamp.Timestamp{Seconds: 1571443200, Nanos: 5},
+		Nickname:  &wrappers.StringValue{Value: "kit"},
+	}
+	if !proto.Equal(got, want) {
+		t.Errorf("got  %v\nwant %v", got, want)
+	}
+}
+
+func TestDecodeUnknownField(t *testing.T) {
+	for format, in := range map[string]string{
+		"yaml": "nope: 1\n",
+		"xml":  "<Kitchen><nope>1</nope></Kitchen>",
+		"csv":  "nope\n1\n",
+	} {
+		if err := DefaultDecoders[format].NewDecoder(strings.NewReader(in)).Decode(&testpb.Kitchen{}); err == nil {
+			t.Errorf("%s: expected an error for an unknown field", format)
+		}
+	}
+}
+
+func TestEncodePrettyStruct(t *testing.T) {
+	m := &testpb.Kitchen{Name: "a", Extra: &_struct.Struct{Fields: map[string]*_struct.Value{
+		"n": {Kind: &_struct.Value_NumberValue{NumberValue: 1}},
+	}}}
+	var b bytes.Buffer
+	if err := DefaultEncoders["prettyjson"].NewEncoder(&b).Encode(m); err != nil {
+		t.Fatal(err)
+	}
+	if want := "{\n\t\"name\": \"a\",\n\t\"extra\": {\n\t\t\"n\": 1\n\t}\n}\n"; b.String() != want {
+		t.Errorf("got:\n%swant:\n%s", b.String(), want)
+	}
+}
+
+var registerNote sync.Once
+
+// noteAny returns an Any holding a notes.Note, a message with no Go type,
+// whose descriptor it registers as generated files register theirs.
+func noteAny(t *testing.T) *any.Any {
+	registerNote.Do(func() {
+		set := &descpb.FileDescriptorSet{File: []*descpb.FileDescriptorProto{{
+			Name:    proto.String("notes.proto"),
+			Package: proto.String("notes"),
+			Syntax:  proto.String("proto3"),
+			MessageType: []*descpb.DescriptorProto{{
+				Name: proto.String("Note"),
+				Field: []*descpb.FieldDescriptorProto{{
+					Name:     proto.String("text"),
+					JsonName: proto.String("text"),
+					Number:   proto.Int32(1),
+					Label:    descpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+					Type:     descpb.FieldDescriptorProto_TYPE_STRING.Enum(),
+				}, {
+					Name:     proto.String("stars"),
+					JsonName: proto.String("stars"),
+					Number:   proto.Int32(2),
+					Label:    descpb.FieldDescriptorProto_LABEL_REPEATED.Enum(),
+					Type:     descpb.FieldDescriptorProto_TYPE_INT64.Enum(),
+				}},
+			}},
+		}}}
+		b, err := proto.Marshal(set)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var gz bytes.Buffer
+		w := gzip.NewWriter(&gz)
+		w.Write(b)
+		w.Close()
+		describe.Register(gz.Bytes())
+	})
+	// text: "hi", stars: [5, 3], packed
+	return &any.Any{TypeUrl: "type.googleapis.com/notes.Note", Value: []byte{0x0a, 2, 'h', 'i', 0x12, 2, 5, 3}}
+}
+
+func TestStruct(t *testing.T) {
+	s, err := Struct(map[string]interface{}{
+		"name":   "a",
+		"count":  2,
+		"tags":   []string{"x"},
+		"nested": struct{ OK bool }{true},
+		"raw":    json.RawMessage(`{"id":"one"}`),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var b bytes.Buffer
+	if err := DefaultEncoders["json"].NewEncoder(&b).Encode(s); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := b.String(), `{"count":2,"name":"a","nested":{"OK":true},"raw":{"id":"one"},"tags":["x"]}`+"\n"; got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+	if _, err := Struct([]string{"not", "an", "object"}); err == nil {
+		t.Error("expected an error for a list")
+	}
+}
+
+func TestDynamicAny(t *testing.T) {
+	want := &testpb.Kitchen{Name: "sink", Detail: noteAny(t)}
+	for format, output := range map[string]string{
+		"json": `{"name":"sink","detail":{"@type":"type.googleapis.com/notes.Note","stars":["5","3"],"text":"hi"}}`,
+		"yaml": "detail:\n  '@type': type.googleapis.com/notes.Note\n  stars:\n  - \"5\"\n  - \"3\"\n  text: hi\n",
+		"xml":  `<detail type="type.googleapis.com/notes.Note">{&#34;stars&#34;:[&#34;5&#34;,&#34;3&#34;],&#34;text&#34;:&#34;hi&#34;}</detail>`,
+	} {
+		var b bytes.Buffer
+		if err := DefaultEncoders[format].NewEncoder(&b).Encode(want); err != nil {
+			t.Fatalf("%s: %v", format, err)
+		}
+		if !strings.Contains(b.String(), output) {
+			t.Errorf("%s: got\n%s\nwant it to contain\n%s", format, b.String(), output)
+		}
+		got := &testpb.Kitchen{}
+		if err := DefaultDecoders[format].NewDecoder(&b).Decode(got); err != nil {
+			t.Fatalf("%s: decode: %v\n%s", format, err, b.String())
+		}
+		if !proto.Equal(got, want) {
+			t.Errorf("%s: round trip:\ngot  %v\nwant %v", format, got, want)
+		}
+	}
+}
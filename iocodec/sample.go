@@ -0,0 +1,422 @@
+package iocodec
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+	_ "github.com/golang/protobuf/ptypes/empty" // Any placeholders refer to google.protobuf.Empty
+	"gopkg.in/yaml.v2"
+)
+
+var (
+	commentsMu sync.RWMutex
+	comments   = map[string]string{}
+)
+
+// RegisterComments records the proto source comments of messages and fields,
+// keyed by their fully-qualified names (e.g. "pkg.Message" and
+// "pkg.Message.field"). Generated code registers the comments of the request
+// messages it knows about so samples can show them.
+func RegisterComments(c map[string]string) {
+	commentsMu.Lock()
+	defer commentsMu.Unlock()
+	for k, v := range c {
+		comments[k] = v
+	}
+}
+
+func commentOf(name string) string {
+	commentsMu.RLock()
+	defer commentsMu.RUnlock()
+	return comments[name]
+}
+
+var (
+	outputOnlyMu sync.RWMutex
+	outputOnly   = map[string]bool{}
+)
+
+// RegisterOutputOnly records the fields that only the server sets, like the
+// fields annotated with (google.api.field_behavior) = OUTPUT_ONLY, by their
+// fully-qualified names. Samples leave them out.
+func RegisterOutputOnly(names ...string) {
+	outputOnlyMu.Lock()
+	defer outputOnlyMu.Unlock()
+	for _, name := range names {
+		outputOnly[name] = true
+	}
+}
+
+func isOutputOnly(name string) bool {
+	outputOnlyMu.RLock()
+	defer outputOnlyMu.RUnlock()
+	return outputOnly[name]
+}
+
+// A Sample is an example of a message with every field populated with a
+// typed placeholder. Repeated fields and maps hold one entry, enums list their
+// names, and the first member of each oneof is set. Encoders that support
+// comments (YAML) also annotate fields with their proto comments and types and
+// show the other oneof members commented out. Message types that recurse into
+// themselves are expanded once per path; deeper occurrences are left empty.
+type Sample struct {
+	typ    reflect.Type
+	fields sampleMessage
+}
+
+// NewSample returns a sample of the message type of m.
+func NewSample(m proto.Message) *Sample {
+	t := reflect.TypeOf(m)
+	return &Sample{typ: t, fields: sampleOf(t, nil)}
+}
+
+// A sampleField is a field of a sample message along with its annotations.
+type sampleField struct {
+	name        string
+	value       interface{}
+	comment     string
+	typ         string
+	alternative bool // a oneof member shown only as a comment
+}
+
+type sampleMessage []sampleField
+
+func sampleOf(t reflect.Type, path []reflect.Type) sampleMessage {
+	path = append(path, t)
+	name := proto.MessageName(reflect.Zero(t).Interface().(proto.Message))
+	st := t.Elem()
+	sp := proto.GetProperties(st)
+	out := sampleMessage{}
+	for i, p := range sp.Prop {
+		if strings.HasPrefix(p.Name, "XXX_") {
+			continue
+		}
+		if p.Tag != 0 {
+			if isOutputOnly(name + "." + p.OrigName) {
+				continue
+			}
+			out = append(out, sampleFieldOf(name, p, st.Field(i).Type, path))
+			continue
+		}
+		// oneof: list the members in tag order, setting only the first
+		var members []*proto.OneofProperties
+		for _, oop := range sp.OneofTypes {
+			if oop.Field == i && !isOutputOnly(name+"."+oop.Prop.OrigName) {
+				members = append(members, oop)
+			}
+		}
+		sort.Slice(members, func(i, j int) bool { return members[i].Prop.Tag < members[j].Prop.Tag })
+		for j, oop := range members {
+			f := sampleFieldOf(name, oop.Prop, oop.Type.Elem().Field(0).Type, path)
+			f.typ += ", oneof " + p.OrigName
+			f.alternative = j > 0
+			out = append(out, f)
+		}
+	}
+	return out
+}
+
+func sampleFieldOf(msgName string, p *proto.Properties, t reflect.Type, path []reflect.Type) sampleField {
+	f := sampleField{name: p.JSONName, comment: commentOf(msgName + "." + p.OrigName)}
+	if f.name == "" {
+		f.name = p.OrigName
+	}
+	switch {
+	case t.Kind() == reflect.Map:
+		key, keyType := sampleValue(p.MapKeyProp, t.Key(), path)
+		value, valueType := sampleValue(p.MapValProp, t.Elem(), path)
+		f.value = object{{fmt.Sprint(key), value}}
+		f.typ = fmt.Sprintf("map<%s, %s>", keyType, valueType)
+	case t.Kind() == reflect.Slice && t.Elem().Kind() != reflect.Uint8:
+		value, typ := sampleValue(p, t.Elem(), path)
+		f.value = []interface{}{value}
+		f.typ = "repeated " + typ
+	default:
+		f.value, f.typ = sampleValue(p, t, path)
+	}
+	return f
+}
+
+// sampleValue returns the placeholder for a value of type t and the name of its proto type.
+func sampleValue(p *proto.Properties, t reflect.Type, path []reflect.Type) (interface{}, string) {
+	if isMessage(t) {
+		name := proto.MessageName(reflect.Zero(t).Interface().(proto.Message))
+		if wkt := wellKnownType(t); wkt != "" {
+			return sampleWellKnown(wkt), name
+		}
+		for _, seen := range path {
+			if seen == t {
+				return object{}, name + " (recursive)"
+			}
+		}
+		return sampleOf(t, path), name
+	}
+	if p.Enum != "" {
+		values := proto.EnumValueMap(p.Enum)
+		names := make([]string, 0, len(values))
+		for n := range values {
+			names = append(names, n)
+		}
+		sort.Slice(names, func(i, j int) bool { return values[names[i]] < values[names[j]] })
+		if len(names) == 0 {
+			return 0, "enum"
+		}
+		return names[0], "enum " + strings.Join(names, " | ")
+	}
+	switch t.Kind() {
+	case reflect.Bool:
+		return false, "bool"
+	case reflect.String:
+		return "string", "string"
+	case reflect.Slice:
+		return "Ynl0ZXM=", "bytes"
+	case reflect.Int64, reflect.Uint64:
+		// 64-bit integers are strings in the JSON mapping
+		return "0", scalarType(t, p.Wire)
+	}
+	return 0, scalarType(t, p.Wire)
+}
+
+// scalarType returns the name of the proto numeric type held in Go type t
+// with the given wire encoding.
+func scalarType(t reflect.Type, wire string) string {
+	switch t.Kind() {
+	case reflect.Float32:
+		return "float"
+	case reflect.Float64:
+		return "double"
+	case reflect.Int32:
+		switch wire {
+		case "zigzag32":
+			return "sint32"
+		case "fixed32":
+			return "sfixed32"
+		}
+		return "int32"
+	case reflect.Int64:
+		switch wire {
+		case "zigzag64":
+			return "sint64"
+		case "fixed64":
+			return "sfixed64"
+		}
+		return "int64"
+	case reflect.Uint32:
+		if wire == "fixed32" {
+			return "fixed32"
+		}
+		return "uint32"
+	case reflect.Uint64:
+		if wire == "fixed64" {
+			return "fixed64"
+		}
+		return "uint64"
+	}
+	return t.String()
+}
+
+func sampleWellKnown(wkt string) interface{} {
+	switch wkt {
+	case "Timestamp":
+		return "1970-01-01T00:00:00Z"
+	case "Duration":
+		return "0s"
+	case "DoubleValue", "FloatValue", "Int32Value", "UInt32Value":
+		return 0
+	case "Int64Value", "UInt64Value":
+		return "0"
+	case "BoolValue":
+		return false
+	case "StringValue":
+		return "string"
+	case "BytesValue":
+		return "Ynl0ZXM="
+	case "Struct":
+		return object{}
+	case "ListValue":
+		return []interface{}{}
+	case "Any":
+		return object{{"@type", "type.googleapis.com/google.protobuf.Empty"}, {"value", object{}}}
+	}
+	return nil
+}
+
+// tree returns the sample as a JSON mapping tree, without the annotations
+// and oneof alternatives.
+func (s *Sample) tree() object {
+	return s.fields.tree()
+}
+
+func (m sampleMessage) tree() object {
+	o := object{}
+	for _, f := range m {
+		if !f.alternative {
+			o = append(o, member{f.name, sampleTree(f.value)})
+		}
+	}
+	return o
+}
+
+func sampleTree(v interface{}) interface{} {
+	switch t := v.(type) {
+	case sampleMessage:
+		return t.tree()
+	case object:
+		o := make(object, len(t))
+		for i, m := range t {
+			o[i] = member{m.Name, sampleTree(m.Value)}
+		}
+		return o
+	case []interface{}:
+		l := make([]interface{}, len(t))
+		for i, item := range t {
+			l[i] = sampleTree(item)
+		}
+		return l
+	}
+	return v
+}
+
+func (s *Sample) encodeXML(e *xml.Encoder) error {
+	start := xml.StartElement{Name: xml.Name{Local: messageName(reflect.Zero(s.typ).Interface().(proto.Message))}}
+	if err := writeXMLMessage(e, start, s.tree(), s.typ); err != nil {
+		return err
+	}
+	return e.Flush()
+}
+
+// encodeYAML writes the sample as YAML, annotated with comments.
+func (s *Sample) encodeYAML() ([]byte, error) {
+	var b bytes.Buffer
+	name := proto.MessageName(reflect.Zero(s.typ).Interface().(proto.Message))
+	if c := commentOf(name); c != "" {
+		writeYAMLComment(&b, "", c)
+	}
+	fmt.Fprintf(&b, "# %s\n", name)
+	if err := writeYAMLMessage(&b, "", s.fields); err != nil {
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
+
+// writeYAMLComment writes a proto source comment.
+func writeYAMLComment(b *bytes.Buffer, indent, text string) {
+	for _, line := range strings.Split(text, "\n") {
+		writeYAMLCommentLine(b, indent, strings.TrimPrefix(line, " "))
+	}
+}
+
+func writeYAMLCommentLine(b *bytes.Buffer, indent, line string) {
+	b.WriteString(strings.TrimRight(indent+"# "+line, " "))
+	b.WriteByte('\n')
+}
+
+func writeYAMLMessage(b *bytes.Buffer, indent string, m sampleMessage) error {
+	for _, f := range m {
+		if !f.alternative {
+			if err := writeYAMLField(b, indent, f); err != nil {
+				return err
+			}
+			continue
+		}
+		// comment out oneof alternatives line by line
+		var alt bytes.Buffer
+		if err := writeYAMLField(&alt, "", f); err != nil {
+			return err
+		}
+		for _, line := range strings.Split(strings.TrimSuffix(alt.String(), "\n"), "\n") {
+			writeYAMLCommentLine(b, indent, line)
+		}
+	}
+	return nil
+}
+
+func writeYAMLField(b *bytes.Buffer, indent string, f sampleField) error {
+	if f.comment != "" {
+		writeYAMLComment(b, indent, f.comment)
+	}
+	key, err := yamlScalar(f.name)
+	if err != nil {
+		return err
+	}
+	b.WriteString(indent + key + ":")
+	return writeYAMLValue(b, indent, f.value, f.typ)
+}
+
+// writeYAMLValue writes v after a mapping key or a sequence dash, followed by
+// the type annotation.
+func writeYAMLValue(b *bytes.Buffer, indent string, v interface{}, typ string) error {
+	annotation := ""
+	if typ != "" {
+		annotation = " # " + typ
+	}
+	switch t := v.(type) {
+	case sampleMessage:
+		if len(t) == 0 {
+			b.WriteString(" {}" + annotation + "\n")
+			return nil
+		}
+		b.WriteString(annotation + "\n")
+		return writeYAMLMessage(b, indent+"  ", t)
+	case object:
+		if len(t) == 0 {
+			b.WriteString(" {}" + annotation + "\n")
+			return nil
+		}
+		b.WriteString(annotation + "\n")
+		for _, m := range t {
+			key, err := yamlScalar(m.Name)
+			if err != nil {
+				return err
+			}
+			b.WriteString(indent + "  " + key + ":")
+			if err := writeYAMLValue(b, indent+"  ", m.Value, ""); err != nil {
+				return err
+			}
+		}
+		return nil
+	case []interface{}:
+		if len(t) == 0 {
+			b.WriteString(" []" + annotation + "\n")
+			return nil
+		}
+		b.WriteString(annotation + "\n")
+		for _, item := range t {
+			if m, ok := item.(sampleMessage); ok && len(m) > 0 {
+				// write the message as if it were nested, then turn its
+				// first indentation into the sequence dash
+				var mb bytes.Buffer
+				if err := writeYAMLMessage(&mb, indent+"  ", m); err != nil {
+					return err
+				}
+				b.WriteString(indent + "- " + strings.TrimPrefix(mb.String(), indent+"  "))
+				continue
+			}
+			b.WriteString(indent + "-")
+			if err := writeYAMLValue(b, indent, item, ""); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	s, err := yamlScalar(v)
+	if err != nil {
+		return err
+	}
+	b.WriteString(" " + s + annotation + "\n")
+	return nil
+}
+
+func yamlScalar(v interface{}) (string, error) {
+	b, err := yaml.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(string(b), "\n"), nil
+}
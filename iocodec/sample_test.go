@@ -0,0 +1,82 @@
+package iocodec
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/tetratelabs/protoc-gen-cobra/iocodec/internal/testpb"
+)
+
+func TestSampleDecodes(t *testing.T) {
+	for _, format := range []string{"json", "prettyjson", "yaml", "xml"} {
+		t.Run(format, func(t *testing.T) {
+			var b bytes.Buffer
+			if err := DefaultEncoders[format].NewEncoder(&b).Encode(NewSample(&testpb.Kitchen{})); err != nil {
+				t.Fatal(err)
+			}
+			decoderFormat := format
+			if format == "prettyjson" {
+				decoderFormat = "json"
+			}
+			got := &testpb.Kitchen{}
+			if err := DefaultDecoders[decoderFormat].NewDecoder(&b).Decode(got); err != nil {
+				t.Fatalf("decode: %v\n%s", err, b.String())
+			}
+			if got.Name != "string" || len(got.Tags) != 1 || len(got.ItemsById) != 1 || len(got.Items[0].Colors) != 1 {
+				t.Errorf("sample is not populated: %v", got)
+			}
+			if got.GetText() != "string" {
+				t.Errorf("expected the first oneof member to be set, got %v", got.Choice)
+			}
+		})
+	}
+}
+
+func TestSampleYAML(t *testing.T) {
+	RegisterComments(map[string]string{
+		"testpb.Tree":          " Tree is a recursive message.",
+		"testpb.Tree.children": " Children of the tree.\n Each is a tree itself.",
+	})
+	var b bytes.Buffer
+	if err := DefaultEncoders["yaml"].NewEncoder(&b).Encode(NewSample(&testpb.Tree{})); err != nil {
+		t.Fatal(err)
+	}
+	want := `# Tree is a recursive message.
+# testpb.Tree
+name: string # string
+# Children of the tree.
+# Each is a tree itself.
+children: # repeated testpb.Tree (recursive)
+- {}
+`
+	if got := b.String(); got != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestSampleYAMLOneofAlternatives(t *testing.T) {
+	var b bytes.Buffer
+	if err := DefaultEncoders["yaml"].NewEncoder(&b).Encode(NewSample(&testpb.Kitchen{})); err != nil {
+		t.Fatal(err)
+	}
+	want := `text: string # string, oneof choice
+# choiceItem: # testpb.Item, oneof choice
+#   id: string # string
+#   colors: # repeated enum COLOR_UNSPECIFIED | BLUE
+#   - COLOR_UNSPECIFIED
+`
+	if !bytes.Contains(b.Bytes(), []byte(want)) {
+		t.Errorf("expected oneof alternatives in:\n%s", b.String())
+	}
+}
+
+func TestSampleOutputOnly(t *testing.T) {
+	RegisterOutputOnly("testpb.Kitchen.created_at")
+	var b bytes.Buffer
+	if err := DefaultEncoders["yaml"].NewEncoder(&b).Encode(NewSample(&testpb.Kitchen{})); err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Contains(b.Bytes(), []byte("createdAt")) {
+		t.Errorf("expected no output only fields in:\n%s", b.String())
+	}
+}
@@ -0,0 +1,342 @@
+package iocodec
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/tetratelabs/protoc-gen-cobra/describe"
+)
+
+// Messages are represented in XML with one element per field, named after the
+// field's JSON name. Repeated fields repeat the element, map fields hold one
+// <entry key="..."> element per map entry, and Any values carry their type URL
+// in a type attribute. Struct, Value and ListValue fields hold their JSON text,
+// as do Any values of types with no generated Go type.
+//
+//	<MapListResponse>
+//		<mapField>
+//			<entry key="foo">bar</entry>
+//		</mapField>
+//		<listField>a</listField>
+//		<listField>b</listField>
+//	</MapListResponse>
+
+func encodeXML(e *xml.Encoder, m proto.Message) error {
+	tree, err := marshalTree(m)
+	if err != nil {
+		return err
+	}
+	start := xml.StartElement{Name: xml.Name{Local: messageName(m)}}
+	if err := writeXMLMessage(e, start, tree, reflect.TypeOf(m)); err != nil {
+		return err
+	}
+	return e.Flush()
+}
+
+func writeXMLMessage(e *xml.Encoder, start xml.StartElement, v interface{}, t reflect.Type) error {
+	if wkt := wellKnownType(t); wkt != "" {
+		return writeXMLWellKnown(e, start, v, wkt)
+	}
+	o, ok := v.(object)
+	if !ok {
+		return fmt.Errorf("expected an object for message %s, got %T", t.Elem().Name(), v)
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := writeXMLFields(e, o, t); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+func writeXMLFields(e *xml.Encoder, o object, t reflect.Type) error {
+	fs := fieldsOf(t)
+	for _, mb := range o {
+		f, ok := fs[mb.Name]
+		if !ok {
+			return fmt.Errorf("unknown field %q in %s", mb.Name, t.Elem().Name())
+		}
+		start := xml.StartElement{Name: xml.Name{Local: mb.Name}}
+		switch {
+		case f.typ.Kind() == reflect.Map:
+			if err := e.EncodeToken(start); err != nil {
+				return err
+			}
+			entries, _ := mb.Value.(object)
+			for _, entry := range entries {
+				es := xml.StartElement{
+					Name: xml.Name{Local: "entry"},
+					Attr: []xml.Attr{{Name: xml.Name{Local: "key"}, Value: entry.Name}},
+				}
+				if err := writeXMLValue(e, es, entry.Value, f.typ.Elem()); err != nil {
+					return err
+				}
+			}
+			if err := e.EncodeToken(start.End()); err != nil {
+				return err
+			}
+		case f.typ.Kind() == reflect.Slice && f.typ.Elem().Kind() != reflect.Uint8:
+			items, _ := mb.Value.([]interface{})
+			for _, item := range items {
+				if err := writeXMLValue(e, start, item, f.typ.Elem()); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := writeXMLValue(e, start, mb.Value, f.typ); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func writeXMLValue(e *xml.Encoder, start xml.StartElement, v interface{}, t reflect.Type) error {
+	if isMessage(t) {
+		return writeXMLMessage(e, start, v, t)
+	}
+	return writeXMLText(e, start, v)
+}
+
+func writeXMLWellKnown(e *xml.Encoder, start xml.StartElement, v interface{}, wkt string) error {
+	switch wkt {
+	case "Struct", "Value", "ListValue":
+		b, err := json.Marshal(v)
+		if err != nil {
+			return err
+		}
+		return writeXMLText(e, start, string(b))
+	case "Empty":
+		return writeXMLText(e, start, nil)
+	case "Any":
+		o, ok := v.(object)
+		if !ok || len(o) == 0 || o[0].Name != "@type" {
+			return fmt.Errorf("expected an object with '@type' for Any, got %v", v)
+		}
+		typeURL, _ := o[0].Value.(string)
+		msg, err := resolveAny(typeURL)
+		if err != nil {
+			return err
+		}
+		start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: "type"}, Value: typeURL})
+		if describe.IsDynamic(msg) {
+			// with no Go type to guide the elements, the value is written
+			// in JSON, like a Struct
+			b, err := json.Marshal(o[1:])
+			if err != nil {
+				return err
+			}
+			return writeXMLText(e, start, string(b))
+		}
+		t := reflect.TypeOf(msg)
+		if err := e.EncodeToken(start); err != nil {
+			return err
+		}
+		if inner := wellKnownType(t); inner != "" {
+			var value interface{}
+			for _, mb := range o[1:] {
+				if mb.Name == "value" {
+					value = mb.Value
+				}
+			}
+			err = writeXMLWellKnown(e, xml.StartElement{Name: xml.Name{Local: "value"}}, value, inner)
+		} else {
+			err = writeXMLFields(e, o[1:], t)
+		}
+		if err != nil {
+			return err
+		}
+		return e.EncodeToken(start.End())
+	}
+	return writeXMLText(e, start, v)
+}
+
+func writeXMLText(e *xml.Encoder, start xml.StartElement, v interface{}) error {
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if v != nil {
+		if err := e.EncodeToken(xml.CharData(fmt.Sprint(v))); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// An xmlNode is a parsed XML element.
+type xmlNode struct {
+	name     string
+	attr     []xml.Attr
+	text     string
+	children []*xmlNode
+}
+
+func (n *xmlNode) attrValue(name string) (string, bool) {
+	for _, a := range n.attr {
+		if a.Name.Local == name {
+			return a.Value, true
+		}
+	}
+	return "", false
+}
+
+// readXMLNode reads the next root element from d. It returns io.EOF when
+// there are no elements left.
+func readXMLNode(d *xml.Decoder) (*xmlNode, error) {
+	for {
+		tok, err := d.Token()
+		if err != nil {
+			return nil, err
+		}
+		if start, ok := tok.(xml.StartElement); ok {
+			return readXMLElement(d, start)
+		}
+	}
+}
+
+func readXMLElement(d *xml.Decoder, start xml.StartElement) (*xmlNode, error) {
+	n := &xmlNode{name: start.Name.Local, attr: start.Attr}
+	var text strings.Builder
+	for {
+		tok, err := d.Token()
+		if err != nil {
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			return nil, err
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			c, err := readXMLElement(d, t)
+			if err != nil {
+				return nil, err
+			}
+			n.children = append(n.children, c)
+		case xml.CharData:
+			text.Write(t)
+		case xml.EndElement:
+			n.text = text.String()
+			if len(n.children) > 0 {
+				n.text = strings.TrimSpace(n.text)
+			}
+			return n, nil
+		}
+	}
+}
+
+// xmlMessage maps the element n to the generic form of the message type t,
+// which coerceMessage then turns into its JSON mapping.
+func xmlMessage(n *xmlNode, t reflect.Type) (interface{}, error) {
+	if wkt := wellKnownType(t); wkt != "" {
+		return xmlWellKnown(n, wkt)
+	}
+	fs := fieldsOf(t)
+	out := map[string]interface{}{}
+	for _, c := range n.children {
+		f, ok := fs[c.name]
+		if !ok {
+			return nil, fmt.Errorf("unknown field %q in %s", c.name, t.Elem().Name())
+		}
+		switch {
+		case f.typ.Kind() == reflect.Map:
+			m, _ := out[c.name].(map[string]interface{})
+			if m == nil {
+				m = map[string]interface{}{}
+				out[c.name] = m
+			}
+			for _, entry := range c.children {
+				key, ok := entry.attrValue("key")
+				if !ok {
+					return nil, fmt.Errorf("%s: map entry without a key attribute", c.name)
+				}
+				v, err := xmlValue(entry, f.typ.Elem())
+				if err != nil {
+					return nil, fmt.Errorf("%s[%s]: %v", c.name, key, err)
+				}
+				m[key] = v
+			}
+		case f.typ.Kind() == reflect.Slice && f.typ.Elem().Kind() != reflect.Uint8:
+			v, err := xmlValue(c, f.typ.Elem())
+			if err != nil {
+				return nil, fmt.Errorf("%s: %v", c.name, err)
+			}
+			l, _ := out[c.name].([]interface{})
+			out[c.name] = append(l, v)
+		default:
+			v, err := xmlValue(c, f.typ)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %v", c.name, err)
+			}
+			out[c.name] = v
+		}
+	}
+	return out, nil
+}
+
+func xmlValue(n *xmlNode, t reflect.Type) (interface{}, error) {
+	if isMessage(t) {
+		return xmlMessage(n, t)
+	}
+	return n.text, nil
+}
+
+func xmlWellKnown(n *xmlNode, wkt string) (interface{}, error) {
+	switch wkt {
+	case "Struct", "Value", "ListValue":
+		if strings.TrimSpace(n.text) == "" {
+			return nil, nil
+		}
+		var v interface{}
+		if err := json.Unmarshal([]byte(n.text), &v); err != nil {
+			return nil, fmt.Errorf("bad %s: %v", wkt, err)
+		}
+		return v, nil
+	case "Empty":
+		return map[string]interface{}{}, nil
+	case "Any":
+		typeURL, ok := n.attrValue("type")
+		if !ok {
+			return nil, fmt.Errorf("Any is missing the type attribute")
+		}
+		msg, err := resolveAny(typeURL)
+		if err != nil {
+			return nil, err
+		}
+		if describe.IsDynamic(msg) {
+			v := map[string]interface{}{}
+			if strings.TrimSpace(n.text) != "" {
+				if err := json.Unmarshal([]byte(n.text), &v); err != nil {
+					return nil, fmt.Errorf("bad Any: %v", err)
+				}
+			}
+			v["@type"] = typeURL
+			return v, nil
+		}
+		t := reflect.TypeOf(msg)
+		if inner := wellKnownType(t); inner != "" {
+			var value interface{}
+			for _, c := range n.children {
+				if c.name == "value" {
+					if value, err = xmlWellKnown(c, inner); err != nil {
+						return nil, err
+					}
+				}
+			}
+			return map[string]interface{}{"@type": typeURL, "value": value}, nil
+		}
+		v, err := xmlMessage(n, t)
+		if err != nil {
+			return nil, err
+		}
+		v.(map[string]interface{})["@type"] = typeURL
+		return v, nil
+	}
+	return n.text, nil
+}
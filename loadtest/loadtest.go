@@ -0,0 +1,232 @@
+// Package loadtest calls a method over and over for generated commands, and
+// reports the status codes, throughput and latencies of the calls.
+package loadtest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/pflag"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Options control how many calls are made and how fast.
+type Options struct {
+	Repeat      int
+	Concurrency int
+	Rate        float64
+	Duration    time.Duration
+	Connections int
+}
+
+// AddFlags adds the flags setting the options to fs.
+func (o *Options) AddFlags(fs *pflag.FlagSet) {
+	fs.IntVar(&o.Repeat, "repeat", o.Repeat, "load test: make this many calls")
+	fs.IntVar(&o.Concurrency, "concurrency", o.Concurrency, "load test: number of calls in flight")
+	fs.Float64Var(&o.Rate, "rate", o.Rate, "load test: calls per second, 0 for no limit")
+	fs.DurationVar(&o.Duration, "duration", o.Duration, "load test: make calls for this long")
+	fs.IntVar(&o.Connections, "connections", o.Connections, "load test: number of connections to spread the calls over")
+}
+
+// Enabled reports whether the options ask for a load test: --concurrency and
+// --rate only shape one.
+func (o *Options) Enabled() bool {
+	return o.Repeat > 0 || o.Duration > 0
+}
+
+// A Call makes one call over conn, and returns when it is complete.
+type Call func(ctx context.Context, conn *grpc.ClientConn) error
+
+// Run makes calls until o.Repeat of them are made or o.Duration is over, over
+// conn and the connections it dials, up to o.Connections of them, which are
+// handed out to the concurrent calls in turn, and writes a report of them to
+// w. It fails if any call did.
+func Run(o Options, conn *grpc.ClientConn, dial func() (*grpc.ClientConn, error), call Call, w io.Writer) error {
+	if !o.Enabled() {
+		return errors.New("load test: --repeat or --duration is required")
+	}
+	if o.Concurrency <= 0 {
+		o.Concurrency = 1
+	}
+	if o.Connections <= 0 {
+		o.Connections = 1
+	}
+	conns := []*grpc.ClientConn{conn}
+	defer func() {
+		for _, c := range conns[1:] {
+			c.Close()
+		}
+	}()
+	for len(conns) < o.Connections {
+		c, err := dial()
+		if err != nil {
+			return fmt.Errorf("load test: %v", err)
+		}
+		conns = append(conns, c)
+	}
+
+	ctx := context.Background()
+	if o.Duration > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, o.Duration)
+		defer cancel()
+	}
+	// the calls to make, unless the duration is over first
+	calls := make(chan struct{})
+	go func() {
+		defer close(calls)
+		var tick <-chan time.Time
+		if o.Rate > 0 {
+			ticker := time.NewTicker(time.Duration(float64(time.Second) / o.Rate))
+			defer ticker.Stop()
+			tick = ticker.C
+		}
+		for i := 0; o.Repeat <= 0 || i < o.Repeat; i++ {
+			if tick != nil {
+				select {
+				case <-tick:
+				case <-ctx.Done():
+					return
+				}
+			}
+			select {
+			case calls <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	r := &report{codes: map[codes.Code]int{}}
+	start := time.Now()
+	var wg sync.WaitGroup
+	for i := 0; i < o.Concurrency; i++ {
+		wg.Add(1)
+		go func(conn *grpc.ClientConn) {
+			defer wg.Done()
+			for range calls {
+				t := time.Now()
+				// calls in flight when the duration is over are
+				// allowed to complete; the context is cancelled
+				// after, to release what the call left open
+				ctx, cancel := context.WithCancel(context.Background())
+				err := call(ctx, conn)
+				cancel()
+				r.add(time.Since(t), status.Code(err))
+			}
+		}(conns[i%len(conns)])
+	}
+	wg.Wait()
+	r.elapsed = time.Since(start)
+	r.write(w)
+	if failed := len(r.latencies) - r.codes[codes.OK]; failed > 0 {
+		return fmt.Errorf("load test: %d of %d call(s) failed", failed, len(r.latencies))
+	}
+	return nil
+}
+
+// A report collects the outcomes of the calls.
+type report struct {
+	mu        sync.Mutex
+	latencies []time.Duration
+	codes     map[codes.Code]int
+	elapsed   time.Duration
+}
+
+func (r *report) add(latency time.Duration, code codes.Code) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.latencies = append(r.latencies, latency)
+	r.codes[code]++
+}
+
+// The number of buckets of the latency histogram.
+const buckets = 10
+
+func (r *report) write(out io.Writer) {
+	w := tabwriter.NewWriter(out, 0, 8, 1, ' ', 0)
+	defer w.Flush()
+	n := len(r.latencies)
+	fmt.Fprintf(w, "Summary:\n")
+	fmt.Fprintf(w, "  Calls:\t%d\n", n)
+	fmt.Fprintf(w, "  Duration:\t%v\n", r.elapsed.Round(time.Millisecond))
+	fmt.Fprintf(w, "  Throughput:\t%.2f calls/s\n", float64(n)/r.elapsed.Seconds())
+	if n == 0 {
+		return
+	}
+	sort.Slice(r.latencies, func(i, j int) bool { return r.latencies[i] < r.latencies[j] })
+	var total time.Duration
+	for _, l := range r.latencies {
+		total += l
+	}
+	fmt.Fprintf(w, "  Latency:\tmin %v, mean %v, max %v\n", round(r.latencies[0]), round(total/time.Duration(n)), round(r.latencies[n-1]))
+	fmt.Fprintf(w, "  Percentiles:\tp50 %v, p90 %v, p99 %v\n", round(r.percentile(50)), round(r.percentile(90)), round(r.percentile(99)))
+
+	fmt.Fprintf(w, "\nStatus codes:\n")
+	cs := make([]codes.Code, 0, len(r.codes))
+	for c := range r.codes {
+		cs = append(cs, c)
+	}
+	sort.Slice(cs, func(i, j int) bool { return cs[i] < cs[j] })
+	for _, c := range cs {
+		fmt.Fprintf(w, "  %v:\t%d\n", c, r.codes[c])
+	}
+
+	fmt.Fprintf(w, "\nLatency histogram:\n")
+	min, max := r.latencies[0], r.latencies[n-1]
+	width := (max - min) / buckets
+	counts := make([]int, buckets)
+	for _, l := range r.latencies {
+		b := buckets - 1
+		if width > 0 && int((l-min)/width) < buckets {
+			b = int((l - min) / width)
+		}
+		counts[b]++
+	}
+	most := 0
+	for _, c := range counts {
+		if c > most {
+			most = c
+		}
+	}
+	for i, c := range counts {
+		if width == 0 && i < buckets-1 {
+			continue
+		}
+		// buckets are labelled with their upper bounds
+		bound := min + width*time.Duration(i+1)
+		if i == buckets-1 {
+			bound = max
+		}
+		fmt.Fprintf(w, "  %v\t[%d]\t%s\n", round(bound), c, strings.Repeat("∎", c*40/most))
+	}
+}
+
+// percentile returns the latency below which p percent of the sorted
+// latencies are.
+func (r *report) percentile(p int) time.Duration {
+	i := (len(r.latencies)*p+99)/100 - 1
+	if i < 0 {
+		i = 0
+	}
+	return r.latencies[i]
+}
+
+func round(d time.Duration) time.Duration {
+	switch {
+	case d > time.Second:
+		return d.Round(time.Millisecond)
+	case d > time.Millisecond:
+		return d.Round(time.Microsecond)
+	}
+	return d
+}
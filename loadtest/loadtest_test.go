@@ -0,0 +1,112 @@
+package loadtest
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRunRepeat(t *testing.T) {
+	var calls, inFlight, most int32
+	call := func(ctx context.Context, conn *grpc.ClientConn) error {
+		n := atomic.AddInt32(&calls, 1)
+		in := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			m := atomic.LoadInt32(&most)
+			if in <= m || atomic.CompareAndSwapInt32(&most, m, in) {
+				break
+			}
+		}
+		time.Sleep(time.Millisecond)
+		if n%4 == 0 {
+			return status.Error(codes.Unavailable, "busy")
+		}
+		return nil
+	}
+	var b bytes.Buffer
+	err := Run(Options{Repeat: 20, Concurrency: 3}, nil, nil, call, &b)
+	if err == nil || err.Error() != "load test: 5 of 20 call(s) failed" {
+		t.Errorf("got error %v", err)
+	}
+	if calls != 20 {
+		t.Errorf("got %d calls, want 20", calls)
+	}
+	if most > 3 {
+		t.Errorf("got %d calls in flight, want at most 3", most)
+	}
+	for _, want := range []string{"  Calls:       20\n", "  OK:          15\n", "  Unavailable: 5\n", "  Percentiles: p50 ", "Latency histogram:\n"} {
+		if !strings.Contains(b.String(), want) {
+			t.Errorf("missing %q in:\n%s", want, b.String())
+		}
+	}
+}
+
+func TestRunDurationAndRate(t *testing.T) {
+	var calls int32
+	call := func(ctx context.Context, conn *grpc.ClientConn) error {
+		atomic.AddInt32(&calls, 1)
+		return nil
+	}
+	var b bytes.Buffer
+	if err := Run(Options{Duration: 100 * time.Millisecond, Rate: 100, Concurrency: 4}, nil, nil, call, &b); err != nil {
+		t.Fatal(err)
+	}
+	// about 10 calls, one every 10ms
+	if calls < 5 || calls > 11 {
+		t.Errorf("got %d calls, want about 10", calls)
+	}
+}
+
+func TestRunConnections(t *testing.T) {
+	// connections are made lazily, so nothing needs to listen
+	first, err := grpc.Dial("localhost:1", grpc.WithInsecure())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer first.Close()
+	second, err := grpc.Dial("localhost:1", grpc.WithInsecure())
+	if err != nil {
+		t.Fatal(err)
+	}
+	dialed := 0
+	dial := func() (*grpc.ClientConn, error) {
+		dialed++
+		return second, nil
+	}
+	var onFirst, onSecond int32
+	call := func(ctx context.Context, conn *grpc.ClientConn) error {
+		if conn == first {
+			atomic.AddInt32(&onFirst, 1)
+		} else if conn == second {
+			atomic.AddInt32(&onSecond, 1)
+		}
+		time.Sleep(time.Millisecond)
+		return nil
+	}
+	var b bytes.Buffer
+	if err := Run(Options{Repeat: 10, Concurrency: 2, Connections: 2}, first, dial, call, &b); err != nil {
+		t.Fatal(err)
+	}
+	if dialed != 1 || onFirst == 0 || onSecond == 0 || onFirst+onSecond != 10 {
+		t.Errorf("dialed %d, got %d calls on the first connection and %d on the second", dialed, onFirst, onSecond)
+	}
+}
+
+func TestRunNeedsLimit(t *testing.T) {
+	o := Options{Concurrency: 2, Rate: 10}
+	if o.Enabled() {
+		t.Error("--concurrency and --rate alone enable a load test")
+	}
+	err := Run(o, nil, nil, nil, nil)
+	if err == nil || err.Error() != "load test: --repeat or --duration is required" {
+		t.Errorf("got %v", err)
+	}
+}
@@ -60,6 +60,7 @@ func main() {
 	g.WrapTypes()
 
 	g.SetPackageNames()
+	g.BuildTypeNameMap()
 
 	g.GenerateAllFiles()
 
@@ -0,0 +1,64 @@
+// Package operation waits for the google.longrunning.Operation that methods
+// of long-running APIs return to complete, so their results can be printed
+// instead of the bare operations.
+package operation
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/genproto/googleapis/longrunning"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+
+	"github.com/tetratelabs/protoc-gen-cobra/describe"
+)
+
+// Wait polls op with the Operations.GetOperation method of the server on conn,
+// every interval, until it is done, and returns its result. A timeout of 0
+// waits as long as it takes.
+func Wait(conn *grpc.ClientConn, op *longrunning.Operation, interval, timeout time.Duration) (proto.Message, error) {
+	ctx := context.Background()
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	cli := longrunning.NewOperationsClient(conn)
+	name := op.GetName()
+	for !op.GetDone() {
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("operation %s: %v", name, ctx.Err())
+		case <-time.After(interval):
+		}
+		var err error
+		op, err = cli.GetOperation(ctx, &longrunning.GetOperationRequest{Name: name})
+		if err != nil {
+			return nil, fmt.Errorf("operation %s: %v", name, err)
+		}
+	}
+	return Result(op)
+}
+
+// Result returns the unpacked response of the done operation op, or its
+// error as a gRPC status error. The response type is resolved as the other Any
+// values are, with describe.Resolve, so it needn't be linked in.
+func Result(op *longrunning.Operation) (proto.Message, error) {
+	if err := op.GetError(); err != nil {
+		return nil, status.ErrorProto(err)
+	}
+	if op.GetResponse() == nil {
+		return nil, fmt.Errorf("operation %s: no response", op.GetName())
+	}
+	resp, err := describe.Resolve(op.GetResponse().GetTypeUrl())
+	if err != nil {
+		return nil, fmt.Errorf("operation %s: %v", op.GetName(), err)
+	}
+	if err := proto.Unmarshal(op.GetResponse().GetValue(), resp); err != nil {
+		return nil, fmt.Errorf("operation %s: %v", op.GetName(), err)
+	}
+	return resp, nil
+}
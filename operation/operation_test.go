@@ -0,0 +1,161 @@
+package operation
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	descpb "github.com/golang/protobuf/protoc-gen-go/descriptor"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"google.golang.org/genproto/googleapis/longrunning"
+	spb "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/tetratelabs/protoc-gen-cobra/describe"
+)
+
+// fakeOperations completes each operation after a number of polls.
+type fakeOperations struct {
+	longrunning.OperationsServer
+	polls  int
+	result *longrunning.Operation
+}
+
+func (f *fakeOperations) GetOperation(ctx context.Context, req *longrunning.GetOperationRequest) (*longrunning.Operation, error) {
+	if req.GetName() != f.result.GetName() {
+		return nil, status.Error(codes.NotFound, "no operation "+req.GetName())
+	}
+	if f.polls--; f.polls > 0 {
+		return &longrunning.Operation{Name: req.GetName()}, nil
+	}
+	return f.result, nil
+}
+
+// dial serves ops in process and returns a connection to it, and a function
+// closing both.
+func dial(t *testing.T, ops longrunning.OperationsServer) (*grpc.ClientConn, func()) {
+	lis := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer()
+	longrunning.RegisterOperationsServer(srv, ops)
+	go srv.Serve(lis)
+	conn, err := grpc.Dial("bufnet", grpc.WithInsecure(), grpc.WithDialer(func(string, time.Duration) (net.Conn, error) {
+		return lis.Dial()
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return conn, func() {
+		conn.Close()
+		srv.Stop()
+	}
+}
+
+func TestWaitResponse(t *testing.T) {
+	resp, _ := ptypes.MarshalAny(&wrappers.StringValue{Value: "done"})
+	ops := &fakeOperations{polls: 3, result: &longrunning.Operation{
+		Name: "operations/1", Done: true, Result: &longrunning.Operation_Response{Response: resp},
+	}}
+	conn, done := dial(t, ops)
+	defer done()
+	got, err := Wait(conn, &longrunning.Operation{Name: "operations/1"}, time.Millisecond, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (&wrappers.StringValue{Value: "done"}); !proto.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+	if ops.polls != 0 {
+		t.Errorf("got %d polls left, want 0", ops.polls)
+	}
+}
+
+func TestWaitError(t *testing.T) {
+	ops := &fakeOperations{polls: 1, result: &longrunning.Operation{
+		Name: "operations/1", Done: true,
+		Result: &longrunning.Operation_Error{Error: &spb.Status{Code: int32(codes.ResourceExhausted), Message: "out of quota"}},
+	}}
+	conn, done := dial(t, ops)
+	defer done()
+	_, err := Wait(conn, &longrunning.Operation{Name: "operations/1"}, time.Millisecond, 0)
+	if want := "rpc error: code = ResourceExhausted desc = out of quota"; err == nil || err.Error() != want {
+		t.Errorf("got %v, want %s", err, want)
+	}
+}
+
+func TestWaitTimeout(t *testing.T) {
+	ops := &fakeOperations{polls: 1 << 30, result: &longrunning.Operation{Name: "operations/1", Done: true}}
+	conn, done := dial(t, ops)
+	defer done()
+	_, err := Wait(conn, &longrunning.Operation{Name: "operations/1"}, time.Millisecond, 20*time.Millisecond)
+	if err == nil || !strings.HasPrefix(err.Error(), "operation operations/1: ") {
+		t.Errorf("got %v, want a timeout", err)
+	}
+}
+
+func TestWaitDone(t *testing.T) {
+	// a done operation is not polled
+	resp, _ := ptypes.MarshalAny(&wrappers.Int32Value{Value: 7})
+	op := &longrunning.Operation{Name: "operations/2", Done: true, Result: &longrunning.Operation_Response{Response: resp}}
+	got, err := Wait(nil, op, time.Hour, 0)
+	if err != nil || !proto.Equal(got, &wrappers.Int32Value{Value: 7}) {
+		t.Errorf("got %v, %v", got, err)
+	}
+}
+
+func TestResultOfDynamicType(t *testing.T) {
+	// lro.Report has no Go type; it's known from its registered descriptor
+	set := &descpb.FileDescriptorSet{File: []*descpb.FileDescriptorProto{{
+		Name:    proto.String("report.proto"),
+		Package: proto.String("lro"),
+		Syntax:  proto.String("proto3"),
+		MessageType: []*descpb.DescriptorProto{{
+			Name: proto.String("Report"),
+			Field: []*descpb.FieldDescriptorProto{{
+				Name:     proto.String("title"),
+				JsonName: proto.String("title"),
+				Number:   proto.Int32(1),
+				Label:    descpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+				Type:     descpb.FieldDescriptorProto_TYPE_STRING.Enum(),
+			}},
+		}},
+	}}}
+	b, err := proto.Marshal(set)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var gz bytes.Buffer
+	w := gzip.NewWriter(&gz)
+	w.Write(b)
+	w.Close()
+	describe.Register(gz.Bytes())
+
+	// title: "done"
+	resp := &any.Any{TypeUrl: "type.googleapis.com/lro.Report", Value: []byte("\n\x04done")}
+	got, err := Result(&longrunning.Operation{Name: "operations/3", Done: true, Result: &longrunning.Operation_Response{Response: resp}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	js, err := (&jsonpb.Marshaler{}).MarshalToString(got)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"title":"done"}`; js != want {
+		t.Errorf("got %s, want %s", js, want)
+	}
+
+	resp.TypeUrl = "type.googleapis.com/lro.Missing"
+	if _, err := Result(&longrunning.Operation{Name: "operations/4", Done: true, Result: &longrunning.Operation_Response{Response: resp}}); err == nil {
+		t.Error("expected an error for an unknown type")
+	}
+}
@@ -0,0 +1,243 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: options/cobra.proto
+
+package options
+
+import (
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	descriptor "github.com/golang/protobuf/protoc-gen-go/descriptor"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+// CommandOptions shape the command of a service or method.
+type CommandOptions struct {
+	// The name of the command, instead of the lowercased service or method name.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Other names the command can be called by.
+	Aliases []string `protobuf:"bytes,2,rep,name=aliases,proto3" json:"aliases,omitempty"`
+	// The short description shown in help listings.
+	Short string `protobuf:"bytes,3,opt,name=short,proto3" json:"short,omitempty"`
+	// Hide the command from help listings.
+	Hidden bool `protobuf:"varint,4,opt,name=hidden,proto3" json:"hidden,omitempty"`
+	// Don't generate the command at all.
+	Skip bool `protobuf:"varint,5,opt,name=skip,proto3" json:"skip,omitempty"`
+	// Ask for the command name to be typed, or --yes, before calling the
+	// method, which can't be undone. Only for methods.
+	Destructive          bool     `protobuf:"varint,6,opt,name=destructive,proto3" json:"destructive,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CommandOptions) Reset()         { *m = CommandOptions{} }
+func (m *CommandOptions) String() string { return proto.CompactTextString(m) }
+func (*CommandOptions) ProtoMessage()    {}
+func (*CommandOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed2866317bb1071c, []int{0}
+}
+
+func (m *CommandOptions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CommandOptions.Unmarshal(m, b)
+}
+func (m *CommandOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CommandOptions.Marshal(b, m, deterministic)
+}
+func (m *CommandOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommandOptions.Merge(m, src)
+}
+func (m *CommandOptions) XXX_Size() int {
+	return xxx_messageInfo_CommandOptions.Size(m)
+}
+func (m *CommandOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommandOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommandOptions proto.InternalMessageInfo
+
+func (m *CommandOptions) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CommandOptions) GetAliases() []string {
+	if m != nil {
+		return m.Aliases
+	}
+	return nil
+}
+
+func (m *CommandOptions) GetShort() string {
+	if m != nil {
+		return m.Short
+	}
+	return ""
+}
+
+func (m *CommandOptions) GetHidden() bool {
+	if m != nil {
+		return m.Hidden
+	}
+	return false
+}
+
+func (m *CommandOptions) GetSkip() bool {
+	if m != nil {
+		return m.Skip
+	}
+	return false
+}
+
+func (m *CommandOptions) GetDestructive() bool {
+	if m != nil {
+		return m.Destructive
+	}
+	return false
+}
+
+// FlagOptions shape the flag of a request field.
+type FlagOptions struct {
+	// The name of the flag, instead of the lowercased field name. For message
+	// fields, it is the prefix of the flags of the nested fields.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// A one letter abbreviation of the flag. It can't be one of the letters the
+	// generated commands use (f, h, o, p and s).
+	Shorthand string `protobuf:"bytes,2,opt,name=shorthand,proto3" json:"shorthand,omitempty"`
+	// The default value of the flag, written as it would be on the command line.
+	// It only applies when the request file doesn't set the field.
+	Default string `protobuf:"bytes,3,opt,name=default,proto3" json:"default,omitempty"`
+	// Don't generate a flag for the field, or for the fields nested in it.
+	Skip                 bool     `protobuf:"varint,4,opt,name=skip,proto3" json:"skip,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FlagOptions) Reset()         { *m = FlagOptions{} }
+func (m *FlagOptions) String() string { return proto.CompactTextString(m) }
+func (*FlagOptions) ProtoMessage()    {}
+func (*FlagOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed2866317bb1071c, []int{1}
+}
+
+func (m *FlagOptions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FlagOptions.Unmarshal(m, b)
+}
+func (m *FlagOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FlagOptions.Marshal(b, m, deterministic)
+}
+func (m *FlagOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FlagOptions.Merge(m, src)
+}
+func (m *FlagOptions) XXX_Size() int {
+	return xxx_messageInfo_FlagOptions.Size(m)
+}
+func (m *FlagOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_FlagOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FlagOptions proto.InternalMessageInfo
+
+func (m *FlagOptions) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *FlagOptions) GetShorthand() string {
+	if m != nil {
+		return m.Shorthand
+	}
+	return ""
+}
+
+func (m *FlagOptions) GetDefault() string {
+	if m != nil {
+		return m.Default
+	}
+	return ""
+}
+
+func (m *FlagOptions) GetSkip() bool {
+	if m != nil {
+		return m.Skip
+	}
+	return false
+}
+
+var E_Service = &proto.ExtensionDesc{
+	ExtendedType:  (*descriptor.ServiceOptions)(nil),
+	ExtensionType: (*CommandOptions)(nil),
+	Field:         51237,
+	Name:          "cobra.service",
+	Tag:           "bytes,51237,opt,name=service",
+	Filename:      "options/cobra.proto",
+}
+
+var E_Method = &proto.ExtensionDesc{
+	ExtendedType:  (*descriptor.MethodOptions)(nil),
+	ExtensionType: (*CommandOptions)(nil),
+	Field:         51237,
+	Name:          "cobra.method",
+	Tag:           "bytes,51237,opt,name=method",
+	Filename:      "options/cobra.proto",
+}
+
+var E_Flag = &proto.ExtensionDesc{
+	ExtendedType:  (*descriptor.FieldOptions)(nil),
+	ExtensionType: (*FlagOptions)(nil),
+	Field:         51237,
+	Name:          "cobra.flag",
+	Tag:           "bytes,51237,opt,name=flag",
+	Filename:      "options/cobra.proto",
+}
+
+func init() {
+	proto.RegisterType((*CommandOptions)(nil), "cobra.CommandOptions")
+	proto.RegisterType((*FlagOptions)(nil), "cobra.FlagOptions")
+	proto.RegisterExtension(E_Service)
+	proto.RegisterExtension(E_Method)
+	proto.RegisterExtension(E_Flag)
+}
+
+func init() { proto.RegisterFile("options/cobra.proto", fileDescriptor_ed2866317bb1071c) }
+
+var fileDescriptor_ed2866317bb1071c = []byte{
+	// 344 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xbd, 0x6a, 0xf3, 0x30,
+	0x18, 0x85, 0x71, 0xe2, 0x38, 0x5f, 0x14, 0xf8, 0x06, 0xf5, 0x07, 0x51, 0xfa, 0x63, 0x32, 0x65,
+	0x89, 0x4d, 0xdb, 0x2d, 0x63, 0x0b, 0xe9, 0x54, 0x02, 0xee, 0xd6, 0x4d, 0xb6, 0xde, 0xd8, 0xa2,
+	0xb6, 0x65, 0x24, 0x39, 0xd7, 0xd1, 0x6b, 0x28, 0xf4, 0x3e, 0x4b, 0x5e, 0xd9, 0x4d, 0x4a, 0xa0,
+	0x74, 0xd3, 0x39, 0x47, 0x7e, 0x7c, 0x38, 0x88, 0x9c, 0xa8, 0xc6, 0x4a, 0x55, 0x9b, 0x38, 0x53,
+	0xa9, 0xe6, 0x51, 0xa3, 0x95, 0x55, 0x74, 0x84, 0xe2, 0x22, 0xcc, 0x95, 0xca, 0x4b, 0x88, 0xd1,
+	0x4c, 0xdb, 0x4d, 0x2c, 0xc0, 0x64, 0x5a, 0x36, 0x56, 0x69, 0x77, 0x71, 0xf6, 0xe1, 0x91, 0xff,
+	0x8f, 0xaa, 0xaa, 0x78, 0x2d, 0xd6, 0x8e, 0x43, 0x29, 0xf1, 0x6b, 0x5e, 0x01, 0xf3, 0x42, 0x6f,
+	0x3e, 0x49, 0xf0, 0x4c, 0x19, 0x19, 0xf3, 0x52, 0x72, 0x03, 0x86, 0x0d, 0xc2, 0xe1, 0x7c, 0x92,
+	0xf4, 0x92, 0x9e, 0x92, 0x91, 0x29, 0x94, 0xb6, 0x6c, 0x88, 0xd7, 0x9d, 0xa0, 0xe7, 0x24, 0x28,
+	0xa4, 0x10, 0x50, 0x33, 0x3f, 0xf4, 0xe6, 0xff, 0x92, 0x4e, 0xed, 0xd8, 0xe6, 0x4d, 0x36, 0x6c,
+	0x84, 0x2e, 0x9e, 0x69, 0x48, 0xa6, 0x02, 0x8c, 0xd5, 0x6d, 0x66, 0xe5, 0x16, 0x58, 0x80, 0xd1,
+	0xa1, 0x35, 0xab, 0xc8, 0x74, 0x55, 0xf2, 0xfc, 0xb7, 0x82, 0x97, 0x64, 0x82, 0x7f, 0x2e, 0x78,
+	0x2d, 0xd8, 0x00, 0x83, 0xbd, 0xb1, 0xab, 0x2f, 0x60, 0xc3, 0xdb, 0xb2, 0xaf, 0xd9, 0xcb, 0xef,
+	0x42, 0xfe, 0xbe, 0xd0, 0x32, 0x21, 0x63, 0x03, 0x7a, 0x2b, 0x33, 0xa0, 0x37, 0x91, 0x5b, 0x30,
+	0xea, 0x17, 0x8c, 0x5e, 0x5c, 0xd2, 0x75, 0x61, 0x9f, 0xef, 0x3b, 0xe0, 0xf4, 0xee, 0x2c, 0x72,
+	0xf3, 0xff, 0xdc, 0x32, 0xe9, 0x41, 0xcb, 0x35, 0x09, 0x2a, 0xb0, 0x85, 0x12, 0xf4, 0xfa, 0x08,
+	0xf9, 0x8c, 0xc1, 0x1f, 0x89, 0x1d, 0x66, 0xf9, 0x44, 0xfc, 0x4d, 0xc9, 0x73, 0x7a, 0x75, 0x84,
+	0x5b, 0x49, 0x28, 0x8f, 0x68, 0xb4, 0xa3, 0x1d, 0xec, 0x98, 0x20, 0xe0, 0xe1, 0xf6, 0x35, 0xce,
+	0xa5, 0x2d, 0xda, 0x34, 0xca, 0x54, 0x15, 0x5b, 0xb0, 0x9a, 0x5b, 0x28, 0x79, 0x6a, 0xdc, 0xab,
+	0xc9, 0x16, 0x39, 0xd4, 0x0b, 0xfc, 0x38, 0xee, 0x5e, 0x5a, 0x1a, 0x60, 0x72, 0xff, 0x35, 0x00,
+	0x8a, 0xac, 0xf4, 0x25, 0x7b, 0x02, 0x00, 0x00,
+}
@@ -0,0 +1,597 @@
+// Package override sets the fields of the requests of generated commands from
+// paths, the way Helm's --set flags set chart values, so repeated fields,
+// maps, oneofs and deeply nested messages can be set without a request file.
+//
+// A path names fields by their proto or JSON names, separated by dots, and
+// indexes repeated fields and maps in brackets:
+//
+//	--set 'items[0].labels[env]=prod'
+//
+// Paths are resolved against the descriptor of the request, so values are
+// checked and converted to the type of their field, the missing messages, list
+// entries and map entries on the way are created, and unknown names are
+// reported with the closest known ones.
+package override
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	descpb "github.com/golang/protobuf/protoc-gen-go/descriptor"
+	"github.com/spf13/pflag"
+
+	"github.com/tetratelabs/protoc-gen-cobra/describe"
+	"github.com/tetratelabs/protoc-gen-cobra/iocodec"
+)
+
+// Options hold the assignments of the flags, as path=value.
+type Options struct {
+	Values []string
+	JSON   []string
+	Files  []string
+}
+
+// AddFlags adds the flags setting the options to fs.
+func (o *Options) AddFlags(fs *pflag.FlagSet) {
+	fs.StringArrayVar(&o.Values, "set", o.Values, "set a field of the request, as path=value, such as items[0].labels[env]=prod; repeatable")
+	fs.StringArrayVar(&o.JSON, "set-json", o.JSON, "set a field of the request to a JSON value, as path=json, such as tags=[\"a\",\"b\"]; repeatable")
+	fs.StringArrayVar(&o.Files, "set-file", o.Files, "set a field of the request to the contents of a file, as path=@file; repeatable")
+}
+
+// Enabled reports whether any field is set.
+func (o *Options) Enabled() bool {
+	return len(o.Values) > 0 || len(o.JSON) > 0 || len(o.Files) > 0
+}
+
+// Decoder returns a decoder setting the fields of every message d decodes.
+func (o *Options) Decoder(d iocodec.Decoder) iocodec.Decoder {
+	if !o.Enabled() {
+		return d
+	}
+	return &decoder{d, o}
+}
+
+type decoder struct {
+	iocodec.Decoder
+	o *Options
+}
+
+func (d *decoder) Decode(v interface{}) error {
+	if err := d.Decoder.Decode(v); err != nil {
+		return err
+	}
+	if m, ok := v.(proto.Message); ok {
+		return d.o.Apply(m)
+	}
+	return nil
+}
+
+// Apply sets the fields of m: those of --set first, then those of --set-json
+// and --set-file, each in the order given.
+func (o *Options) Apply(m proto.Message) error {
+	if !o.Enabled() {
+		return nil
+	}
+	return edit(m, func(tree map[string]interface{}, def *describe.Definition) error {
+		for _, a := range []struct {
+			flag    string
+			assigns []string
+			value   func(f *descpb.FieldDescriptorProto, s string) (interface{}, error)
+		}{
+			{"--set", o.Values, scalarValue},
+			{"--set-json", o.JSON, jsonValue},
+			{"--set-file", o.Files, fileValue},
+		} {
+			for _, assign := range a.assigns {
+				i := strings.Index(assign, "=")
+				if i < 0 {
+					return fmt.Errorf("%s %s: want path=value", a.flag, assign)
+				}
+				path, s := assign[:i], assign[i+1:]
+				value := func(f *descpb.FieldDescriptorProto) (interface{}, error) { return a.value(f, s) }
+				if err := set(tree, def, path, value); err != nil {
+					return fmt.Errorf("%s %s: %v", a.flag, path, err)
+				}
+			}
+		}
+		return nil
+	})
+}
+
+// Set sets the field at path of m to s, converted to the type of the field
+// as --set converts it.
+func Set(m proto.Message, path, s string) error {
+	return edit(m, func(tree map[string]interface{}, def *describe.Definition) error {
+		return set(tree, def, path, func(f *descpb.FieldDescriptorProto) (interface{}, error) { return scalarValue(f, s) })
+	})
+}
+
+// SetJSON sets the field at path of m to the JSON value s, as --set-json does.
+func SetJSON(m proto.Message, path, s string) error {
+	return edit(m, func(tree map[string]interface{}, def *describe.Definition) error {
+		return set(tree, def, path, func(f *descpb.FieldDescriptorProto) (interface{}, error) { return jsonValue(f, s) })
+	})
+}
+
+// edit calls fn with the JSON mapping of m and the definition of its type,
+// and sets m to the mapping fn leaves, unless it fails.
+func edit(m proto.Message, fn func(tree map[string]interface{}, def *describe.Definition) error) error {
+	def := describe.Named(proto.MessageName(m))
+	if def == nil {
+		return fmt.Errorf("override: no descriptor of %s", proto.MessageName(m))
+	}
+	var b bytes.Buffer
+	if err := iocodec.Marshaler().Marshal(&b, m); err != nil {
+		return err
+	}
+	tree := map[string]interface{}{}
+	dec := json.NewDecoder(&b)
+	dec.UseNumber()
+	if err := dec.Decode(&tree); err != nil {
+		return err
+	}
+	if err := fn(tree, def); err != nil {
+		return err
+	}
+	js, err := json.Marshal(tree)
+	if err != nil {
+		return err
+	}
+	m.Reset()
+	return iocodec.Unmarshaler().Unmarshal(bytes.NewReader(js), m)
+}
+
+// A segment is a field of a path, with its index or key.
+type segment struct {
+	name  string
+	index *string
+}
+
+func parsePath(path string) ([]segment, error) {
+	var segs []segment
+	for rest := path; ; {
+		end := strings.IndexAny(rest, ".[")
+		if end < 0 {
+			end = len(rest)
+		}
+		seg := segment{name: rest[:end]}
+		if seg.name == "" {
+			return nil, fmt.Errorf("bad path %q: empty field name", path)
+		}
+		rest = rest[end:]
+		if strings.HasPrefix(rest, "[") {
+			end := strings.Index(rest, "]")
+			if end < 0 {
+				return nil, fmt.Errorf("bad path %q: missing ]", path)
+			}
+			index := rest[1:end]
+			seg.index = &index
+			rest = rest[end+1:]
+		}
+		segs = append(segs, seg)
+		if rest == "" {
+			return segs, nil
+		}
+		if rest[0] != '.' {
+			return nil, fmt.Errorf("bad path %q: want . after ]", path)
+		}
+		rest = rest[1:]
+	}
+}
+
+// set sets the field at path of tree, the JSON mapping of a message of def,
+// to the value value returns for the field.
+func set(tree map[string]interface{}, def *describe.Definition, path string, value func(f *descpb.FieldDescriptorProto) (interface{}, error)) error {
+	segs, err := parsePath(path)
+	if err != nil {
+		return err
+	}
+	obj := tree
+	for i, seg := range segs {
+		desc, ok := def.Descriptor.(*descpb.DescriptorProto)
+		if !ok || isWellKnown(def.Name) {
+			return fmt.Errorf("%s is a %s; set it whole with --set-json", strings.Join(pathNames(segs[:i]), "."), def.Name)
+		}
+		f := field(desc, seg.name)
+		if f == nil {
+			return unknownField(def.Name, desc, seg.name)
+		}
+		last := i == len(segs)-1
+		key := f.GetJsonName()
+		if key == "" {
+			key = f.GetName()
+		}
+		clearOneof(obj, desc, f)
+
+		target := f
+		var assign func(v interface{})
+		var child func() map[string]interface{}
+		switch entry := mapEntry(f); {
+		case entry != nil && seg.index != nil:
+			m, _ := obj[key].(map[string]interface{})
+			if m == nil {
+				m = map[string]interface{}{}
+				obj[key] = m
+			}
+			target = entry.Field[1]
+			if err := checkKey(entry.Field[0], *seg.index); err != nil {
+				return fmt.Errorf("%s: bad key: %v", seg.name, err)
+			}
+			k := *seg.index
+			assign = func(v interface{}) { m[k] = v }
+			child = func() map[string]interface{} {
+				c, _ := m[k].(map[string]interface{})
+				if c == nil {
+					c = map[string]interface{}{}
+					m[k] = c
+				}
+				return c
+			}
+		case f.GetLabel() == descpb.FieldDescriptorProto_LABEL_REPEATED && seg.index != nil:
+			n, err := strconv.Atoi(*seg.index)
+			if err != nil || n < 0 {
+				return fmt.Errorf("%s: bad index %q", seg.name, *seg.index)
+			}
+			l, _ := obj[key].([]interface{})
+			for len(l) <= n {
+				// the entries on the way are zero
+				l = append(l, zero(f))
+			}
+			obj[key] = l
+			assign = func(v interface{}) { l[n] = v }
+			child = func() map[string]interface{} {
+				c, _ := l[n].(map[string]interface{})
+				if c == nil {
+					c = map[string]interface{}{}
+					l[n] = c
+				}
+				return c
+			}
+		case seg.index != nil:
+			return fmt.Errorf("%s is neither repeated nor a map, and has no index", seg.name)
+		default:
+			if entry != nil && !last {
+				return fmt.Errorf("%s is a map; give a key, such as %s[key]", seg.name, seg.name)
+			}
+			if f.GetLabel() == descpb.FieldDescriptorProto_LABEL_REPEATED && !last {
+				return fmt.Errorf("%s is repeated; give an index, such as %s[0]", seg.name, seg.name)
+			}
+			assign = func(v interface{}) { obj[key] = v }
+			child = func() map[string]interface{} {
+				c, _ := obj[key].(map[string]interface{})
+				if c == nil {
+					c = map[string]interface{}{}
+					obj[key] = c
+				}
+				return c
+			}
+		}
+		if last {
+			if seg.index == nil && f.GetLabel() == descpb.FieldDescriptorProto_LABEL_REPEATED {
+				// the whole list or map
+				target = nil
+			}
+			v, err := value(target)
+			if err != nil {
+				return err
+			}
+			assign(v)
+			return nil
+		}
+		if target.GetType() != descpb.FieldDescriptorProto_TYPE_MESSAGE {
+			return fmt.Errorf("%s is a %s, with no fields", strings.Join(pathNames(segs[:i+1]), "."), typeName(target))
+		}
+		if name := typeName(target); isWellKnown(name) {
+			return fmt.Errorf("%s is a %s; set it whole with --set-json", strings.Join(pathNames(segs[:i+1]), "."), name)
+		}
+		if def = describe.Named(target.GetTypeName()); def == nil {
+			return fmt.Errorf("no descriptor of %s", strings.TrimPrefix(target.GetTypeName(), "."))
+		}
+		obj = child()
+	}
+	return nil
+}
+
+func pathNames(segs []segment) []string {
+	names := make([]string, len(segs))
+	for i, s := range segs {
+		names[i] = s.name
+		if s.index != nil {
+			names[i] += "[" + *s.index + "]"
+		}
+	}
+	return names
+}
+
+// field returns the field of desc with the proto or JSON name, or nil.
+func field(desc *descpb.DescriptorProto, name string) *descpb.FieldDescriptorProto {
+	for _, f := range desc.Field {
+		if f.GetName() == name || f.GetJsonName() == name {
+			return f
+		}
+	}
+	return nil
+}
+
+func mapEntry(f *descpb.FieldDescriptorProto) *descpb.DescriptorProto {
+	if f.GetType() != descpb.FieldDescriptorProto_TYPE_MESSAGE || f.GetLabel() != descpb.FieldDescriptorProto_LABEL_REPEATED {
+		return nil
+	}
+	if d := describe.Named(f.GetTypeName()); d != nil {
+		if entry, ok := d.Descriptor.(*descpb.DescriptorProto); ok && entry.GetOptions().GetMapEntry() {
+			return entry
+		}
+	}
+	return nil
+}
+
+// clearOneof removes the other members of the oneof of f from obj, as setting
+// f clears them.
+func clearOneof(obj map[string]interface{}, desc *descpb.DescriptorProto, f *descpb.FieldDescriptorProto) {
+	if f.OneofIndex == nil {
+		return
+	}
+	for _, o := range desc.Field {
+		if o != f && o.OneofIndex != nil && o.GetOneofIndex() == f.GetOneofIndex() {
+			delete(obj, o.GetJsonName())
+			delete(obj, o.GetName())
+		}
+	}
+}
+
+// isWellKnown reports whether the message name has a JSON mapping of its own,
+// so its fields can't be set one by one.
+func isWellKnown(name string) bool {
+	return strings.HasPrefix(name, "google.protobuf.")
+}
+
+func typeName(f *descpb.FieldDescriptorProto) string {
+	if f.GetTypeName() != "" {
+		return strings.TrimPrefix(f.GetTypeName(), ".")
+	}
+	return strings.ToLower(strings.TrimPrefix(f.GetType().String(), "TYPE_"))
+}
+
+// zero returns the JSON mapping of the zero value of the repeated field f.
+func zero(f *descpb.FieldDescriptorProto) interface{} {
+	switch f.GetType() {
+	case descpb.FieldDescriptorProto_TYPE_MESSAGE:
+		if isWellKnown(typeName(f)) {
+			return nil
+		}
+		return map[string]interface{}{}
+	case descpb.FieldDescriptorProto_TYPE_STRING, descpb.FieldDescriptorProto_TYPE_BYTES:
+		return ""
+	case descpb.FieldDescriptorProto_TYPE_BOOL:
+		return false
+	}
+	return json.Number("0")
+}
+
+func checkKey(f *descpb.FieldDescriptorProto, key string) error {
+	if f.GetType() == descpb.FieldDescriptorProto_TYPE_STRING {
+		return nil
+	}
+	_, err := scalarValue(f, key)
+	return err
+}
+
+// scalarValue returns the JSON mapping of the text s as a value of the field
+// f. Bytes take s as is.
+func scalarValue(f *descpb.FieldDescriptorProto, s string) (interface{}, error) {
+	if f == nil {
+		return nil, fmt.Errorf("can't set a whole list or map to %q; give an index, or use --set-json", s)
+	}
+	switch f.GetType() {
+	case descpb.FieldDescriptorProto_TYPE_STRING:
+		return s, nil
+	case descpb.FieldDescriptorProto_TYPE_BYTES:
+		return base64.StdEncoding.EncodeToString([]byte(s)), nil
+	case descpb.FieldDescriptorProto_TYPE_BOOL:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return nil, fmt.Errorf("%q isn't a bool", s)
+		}
+		return b, nil
+	case descpb.FieldDescriptorProto_TYPE_FLOAT, descpb.FieldDescriptorProto_TYPE_DOUBLE:
+		switch s {
+		case "NaN", "Infinity", "-Infinity":
+			return s, nil
+		}
+		bits := 64
+		if f.GetType() == descpb.FieldDescriptorProto_TYPE_FLOAT {
+			bits = 32
+		}
+		if _, err := strconv.ParseFloat(s, bits); err != nil {
+			return nil, fmt.Errorf("%q isn't a %s", s, typeName(f))
+		}
+		return json.Number(s), nil
+	case descpb.FieldDescriptorProto_TYPE_INT32, descpb.FieldDescriptorProto_TYPE_SINT32, descpb.FieldDescriptorProto_TYPE_SFIXED32:
+		if _, err := strconv.ParseInt(s, 10, 32); err != nil {
+			return nil, fmt.Errorf("%q isn't an %s", s, typeName(f))
+		}
+		return json.Number(s), nil
+	case descpb.FieldDescriptorProto_TYPE_UINT32, descpb.FieldDescriptorProto_TYPE_FIXED32:
+		if _, err := strconv.ParseUint(s, 10, 32); err != nil {
+			return nil, fmt.Errorf("%q isn't a %s", s, typeName(f))
+		}
+		return json.Number(s), nil
+	case descpb.FieldDescriptorProto_TYPE_INT64, descpb.FieldDescriptorProto_TYPE_SINT64, descpb.FieldDescriptorProto_TYPE_SFIXED64:
+		if _, err := strconv.ParseInt(s, 10, 64); err != nil {
+			return nil, fmt.Errorf("%q isn't an %s", s, typeName(f))
+		}
+		return s, nil
+	case descpb.FieldDescriptorProto_TYPE_UINT64, descpb.FieldDescriptorProto_TYPE_FIXED64:
+		if _, err := strconv.ParseUint(s, 10, 64); err != nil {
+			return nil, fmt.Errorf("%q isn't a %s", s, typeName(f))
+		}
+		return s, nil
+	case descpb.FieldDescriptorProto_TYPE_ENUM:
+		return enumValue(f, s)
+	case descpb.FieldDescriptorProto_TYPE_MESSAGE:
+		return wellKnownValue(f, s)
+	}
+	return nil, fmt.Errorf("can't set a %s", typeName(f))
+}
+
+func enumValue(f *descpb.FieldDescriptorProto, s string) (interface{}, error) {
+	def := describe.Named(f.GetTypeName())
+	if def == nil {
+		return nil, fmt.Errorf("no descriptor of %s", typeName(f))
+	}
+	e := def.Descriptor.(*descpb.EnumDescriptorProto)
+	var names []string
+	for _, v := range e.Value {
+		if v.GetName() == s || strconv.Itoa(int(v.GetNumber())) == s {
+			return v.GetName(), nil
+		}
+		names = append(names, v.GetName())
+	}
+	return nil, fmt.Errorf("unknown value %q of %s%s", s, def.Name, suggest(s, names))
+}
+
+// wellKnownValue returns the JSON mapping of the text s as a value of the
+// well-known message type of f.
+func wellKnownValue(f *descpb.FieldDescriptorProto, s string) (interface{}, error) {
+	switch name := typeName(f); name {
+	case "google.protobuf.Timestamp", "google.protobuf.Duration", "google.protobuf.FieldMask", "google.protobuf.StringValue":
+		return s, nil
+	case "google.protobuf.BytesValue":
+		return base64.StdEncoding.EncodeToString([]byte(s)), nil
+	case "google.protobuf.BoolValue", "google.protobuf.Int32Value", "google.protobuf.UInt32Value",
+		"google.protobuf.Int64Value", "google.protobuf.UInt64Value", "google.protobuf.FloatValue", "google.protobuf.DoubleValue":
+		// as the value field of the wrapper
+		kind := strings.ToUpper(strings.TrimSuffix(strings.TrimPrefix(name, "google.protobuf."), "Value"))
+		return scalarValue(&descpb.FieldDescriptorProto{Type: descpb.FieldDescriptorProto_Type(descpb.FieldDescriptorProto_Type_value["TYPE_"+kind]).Enum()}, s)
+	case "google.protobuf.Value":
+		// JSON, or else a string
+		var v interface{}
+		if err := unmarshalJSON(s, &v); err == nil {
+			return v, nil
+		}
+		return s, nil
+	}
+	return nil, fmt.Errorf("a %s has fields; set them one by one, or set it whole with --set-json", typeName(f))
+}
+
+func unmarshalJSON(s string, v interface{}) error {
+	dec := json.NewDecoder(strings.NewReader(s))
+	dec.UseNumber()
+	if err := dec.Decode(v); err != nil {
+		return err
+	}
+	if dec.More() {
+		return fmt.Errorf("more than one JSON value in %q", s)
+	}
+	return nil
+}
+
+// jsonValue returns the JSON value s, which jsonpb checks against f later.
+func jsonValue(f *descpb.FieldDescriptorProto, s string) (interface{}, error) {
+	var v interface{}
+	if err := unmarshalJSON(s, &v); err != nil {
+		return nil, fmt.Errorf("bad JSON: %v", err)
+	}
+	return v, nil
+}
+
+// fileValue returns the contents of the file s names, with an @ prefix or
+// not: as is for strings and bytes, and as JSON otherwise.
+func fileValue(f *descpb.FieldDescriptorProto, s string) (interface{}, error) {
+	b, err := ioutil.ReadFile(strings.TrimPrefix(s, "@"))
+	if err != nil {
+		return nil, err
+	}
+	if f != nil {
+		switch f.GetType() {
+		case descpb.FieldDescriptorProto_TYPE_STRING:
+			return string(b), nil
+		case descpb.FieldDescriptorProto_TYPE_BYTES:
+			return base64.StdEncoding.EncodeToString(b), nil
+		}
+	}
+	return jsonValue(f, string(b))
+}
+
+func unknownField(name string, desc *descpb.DescriptorProto, field string) error {
+	var names []string
+	for _, f := range desc.Field {
+		names = append(names, f.GetName())
+	}
+	return fmt.Errorf("unknown field %q in %s%s", field, name, suggest(field, names))
+}
+
+// suggest returns the names closest to s, or all of them when none is close,
+// to append to an error.
+func suggest(s string, names []string) string {
+	if len(names) == 0 {
+		return ""
+	}
+	best := math.MaxInt32
+	var closest []string
+	for _, n := range names {
+		d := distance(strings.ToLower(s), strings.ToLower(n))
+		if strings.EqualFold(strings.Replace(n, "_", "", -1), strings.Replace(s, "_", "", -1)) {
+			d = 0
+		}
+		switch {
+		case d < best:
+			best, closest = d, []string{n}
+		case d == best:
+			closest = append(closest, n)
+		}
+	}
+	if best <= 2 || best <= len(s)/3 {
+		return fmt.Sprintf("; did you mean %s?", quoteAll(closest, " or "))
+	}
+	sorted := append([]string(nil), names...)
+	sort.Strings(sorted)
+	return fmt.Sprintf("; want one of %s", quoteAll(sorted, ", "))
+}
+
+func quoteAll(names []string, sep string) string {
+	quoted := make([]string, len(names))
+	for i, n := range names {
+		quoted[i] = strconv.Quote(n)
+	}
+	return strings.Join(quoted, sep)
+}
+
+// distance returns the Levenshtein distance between a and b.
+func distance(a, b string) int {
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = min3(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(b)]
+}
+
+func min3(a, b, c int) int {
+	if b < a {
+		a = b
+	}
+	if c < a {
+		a = c
+	}
+	return a
+}
@@ -0,0 +1,183 @@
+package override
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	descpb "github.com/golang/protobuf/protoc-gen-go/descriptor"
+	_ "github.com/golang/protobuf/ptypes/duration" // a field of orders.Order
+
+	"github.com/tetratelabs/protoc-gen-cobra/describe"
+)
+
+func newField(name, jsonName string, number int32, typ descpb.FieldDescriptorProto_Type, typeName string, repeated bool) *descpb.FieldDescriptorProto {
+	f := &descpb.FieldDescriptorProto{
+		Name:     proto.String(name),
+		JsonName: proto.String(jsonName),
+		Number:   proto.Int32(number),
+		Label:    descpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+		Type:     typ.Enum(),
+	}
+	if typeName != "" {
+		f.TypeName = proto.String(typeName)
+	}
+	if repeated {
+		f.Label = descpb.FieldDescriptorProto_LABEL_REPEATED.Enum()
+	}
+	return f
+}
+
+func init() {
+	// orders.Order has no Go type; its messages are dynamic
+	card := newField("card", "card", 4, descpb.FieldDescriptorProto_TYPE_STRING, "", false)
+	card.OneofIndex = proto.Int32(0)
+	voucher := newField("voucher_code", "voucherCode", 5, descpb.FieldDescriptorProto_TYPE_STRING, "", false)
+	voucher.OneofIndex = proto.Int32(0)
+	set := &descpb.FileDescriptorSet{File: []*descpb.FileDescriptorProto{{
+		Name:    proto.String("orders.proto"),
+		Package: proto.String("orders"),
+		Syntax:  proto.String("proto3"),
+		MessageType: []*descpb.DescriptorProto{{
+			Name: proto.String("Order"),
+			Field: []*descpb.FieldDescriptorProto{
+				newField("id", "id", 1, descpb.FieldDescriptorProto_TYPE_STRING, "", false),
+				newField("items", "items", 2, descpb.FieldDescriptorProto_TYPE_MESSAGE, ".orders.Item", true),
+				newField("labels", "labels", 3, descpb.FieldDescriptorProto_TYPE_MESSAGE, ".orders.Order.LabelsEntry", true),
+				card,
+				voucher,
+				newField("total", "total", 6, descpb.FieldDescriptorProto_TYPE_INT64, "", false),
+				newField("ttl", "ttl", 7, descpb.FieldDescriptorProto_TYPE_MESSAGE, ".google.protobuf.Duration", false),
+				newField("note", "note", 8, descpb.FieldDescriptorProto_TYPE_BYTES, "", false),
+			},
+			NestedType: []*descpb.DescriptorProto{{
+				Name: proto.String("LabelsEntry"),
+				Field: []*descpb.FieldDescriptorProto{
+					newField("key", "key", 1, descpb.FieldDescriptorProto_TYPE_STRING, "", false),
+					newField("value", "value", 2, descpb.FieldDescriptorProto_TYPE_STRING, "", false),
+				},
+				Options: &descpb.MessageOptions{MapEntry: proto.Bool(true)},
+			}},
+			OneofDecl: []*descpb.OneofDescriptorProto{{Name: proto.String("payment")}},
+		}, {
+			Name: proto.String("Item"),
+			Field: []*descpb.FieldDescriptorProto{
+				newField("sku", "sku", 1, descpb.FieldDescriptorProto_TYPE_STRING, "", false),
+				newField("quantity", "quantity", 2, descpb.FieldDescriptorProto_TYPE_INT32, "", false),
+				newField("size", "size", 3, descpb.FieldDescriptorProto_TYPE_ENUM, ".orders.Size", false),
+				newField("gift", "gift", 4, descpb.FieldDescriptorProto_TYPE_BOOL, "", false),
+			},
+		}},
+		EnumType: []*descpb.EnumDescriptorProto{{
+			Name: proto.String("Size"),
+			Value: []*descpb.EnumValueDescriptorProto{
+				{Name: proto.String("SMALL"), Number: proto.Int32(0)},
+				{Name: proto.String("LARGE"), Number: proto.Int32(1)},
+			},
+		}},
+	}}}
+	b, err := proto.Marshal(set)
+	if err != nil {
+		panic(err)
+	}
+	var gz bytes.Buffer
+	w := gzip.NewWriter(&gz)
+	w.Write(b)
+	w.Close()
+	describe.Register(gz.Bytes())
+}
+
+// apply applies o to an order read from the JSON in, and returns the JSON of
+// the order.
+func apply(t *testing.T, o Options, in string) (string, error) {
+	m, err := describe.Resolve("orders.Order")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := jsonpb.UnmarshalString(in, m); err != nil {
+		t.Fatal(err)
+	}
+	if err := o.Apply(m); err != nil {
+		return "", err
+	}
+	return (&jsonpb.Marshaler{}).MarshalToString(m)
+}
+
+func TestApply(t *testing.T) {
+	dir, err := ioutil.TempDir("", "override")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	note := filepath.Join(dir, "note.txt")
+	if err := ioutil.WriteFile(note, []byte("thanks"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tc := range []struct {
+		name string
+		o    Options
+		in   string
+		want string
+	}{
+		{"scalar", Options{Values: []string{"id=42", "total=9007199254740993"}}, `{}`, `{"id":"42","total":"9007199254740993"}`},
+		{"over the request", Options{Values: []string{"id=2"}}, `{"id":"1","total":"3"}`, `{"id":"2","total":"3"}`},
+		{"list entries", Options{Values: []string{"items[1].sku=b", "items[0].quantity=2", "items[1].size=LARGE", "items[1].gift=true"}}, `{}`,
+			`{"items":[{"quantity":2},{"sku":"b","size":"LARGE","gift":true}]}`},
+		{"list entry of the request", Options{Values: []string{"items[0].quantity=5"}}, `{"items":[{"sku":"a"}]}`, `{"items":[{"sku":"a","quantity":5}]}`},
+		{"enum number", Options{Values: []string{"items[0].size=1"}}, `{}`, `{"items":[{"size":"LARGE"}]}`},
+		{"map", Options{Values: []string{"labels[app.kubernetes.io/name]=shop", "labels[env]=prod"}}, `{"labels":{"env":"dev"}}`,
+			`{"labels":{"app.kubernetes.io/name":"shop","env":"prod"}}`},
+		{"oneof", Options{Values: []string{"voucherCode=FREE"}}, `{"card":"1234"}`, `{"voucherCode":"FREE"}`},
+		{"proto name", Options{Values: []string{"voucher_code=FREE"}}, `{}`, `{"voucherCode":"FREE"}`},
+		{"well-known", Options{Values: []string{"ttl=1.5s"}}, `{}`, `{"ttl":"1.500s"}`},
+		{"bytes", Options{Values: []string{"note=hi"}}, `{}`, `{"note":"aGk="}`},
+		{"json", Options{JSON: []string{`items=[{"sku":"a"},{"sku":"b"}]`, `labels={"a":"b"}`}}, `{"items":[{"sku":"x"}]}`,
+			`{"items":[{"sku":"a"},{"sku":"b"}],"labels":{"a":"b"}}`},
+		{"json after set", Options{Values: []string{"items[0].sku=x"}, JSON: []string{`items[0]={"sku":"y"}`}}, `{}`, `{"items":[{"sku":"y"}]}`},
+		{"file", Options{Files: []string{"note=@" + note, "id=" + note}}, `{}`, `{"id":"thanks","note":"dGhhbmtz"}`},
+	} {
+		got, err := apply(t, tc.o, tc.in)
+		if err != nil {
+			t.Errorf("%s: %v", tc.name, err)
+			continue
+		}
+		if got != tc.want {
+			t.Errorf("%s: got %s, want %s", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestApplyErrors(t *testing.T) {
+	for _, tc := range []struct {
+		o    Options
+		want string
+	}{
+		{Options{Values: []string{"itmes[0].sku=a"}}, `--set itmes[0].sku: unknown field "itmes" in orders.Order; did you mean "items"?`},
+		{Options{Values: []string{"items[0].colour=red"}}, `--set items[0].colour: unknown field "colour" in orders.Item; want one of "gift", "quantity", "size", "sku"`},
+		{Options{Values: []string{"items[0].size=LARGER"}}, `unknown value "LARGER" of orders.Size; did you mean "LARGE"?`},
+		{Options{Values: []string{"items[0].quantity=many"}}, `"many" isn't an int32`},
+		{Options{Values: []string{"items.sku=a"}}, `items is repeated; give an index, such as items[0]`},
+		{Options{Values: []string{"labels.env=a"}}, `labels is a map; give a key, such as labels[key]`},
+		{Options{Values: []string{"items=a"}}, `can't set a whole list or map to "a"; give an index, or use --set-json`},
+		{Options{Values: []string{"items[-1].sku=a"}}, `items: bad index "-1"`},
+		{Options{Values: []string{"id[0]=a"}}, `id is neither repeated nor a map, and has no index`},
+		{Options{Values: []string{"id.x=a"}}, `id is a string, with no fields`},
+		{Options{Values: []string{"ttl.seconds=1"}}, `ttl is a google.protobuf.Duration; set it whole with --set-json`},
+		{Options{Values: []string{"items[0]=a"}}, `a orders.Item has fields; set them one by one, or set it whole with --set-json`},
+		{Options{Values: []string{"id"}}, `--set id: want path=value`},
+		{Options{Values: []string{"items[0.sku=a"}}, `bad path "items[0.sku": missing ]`},
+		{Options{JSON: []string{"id={"}}, `--set-json id: bad JSON`},
+	} {
+		_, err := apply(t, tc.o, `{}`)
+		if err == nil || !strings.Contains(err.Error(), tc.want) {
+			t.Errorf("%v: got %v, want %s", tc.o, err, tc.want)
+		}
+	}
+}
@@ -0,0 +1,34 @@
+// Package pagination follows the page tokens of AIP-158 list methods for the
+// --all and --max-pages flags of their generated commands, writing the
+// results of every page one by one, as if the method were server streaming.
+package pagination
+
+import (
+	"github.com/golang/protobuf/proto"
+
+	"github.com/tetratelabs/protoc-gen-cobra/iocodec"
+)
+
+// A Lister calls a list method for the page of token, and returns its results
+// and the token of the next page, which is empty after the last one.
+type Lister func(token string) ([]proto.Message, string, error)
+
+// Follow writes the results of the pages list returns to out, from the page of
+// token to the last one, or to the first maxPages of them if it's more than 0.
+func Follow(list Lister, token string, maxPages int, out iocodec.Encoder) error {
+	for pages := 1; ; pages++ {
+		items, next, err := list(token)
+		if err != nil {
+			return err
+		}
+		for _, item := range items {
+			if err := out.Encode(item); err != nil {
+				return err
+			}
+		}
+		if next == "" || pages == maxPages {
+			return nil
+		}
+		token = next
+	}
+}
@@ -0,0 +1,83 @@
+package pagination
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/wrappers"
+)
+
+// fakeServer serves its pages of results, each under the token of the page
+// before it, and records the tokens it's called with.
+type fakeServer struct {
+	pages  [][]string
+	tokens []string
+}
+
+func (f *fakeServer) list(token string) ([]proto.Message, string, error) {
+	f.tokens = append(f.tokens, token)
+	i := 0
+	if token != "" {
+		if !strings.HasPrefix(token, "page-") || len(token) != 6 {
+			return nil, "", errors.New("invalid page token " + token)
+		}
+		i = int(token[5] - '0')
+	}
+	var items []proto.Message
+	for _, s := range f.pages[i] {
+		items = append(items, &wrappers.StringValue{Value: s})
+	}
+	next := ""
+	if i+1 < len(f.pages) {
+		next = "page-" + string('0'+rune(i+1))
+	}
+	return items, next, nil
+}
+
+type collector []string
+
+func (c *collector) Encode(v interface{}) error {
+	*c = append(*c, v.(*wrappers.StringValue).GetValue())
+	return nil
+}
+
+func TestFollow(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		token    string
+		maxPages int
+		items    string
+		tokens   string
+	}{
+		{"all", "", 0, "a b c d e", ",page-1,page-2"},
+		{"max pages", "", 2, "a b c", ",page-1"},
+		{"more max pages than pages", "", 5, "a b c d e", ",page-1,page-2"},
+		{"from a token", "page-1", 0, "c d e", "page-1,page-2"},
+		{"from a token with max pages", "page-1", 1, "c", "page-1"},
+	} {
+		server := &fakeServer{pages: [][]string{{"a", "b"}, {"c"}, {"d", "e"}}}
+		var out collector
+		if err := Follow(server.list, tc.token, tc.maxPages, &out); err != nil {
+			t.Fatalf("%s: %v", tc.name, err)
+		}
+		if got := strings.Join(out, " "); got != tc.items {
+			t.Errorf("%s: got items %q, want %q", tc.name, got, tc.items)
+		}
+		if got := strings.Join(server.tokens, ","); got != tc.tokens {
+			t.Errorf("%s: got tokens %q, want %q", tc.name, got, tc.tokens)
+		}
+	}
+}
+
+func TestFollowFailure(t *testing.T) {
+	server := &fakeServer{pages: [][]string{{"a"}, {"b"}}}
+	var out collector
+	if err := Follow(server.list, "bogus", 0, &out); err == nil || err.Error() != "invalid page token bogus" {
+		t.Errorf("got %v", err)
+	}
+	if len(out) != 0 {
+		t.Errorf("got items %q", out)
+	}
+}
@@ -0,0 +1,227 @@
+// Package prompting asks for the requests of generated commands on the
+// terminal, when they're run by hand without all of their fields: it walks
+// the descriptor of the request, asking for each top-level field it doesn't
+// set, with the type of the field, and then for confirmation of the request.
+//
+// Answers are checked and converted to the type of their field as --set and
+// --set-json convert theirs; enums are chosen from a menu. Fields annotated
+// with (google.api.field_behavior) = REQUIRED must be answered, the others can
+// be skipped, and output only fields aren't asked for.
+package prompting
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	descpb "github.com/golang/protobuf/protoc-gen-go/descriptor"
+	"golang.org/x/crypto/ssh/terminal"
+	"google.golang.org/genproto/googleapis/api/annotations"
+
+	"github.com/tetratelabs/protoc-gen-cobra/describe"
+	"github.com/tetratelabs/protoc-gen-cobra/iocodec"
+	"github.com/tetratelabs/protoc-gen-cobra/override"
+)
+
+// ErrDeclined is returned when the request isn't confirmed.
+var ErrDeclined = errors.New("the request wasn't sent")
+
+// IsTerminal reports whether f is a terminal, rather than a file, a pipe or a
+// device like /dev/null.
+func IsTerminal(f *os.File) bool {
+	return terminal.IsTerminal(int(f.Fd()))
+}
+
+// A Prompter asks questions on out and reads the answers from in, a line each.
+type Prompter struct {
+	in  *bufio.Reader
+	out io.Writer
+}
+
+// New returns a prompter asking on out and reading from in.
+func New(in io.Reader, out io.Writer) *Prompter {
+	return &Prompter{in: bufio.NewReader(in), out: out}
+}
+
+// Fields asks for the top-level fields m doesn't set, in the order of its
+// descriptor, and sets them. The members of oneofs are asked for until one is
+// set.
+func (p *Prompter) Fields(m proto.Message) error {
+	def := describe.Named(proto.MessageName(m))
+	if def == nil {
+		return fmt.Errorf("prompting: no descriptor of %s", proto.MessageName(m))
+	}
+	desc, ok := def.Descriptor.(*descpb.DescriptorProto)
+	if !ok {
+		return fmt.Errorf("prompting: %s isn't a message", def.Name)
+	}
+	for _, f := range desc.Field {
+		if describe.HasBehavior(f, annotations.FieldBehavior_OUTPUT_ONLY) {
+			continue
+		}
+		set, err := setFields(m)
+		if err != nil {
+			return err
+		}
+		if set[f.GetJsonName()] || set[f.GetName()] || oneofSet(desc, f, set) {
+			continue
+		}
+		if err := p.field(m, f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// field asks for f until it's answered validly, or skipped when it may be.
+func (p *Prompter) field(m proto.Message, f *descpb.FieldDescriptorProto) error {
+	required := describe.HasBehavior(f, annotations.FieldBehavior_REQUIRED)
+	var choices []string
+	if f.GetType() == descpb.FieldDescriptorProto_TYPE_ENUM && f.GetLabel() != descpb.FieldDescriptorProto_LABEL_REPEATED {
+		choices = enumValues(f.GetTypeName())
+	}
+	kind, isJSON := describeType(f)
+	if required {
+		kind += ", required"
+	}
+	if choices != nil {
+		fmt.Fprintf(p.out, "%s (%s):\n", f.GetName(), kind)
+		for i, c := range choices {
+			fmt.Fprintf(p.out, "  %d) %s\n", i+1, c)
+		}
+	}
+	for {
+		if choices != nil {
+			fmt.Fprintf(p.out, "%s [1-%d]: ", f.GetName(), len(choices))
+		} else {
+			fmt.Fprintf(p.out, "%s (%s): ", f.GetName(), kind)
+		}
+		answer, err := p.line()
+		if err != nil {
+			return fmt.Errorf("no answer for %s: %v", f.GetName(), err)
+		}
+		if answer == "" {
+			if !required {
+				return nil
+			}
+			fmt.Fprintf(p.out, "  %s is required\n", f.GetName())
+			continue
+		}
+		if n, err := strconv.Atoi(answer); err == nil && n >= 1 && n <= len(choices) {
+			answer = choices[n-1]
+		}
+		if isJSON {
+			err = override.SetJSON(m, f.GetName(), answer)
+		} else {
+			err = override.Set(m, f.GetName(), answer)
+		}
+		if err == nil {
+			return nil
+		}
+		fmt.Fprintf(p.out, "  %v\n", err)
+	}
+}
+
+// Confirm prints m, encoded by em, and asks whether to send it. It returns
+// ErrDeclined unless the answer is yes.
+func (p *Prompter) Confirm(m proto.Message, em iocodec.EncoderMaker) error {
+	if err := em.NewEncoder(p.out).Encode(m); err != nil {
+		return err
+	}
+	fmt.Fprint(p.out, "Send the request? [y/N]: ")
+	answer, err := p.line()
+	if err != nil {
+		return ErrDeclined
+	}
+	switch strings.ToLower(answer) {
+	case "y", "yes":
+		return nil
+	}
+	return ErrDeclined
+}
+
+// line reads an answer, without the surrounding space.
+func (p *Prompter) line() (string, error) {
+	s, err := p.in.ReadString('\n')
+	if err == io.EOF && s != "" {
+		err = nil
+	}
+	return strings.TrimSpace(s), err
+}
+
+// setFields returns the names of the fields m sets, in its JSON mapping.
+func setFields(m proto.Message) (map[string]bool, error) {
+	var b bytes.Buffer
+	if err := iocodec.Marshaler().Marshal(&b, m); err != nil {
+		return nil, err
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(b.Bytes(), &fields); err != nil {
+		return nil, err
+	}
+	set := map[string]bool{}
+	for name := range fields {
+		set[name] = true
+	}
+	return set, nil
+}
+
+// oneofSet reports whether another member of the oneof of f is set.
+func oneofSet(desc *descpb.DescriptorProto, f *descpb.FieldDescriptorProto, set map[string]bool) bool {
+	if f.OneofIndex == nil {
+		return false
+	}
+	for _, o := range desc.Field {
+		if o.OneofIndex != nil && o.GetOneofIndex() == f.GetOneofIndex() && (set[o.GetJsonName()] || set[o.GetName()]) {
+			return true
+		}
+	}
+	return false
+}
+
+// describeType returns the type of f as shown in its prompt, and whether its
+// answer is JSON, as for lists, maps and messages without a JSON mapping of
+// their own.
+func describeType(f *descpb.FieldDescriptorProto) (string, bool) {
+	name := strings.ToLower(strings.TrimPrefix(f.GetType().String(), "TYPE_"))
+	if f.GetTypeName() != "" {
+		name = strings.TrimPrefix(f.GetTypeName(), ".")
+	}
+	if f.GetLabel() == descpb.FieldDescriptorProto_LABEL_REPEATED {
+		if d := describe.Named(f.GetTypeName()); d != nil {
+			if entry, ok := d.Descriptor.(*descpb.DescriptorProto); ok && entry.GetOptions().GetMapEntry() && len(entry.Field) == 2 {
+				k, _ := describeType(entry.Field[0])
+				v, _ := describeType(entry.Field[1])
+				return fmt.Sprintf("map<%s, %s>, JSON", k, v), true
+			}
+		}
+		return "repeated " + name + ", JSON", true
+	}
+	if f.GetType() == descpb.FieldDescriptorProto_TYPE_MESSAGE && !strings.HasPrefix(name, "google.protobuf.") {
+		return name + ", JSON", true
+	}
+	return name, false
+}
+
+func enumValues(typeName string) []string {
+	d := describe.Named(typeName)
+	if d == nil {
+		return nil
+	}
+	e, ok := d.Descriptor.(*descpb.EnumDescriptorProto)
+	if !ok {
+		return nil
+	}
+	names := make([]string, len(e.Value))
+	for i, v := range e.Value {
+		names[i] = v.GetName()
+	}
+	return names
+}
@@ -0,0 +1,163 @@
+package prompting
+
+import (
+	"bytes"
+	"compress/gzip"
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	descpb "github.com/golang/protobuf/protoc-gen-go/descriptor"
+	"google.golang.org/genproto/googleapis/api/annotations"
+
+	"github.com/tetratelabs/protoc-gen-cobra/describe"
+	"github.com/tetratelabs/protoc-gen-cobra/iocodec"
+)
+
+func newField(name, jsonName string, number int32, typ descpb.FieldDescriptorProto_Type, typeName string, behavior ...annotations.FieldBehavior) *descpb.FieldDescriptorProto {
+	f := &descpb.FieldDescriptorProto{
+		Name:     proto.String(name),
+		JsonName: proto.String(jsonName),
+		Number:   proto.Int32(number),
+		Label:    descpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+		Type:     typ.Enum(),
+	}
+	if typeName != "" {
+		f.TypeName = proto.String(typeName)
+	}
+	if len(behavior) > 0 {
+		f.Options = &descpb.FieldOptions{}
+		if err := proto.SetExtension(f.Options, annotations.E_FieldBehavior, behavior); err != nil {
+			panic(err)
+		}
+	}
+	return f
+}
+
+func init() {
+	// tickets.Ticket has no Go type; its messages are dynamic
+	labels := newField("labels", "labels", 3, descpb.FieldDescriptorProto_TYPE_MESSAGE, ".tickets.Ticket.LabelsEntry")
+	labels.Label = descpb.FieldDescriptorProto_LABEL_REPEATED.Enum()
+	email := newField("email", "email", 5, descpb.FieldDescriptorProto_TYPE_STRING, "")
+	email.OneofIndex = proto.Int32(0)
+	phone := newField("phone", "phone", 6, descpb.FieldDescriptorProto_TYPE_STRING, "")
+	phone.OneofIndex = proto.Int32(0)
+	set := &descpb.FileDescriptorSet{File: []*descpb.FileDescriptorProto{{
+		Name:    proto.String("tickets.proto"),
+		Package: proto.String("tickets"),
+		Syntax:  proto.String("proto3"),
+		MessageType: []*descpb.DescriptorProto{{
+			Name: proto.String("Ticket"),
+			Field: []*descpb.FieldDescriptorProto{
+				newField("title", "title", 1, descpb.FieldDescriptorProto_TYPE_STRING, "", annotations.FieldBehavior_REQUIRED),
+				newField("priority", "priority", 2, descpb.FieldDescriptorProto_TYPE_ENUM, ".tickets.Priority"),
+				labels,
+				newField("id", "id", 4, descpb.FieldDescriptorProto_TYPE_STRING, "", annotations.FieldBehavior_OUTPUT_ONLY),
+				email,
+				phone,
+				newField("estimate", "estimate", 7, descpb.FieldDescriptorProto_TYPE_INT32, ""),
+			},
+			NestedType: []*descpb.DescriptorProto{{
+				Name: proto.String("LabelsEntry"),
+				Field: []*descpb.FieldDescriptorProto{
+					newField("key", "key", 1, descpb.FieldDescriptorProto_TYPE_STRING, ""),
+					newField("value", "value", 2, descpb.FieldDescriptorProto_TYPE_STRING, ""),
+				},
+				Options: &descpb.MessageOptions{MapEntry: proto.Bool(true)},
+			}},
+			OneofDecl: []*descpb.OneofDescriptorProto{{Name: proto.String("contact")}},
+		}},
+		EnumType: []*descpb.EnumDescriptorProto{{
+			Name: proto.String("Priority"),
+			Value: []*descpb.EnumValueDescriptorProto{
+				{Name: proto.String("LOW"), Number: proto.Int32(0)},
+				{Name: proto.String("HIGH"), Number: proto.Int32(1)},
+			},
+		}},
+	}}}
+	b, err := proto.Marshal(set)
+	if err != nil {
+		panic(err)
+	}
+	var gz bytes.Buffer
+	w := gzip.NewWriter(&gz)
+	w.Write(b)
+	w.Close()
+	describe.Register(gz.Bytes())
+}
+
+func ticket(t *testing.T, in string) proto.Message {
+	m, err := describe.Resolve("tickets.Ticket")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := jsonpb.UnmarshalString(in, m); err != nil {
+		t.Fatal(err)
+	}
+	return m
+}
+
+func TestFields(t *testing.T) {
+	m := ticket(t, `{}`)
+	answers := strings.Join([]string{
+		"",              // title is required
+		"Broken",        // title
+		"3",             // out of the menu
+		"2",             // priority
+		`{"team":"db"}`, // labels
+		"",              // email is skipped
+		"555",           // phone
+		"soon",          // estimate isn't an int32
+		"",              // estimate is skipped
+	}, "\n") + "\n"
+	var out bytes.Buffer
+	if err := New(strings.NewReader(answers), &out).Fields(m); err != nil {
+		t.Fatal(err)
+	}
+	got, _ := (&jsonpb.Marshaler{}).MarshalToString(m)
+	if want := `{"title":"Broken","priority":"HIGH","labels":{"team":"db"},"phone":"555"}`; got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+	want := `title (string, required):   title is required
+title (string, required): priority (tickets.Priority):
+  1) LOW
+  2) HIGH
+priority [1-2]:   unknown value "3" of tickets.Priority; want one of "HIGH", "LOW"
+priority [1-2]: labels (map<string, string>, JSON): email (string): phone (string): estimate (int32):   "soon" isn't an int32
+estimate (int32): `
+	if out.String() != want {
+		t.Errorf("got prompts\n%s\nwant\n%s", out.String(), want)
+	}
+}
+
+func TestFieldsSkipsSetFields(t *testing.T) {
+	m := ticket(t, `{"title":"Broken","priority":"HIGH","labels":{"a":"b"},"email":"a@b.c","estimate":2}`)
+	var out bytes.Buffer
+	if err := New(strings.NewReader(""), &out).Fields(m); err != nil {
+		t.Fatal(err)
+	}
+	if out.Len() != 0 {
+		t.Errorf("got prompts %q for a request setting every field", out.String())
+	}
+}
+
+func TestFieldsWithoutAnswer(t *testing.T) {
+	err := New(strings.NewReader(""), &bytes.Buffer{}).Fields(ticket(t, `{}`))
+	if err == nil || !strings.HasPrefix(err.Error(), "no answer for title") {
+		t.Errorf("got %v, want no answer", err)
+	}
+}
+
+func TestConfirm(t *testing.T) {
+	m := ticket(t, `{"title":"Broken"}`)
+	for answer, want := range map[string]error{"y\n": nil, "Yes\n": nil, "n\n": ErrDeclined, "\n": ErrDeclined, "": ErrDeclined} {
+		var out bytes.Buffer
+		if err := New(strings.NewReader(answer), &out).Confirm(m, iocodec.DefaultEncoders["json"]); err != want {
+			t.Errorf("%q: got %v, want %v", answer, err, want)
+		}
+		if got := out.String(); got != `{"title":"Broken"}`+"\nSend the request? [y/N]: " {
+			t.Errorf("%q: got %q", answer, got)
+		}
+	}
+}
@@ -0,0 +1,287 @@
+// Package recording records the calls generated commands make to a session
+// file, and replays them against any server, optionally comparing the new
+// responses to the recorded ones.
+//
+// A session file holds a JSON document per line, one per call, in the order
+// the calls completed. Each call names its method and the types of its
+// messages, which are in their proto3 JSON mapping, so the file describes
+// itself to any binary that links the types in.
+package recording
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/tetratelabs/protoc-gen-cobra/iocodec"
+)
+
+// A Call is the record of a call.
+type Call struct {
+	Method       string      `json:"method"`
+	RequestType  string      `json:"requestType,omitempty"`
+	ResponseType string      `json:"responseType,omitempty"`
+	ClientStream bool        `json:"clientStream,omitempty"`
+	ServerStream bool        `json:"serverStream,omitempty"`
+	Metadata     metadata.MD `json:"metadata,omitempty"`
+	Start        time.Time   `json:"start"`
+	Messages     []Message   `json:"messages"`
+	Status       Status      `json:"status"`
+	Duration     Duration    `json:"duration"`
+}
+
+// A Message is a request or a response of a call, along with when it was sent
+// or received since the call started.
+type Message struct {
+	Offset   Duration        `json:"offset"`
+	Request  json.RawMessage `json:"request,omitempty"`
+	Response json.RawMessage `json:"response,omitempty"`
+}
+
+// Status is the status a call ended with.
+type Status struct {
+	Code    string `json:"code"`
+	Message string `json:"message,omitempty"`
+}
+
+// Duration is a time.Duration written as a string, such as "1.5ms".
+type Duration time.Duration
+
+// MarshalJSON implements the json.Marshaler interface.
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(d).String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (d *Duration) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	v, err := time.ParseDuration(s)
+	*d = Duration(v)
+	return err
+}
+
+// The recorders of the files open, so the connections of a command all
+// record to the same one.
+var (
+	mu        sync.Mutex
+	recorders = map[string]*Recorder{}
+)
+
+// A Recorder writes a record of every call made over the connections it
+// intercepts to a session file.
+type Recorder struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+// Open returns the recorder of the session file at path, which is created, or
+// truncated, the first time it is opened.
+func Open(path string) (*Recorder, error) {
+	mu.Lock()
+	defer mu.Unlock()
+	if r, ok := recorders[path]; ok {
+		return r, nil
+	}
+	f, err := os.Create(path)
+	if err != nil {
+		return nil, err
+	}
+	r := &Recorder{w: f}
+	recorders[path] = r
+	return r, nil
+}
+
+// DialOptions returns the options intercepting the calls of a connection to
+// record them.
+func (r *Recorder) DialOptions() []grpc.DialOption {
+	return []grpc.DialOption{
+		grpc.WithChainUnaryInterceptor(r.unary),
+		grpc.WithChainStreamInterceptor(r.stream),
+	}
+}
+
+func (r *Recorder) unary(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	c := r.start(ctx, method, false, false)
+	c.request(req)
+	c.responseType(reply)
+	err := invoker(ctx, method, req, reply, cc, opts...)
+	if err == nil {
+		c.response(reply)
+	}
+	c.finish(err)
+	return err
+}
+
+func (r *Recorder) stream(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	c := r.start(ctx, method, desc.ClientStreams, desc.ServerStreams)
+	s, err := streamer(ctx, desc, cc, method, opts...)
+	if err != nil {
+		c.finish(err)
+		return nil, err
+	}
+	go func() {
+		select {
+		case <-ctx.Done():
+			// the caller stopped the call, such as after the messages
+			// it wanted, and may not receive from the stream again
+			c.finish(contextError(ctx))
+		case <-c.done:
+		}
+	}()
+	return &recordedStream{ClientStream: s, ctx: ctx, call: c}, nil
+}
+
+// contextError returns the status of a call whose context is done, as gRPC
+// reports it.
+func contextError(ctx context.Context) error {
+	code := codes.Canceled
+	if ctx.Err() == context.DeadlineExceeded {
+		code = codes.DeadlineExceeded
+	}
+	return status.Error(code, ctx.Err().Error())
+}
+
+func (r *Recorder) start(ctx context.Context, method string, clientStream, serverStream bool) *call {
+	md, _ := metadata.FromOutgoingContext(ctx)
+	return &call{
+		r: r,
+		Call: Call{
+			Method:       method,
+			ClientStream: clientStream,
+			ServerStream: serverStream,
+			Metadata:     md,
+			Start:        time.Now(),
+			Messages:     []Message{},
+		},
+		done: make(chan struct{}),
+	}
+}
+
+// A call is being recorded, while its messages are sent and received,
+// concurrently on bidirectional streams.
+type call struct {
+	r    *Recorder
+	mu   sync.Mutex
+	once sync.Once
+	done chan struct{} // closed once the call is written
+	Call
+}
+
+func (c *call) request(m interface{}) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.RequestType = messageName(m)
+	c.Messages = append(c.Messages, Message{Offset: c.offset(), Request: marshal(m)})
+}
+
+func (c *call) responseType(m interface{}) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.ResponseType = messageName(m)
+}
+
+func (c *call) response(m interface{}) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.Messages = append(c.Messages, Message{Offset: c.offset(), Response: marshal(m)})
+}
+
+func (c *call) offset() Duration {
+	return Duration(time.Since(c.Start))
+}
+
+// finish writes the record of the call, once it ends with err.
+func (c *call) finish(err error) {
+	c.once.Do(func() {
+		defer close(c.done)
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		st := status.Convert(err)
+		c.Status = Status{Code: st.Code().String(), Message: st.Message()}
+		c.Duration = c.offset()
+		b, err := json.Marshal(&c.Call)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "record %s: %v\n", c.Method, err)
+			return
+		}
+		c.r.mu.Lock()
+		defer c.r.mu.Unlock()
+		if _, err := c.r.w.Write(append(b, '\n')); err != nil {
+			fmt.Fprintf(os.Stderr, "record %s: %v\n", c.Method, err)
+		}
+	})
+}
+
+type recordedStream struct {
+	grpc.ClientStream
+	ctx  context.Context
+	call *call
+}
+
+func (s *recordedStream) SendMsg(m interface{}) error {
+	err := s.ClientStream.SendMsg(m)
+	switch err {
+	case nil:
+		s.call.request(m)
+	case io.EOF:
+		// the server ended the stream, RecvMsg tells why
+	default:
+		s.call.finish(err)
+	}
+	return err
+}
+
+func (s *recordedStream) RecvMsg(m interface{}) error {
+	s.call.responseType(m)
+	err := s.ClientStream.RecvMsg(m)
+	switch {
+	case s.ctx.Err() != nil:
+		// what is received once the caller stopped the call isn't part of
+		// it
+		s.call.finish(contextError(s.ctx))
+	case err == nil:
+		s.call.response(m)
+		if !s.call.ServerStream {
+			s.call.finish(nil)
+		}
+	case err == io.EOF:
+		s.call.finish(nil)
+	default:
+		s.call.finish(err)
+	}
+	return err
+}
+
+func messageName(m interface{}) string {
+	if pm, ok := m.(proto.Message); ok {
+		return proto.MessageName(pm)
+	}
+	return ""
+}
+
+// marshal returns the proto3 JSON mapping of m, or null if it has none.
+func marshal(m interface{}) json.RawMessage {
+	pm, ok := m.(proto.Message)
+	if !ok {
+		return json.RawMessage("null")
+	}
+	var b bytes.Buffer
+	if err := iocodec.Marshaler().Marshal(&b, pm); err != nil {
+		return json.RawMessage("null")
+	}
+	return b.Bytes()
+}
@@ -0,0 +1,314 @@
+package recording
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	testpb "google.golang.org/grpc/test/grpc_testing"
+
+	"github.com/tetratelabs/protoc-gen-cobra/iocodec"
+	"github.com/tetratelabs/protoc-gen-cobra/streaming"
+)
+
+// testServer echoes payloads; with suffix, it appends it to the user names it
+// returns.
+type testServer struct {
+	testpb.UnimplementedTestServiceServer
+	suffix string
+}
+
+func (s *testServer) UnaryCall(ctx context.Context, req *testpb.SimpleRequest) (*testpb.SimpleResponse, error) {
+	if req.GetResponseSize() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "negative size")
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	return &testpb.SimpleResponse{Payload: req.GetPayload(), Username: strings.Join(md["user"], ",") + s.suffix}, nil
+}
+
+func (s *testServer) StreamingOutputCall(req *testpb.StreamingOutputCallRequest, stream testpb.TestService_StreamingOutputCallServer) error {
+	for _, p := range req.GetResponseParameters() {
+		if err := stream.Send(&testpb.StreamingOutputCallResponse{Payload: &testpb.Payload{Body: make([]byte, p.GetSize())}}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *testServer) StreamingInputCall(stream testpb.TestService_StreamingInputCallServer) error {
+	var size int32
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			return stream.SendAndClose(&testpb.StreamingInputCallResponse{AggregatedPayloadSize: size})
+		}
+		if err != nil {
+			return err
+		}
+		size += int32(len(req.GetPayload().GetBody()))
+	}
+}
+
+func (s *testServer) FullDuplexCall(stream testpb.TestService_FullDuplexCallServer) error {
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := stream.Send(&testpb.StreamingOutputCallResponse{Payload: req.GetPayload()}); err != nil {
+			return err
+		}
+	}
+}
+
+// dial serves srv in process and returns a connection to it, and a function
+// closing both.
+func dial(t *testing.T, srv testpb.TestServiceServer, opts ...grpc.DialOption) (*grpc.ClientConn, func()) {
+	lis := bufconn.Listen(1 << 20)
+	s := grpc.NewServer()
+	testpb.RegisterTestServiceServer(s, srv)
+	go s.Serve(lis)
+	opts = append(opts, grpc.WithInsecure(), grpc.WithDialer(func(string, time.Duration) (net.Conn, error) {
+		return lis.Dial()
+	}))
+	conn, err := grpc.Dial("bufnet", opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return conn, func() {
+		conn.Close()
+		s.Stop()
+	}
+}
+
+// record makes a call of every shape, and a failing one, over a recorded
+// connection, and returns the session file.
+func record(t *testing.T, dir string) string {
+	path := filepath.Join(dir, "session.ndjson")
+	rec, err := Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	conn, done := dial(t, &testServer{}, rec.DialOptions()...)
+	defer done()
+	cli := testpb.NewTestServiceClient(conn)
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "user", "ann")
+
+	if _, err := cli.UnaryCall(ctx, &testpb.SimpleRequest{Payload: &testpb.Payload{Body: []byte("hi")}}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := cli.UnaryCall(ctx, &testpb.SimpleRequest{ResponseSize: -1}); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("got %v, want InvalidArgument", err)
+	}
+
+	out, err := cli.StreamingOutputCall(ctx, &testpb.StreamingOutputCallRequest{
+		ResponseParameters: []*testpb.ResponseParameters{{Size: 1}, {Size: 2}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for {
+		if _, err := out.Recv(); err == io.EOF {
+			break
+		} else if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	in, err := cli.StreamingInputCall(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, body := range []string{"a", "bc"} {
+		if err := in.Send(&testpb.StreamingInputCallRequest{Payload: &testpb.Payload{Body: []byte(body)}}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := in.CloseAndRecv(); err != nil {
+		t.Fatal(err)
+	}
+
+	duplex, err := cli.FullDuplexCall(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, body := range []string{"x", "y"} {
+		if err := duplex.Send(&testpb.StreamingOutputCallRequest{Payload: &testpb.Payload{Body: []byte(body)}}); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := duplex.Recv(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	duplex.CloseSend()
+	if _, err := duplex.Recv(); err != io.EOF {
+		t.Fatalf("got %v, want EOF", err)
+	}
+	return path
+}
+
+func TestRecord(t *testing.T) {
+	dir, err := ioutil.TempDir("", "recording")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	b, err := ioutil.ReadFile(record(t, dir))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	d := json.NewDecoder(bytes.NewReader(b))
+	for {
+		var c Call
+		if err := d.Decode(&c); err == io.EOF {
+			break
+		} else if err != nil {
+			t.Fatal(err)
+		}
+		var messages []string
+		for _, m := range c.Messages {
+			if m.Request != nil {
+				messages = append(messages, ">"+string(m.Request))
+			} else {
+				messages = append(messages, "<"+string(m.Response))
+			}
+		}
+		got = append(got, strings.Join([]string{c.Method, c.RequestType, c.ResponseType,
+			strings.Join(c.Metadata["user"], ","), c.Status.String(), strings.Join(messages, " ")}, " "))
+	}
+	want := []string{
+		`/grpc.testing.TestService/UnaryCall grpc.testing.SimpleRequest grpc.testing.SimpleResponse ann OK >{"payload":{"body":"aGk="}} <{"payload":{"body":"aGk="},"username":"ann"}`,
+		`/grpc.testing.TestService/UnaryCall grpc.testing.SimpleRequest grpc.testing.SimpleResponse ann InvalidArgument (negative size) >{"responseSize":-1}`,
+		`/grpc.testing.TestService/StreamingOutputCall grpc.testing.StreamingOutputCallRequest grpc.testing.StreamingOutputCallResponse ann OK >{"responseParameters":[{"size":1},{"size":2}]} <{"payload":{"body":"AA=="}} <{"payload":{"body":"AAA="}}`,
+		`/grpc.testing.TestService/StreamingInputCall grpc.testing.StreamingInputCallRequest grpc.testing.StreamingInputCallResponse ann OK >{"payload":{"body":"YQ=="}} >{"payload":{"body":"YmM="}} <{"aggregatedPayloadSize":3}`,
+		`/grpc.testing.TestService/FullDuplexCall grpc.testing.StreamingOutputCallRequest grpc.testing.StreamingOutputCallResponse ann OK >{"payload":{"body":"eA=="}} <{"payload":{"body":"eA=="}} >{"payload":{"body":"eQ=="}} <{"payload":{"body":"eQ=="}}`,
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("got:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestReplay(t *testing.T) {
+	dir, err := ioutil.TempDir("", "recording")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := record(t, dir)
+
+	for _, tc := range []struct {
+		suffix string
+		diffs  string
+	}{
+		{"", ""},
+		{"!", `call 1 (/grpc.testing.TestService/UnaryCall): response 1: username: recorded "ann", got "ann!"` + "\n"},
+	} {
+		f, err := os.Open(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		conn, done := dial(t, &testServer{suffix: tc.suffix})
+		var out, diffs bytes.Buffer
+		err = Replay(conn, f, true, iocodec.DefaultEncoders["json"].NewEncoder(&out), &diffs)
+		done()
+		f.Close()
+		if (err != nil) != (tc.diffs != "") || diffs.String() != tc.diffs {
+			t.Errorf("suffix %q: got error %v and differences:\n%s\nwant:\n%s", tc.suffix, err, diffs.String(), tc.diffs)
+		}
+		if n := strings.Count(out.String(), "\n"); n != 6 {
+			t.Errorf("suffix %q: got %d responses, want 6:\n%s", tc.suffix, n, out.String())
+		}
+	}
+}
+
+func TestRecordStoppedStream(t *testing.T) {
+	dir, err := ioutil.TempDir("", "recording")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "session.ndjson")
+	rec, err := Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	conn, done := dial(t, &testServer{}, rec.DialOptions()...)
+	cli := testpb.NewTestServiceClient(conn)
+	open := func(ctx context.Context) (func() (proto.Message, error), error) {
+		stream, err := cli.StreamingOutputCall(ctx, &testpb.StreamingOutputCallRequest{
+			ResponseParameters: []*testpb.ResponseParameters{{Size: 1}, {Size: 2}, {Size: 3}},
+		})
+		if err != nil {
+			return nil, err
+		}
+		return func() (proto.Message, error) { return stream.Recv() }, nil
+	}
+	// as with --max-messages 2, the call is stopped by the client before the
+	// server ends it, and must be recorded by the time Receive returns
+	var out bytes.Buffer
+	err = streaming.Receive(streaming.Options{MaxMessages: 2}, open, iocodec.DefaultEncoders["json"].NewEncoder(&out))
+	done()
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var c Call
+	if err := json.Unmarshal(b, &c); err != nil {
+		t.Fatalf("%v in %q", err, b)
+	}
+	if got := c.Status.String(); got != "Canceled (context canceled)" {
+		t.Errorf("got status %s", got)
+	}
+	if n := len(c.responses()); n != 2 {
+		t.Errorf("got %d responses recorded, want 2", n)
+	}
+
+	// the replay stops where the recorded call was stopped
+	for _, compare := range []bool{false, true} {
+		conn, done := dial(t, &testServer{})
+		var out, diffs bytes.Buffer
+		err = Replay(conn, bytes.NewReader(b), compare, iocodec.DefaultEncoders["json"].NewEncoder(&out), &diffs)
+		done()
+		if err != nil || diffs.Len() > 0 {
+			t.Errorf("compare %v: got error %v and differences:\n%s", compare, err, diffs.String())
+		}
+		if n := strings.Count(out.String(), "\n"); n != 2 {
+			t.Errorf("compare %v: got %d responses, want 2:\n%s", compare, n, out.String())
+		}
+	}
+}
+
+func TestDiffValues(t *testing.T) {
+	var want, got interface{}
+	unmarshalJSON([]byte(`{"a":1,"b":{"c":"x"},"l":[1,2],"gone":true}`), &want)
+	unmarshalJSON([]byte(`{"a":2,"b":{"c":"y"},"l":[1],"new":"n"}`), &got)
+	diffs := strings.Join(diffValues("", want, got), "\n")
+	if w := `a: recorded 1, got 2
+b.c: recorded "x", got "y"
+gone: recorded true, got nothing
+l: recorded [1,2], got [1]
+new: recorded nothing, got "n"`; diffs != w {
+		t.Errorf("got:\n%s\nwant:\n%s", diffs, w)
+	}
+}
@@ -0,0 +1,293 @@
+package recording
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/tetratelabs/protoc-gen-cobra/iocodec"
+)
+
+// Replay makes the calls recorded in the session file r again over conn, with
+// the recorded metadata and requests, and writes their responses to out. With
+// compare, it reports how the responses and statuses differ from the recorded
+// ones on w, and fails if any does; otherwise it fails as soon as a call does.
+func Replay(conn *grpc.ClientConn, r io.Reader, compare bool, out iocodec.Encoder, w io.Writer) error {
+	d := json.NewDecoder(r)
+	differences := 0
+	for n := 1; ; n++ {
+		var c Call
+		err := d.Decode(&c)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("replay: call %d: %v", n, err)
+		}
+		responses, err := replay(conn, &c)
+		if err != nil && !compare && !c.stopped() {
+			return err
+		}
+		for _, m := range responses {
+			if err := out.Encode(m); err != nil {
+				return err
+			}
+		}
+		if compare {
+			for _, diff := range c.diff(responses, err) {
+				fmt.Fprintf(w, "call %d (%s): %s\n", n, c.Method, diff)
+				differences++
+			}
+		}
+	}
+	if differences > 0 {
+		return fmt.Errorf("replay: %d difference(s)", differences)
+	}
+	return nil
+}
+
+// Requests returns the requests of every call recorded in the session file r,
+// in order.
+func Requests(r io.Reader) ([]proto.Message, error) {
+	d := json.NewDecoder(r)
+	var reqs []proto.Message
+	for n := 1; ; n++ {
+		var c Call
+		err := d.Decode(&c)
+		if err == io.EOF {
+			return reqs, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("replay: call %d: %v", n, err)
+		}
+		cr, err := c.requests()
+		if err != nil {
+			return nil, err
+		}
+		reqs = append(reqs, cr...)
+	}
+}
+
+// requests returns the recorded requests of c.
+func (c *Call) requests() ([]proto.Message, error) {
+	var reqs []proto.Message
+	for _, m := range c.Messages {
+		if m.Request == nil {
+			continue
+		}
+		req, err := newMessage(c.RequestType)
+		if err != nil {
+			return nil, err
+		}
+		if err := iocodec.Unmarshaler().Unmarshal(bytes.NewReader(m.Request), req); err != nil {
+			return nil, fmt.Errorf("replay %s: request %d: %v", c.Method, len(reqs)+1, err)
+		}
+		reqs = append(reqs, req)
+	}
+	return reqs, nil
+}
+
+// replay makes the call c again, and returns its responses.
+func replay(conn *grpc.ClientConn, c *Call) ([]proto.Message, error) {
+	reqs, err := c.requests()
+	if err != nil {
+		return nil, err
+	}
+	newResponse := func() (proto.Message, error) { return newMessage(c.ResponseType) }
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	if c.Metadata != nil {
+		ctx = metadata.NewOutgoingContext(ctx, c.Metadata)
+	}
+	if !c.ClientStream && !c.ServerStream {
+		if len(reqs) != 1 {
+			return nil, fmt.Errorf("replay %s: recorded %d requests, want 1", c.Method, len(reqs))
+		}
+		resp, err := newResponse()
+		if err != nil {
+			return nil, err
+		}
+		if err := conn.Invoke(ctx, c.Method, reqs[0], resp); err != nil {
+			return nil, err
+		}
+		return []proto.Message{resp}, nil
+	}
+
+	desc := &grpc.StreamDesc{ClientStreams: c.ClientStream, ServerStreams: c.ServerStream}
+	stream, err := conn.NewStream(ctx, desc, c.Method)
+	if err != nil {
+		return nil, err
+	}
+	go func() {
+		for _, req := range reqs {
+			if stream.SendMsg(req) != nil {
+				// RecvMsg tells why
+				return
+			}
+		}
+		stream.CloseSend()
+	}()
+	var responses []proto.Message
+	for {
+		if c.stopped() && len(responses) == len(c.responses()) {
+			// stopped where the recorded call was
+			cancel()
+			return responses, contextError(ctx)
+		}
+		resp, err := newResponse()
+		if err != nil {
+			return nil, err
+		}
+		err = stream.RecvMsg(resp)
+		if err == io.EOF {
+			return responses, nil
+		}
+		if err != nil {
+			return responses, err
+		}
+		responses = append(responses, resp)
+		if !c.ServerStream {
+			return responses, nil
+		}
+	}
+}
+
+// stopped reports whether the client stopped the call before the server ended
+// it, as commands stop server streams with --max-messages or --idle-timeout.
+func (c *Call) stopped() bool {
+	return c.ServerStream && c.Status.Code == codes.Canceled.String()
+}
+
+// responses returns the recorded responses of the call.
+func (c *Call) responses() []json.RawMessage {
+	var responses []json.RawMessage
+	for _, m := range c.Messages {
+		if m.Response != nil {
+			responses = append(responses, m.Response)
+		}
+	}
+	return responses
+}
+
+// newMessage returns a new message of the type named name, which must be
+// linked in.
+func newMessage(name string) (proto.Message, error) {
+	t := proto.MessageType(name)
+	if t == nil {
+		return nil, fmt.Errorf("replay: unknown message type %q", name)
+	}
+	return reflect.New(t.Elem()).Interface().(proto.Message), nil
+}
+
+// diff returns how the responses and the error of the replayed call differ
+// from the recorded ones.
+func (c *Call) diff(responses []proto.Message, err error) []string {
+	var diffs []string
+	if st := status.Convert(err); st.Code().String() != c.Status.Code || st.Message() != c.Status.Message {
+		diffs = append(diffs, fmt.Sprintf("status: recorded %s, got %s", c.Status, Status{st.Code().String(), st.Message()}))
+	}
+	recorded := c.responses()
+	if len(recorded) != len(responses) {
+		diffs = append(diffs, fmt.Sprintf("recorded %d response(s), got %d", len(recorded), len(responses)))
+	}
+	for i := 0; i < len(recorded) && i < len(responses); i++ {
+		var want, got interface{}
+		if err := unmarshalJSON(recorded[i], &want); err != nil {
+			diffs = append(diffs, fmt.Sprintf("response %d: %v", i+1, err))
+			continue
+		}
+		if err := unmarshalJSON(marshal(responses[i]), &got); err != nil {
+			diffs = append(diffs, fmt.Sprintf("response %d: %v", i+1, err))
+			continue
+		}
+		for _, d := range diffValues("", want, got) {
+			diffs = append(diffs, fmt.Sprintf("response %d: %s", i+1, d))
+		}
+	}
+	return diffs
+}
+
+// String returns the status as its code, followed by its message if any.
+func (s Status) String() string {
+	if s.Message == "" {
+		return s.Code
+	}
+	return s.Code + " (" + s.Message + ")"
+}
+
+func unmarshalJSON(b []byte, v interface{}) error {
+	d := json.NewDecoder(bytes.NewReader(b))
+	d.UseNumber()
+	return d.Decode(v)
+}
+
+// diffValues returns the differences between the JSON values want and got, at
+// path, field by field.
+func diffValues(path string, want, got interface{}) []string {
+	wm, wok := want.(map[string]interface{})
+	gm, gok := got.(map[string]interface{})
+	if wok && gok {
+		var names []string
+		for k := range wm {
+			names = append(names, k)
+		}
+		for k := range gm {
+			if _, ok := wm[k]; !ok {
+				names = append(names, k)
+			}
+		}
+		sort.Strings(names)
+		var diffs []string
+		for _, k := range names {
+			diffs = append(diffs, diffValues(join(path, k), wm[k], gm[k])...)
+		}
+		return diffs
+	}
+	wl, wok := want.([]interface{})
+	gl, gok := got.([]interface{})
+	if wok && gok && len(wl) == len(gl) {
+		var diffs []string
+		for i := range wl {
+			diffs = append(diffs, diffValues(fmt.Sprintf("%s[%d]", path, i), wl[i], gl[i])...)
+		}
+		return diffs
+	}
+	if reflect.DeepEqual(want, got) {
+		return nil
+	}
+	if path == "" {
+		path = "."
+	}
+	return []string{fmt.Sprintf("%s: recorded %s, got %s", path, jsonText(want), jsonText(got))}
+}
+
+func join(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+// jsonText returns v as JSON, or "nothing" if it's unset.
+func jsonText(v interface{}) string {
+	if v == nil {
+		return "nothing"
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return strings.TrimSpace(string(b))
+}
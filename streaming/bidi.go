@@ -0,0 +1,72 @@
+package streaming
+
+import (
+	"context"
+	"io"
+
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc"
+
+	"github.com/tetratelabs/protoc-gen-cobra/iocodec"
+)
+
+// A BidiOpener opens a bidirectional stream, and returns it along with the
+// function receiving its messages, which returns io.EOF once the server ends
+// the stream.
+type BidiOpener func(ctx context.Context) (grpc.ClientStream, func() (proto.Message, error), error)
+
+// Bidi opens a stream with open, and sends the requests next returns on it,
+// until it returns io.EOF, while it writes the messages it receives to out,
+// until the server ends the stream, so they are written as they arrive. A
+// failure on either side cancels the stream, which ends the other side, and is
+// returned.
+func Bidi(open BidiOpener, next func() (proto.Message, error), out iocodec.Encoder) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream, recv, err := open(ctx)
+	if err != nil {
+		return err
+	}
+	sent := make(chan error, 1)
+	go func() {
+		err := func() error {
+			for {
+				req, err := next()
+				if err == io.EOF {
+					return stream.CloseSend()
+				}
+				if err != nil {
+					return err
+				}
+				err = stream.SendMsg(req)
+				if err == io.EOF {
+					// the server ended the stream, receiving tells why
+					return nil
+				}
+				if err != nil {
+					return err
+				}
+			}
+		}()
+		if err != nil {
+			cancel()
+		}
+		sent <- err
+	}()
+	for {
+		resp, err := recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			if ctx.Err() != nil {
+				// sending failed first
+				return <-sent
+			}
+			return err
+		}
+		if err := out.Encode(resp); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,163 @@
+package streaming
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	testpb "google.golang.org/grpc/test/grpc_testing"
+)
+
+// duplexServer echoes the payload of every request as it receives it, or
+// fails with Aborted for requests asking for a negative size.
+type duplexServer struct {
+	testpb.UnimplementedTestServiceServer
+}
+
+func (*duplexServer) FullDuplexCall(stream testpb.TestService_FullDuplexCallServer) error {
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		for _, p := range req.GetResponseParameters() {
+			if p.GetSize() < 0 {
+				return status.Error(codes.Aborted, "negative size")
+			}
+		}
+		if err := stream.Send(&testpb.StreamingOutputCallResponse{Payload: req.GetPayload()}); err != nil {
+			return err
+		}
+	}
+}
+
+// openDuplex serves duplexServer in process, and returns the opener of its
+// full duplex calls and a function stopping it.
+func openDuplex(t *testing.T) (BidiOpener, func()) {
+	lis := bufconn.Listen(1 << 20)
+	s := grpc.NewServer()
+	testpb.RegisterTestServiceServer(s, &duplexServer{})
+	go s.Serve(lis)
+	conn, err := grpc.Dial("bufnet", grpc.WithInsecure(), grpc.WithDialer(func(string, time.Duration) (net.Conn, error) {
+		return lis.Dial()
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	cli := testpb.NewTestServiceClient(conn)
+	open := func(ctx context.Context) (grpc.ClientStream, func() (proto.Message, error), error) {
+		stream, err := cli.FullDuplexCall(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+		return stream, func() (proto.Message, error) { return stream.Recv() }, nil
+	}
+	return open, func() {
+		conn.Close()
+		s.Stop()
+	}
+}
+
+func request(body string) *testpb.StreamingOutputCallRequest {
+	return &testpb.StreamingOutputCallRequest{Payload: &testpb.Payload{Body: []byte(body)}}
+}
+
+func TestBidiOverlaps(t *testing.T) {
+	open, stop := openDuplex(t)
+	defer stop()
+	var got []string
+	received := make(chan struct{}, 3)
+	out := encoderFunc(func(v interface{}) error {
+		got = append(got, string(v.(*testpb.StreamingOutputCallResponse).GetPayload().GetBody()))
+		received <- struct{}{}
+		return nil
+	})
+	sent := 0
+	next := func() (proto.Message, error) {
+		if sent > 0 {
+			// the stream is still being sent when the response to the
+			// last request is received
+			select {
+			case <-received:
+			case <-time.After(time.Second):
+				return nil, errors.New("no response before the next request")
+			}
+		}
+		if sent == 3 {
+			return nil, io.EOF
+		}
+		sent++
+		return request(string('a' + rune(sent-1))), nil
+	}
+	if err := Bidi(open, next, out); err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 3 || got[0] != "a" || got[1] != "b" || got[2] != "c" {
+		t.Errorf("got %q, want [a b c]", got)
+	}
+}
+
+func TestBidiReceiveFailureEndsSending(t *testing.T) {
+	for name, tc := range map[string]struct {
+		fail func(req *testpb.StreamingOutputCallRequest) // fails the second request
+		out  encoderFunc
+		code codes.Code
+	}{
+		"status": {
+			fail: func(req *testpb.StreamingOutputCallRequest) {
+				req.ResponseParameters = []*testpb.ResponseParameters{{Size: -1}}
+			},
+			out:  func(interface{}) error { return nil },
+			code: codes.Aborted,
+		},
+		"output": {
+			// the server keeps the stream open; only the client can end it
+			fail: func(*testpb.StreamingOutputCallRequest) {},
+			out: func(interface{}) error {
+				return status.Error(codes.Unknown, "closed pipe")
+			},
+			code: codes.Unknown,
+		},
+	} {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			open, stop := openDuplex(t)
+			defer stop()
+			var sent int32
+			next := func() (proto.Message, error) {
+				// requests keep coming until sending is ended
+				n := atomic.AddInt32(&sent, 1)
+				req := request("x")
+				if n == 2 {
+					tc.fail(req)
+				}
+				time.Sleep(time.Millisecond)
+				return req, nil
+			}
+			err := Bidi(open, next, tc.out)
+			if status.Code(err) != tc.code {
+				t.Fatalf("got %v, want %v", err, tc.code)
+			}
+			// sending ends with the stream, once the request being
+			// read when it failed is dealt with
+			time.Sleep(20 * time.Millisecond)
+			n := atomic.LoadInt32(&sent)
+			time.Sleep(50 * time.Millisecond)
+			if more := atomic.LoadInt32(&sent) - n; more > 0 {
+				t.Errorf("%d more request(s) read after the stream failed", more)
+			}
+		})
+	}
+}
@@ -0,0 +1,179 @@
+// Package streaming receives the messages of server streams for generated
+// commands, with limits on how many messages to wait for and for how long,
+// and reconnects when a followed stream is interrupted. It also sends and
+// receives bidirectional streams at once.
+package streaming
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sync/atomic"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/spf13/pflag"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/tetratelabs/protoc-gen-cobra/iocodec"
+)
+
+// The delays before reconnecting a followed stream, doubling after every
+// failed attempt.
+var (
+	initialBackoff = time.Second
+	maxBackoff     = 30 * time.Second
+)
+
+// stderr is where summaries and reconnections are reported.
+var stderr io.Writer = os.Stderr
+
+// Options control how a server stream is received.
+type Options struct {
+	MaxMessages int
+	IdleTimeout time.Duration
+	Follow      bool
+	Summary     bool
+}
+
+// AddFlags adds the flags setting the options to fs.
+func (o *Options) AddFlags(fs *pflag.FlagSet) {
+	fs.IntVar(&o.MaxMessages, "max-messages", o.MaxMessages, "stop after receiving this many messages, 0 for no limit")
+	fs.DurationVar(&o.IdleTimeout, "idle-timeout", o.IdleTimeout, "stop when no message is received for this long, 0 for no limit")
+	fs.BoolVar(&o.Follow, "follow", o.Follow, "reconnect, with backoff, when the stream is interrupted by a transient failure")
+	fs.BoolVar(&o.Summary, "summary", o.Summary, "print the number of messages received and the duration on stderr")
+}
+
+// An Opener opens a server stream and returns the function receiving its
+// messages, which returns io.EOF once the server ends the stream.
+type Opener func(ctx context.Context) (func() (proto.Message, error), error)
+
+// Receive opens a stream with open and writes its messages to out until the
+// server ends it, one of the limits of o is reached, or it fails.
+func Receive(o Options, open Opener, out iocodec.Encoder) error {
+	r := &receiver{Options: o, start: time.Now()}
+	err := r.receive(open, out)
+	if o.Summary {
+		fmt.Fprintf(stderr, "received %d message(s) in %v", r.messages, time.Since(r.start).Round(time.Millisecond))
+		if r.reconnects > 0 {
+			fmt.Fprintf(stderr, ", after %d reconnection(s)", r.reconnects)
+		}
+		if r.stopped != "" {
+			fmt.Fprintf(stderr, " (%s)", r.stopped)
+		}
+		fmt.Fprintln(stderr)
+	}
+	return err
+}
+
+type receiver struct {
+	Options
+	start      time.Time
+	messages   int
+	reconnects int
+	stopped    string // why the stream was stopped before the server ended it
+}
+
+func (r *receiver) receive(open Opener, out iocodec.Encoder) error {
+	backoff := initialBackoff
+	for {
+		received := r.messages
+		err := r.stream(open, out)
+		if err == nil || !r.Follow || !transient(err) {
+			return err
+		}
+		if r.messages > received {
+			// the stream worked for a while
+			backoff = initialBackoff
+		}
+		fmt.Fprintf(stderr, "stream interrupted: %v; reconnecting in %v\n", err, backoff)
+		time.Sleep(backoff)
+		if backoff *= 2; backoff > maxBackoff {
+			backoff = maxBackoff
+		}
+		r.reconnects++
+	}
+}
+
+// stream receives the messages of one stream.
+func (r *receiver) stream(open Opener, out iocodec.Encoder) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var idle int32
+	if r.IdleTimeout > 0 {
+		timer := time.AfterFunc(r.IdleTimeout, func() {
+			atomic.StoreInt32(&idle, 1)
+			cancel()
+		})
+		defer timer.Stop()
+		defer func() {
+			if atomic.LoadInt32(&idle) == 1 {
+				r.stopped = fmt.Sprintf("idle for %v", r.IdleTimeout)
+			}
+		}()
+		// every message restarts the timer
+		enc := out
+		out = encoderFunc(func(v interface{}) error {
+			timer.Reset(r.IdleTimeout)
+			return enc.Encode(v)
+		})
+	}
+	recv, err := open(ctx)
+	if err != nil {
+		return r.check(err, &idle)
+	}
+	ended := false
+	defer func() {
+		if ended {
+			return
+		}
+		// the stream is stopped before it ends: it's cancelled and received
+		// until it ends, so what intercepts it, such as --record, sees it end
+		cancel()
+		for {
+			if _, err := recv(); err != nil {
+				return
+			}
+		}
+	}()
+	for r.MaxMessages <= 0 || r.messages < r.MaxMessages {
+		m, err := recv()
+		if err == io.EOF {
+			ended = true
+			return nil
+		}
+		if err != nil {
+			ended = true
+			return r.check(err, &idle)
+		}
+		r.messages++
+		if err := out.Encode(m); err != nil {
+			return err
+		}
+	}
+	r.stopped = fmt.Sprintf("reached %d message(s)", r.MaxMessages)
+	return nil
+}
+
+// check returns err, unless the stream failed because it was idle.
+func (r *receiver) check(err error, idle *int32) error {
+	if atomic.LoadInt32(idle) == 1 {
+		return nil
+	}
+	return err
+}
+
+// transient reports whether err may go away on retry.
+func transient(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.Aborted, codes.ResourceExhausted:
+		return true
+	}
+	return false
+}
+
+type encoderFunc func(v interface{}) error
+
+func (f encoderFunc) Encode(v interface{}) error { return f(v) }
@@ -0,0 +1,146 @@
+package streaming
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func init() {
+	initialBackoff = time.Millisecond
+	maxBackoff = 4 * time.Millisecond
+}
+
+// fakeStreams opens streams that each send the next of its scripts: messages
+// followed by an error, where a nil error waits until the stream is cancelled.
+type fakeStreams struct {
+	scripts []script
+	opened  int
+}
+
+type script struct {
+	messages []string
+	err      error
+}
+
+func (f *fakeStreams) open(ctx context.Context) (func() (proto.Message, error), error) {
+	s := f.scripts[f.opened]
+	f.opened++
+	return func() (proto.Message, error) {
+		if len(s.messages) > 0 {
+			m := &wrappers.StringValue{Value: s.messages[0]}
+			s.messages = s.messages[1:]
+			return m, nil
+		}
+		if s.err != nil {
+			return nil, s.err
+		}
+		<-ctx.Done()
+		return nil, status.Error(codes.Canceled, ctx.Err().Error())
+	}, nil
+}
+
+type collect []string
+
+func (c *collect) Encode(v interface{}) error {
+	*c = append(*c, v.(*wrappers.StringValue).Value)
+	return nil
+}
+
+func TestReceiveEOF(t *testing.T) {
+	f := &fakeStreams{scripts: []script{{[]string{"a", "b"}, io.EOF}}}
+	var got collect
+	if err := Receive(Options{}, f.open, &got); err != nil {
+		t.Fatal(err)
+	}
+	if strings.Join(got, ",") != "a,b" {
+		t.Errorf("got %v", got)
+	}
+}
+
+func TestReceiveMaxMessages(t *testing.T) {
+	f := &fakeStreams{scripts: []script{{[]string{"a", "b", "c"}, nil}}}
+	var got collect
+	if err := Receive(Options{MaxMessages: 2}, f.open, &got); err != nil {
+		t.Fatal(err)
+	}
+	if strings.Join(got, ",") != "a,b" {
+		t.Errorf("got %v", got)
+	}
+}
+
+func TestReceiveIdleTimeout(t *testing.T) {
+	var b bytes.Buffer
+	stderr = &b
+	defer func(w io.Writer) { stderr = w }(stderr)
+
+	f := &fakeStreams{scripts: []script{{[]string{"a"}, nil}}}
+	var got collect
+	if err := Receive(Options{IdleTimeout: 10 * time.Millisecond, Summary: true}, f.open, &got); err != nil {
+		t.Fatal(err)
+	}
+	if strings.Join(got, ",") != "a" {
+		t.Errorf("got %v", got)
+	}
+	if s := b.String(); !strings.HasPrefix(s, "received 1 message(s) in ") || !strings.HasSuffix(s, " (idle for 10ms)\n") {
+		t.Errorf("got summary %q", s)
+	}
+}
+
+func TestReceiveFollow(t *testing.T) {
+	var b bytes.Buffer
+	stderr = &b
+	defer func(w io.Writer) { stderr = w }(stderr)
+
+	unavailable := status.Error(codes.Unavailable, "connection reset")
+	f := &fakeStreams{scripts: []script{
+		{[]string{"a"}, unavailable},
+		{nil, unavailable},
+		{[]string{"b"}, io.EOF},
+	}}
+	var got collect
+	if err := Receive(Options{Follow: true, Summary: true}, f.open, &got); err != nil {
+		t.Fatal(err)
+	}
+	if strings.Join(got, ",") != "a,b" {
+		t.Errorf("got %v", got)
+	}
+	lines := strings.Split(strings.TrimSpace(b.String()), "\n")
+	want := []string{
+		"stream interrupted: rpc error: code = Unavailable desc = connection reset; reconnecting in 1ms",
+		"stream interrupted: rpc error: code = Unavailable desc = connection reset; reconnecting in 2ms",
+	}
+	if len(lines) != 3 || strings.Join(lines[:2], "\n") != strings.Join(want, "\n") ||
+		!strings.HasSuffix(lines[2], ", after 2 reconnection(s)") {
+		t.Errorf("got:\n%s", b.String())
+	}
+}
+
+func TestReceiveErrors(t *testing.T) {
+	// without --follow, and for errors that won't go away
+	unavailable := status.Error(codes.Unavailable, "connection reset")
+	invalid := status.Error(codes.InvalidArgument, "bad interval")
+	for _, test := range []struct {
+		follow bool
+		err    error
+	}{
+		{false, unavailable},
+		{true, invalid},
+		{true, errors.New("broken")},
+	} {
+		f := &fakeStreams{scripts: []script{{[]string{"a"}, test.err}, {nil, io.EOF}}}
+		var got collect
+		if err := Receive(Options{Follow: test.follow}, f.open, &got); err != test.err {
+			t.Errorf("follow %v: got %v, want %v", test.follow, err, test.err)
+		}
+	}
+}
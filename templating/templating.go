@@ -0,0 +1,146 @@
+// Package templating runs the request files of generated commands through
+// text/template before they are decoded, so they can hold values which change
+// from one call to the next: the environment, the time, random IDs, the
+// contents of other files, and variables given on the command line.
+//
+// Templates are executed on the raw text of the file, whatever its format, with
+// the --var variables as data:
+//
+//	{"id": "{{uuid}}", "owner": "{{.owner}}", "at": "{{now "-1h"}}"}
+//
+// Stdin is read whole to be executed, so it's only a template when asked for,
+// leaving client streams read from it streaming.
+package templating
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"io/ioutil"
+	mrand "math/rand"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/spf13/pflag"
+)
+
+// Options hold the variables of the templates and how they are executed.
+type Options struct {
+	Vars   []string
+	Stdin  bool
+	Strict bool
+}
+
+// AddFlags adds the flags setting the options to fs.
+func (o *Options) AddFlags(fs *pflag.FlagSet) {
+	fs.StringArrayVar(&o.Vars, "var", o.Vars, "set a variable of the request file template, as key=value, used as {{.key}}; repeatable")
+	fs.BoolVar(&o.Stdin, "template-stdin", o.Stdin, "execute the request read from stdin as a template too, reading it whole first")
+	fs.BoolVar(&o.Strict, "template-strict", o.Strict, "fail on variables and environment variables the request file template uses which are unset")
+}
+
+// Reader returns a reader of the text of r executed as a template. The files
+// the template reads are relative to the directory of name, the file r reads.
+func (o *Options) Reader(name string, r io.Reader) (io.Reader, error) {
+	text, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	out, err := o.Execute(name, text)
+	if err != nil {
+		return nil, err
+	}
+	return bytes.NewReader(out), nil
+}
+
+// Execute executes text, the template named name, with the variables as data.
+func (o *Options) Execute(name string, text []byte) ([]byte, error) {
+	vars := map[string]string{}
+	for _, v := range o.Vars {
+		i := strings.Index(v, "=")
+		if i <= 0 {
+			return nil, fmt.Errorf("--var %s: want key=value", v)
+		}
+		vars[v[:i]] = v[i+1:]
+	}
+	missing := "missingkey=zero"
+	if o.Strict {
+		missing = "missingkey=error"
+	}
+	t, err := template.New(filepath.Base(name)).Option(missing).Funcs(o.funcs(filepath.Dir(name))).Parse(string(text))
+	if err != nil {
+		return nil, err
+	}
+	var b bytes.Buffer
+	if err := t.Execute(&b, vars); err != nil {
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
+
+// funcs returns the functions of the templates, reading the files in dir.
+func (o *Options) funcs(dir string) template.FuncMap {
+	rnd := mrand.New(mrand.NewSource(time.Now().UnixNano()))
+	return template.FuncMap{
+		// env returns the environment variable, or the default when it's
+		// unset and there's one
+		"env": func(name string, def ...string) (string, error) {
+			v, ok := os.LookupEnv(name)
+			switch {
+			case ok:
+				return v, nil
+			case len(def) > 0:
+				return def[0], nil
+			case o.Strict:
+				return "", fmt.Errorf("environment variable %s isn't set", name)
+			}
+			return "", nil
+		},
+		// now returns the time, moved by an optional duration, in the JSON
+		// mapping of google.protobuf.Timestamp
+		"now": func(offset ...string) (string, error) {
+			t := time.Now()
+			if len(offset) > 0 {
+				d, err := time.ParseDuration(offset[0])
+				if err != nil {
+					return "", err
+				}
+				t = t.Add(d)
+			}
+			return t.UTC().Format(time.RFC3339Nano), nil
+		},
+		"uuid": uuid,
+		// randInt returns a random integer of [min, max)
+		"randInt": func(min, max int) (int, error) {
+			if max <= min {
+				return 0, fmt.Errorf("randInt: %d isn't above %d", max, min)
+			}
+			return min + rnd.Intn(max-min), nil
+		},
+		"file": func(name string) (string, error) {
+			if !filepath.IsAbs(name) {
+				name = filepath.Join(dir, name)
+			}
+			b, err := ioutil.ReadFile(name)
+			return string(b), err
+		},
+		"base64": func(s string) string {
+			return base64.StdEncoding.EncodeToString([]byte(s))
+		},
+	}
+}
+
+// uuid returns a random version 4 UUID.
+func uuid() (string, error) {
+	var u [16]byte
+	if _, err := rand.Read(u[:]); err != nil {
+		return "", err
+	}
+	u[6] = u[6]&0x0f | 0x40
+	u[8] = u[8]&0x3f | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", u[0:4], u[4:6], u[6:8], u[8:10], u[10:]), nil
+}
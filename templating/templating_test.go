@@ -0,0 +1,101 @@
+package templating
+
+import (
+	"encoding/base64"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestExecute(t *testing.T) {
+	dir, err := ioutil.TempDir("", "templating")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	if err := ioutil.WriteFile(filepath.Join(dir, "key.pem"), []byte("secret"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	os.Setenv("TEMPLATING_TEST", "from env")
+	defer os.Unsetenv("TEMPLATING_TEST")
+
+	o := Options{Vars: []string{"owner=ann", "note=a=b"}}
+	for _, tc := range []struct {
+		in, want string
+	}{
+		{`{"owner":"{{.owner}}","note":"{{.note}}"}`, `{"owner":"ann","note":"a=b"}`},
+		{`owner: {{.nobody}}`, `owner: `},
+		{`{{env "TEMPLATING_TEST"}}, {{env "TEMPLATING_UNSET"}}, {{env "TEMPLATING_UNSET" "default"}}`, `from env, , default`},
+		{`{{file "key.pem"}} {{file "key.pem" | base64}}`, `secret ` + base64.StdEncoding.EncodeToString([]byte("secret"))},
+		{`{"id":"no template"}`, `{"id":"no template"}`},
+	} {
+		got, err := o.Execute(filepath.Join(dir, "req.json"), []byte(tc.in))
+		if err != nil {
+			t.Errorf("%s: %v", tc.in, err)
+			continue
+		}
+		if string(got) != tc.want {
+			t.Errorf("%s: got %s, want %s", tc.in, got, tc.want)
+		}
+	}
+}
+
+func TestGenerators(t *testing.T) {
+	var o Options
+	got, err := o.Execute("req.json", []byte(`{{uuid}} {{uuid}} {{now}} {{now "1h"}} {{randInt 5 7}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	f := strings.Fields(string(got))
+	uuid := regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`)
+	if !uuid.MatchString(f[0]) || f[0] == f[1] {
+		t.Errorf("got UUIDs %s and %s", f[0], f[1])
+	}
+	now, err := time.Parse(time.RFC3339Nano, f[2])
+	if err != nil || time.Since(now) > time.Minute {
+		t.Errorf("got now %s (%v)", f[2], err)
+	}
+	later, err := time.Parse(time.RFC3339Nano, f[3])
+	if err != nil || later.Sub(now) < 59*time.Minute {
+		t.Errorf("got an hour from now %s (%v)", f[3], err)
+	}
+	if n, err := strconv.Atoi(f[4]); err != nil || n < 5 || n >= 7 {
+		t.Errorf("got randInt %s", f[4])
+	}
+}
+
+func TestStrict(t *testing.T) {
+	o := Options{Strict: true}
+	for _, in := range []string{`{{.owner}}`, `{{env "TEMPLATING_UNSET"}}`} {
+		if _, err := o.Execute("req.json", []byte(in)); err == nil {
+			t.Errorf("%s: got no error", in)
+		}
+	}
+	if got, err := o.Execute("req.json", []byte(`{{env "TEMPLATING_UNSET" "x"}}`)); err != nil || string(got) != "x" {
+		t.Errorf("got %s, %v, want the default", got, err)
+	}
+}
+
+func TestErrors(t *testing.T) {
+	for _, tc := range []struct {
+		o    Options
+		in   string
+		want string
+	}{
+		{Options{Vars: []string{"owner"}}, ``, `--var owner: want key=value`},
+		{Options{}, `{{.owner`, `req.json:1: unclosed action`},
+		{Options{}, `{{randInt 3 3}}`, `randInt: 3 isn't above 3`},
+		{Options{}, `{{now "soon"}}`, `invalid duration`},
+		{Options{}, `{{file "missing.pem"}}`, `missing.pem`},
+	} {
+		_, err := tc.o.Execute("req.json", []byte(tc.in))
+		if err == nil || !strings.Contains(err.Error(), tc.want) {
+			t.Errorf("%s: got %v, want %s", tc.in, err, tc.want)
+		}
+	}
+}
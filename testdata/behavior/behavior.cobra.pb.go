@@ -0,0 +1,706 @@
+// Code generated by tetratelabs/protoc-gen-cobra.
+// source: behavior/behavior.proto
+// DO NOT EDIT!
+
+/*
+Package behavior is a generated protocol buffer package.
+
+It is generated from these files:
+	behavior/behavior.proto
+
+It has these top-level commands:
+	BooksClientCommand
+*/
+
+package behavior
+
+import (
+	proto "github.com/golang/protobuf/proto"
+	batch "github.com/tetratelabs/protoc-gen-cobra/batch"
+	cobra "github.com/spf13/cobra"
+	context "golang.org/x/net/context"
+	credentials "google.golang.org/grpc/credentials"
+	describe "github.com/tetratelabs/protoc-gen-cobra/describe"
+	destructive "github.com/tetratelabs/protoc-gen-cobra/destructive"
+	dryrun "github.com/tetratelabs/protoc-gen-cobra/dryrun"
+	editing "github.com/tetratelabs/protoc-gen-cobra/editing"
+	filepath "path/filepath"
+	fmt1 "fmt"
+	grpc "google.golang.org/grpc"
+	health "github.com/tetratelabs/protoc-gen-cobra/health"
+	io "io"
+	iocodec "github.com/tetratelabs/protoc-gen-cobra/iocodec"
+	ioutil "io/ioutil"
+	json "encoding/json"
+	loadtest "github.com/tetratelabs/protoc-gen-cobra/loadtest"
+	log "log"
+	metadata "google.golang.org/grpc/metadata"
+	net "net"
+	oauth "google.golang.org/grpc/credentials/oauth"
+	oauth2 "golang.org/x/oauth2"
+	operation "github.com/tetratelabs/protoc-gen-cobra/operation"
+	os "os"
+	override "github.com/tetratelabs/protoc-gen-cobra/override"
+	pagination "github.com/tetratelabs/protoc-gen-cobra/pagination"
+	pflag "github.com/spf13/pflag"
+	prompting "github.com/tetratelabs/protoc-gen-cobra/prompting"
+	recording "github.com/tetratelabs/protoc-gen-cobra/recording"
+	streaming "github.com/tetratelabs/protoc-gen-cobra/streaming"
+	template "text/template"
+	templating "github.com/tetratelabs/protoc-gen-cobra/templating"
+	time "time"
+	tls "crypto/tls"
+	tracing "github.com/tetratelabs/protoc-gen-cobra/tracing"
+	validation "github.com/tetratelabs/protoc-gen-cobra/validation"
+	verbose "github.com/tetratelabs/protoc-gen-cobra/verbose"
+	x509 "crypto/x509"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+var _DefaultBooksClientCommandConfig = _NewBooksClientCommandConfig()
+
+type _BooksClientCommandConfig struct {
+	ServerAddr         string
+	RequestFile        string
+	Stdin              bool
+	PrintSampleRequest bool
+	ResponseFormat     string
+	Timeout            time.Duration
+	TLS                bool
+	ServerName         string
+	InsecureSkipVerify bool
+	CACertFile         string
+	CertFile           string
+	KeyFile            string
+	AuthToken          string
+	AuthTokenType      string
+	JWTKey             string
+	JWTKeyFile         string
+	SkipValidation     bool
+	LoadTest           loadtest.Options
+	Record             string
+	DryRun             bool
+	Verbose            bool
+	Trace              tracing.Options
+	Set                override.Options
+	Template           templating.Options
+	Edit               bool
+	Interactive        bool
+	Destructive        destructive.Options
+}
+
+func _NewBooksClientCommandConfig() *_BooksClientCommandConfig {
+	c := &_BooksClientCommandConfig{
+		ServerAddr:     "localhost:8080",
+		ResponseFormat: "json",
+		Timeout:        10 * time.Second,
+		AuthTokenType:  "Bearer",
+	}
+	return c
+}
+
+func (o *_BooksClientCommandConfig) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVarP(&o.ServerAddr, "server-addr", "s", o.ServerAddr, "server address in form of host:port")
+	fs.StringVarP(&o.RequestFile, "request-file", "f", o.RequestFile, "client request file (must be json, yaml, or xml); use \"-\" for stdin + json")
+	fs.BoolVar(&o.Stdin, "stdin", o.Stdin, "read client request from STDIN; alternative for '-f -'")
+	fs.BoolVarP(&o.PrintSampleRequest, "print-sample-request", "p", o.PrintSampleRequest, "print sample request file and exit")
+	fs.StringVarP(&o.ResponseFormat, "response-format", "o", o.ResponseFormat, "response format (json, prettyjson, yaml, or xml)")
+	fs.DurationVar(&o.Timeout, "timeout", o.Timeout, "client connection timeout")
+	fs.BoolVar(&o.TLS, "tls", o.TLS, "enable tls")
+	fs.StringVar(&o.ServerName, "tls-server-name", o.ServerName, "tls server name override")
+	fs.BoolVar(&o.InsecureSkipVerify, "tls-insecure-skip-verify", o.InsecureSkipVerify, "INSECURE: skip tls checks")
+	fs.StringVar(&o.CACertFile, "tls-ca-cert-file", o.CACertFile, "ca certificate file")
+	fs.StringVar(&o.CertFile, "tls-cert-file", o.CertFile, "client certificate file")
+	fs.StringVar(&o.KeyFile, "tls-key-file", o.KeyFile, "client key file")
+	fs.StringVar(&o.AuthToken, "auth-token", o.AuthToken, "authorization token")
+	fs.StringVar(&o.AuthTokenType, "auth-token-type", o.AuthTokenType, "authorization token type")
+	fs.StringVar(&o.JWTKey, "jwt-key", o.JWTKey, "jwt key")
+	fs.StringVar(&o.JWTKeyFile, "jwt-key-file", o.JWTKeyFile, "jwt key file")
+	fs.BoolVar(&o.SkipValidation, "skip-validation", o.SkipValidation, "skip the client-side validation of requests")
+	o.LoadTest.AddFlags(fs)
+	fs.StringVar(&o.Record, "record", o.Record, "record the calls, with their metadata, messages, statuses and timings, to this session file")
+	fs.BoolVar(&o.DryRun, "dry-run", o.DryRun, "print the requests, where they would be sent and the metadata, with secrets redacted, instead of sending them")
+	fs.BoolVarP(&o.Verbose, "verbose", "v", o.Verbose, "log the connection, the tls handshake, and the metadata, message sizes and timings of the calls to stderr, with secrets redacted")
+	o.Trace.AddFlags(fs)
+	o.Set.AddFlags(fs)
+	o.Template.AddFlags(fs)
+	fs.BoolVar(&o.Edit, "edit", o.Edit, "open the request, or a sample of it without one, in $EDITOR before sending it")
+	fs.BoolVar(&o.Interactive, "interactive", o.Interactive, "when stdin is a terminal, prompt for the fields the request doesn't set, and for confirmation before sending it")
+	o.Destructive.AddFlags(fs)
+}
+
+func BooksClientCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "books",
+	}
+	_DefaultBooksClientCommandConfig.AddFlags(cmd.PersistentFlags())
+
+	for _, s := range _BooksClientSubCommands {
+		cmd.AddCommand(s())
+	}
+	cmd.AddCommand(_BooksReplayCommand())
+	cmd.AddCommand(_BooksHealthCommand())
+	cmd.AddCommand(_BooksDescribeCommand())
+	return cmd
+}
+
+func _BooksReplayCommand() *cobra.Command {
+	var compare bool
+	cmd := &cobra.Command{
+		Use:   "replay [file]",
+		Short: "Replay the calls of a session recorded with --record",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			f, err := os.Open(args[0])
+			if err != nil {
+				log.Fatal(err)
+			}
+			defer f.Close()
+			err = _BooksRoundTrip(nil, nil, func(conn *grpc.ClientConn, cli BooksClient, in iocodec.Decoder, out iocodec.Encoder) error {
+				if _DefaultBooksClientCommandConfig.DryRun {
+					reqs, err := recording.Requests(f)
+					if err != nil {
+						return err
+					}
+					return _BooksDryRun(out, reqs...)
+				}
+				return recording.Replay(conn, f, compare, out, os.Stderr)
+			})
+			if err != nil {
+				log.Fatal(err)
+			}
+		},
+	}
+	cmd.Flags().BoolVar(&compare, "compare", false, "compare the responses and statuses to the recorded ones, field by field, and fail if they differ")
+	return cmd
+}
+
+func _BooksHealthCommand() *cobra.Command {
+	opts := health.Options{Service: "behavior.Books"}
+	cmd := &cobra.Command{
+		Use:     "health",
+		Aliases: []string{"ping"},
+		Short:   "Check the health of behavior.Books with the gRPC health checking protocol, and report how the connection is made",
+		Long:    "Check the health of behavior.Books with the gRPC health checking protocol, and report how the connection is made: its TLS details, the certificate chain of the server and the latency of the check.\n\nExits with 0 if the service is serving, 2 if the connection fails, 3 if the check fails, and 4 if the service isn't serving.",
+		Args:    cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			cfg := _DefaultBooksClientCommandConfig
+			em, err := _BooksEncoderMaker()
+			if err != nil {
+				log.Fatal(err)
+			}
+			if cfg.DryRun {
+				if err := _BooksDryRun(em.NewEncoder(os.Stdout)); err != nil {
+					log.Fatal(err)
+				}
+				return
+			}
+			err = health.Check(opts, cfg.Timeout, func() (*grpc.ClientConn, error) {
+				conn, _, err := _DialBooks()
+				return conn, err
+			}, em.NewEncoder(os.Stdout))
+			if err != nil {
+				log.Print(err)
+				os.Exit(health.ExitCode(err))
+			}
+		},
+	}
+	opts.AddFlags(cmd.Flags())
+	return cmd
+}
+
+func _BooksDescribeCommand() *cobra.Command {
+	var raw bool
+	cmd := &cobra.Command{
+		Use:   "describe [name]",
+		Short: "Print the definition of behavior.Books, or of one of its methods, messages or enums, with its comments",
+		Long:  "Print the definition of behavior.Books, or of one of its methods, messages or enums, with its comments, from the descriptors built into the command.\n\nThe name is a method, as named in the proto file or as its command, or the name of a message or enum, relative to the package of the service or in full. A method is followed by its request and response messages.",
+		Args:  cobra.MaximumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			name := ""
+			if len(args) > 0 {
+				name = args[0]
+			}
+			defs, err := describe.Lookup("behavior.Books", name)
+			if err != nil {
+				log.Fatal(err)
+			}
+			if !raw {
+				if err := describe.Print(os.Stdout, defs); err != nil {
+					log.Fatal(err)
+				}
+				return
+			}
+			em, err := _BooksEncoderMaker()
+			if err != nil {
+				log.Fatal(err)
+			}
+			enc := em.NewEncoder(os.Stdout)
+			for _, d := range defs {
+				if err := enc.Encode(d.Descriptor); err != nil {
+					log.Fatal(err)
+				}
+			}
+		},
+	}
+	cmd.Flags().BoolVar(&raw, "descriptor", false, "print the descriptors of the definitions in the response format instead of proto source")
+	return cmd
+}
+
+func _DialBooks() (*grpc.ClientConn, BooksClient, error) {
+	cfg := _DefaultBooksClientCommandConfig
+	var v *verbose.Logger
+	var opts []grpc.DialOption
+	if cfg.Trace.Enabled() {
+		t, err := tracing.Start(cfg.Trace)
+		if err != nil {
+			return nil, nil, fmt.Errorf("trace: %v", err)
+		}
+		opts = append(opts, t.DialOptions()...)
+	}
+	if cfg.Verbose {
+		v = verbose.New(os.Stderr)
+		opts = append(opts, v.DialOptions()...)
+	}
+	if cfg.TLS {
+		tlsConfig := &tls.Config{}
+		if cfg.InsecureSkipVerify {
+			tlsConfig.InsecureSkipVerify = true
+		}
+		if cfg.CACertFile != "" {
+			cacert, err := ioutil.ReadFile(cfg.CACertFile)
+			if err != nil {
+				return nil, nil, fmt.Errorf("ca cert: %v", err)
+			}
+			certpool := x509.NewCertPool()
+			certpool.AppendCertsFromPEM(cacert)
+			tlsConfig.RootCAs = certpool
+		}
+		if cfg.CertFile != "" {
+			if cfg.KeyFile == "" {
+				return nil, nil, fmt.Errorf("missing key file")
+			}
+			pair, err := tls.LoadX509KeyPair(cfg.CertFile, cfg.KeyFile)
+			if err != nil {
+				return nil, nil, fmt.Errorf("cert/key: %v", err)
+			}
+			tlsConfig.Certificates = []tls.Certificate{pair}
+		}
+		if cfg.ServerName != "" {
+			tlsConfig.ServerName = cfg.ServerName
+		} else {
+			addr, _, _ := net.SplitHostPort(cfg.ServerAddr)
+			tlsConfig.ServerName = addr
+		}
+		//tlsConfig.BuildNameToCertificate()
+		cred := credentials.NewTLS(tlsConfig)
+		if v != nil {
+			cred = v.Credentials(cred)
+		}
+		opts = append(opts, grpc.WithTransportCredentials(cred))
+	} else {
+		opts = append(opts, grpc.WithInsecure())
+	}
+	if cfg.AuthToken != "" {
+		cred := oauth.NewOauthAccess(&oauth2.Token{
+			AccessToken: cfg.AuthToken,
+			TokenType:   cfg.AuthTokenType,
+		})
+		opts = append(opts, grpc.WithPerRPCCredentials(cred))
+	}
+	if cfg.JWTKey != "" {
+		cred, err := oauth.NewJWTAccessFromKey([]byte(cfg.JWTKey))
+		if err != nil {
+			return nil, nil, fmt.Errorf("jwt key: %v", err)
+		}
+		opts = append(opts, grpc.WithPerRPCCredentials(cred))
+	}
+	if cfg.JWTKeyFile != "" {
+		cred, err := oauth.NewJWTAccessFromFile(cfg.JWTKeyFile)
+		if err != nil {
+			return nil, nil, fmt.Errorf("jwt key file: %v", err)
+		}
+		opts = append(opts, grpc.WithPerRPCCredentials(cred))
+	}
+	if cfg.Record != "" {
+		rec, err := recording.Open(cfg.Record)
+		if err != nil {
+			return nil, nil, fmt.Errorf("record: %v", err)
+		}
+		opts = append(opts, rec.DialOptions()...)
+	}
+	var conn *grpc.ClientConn
+	var err error
+	if v != nil {
+		conn, err = v.Dial(cfg.ServerAddr, cfg.Timeout, opts...)
+	} else {
+		conn, err = grpc.Dial(cfg.ServerAddr, append(opts, grpc.WithBlock(), grpc.WithTimeout(cfg.Timeout))...)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	return conn, NewBooksClient(conn), nil
+}
+
+// _BooksEncoderMaker returns the encoder maker of the response format.
+func _BooksEncoderMaker() (iocodec.EncoderMaker, error) {
+	format := _DefaultBooksClientCommandConfig.ResponseFormat
+	if format == "" {
+		format = "json"
+	}
+	em, ok := iocodec.DefaultEncoders[format]
+	if !ok {
+		return nil, fmt.Errorf("invalid response format: %q", format)
+	}
+	return em, nil
+}
+
+type _BooksRoundTripFunc func(conn *grpc.ClientConn, cli BooksClient, in iocodec.Decoder, out iocodec.Encoder) error
+
+// _BooksRoundTrip reads the request with prepare, when set, before it
+// dials the server and calls fn. Commands without a request have no sample.
+func _BooksRoundTrip(sample proto.Message, prepare func(in iocodec.Decoder) error, fn _BooksRoundTripFunc) error {
+	cfg := _DefaultBooksClientCommandConfig
+	em, err := _BooksEncoderMaker()
+	if err != nil {
+		return err
+	}
+	if cfg.PrintSampleRequest {
+		if sample == nil {
+			return fmt.Errorf("no sample request")
+		}
+		return em.NewEncoder(os.Stdout).Encode(iocodec.NewSample(sample))
+	}
+	// read the input request, first from stdin, then from a file, otherwise from args only
+	var d iocodec.Decoder
+	if cfg.Stdin || cfg.RequestFile == "-" {
+		if cfg.Edit {
+			// the editor needs the terminal
+			return fmt.Errorf("--edit can't be used with a request read from stdin")
+		}
+		var in io.Reader = os.Stdin
+		if cfg.Template.Stdin {
+			if in, err = cfg.Template.Reader("stdin", os.Stdin); err != nil {
+				return fmt.Errorf("request template: %v", err)
+			}
+		}
+		d = iocodec.DefaultDecoders["json"].NewDecoder(in)
+	} else if cfg.RequestFile != "" {
+		f, err := os.Open(cfg.RequestFile)
+		if err != nil {
+			return fmt.Errorf("request file: %v", err)
+		}
+		defer f.Close()
+		ext := filepath.Ext(cfg.RequestFile)
+		if len(ext) > 0 && ext[0] == '.' {
+			ext = ext[1:]
+		}
+		dm, ok := iocodec.DefaultDecoders[ext]
+		if !ok {
+			return fmt.Errorf("invalid request file format: %q", ext)
+		}
+		in, err := cfg.Template.Reader(cfg.RequestFile, f)
+		if err != nil {
+			return fmt.Errorf("request template: %v", err)
+		}
+		d = dm.NewDecoder(in)
+	} else {
+		d = iocodec.DefaultDecoders["noop"].NewDecoder(os.Stdin)
+	}
+	d = cfg.Set.Decoder(d)
+	if prepare != nil {
+		if err := prepare(d); err != nil {
+			return err
+		}
+	}
+	if cfg.DryRun {
+		// fn prints what it would send instead
+		return fn(nil, nil, d, em.NewEncoder(os.Stdout))
+	}
+	conn, client, err := _DialBooks()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	return fn(conn, client, d, em.NewEncoder(os.Stdout))
+}
+
+// _BooksPrompter returns the prompter asking for the fields of requests,
+// or nil unless --interactive is given and stdin is a terminal.
+func _BooksPrompter() *prompting.Prompter {
+	if !_DefaultBooksClientCommandConfig.Interactive || !prompting.IsTerminal(os.Stdin) {
+		return nil
+	}
+	return prompting.New(os.Stdin, os.Stderr)
+}
+
+// _BooksConfirmDestructive asks for confirmation of a call of the
+// destructive method, showing req unless nil. It's called once the server is
+// dialed, so sample requests and dry runs, which send nothing, aren't asked
+// about.
+func _BooksConfirmDestructive(command, method string, req proto.Message) error {
+	em, err := _BooksEncoderMaker()
+	if err != nil {
+		return err
+	}
+	return _DefaultBooksClientCommandConfig.Destructive.Confirm(command, method, req, em)
+}
+
+// _BooksEdit opens the editor on m, or on a sample of it when it's empty,
+// in the format of the request file, or else the response format.
+func _BooksEdit(m proto.Message, check func(proto.Message) error) error {
+	cfg := _DefaultBooksClientCommandConfig
+	format := cfg.ResponseFormat
+	if ext := filepath.Ext(cfg.RequestFile); len(ext) > 1 {
+		format = ext[1:]
+	}
+	var v interface{} = m
+	if proto.Size(m) == 0 {
+		v = iocodec.NewSample(m)
+	}
+	return editing.Edit(format, v, m, check)
+}
+
+// _BooksDryRun prints reqs, along with where and how they would be sent.
+func _BooksDryRun(out iocodec.Encoder, reqs ...proto.Message) error {
+	cfg := _DefaultBooksClientCommandConfig
+	plan := dryrun.Plan{
+		Target:   cfg.ServerAddr,
+		Timeout:  cfg.Timeout,
+		Metadata: metadata.MD{},
+		Requests: reqs,
+	}
+	if cfg.TLS {
+		plan.TLS = &dryrun.TLS{
+			ServerName:         cfg.ServerName,
+			InsecureSkipVerify: cfg.InsecureSkipVerify,
+			CACertFile:         cfg.CACertFile,
+			CertFile:           cfg.CertFile,
+			KeyFile:            cfg.KeyFile,
+		}
+		if plan.TLS.ServerName == "" {
+			plan.TLS.ServerName, _, _ = net.SplitHostPort(cfg.ServerAddr)
+		}
+	}
+	if cfg.AuthToken != "" {
+		plan.Metadata.Append("authorization", dryrun.Redact(cfg.AuthTokenType+" "+cfg.AuthToken))
+	}
+	if cfg.JWTKey != "" || cfg.JWTKeyFile != "" {
+		plan.Metadata.Append("authorization", "Bearer "+dryrun.Redacted)
+	}
+	return dryrun.Print(plan, out)
+}
+
+// _BooksLoadTest makes calls with call, as many and as fast as the load
+// test flags say, over conn and the connections it dials, and prints a report
+// of them.
+func _BooksLoadTest(conn *grpc.ClientConn, call func(ctx context.Context, cli BooksClient) error) error {
+	dial := func() (*grpc.ClientConn, error) {
+		conn, _, err := _DialBooks()
+		return conn, err
+	}
+	return loadtest.Run(_DefaultBooksClientCommandConfig.LoadTest, conn, dial, func(ctx context.Context, conn *grpc.ClientConn) error {
+		return call(ctx, NewBooksClient(conn))
+	}, os.Stdout)
+}
+
+func _BooksValidate(m proto.Message, flags *pflag.FlagSet) error {
+	if _DefaultBooksClientCommandConfig.SkipValidation {
+		return nil
+	}
+	return validation.Validate(m, flags)
+}
+
+func _BooksCreateClientCommand() *cobra.Command {
+	reqArgs := &CreateBookRequest{
+		Book: &Book{},
+	}
+	var batchOpts batch.Options
+
+	cmd := &cobra.Command{
+		Use:     "create",
+		Long:    "Create client; call by piping a request in to stdin (--stdin), reading a file (--file), or via flags per field",
+		Example: "TODO: print protobuf method comments here",
+		Run: func(cmd *cobra.Command, args []string) {
+			var v CreateBookRequest
+
+			prepare := func(in iocodec.Decoder) error {
+				err := in.Decode(&v)
+				if err != nil {
+					return err
+				}
+
+				proto.Merge(&v, reqArgs)
+
+				prompter := _BooksPrompter()
+				if prompter != nil {
+					err = prompter.Fields(&v)
+					if err != nil {
+						return err
+					}
+				}
+				if _DefaultBooksClientCommandConfig.Edit {
+					err = _BooksEdit(&v, func(m proto.Message) error {
+						return _BooksValidate(m, cmd.Flags())
+					})
+				} else {
+					err = _BooksValidate(&v, cmd.Flags())
+				}
+				if err != nil {
+					return err
+				}
+				if prompter == nil || _DefaultBooksClientCommandConfig.DryRun {
+					return nil
+				}
+				em, err := _BooksEncoderMaker()
+				if err != nil {
+					return err
+				}
+				return prompter.Confirm(&v, em)
+			}
+
+			if batchOpts.File != "" {
+				// the requests of the batch are read and checked one by one
+				prepare = nil
+			}
+
+			err := _BooksRoundTrip(&v, prepare, func(conn *grpc.ClientConn, cli BooksClient, in iocodec.Decoder, out iocodec.Encoder) error {
+
+				if batchOpts.File != "" {
+					if _DefaultBooksClientCommandConfig.DryRun {
+						return fmt.Errorf("--dry-run can't be used with --batch")
+					}
+					if _DefaultBooksClientCommandConfig.Edit {
+						return fmt.Errorf("--edit can't be used with --batch")
+					}
+					return batch.Run(batchOpts, func(ctx context.Context, r *batch.Record) (proto.Message, error) {
+						var v CreateBookRequest
+						err := r.Decode(&v)
+						if err != nil {
+							return nil, err
+						}
+						err = _DefaultBooksClientCommandConfig.Set.Apply(&v)
+						if err != nil {
+							return nil, batch.Invalid(err)
+						}
+						// the defaults of the record must not leak into
+						// the next ones
+						reqArgs := proto.Clone(reqArgs).(*CreateBookRequest)
+
+						proto.Merge(&v, reqArgs)
+						err = _BooksValidate(&v, cmd.Flags())
+						if err != nil {
+							return nil, batch.Invalid(err)
+						}
+						return cli.Create(ctx, &v)
+					}, out)
+				}
+
+				if _DefaultBooksClientCommandConfig.DryRun {
+					return _BooksDryRun(out, &v)
+				}
+				if _DefaultBooksClientCommandConfig.LoadTest.Enabled() {
+					return _BooksLoadTest(conn, func(ctx context.Context, cli BooksClient) error {
+						_, err := cli.Create(ctx, &v)
+						return err
+					})
+				}
+
+				resp, err := cli.Create(context.Background(), &v)
+				if err != nil {
+					return err
+				}
+
+				return out.Encode(resp)
+
+			})
+			if err != nil {
+				log.Fatal(err)
+			}
+		},
+	}
+
+	cmd.PersistentFlags().StringVar(&reqArgs.Parent, "parent", "", "get-comment-from-proto (required)")
+	cmd.PersistentFlags().StringVar(&reqArgs.Book.Title, "book-title", "", "get-comment-from-proto (required)")
+	cmd.PersistentFlags().StringVar(&reqArgs.Book.Isbn, "book-isbn", "", "get-comment-from-proto (immutable)")
+	batchOpts.AddFlags(cmd.PersistentFlags())
+
+	return cmd
+}
+
+var _BooksClientSubCommands = []func() *cobra.Command{
+	_BooksCreateClientCommand,
+}
+
+func init() {
+	iocodec.RegisterComments(map[string]string{
+		"behavior.Book.name": " The server assigns the name.",
+	})
+}
+
+func init() {
+	validation.RegisterRequired(
+		"behavior.Book.title",
+		"behavior.CreateBookRequest.book",
+		"behavior.CreateBookRequest.parent",
+	)
+	iocodec.RegisterOutputOnly(
+		"behavior.Book.name",
+		"behavior.Book.stats",
+	)
+}
+
+func init() { describe.Register(_descriptorSet_Behavior_2e89c8fe3283a63f) }
+
+var _descriptorSet_Behavior_2e89c8fe3283a63f = []byte{
+	// 630 bytes of a gzipped FileDescriptorSet
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x94, 0x4f, 0x6f, 0xd3, 0x4c,
+	0x10, 0x87, 0x77, 0x77, 0xd6, 0x9b, 0xcd, 0xc4, 0x69, 0xea, 0x71, 0xaa, 0x38, 0xad, 0xde, 0xbe,
+	0x56, 0x2f, 0xb4, 0x20, 0x1a, 0xa9, 0x08, 0xf1, 0xa7, 0x69, 0x25, 0xca, 0x8d, 0x63, 0xe0, 0xc2,
+	0x09, 0x39, 0x62, 0x49, 0xa3, 0x96, 0x38, 0xc4, 0xa6, 0x57, 0x3e, 0x07, 0x12, 0x37, 0xbe, 0x18,
+	0x1f, 0x05, 0xcd, 0xda, 0x9b, 0x0a, 0xb8, 0xe5, 0x19, 0x8f, 0x9f, 0x9d, 0xdf, 0x78, 0x15, 0xfc,
+	0xd9, 0xc5, 0xd1, 0xdc, 0x5d, 0x17, 0x77, 0xcb, 0x72, 0x33, 0x09, 0x3f, 0x4e, 0xd7, 0x9b, 0xb2,
+	0x2e, 0xc9, 0x06, 0xde, 0xff, 0x7f, 0x51, 0x96, 0x8b, 0x5b, 0x37, 0x29, 0xd6, 0xcb, 0xc9, 0xa7,
+	0xa5, 0xbb, 0xfd, 0xf8, 0xe1, 0xcf, 0xd6, 0xa3, 0xf7, 0x98, 0xbc, 0xde, 0xb8, 0xa2, 0x76, 0x57,
+	0x65, 0x79, 0x33, 0x73, 0x5f, 0xbe, 0xba, 0xaa, 0xa6, 0x03, 0x34, 0xeb, 0x62, 0xe3, 0x56, 0x75,
+	0x26, 0x73, 0x79, 0xdc, 0xbd, 0x82, 0x5f, 0xaf, 0xd4, 0xac, 0x2d, 0xd1, 0x03, 0xd4, 0xf3, 0xb2,
+	0xbc, 0xc9, 0x54, 0x2e, 0x8f, 0x7b, 0x67, 0x3b, 0xa7, 0x5b, 0x21, 0x1b, 0x9a, 0x56, 0xdf, 0x70,
+	0xf4, 0x0d, 0x35, 0x97, 0x68, 0x84, 0x7a, 0x55, 0x7c, 0x76, 0xf7, 0x2e, 0x98, 0xf9, 0x02, 0x8d,
+	0x31, 0xaa, 0x97, 0xf5, 0xad, 0xcb, 0x54, 0x78, 0xa2, 0x66, 0x4d, 0x85, 0xdf, 0x59, 0x56, 0xf3,
+	0x55, 0x06, 0xe1, 0x49, 0x34, 0xf3, 0x05, 0x7a, 0x88, 0x51, 0x55, 0x17, 0x75, 0x95, 0x69, 0x7f,
+	0xfc, 0xe0, 0xfe, 0xf8, 0xb7, 0x5c, 0x6e, 0xf4, 0x4d, 0xcb, 0xd1, 0x7f, 0x18, 0xf9, 0x22, 0x0d,
+	0x31, 0x5a, 0x17, 0x0b, 0x57, 0xf9, 0x11, 0xfa, 0xb3, 0x06, 0xce, 0x2e, 0x31, 0xe2, 0xf9, 0x2a,
+	0x7a, 0x8a, 0xa6, 0xd9, 0x01, 0x1d, 0xdc, 0xeb, 0xfe, 0xd9, 0xca, 0xfe, 0x5f, 0x51, 0xdf, 0x7c,
+	0x37, 0x68, 0x48, 0x0b, 0x31, 0x96, 0x68, 0x51, 0xc6, 0x04, 0x42, 0x10, 0xff, 0x52, 0x04, 0x4a,
+	0x24, 0xd8, 0x45, 0x05, 0x82, 0x40, 0x8b, 0x13, 0x44, 0x54, 0x46, 0x90, 0x36, 0xc2, 0x4a, 0x44,
+	0x04, 0x23, 0x24, 0x81, 0xb1, 0x7d, 0xec, 0xa1, 0x36, 0x42, 0x09, 0x82, 0x8e, 0x9a, 0x60, 0x8c,
+	0x11, 0x83, 0x24, 0xe8, 0x98, 0x38, 0x90, 0x22, 0xe8, 0xf4, 0x0f, 0x03, 0x01, 0x41, 0xe7, 0xe4,
+	0x31, 0xeb, 0xb4, 0x20, 0x8d, 0xa2, 0xef, 0x75, 0x9a, 0xdf, 0x41, 0x3b, 0x66, 0x9d, 0xf6, 0xba,
+	0x9e, 0xba, 0xe0, 0x57, 0x18, 0x22, 0x26, 0x1b, 0x48, 0x12, 0xf4, 0xba, 0x83, 0x40, 0x40, 0xd0,
+	0xa3, 0x34, 0x90, 0x25, 0xe8, 0x0d, 0xa7, 0x38, 0x40, 0xeb, 0xe9, 0x87, 0x65, 0xd1, 0xde, 0x79,
+	0x6b, 0x95, 0x04, 0xb1, 0x7a, 0xd1, 0xf6, 0x4a, 0xc3, 0x64, 0x02, 0xf1, 0xb3, 0x4e, 0x2f, 0x10,
+	0x10, 0xc4, 0x3b, 0xe1, 0x0c, 0x69, 0x09, 0xe2, 0xdd, 0xe7, 0xad, 0x55, 0x36, 0xd6, 0x38, 0x79,
+	0xe6, 0x33, 0x48, 0xd2, 0x03, 0xb1, 0xd7, 0x64, 0x60, 0xc9, 0xc0, 0xc6, 0xf8, 0x08, 0xb5, 0x96,
+	0x9c, 0x21, 0x51, 0x97, 0xfb, 0x87, 0xf9, 0xbb, 0x6b, 0x97, 0x57, 0x6e, 0x73, 0xe7, 0x36, 0x79,
+	0x51, 0x55, 0xcb, 0xc5, 0xaa, 0xca, 0xeb, 0x6b, 0x97, 0xf3, 0xf5, 0x39, 0x45, 0x7f, 0x86, 0xf4,
+	0x19, 0x93, 0x36, 0xa3, 0xf4, 0x19, 0x93, 0x6e, 0x3f, 0x10, 0x10, 0x24, 0xbb, 0x49, 0x20, 0x4b,
+	0x90, 0xd0, 0x85, 0x9f, 0x46, 0x86, 0x8c, 0x49, 0x3a, 0xf5, 0x19, 0x25, 0x67, 0x24, 0x35, 0x6d,
+	0x7b, 0x65, 0xc4, 0x14, 0xac, 0x3c, 0x1e, 0x75, 0x77, 0x02, 0x01, 0x01, 0x25, 0x14, 0xc8, 0x12,
+	0x50, 0x7a, 0xde, 0x5a, 0xdb, 0x8c, 0x34, 0x7c, 0xd9, 0x5a, 0x15, 0x41, 0xba, 0xb5, 0xaa, 0x88,
+	0x29, 0x58, 0xf9, 0xc4, 0x74, 0x3b, 0xab, 0x02, 0x82, 0x74, 0x3b, 0xab, 0xb2, 0x04, 0x29, 0x05,
+	0xab, 0x6a, 0xac, 0x69, 0x1a, 0xac, 0x40, 0x30, 0x54, 0x97, 0x6d, 0x2f, 0x18, 0xa6, 0x4e, 0x20,
+	0x49, 0x30, 0xb4, 0xc1, 0x0a, 0xdc, 0xb9, 0xb5, 0x82, 0x25, 0x18, 0x6e, 0x37, 0x00, 0x8d, 0x75,
+	0x98, 0x4e, 0xfd, 0xf7, 0x50, 0xa4, 0x47, 0x62, 0xdc, 0x7c, 0x0f, 0x1e, 0x6d, 0xd4, 0x5c, 0x51,
+	0xad, 0xf8, 0x7b, 0x64, 0xaa, 0xb9, 0x29, 0xca, 0xef, 0x3b, 0x6b, 0x33, 0x28, 0xbf, 0xef, 0xac,
+	0xdd, 0x8c, 0xf2, 0xfb, 0xce, 0x12, 0x9a, 0x1b, 0xff, 0xef, 0xf2, 0xe4, 0xf7, 0x00, 0xbc, 0xb5,
+	0xa4, 0x68, 0xa6, 0x04, 0x00, 0x00,
+}
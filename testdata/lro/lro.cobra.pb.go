@@ -0,0 +1,1087 @@
+// Code generated by tetratelabs/protoc-gen-cobra.
+// source: lro/lro.proto
+// DO NOT EDIT!
+
+/*
+Package lro is a generated protocol buffer package.
+
+It is generated from these files:
+	lro/lro.proto
+
+It has these top-level commands:
+	JobsClientCommand
+*/
+
+package lro
+
+import (
+	proto "github.com/golang/protobuf/proto"
+	batch "github.com/tetratelabs/protoc-gen-cobra/batch"
+	cobra "github.com/spf13/cobra"
+	context "golang.org/x/net/context"
+	credentials "google.golang.org/grpc/credentials"
+	describe "github.com/tetratelabs/protoc-gen-cobra/describe"
+	destructive "github.com/tetratelabs/protoc-gen-cobra/destructive"
+	dryrun "github.com/tetratelabs/protoc-gen-cobra/dryrun"
+	editing "github.com/tetratelabs/protoc-gen-cobra/editing"
+	filepath "path/filepath"
+	fmt1 "fmt"
+	grpc "google.golang.org/grpc"
+	health "github.com/tetratelabs/protoc-gen-cobra/health"
+	io "io"
+	iocodec "github.com/tetratelabs/protoc-gen-cobra/iocodec"
+	ioutil "io/ioutil"
+	json "encoding/json"
+	loadtest "github.com/tetratelabs/protoc-gen-cobra/loadtest"
+	log "log"
+	metadata "google.golang.org/grpc/metadata"
+	net "net"
+	oauth "google.golang.org/grpc/credentials/oauth"
+	oauth2 "golang.org/x/oauth2"
+	operation "github.com/tetratelabs/protoc-gen-cobra/operation"
+	os "os"
+	override "github.com/tetratelabs/protoc-gen-cobra/override"
+	pagination "github.com/tetratelabs/protoc-gen-cobra/pagination"
+	pflag "github.com/spf13/pflag"
+	prompting "github.com/tetratelabs/protoc-gen-cobra/prompting"
+	recording "github.com/tetratelabs/protoc-gen-cobra/recording"
+	streaming "github.com/tetratelabs/protoc-gen-cobra/streaming"
+	template "text/template"
+	templating "github.com/tetratelabs/protoc-gen-cobra/templating"
+	time "time"
+	tls "crypto/tls"
+	tracing "github.com/tetratelabs/protoc-gen-cobra/tracing"
+	validation "github.com/tetratelabs/protoc-gen-cobra/validation"
+	verbose "github.com/tetratelabs/protoc-gen-cobra/verbose"
+	x509 "crypto/x509"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+var _DefaultJobsClientCommandConfig = _NewJobsClientCommandConfig()
+
+type _JobsClientCommandConfig struct {
+	ServerAddr         string
+	RequestFile        string
+	Stdin              bool
+	PrintSampleRequest bool
+	ResponseFormat     string
+	Timeout            time.Duration
+	TLS                bool
+	ServerName         string
+	InsecureSkipVerify bool
+	CACertFile         string
+	CertFile           string
+	KeyFile            string
+	AuthToken          string
+	AuthTokenType      string
+	JWTKey             string
+	JWTKeyFile         string
+	SkipValidation     bool
+	LoadTest           loadtest.Options
+	Record             string
+	DryRun             bool
+	Verbose            bool
+	Trace              tracing.Options
+	Set                override.Options
+	Template           templating.Options
+	Edit               bool
+	Interactive        bool
+	Destructive        destructive.Options
+}
+
+func _NewJobsClientCommandConfig() *_JobsClientCommandConfig {
+	c := &_JobsClientCommandConfig{
+		ServerAddr:     "localhost:8080",
+		ResponseFormat: "json",
+		Timeout:        10 * time.Second,
+		AuthTokenType:  "Bearer",
+	}
+	return c
+}
+
+func (o *_JobsClientCommandConfig) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVarP(&o.ServerAddr, "server-addr", "s", o.ServerAddr, "server address in form of host:port")
+	fs.StringVarP(&o.RequestFile, "request-file", "f", o.RequestFile, "client request file (must be json, yaml, or xml); use \"-\" for stdin + json")
+	fs.BoolVar(&o.Stdin, "stdin", o.Stdin, "read client request from STDIN; alternative for '-f -'")
+	fs.BoolVarP(&o.PrintSampleRequest, "print-sample-request", "p", o.PrintSampleRequest, "print sample request file and exit")
+	fs.StringVarP(&o.ResponseFormat, "response-format", "o", o.ResponseFormat, "response format (json, prettyjson, yaml, or xml)")
+	fs.DurationVar(&o.Timeout, "timeout", o.Timeout, "client connection timeout")
+	fs.BoolVar(&o.TLS, "tls", o.TLS, "enable tls")
+	fs.StringVar(&o.ServerName, "tls-server-name", o.ServerName, "tls server name override")
+	fs.BoolVar(&o.InsecureSkipVerify, "tls-insecure-skip-verify", o.InsecureSkipVerify, "INSECURE: skip tls checks")
+	fs.StringVar(&o.CACertFile, "tls-ca-cert-file", o.CACertFile, "ca certificate file")
+	fs.StringVar(&o.CertFile, "tls-cert-file", o.CertFile, "client certificate file")
+	fs.StringVar(&o.KeyFile, "tls-key-file", o.KeyFile, "client key file")
+	fs.StringVar(&o.AuthToken, "auth-token", o.AuthToken, "authorization token")
+	fs.StringVar(&o.AuthTokenType, "auth-token-type", o.AuthTokenType, "authorization token type")
+	fs.StringVar(&o.JWTKey, "jwt-key", o.JWTKey, "jwt key")
+	fs.StringVar(&o.JWTKeyFile, "jwt-key-file", o.JWTKeyFile, "jwt key file")
+	fs.BoolVar(&o.SkipValidation, "skip-validation", o.SkipValidation, "skip the client-side validation of requests")
+	o.LoadTest.AddFlags(fs)
+	fs.StringVar(&o.Record, "record", o.Record, "record the calls, with their metadata, messages, statuses and timings, to this session file")
+	fs.BoolVar(&o.DryRun, "dry-run", o.DryRun, "print the requests, where they would be sent and the metadata, with secrets redacted, instead of sending them")
+	fs.BoolVarP(&o.Verbose, "verbose", "v", o.Verbose, "log the connection, the tls handshake, and the metadata, message sizes and timings of the calls to stderr, with secrets redacted")
+	o.Trace.AddFlags(fs)
+	o.Set.AddFlags(fs)
+	o.Template.AddFlags(fs)
+	fs.BoolVar(&o.Edit, "edit", o.Edit, "open the request, or a sample of it without one, in $EDITOR before sending it")
+	fs.BoolVar(&o.Interactive, "interactive", o.Interactive, "when stdin is a terminal, prompt for the fields the request doesn't set, and for confirmation before sending it")
+	o.Destructive.AddFlags(fs)
+}
+
+func JobsClientCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "jobs",
+	}
+	_DefaultJobsClientCommandConfig.AddFlags(cmd.PersistentFlags())
+
+	for _, s := range _JobsClientSubCommands {
+		cmd.AddCommand(s())
+	}
+	cmd.AddCommand(_JobsReplayCommand())
+	cmd.AddCommand(_JobsHealthCommand())
+	cmd.AddCommand(_JobsDescribeCommand())
+	return cmd
+}
+
+func _JobsReplayCommand() *cobra.Command {
+	var compare bool
+	cmd := &cobra.Command{
+		Use:   "replay [file]",
+		Short: "Replay the calls of a session recorded with --record",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			f, err := os.Open(args[0])
+			if err != nil {
+				log.Fatal(err)
+			}
+			defer f.Close()
+			err = _JobsRoundTrip(nil, nil, func(conn *grpc.ClientConn, cli JobsClient, in iocodec.Decoder, out iocodec.Encoder) error {
+				if _DefaultJobsClientCommandConfig.DryRun {
+					reqs, err := recording.Requests(f)
+					if err != nil {
+						return err
+					}
+					return _JobsDryRun(out, reqs...)
+				}
+				return recording.Replay(conn, f, compare, out, os.Stderr)
+			})
+			if err != nil {
+				log.Fatal(err)
+			}
+		},
+	}
+	cmd.Flags().BoolVar(&compare, "compare", false, "compare the responses and statuses to the recorded ones, field by field, and fail if they differ")
+	return cmd
+}
+
+func _JobsHealthCommand() *cobra.Command {
+	opts := health.Options{Service: "lro.Jobs"}
+	cmd := &cobra.Command{
+		Use:     "health",
+		Aliases: []string{"ping"},
+		Short:   "Check the health of lro.Jobs with the gRPC health checking protocol, and report how the connection is made",
+		Long:    "Check the health of lro.Jobs with the gRPC health checking protocol, and report how the connection is made: its TLS details, the certificate chain of the server and the latency of the check.\n\nExits with 0 if the service is serving, 2 if the connection fails, 3 if the check fails, and 4 if the service isn't serving.",
+		Args:    cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			cfg := _DefaultJobsClientCommandConfig
+			em, err := _JobsEncoderMaker()
+			if err != nil {
+				log.Fatal(err)
+			}
+			if cfg.DryRun {
+				if err := _JobsDryRun(em.NewEncoder(os.Stdout)); err != nil {
+					log.Fatal(err)
+				}
+				return
+			}
+			err = health.Check(opts, cfg.Timeout, func() (*grpc.ClientConn, error) {
+				conn, _, err := _DialJobs()
+				return conn, err
+			}, em.NewEncoder(os.Stdout))
+			if err != nil {
+				log.Print(err)
+				os.Exit(health.ExitCode(err))
+			}
+		},
+	}
+	opts.AddFlags(cmd.Flags())
+	return cmd
+}
+
+func _JobsDescribeCommand() *cobra.Command {
+	var raw bool
+	cmd := &cobra.Command{
+		Use:   "describe [name]",
+		Short: "Print the definition of lro.Jobs, or of one of its methods, messages or enums, with its comments",
+		Long:  "Print the definition of lro.Jobs, or of one of its methods, messages or enums, with its comments, from the descriptors built into the command.\n\nThe name is a method, as named in the proto file or as its command, or the name of a message or enum, relative to the package of the service or in full. A method is followed by its request and response messages.",
+		Args:  cobra.MaximumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			name := ""
+			if len(args) > 0 {
+				name = args[0]
+			}
+			defs, err := describe.Lookup("lro.Jobs", name)
+			if err != nil {
+				log.Fatal(err)
+			}
+			if !raw {
+				if err := describe.Print(os.Stdout, defs); err != nil {
+					log.Fatal(err)
+				}
+				return
+			}
+			em, err := _JobsEncoderMaker()
+			if err != nil {
+				log.Fatal(err)
+			}
+			enc := em.NewEncoder(os.Stdout)
+			for _, d := range defs {
+				if err := enc.Encode(d.Descriptor); err != nil {
+					log.Fatal(err)
+				}
+			}
+		},
+	}
+	cmd.Flags().BoolVar(&raw, "descriptor", false, "print the descriptors of the definitions in the response format instead of proto source")
+	return cmd
+}
+
+func _DialJobs() (*grpc.ClientConn, JobsClient, error) {
+	cfg := _DefaultJobsClientCommandConfig
+	var v *verbose.Logger
+	var opts []grpc.DialOption
+	if cfg.Trace.Enabled() {
+		t, err := tracing.Start(cfg.Trace)
+		if err != nil {
+			return nil, nil, fmt.Errorf("trace: %v", err)
+		}
+		opts = append(opts, t.DialOptions()...)
+	}
+	if cfg.Verbose {
+		v = verbose.New(os.Stderr)
+		opts = append(opts, v.DialOptions()...)
+	}
+	if cfg.TLS {
+		tlsConfig := &tls.Config{}
+		if cfg.InsecureSkipVerify {
+			tlsConfig.InsecureSkipVerify = true
+		}
+		if cfg.CACertFile != "" {
+			cacert, err := ioutil.ReadFile(cfg.CACertFile)
+			if err != nil {
+				return nil, nil, fmt.Errorf("ca cert: %v", err)
+			}
+			certpool := x509.NewCertPool()
+			certpool.AppendCertsFromPEM(cacert)
+			tlsConfig.RootCAs = certpool
+		}
+		if cfg.CertFile != "" {
+			if cfg.KeyFile == "" {
+				return nil, nil, fmt.Errorf("missing key file")
+			}
+			pair, err := tls.LoadX509KeyPair(cfg.CertFile, cfg.KeyFile)
+			if err != nil {
+				return nil, nil, fmt.Errorf("cert/key: %v", err)
+			}
+			tlsConfig.Certificates = []tls.Certificate{pair}
+		}
+		if cfg.ServerName != "" {
+			tlsConfig.ServerName = cfg.ServerName
+		} else {
+			addr, _, _ := net.SplitHostPort(cfg.ServerAddr)
+			tlsConfig.ServerName = addr
+		}
+		//tlsConfig.BuildNameToCertificate()
+		cred := credentials.NewTLS(tlsConfig)
+		if v != nil {
+			cred = v.Credentials(cred)
+		}
+		opts = append(opts, grpc.WithTransportCredentials(cred))
+	} else {
+		opts = append(opts, grpc.WithInsecure())
+	}
+	if cfg.AuthToken != "" {
+		cred := oauth.NewOauthAccess(&oauth2.Token{
+			AccessToken: cfg.AuthToken,
+			TokenType:   cfg.AuthTokenType,
+		})
+		opts = append(opts, grpc.WithPerRPCCredentials(cred))
+	}
+	if cfg.JWTKey != "" {
+		cred, err := oauth.NewJWTAccessFromKey([]byte(cfg.JWTKey))
+		if err != nil {
+			return nil, nil, fmt.Errorf("jwt key: %v", err)
+		}
+		opts = append(opts, grpc.WithPerRPCCredentials(cred))
+	}
+	if cfg.JWTKeyFile != "" {
+		cred, err := oauth.NewJWTAccessFromFile(cfg.JWTKeyFile)
+		if err != nil {
+			return nil, nil, fmt.Errorf("jwt key file: %v", err)
+		}
+		opts = append(opts, grpc.WithPerRPCCredentials(cred))
+	}
+	if cfg.Record != "" {
+		rec, err := recording.Open(cfg.Record)
+		if err != nil {
+			return nil, nil, fmt.Errorf("record: %v", err)
+		}
+		opts = append(opts, rec.DialOptions()...)
+	}
+	var conn *grpc.ClientConn
+	var err error
+	if v != nil {
+		conn, err = v.Dial(cfg.ServerAddr, cfg.Timeout, opts...)
+	} else {
+		conn, err = grpc.Dial(cfg.ServerAddr, append(opts, grpc.WithBlock(), grpc.WithTimeout(cfg.Timeout))...)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	return conn, NewJobsClient(conn), nil
+}
+
+// _JobsEncoderMaker returns the encoder maker of the response format.
+func _JobsEncoderMaker() (iocodec.EncoderMaker, error) {
+	format := _DefaultJobsClientCommandConfig.ResponseFormat
+	if format == "" {
+		format = "json"
+	}
+	em, ok := iocodec.DefaultEncoders[format]
+	if !ok {
+		return nil, fmt.Errorf("invalid response format: %q", format)
+	}
+	return em, nil
+}
+
+type _JobsRoundTripFunc func(conn *grpc.ClientConn, cli JobsClient, in iocodec.Decoder, out iocodec.Encoder) error
+
+// _JobsRoundTrip reads the request with prepare, when set, before it
+// dials the server and calls fn. Commands without a request have no sample.
+func _JobsRoundTrip(sample proto.Message, prepare func(in iocodec.Decoder) error, fn _JobsRoundTripFunc) error {
+	cfg := _DefaultJobsClientCommandConfig
+	em, err := _JobsEncoderMaker()
+	if err != nil {
+		return err
+	}
+	if cfg.PrintSampleRequest {
+		if sample == nil {
+			return fmt.Errorf("no sample request")
+		}
+		return em.NewEncoder(os.Stdout).Encode(iocodec.NewSample(sample))
+	}
+	// read the input request, first from stdin, then from a file, otherwise from args only
+	var d iocodec.Decoder
+	if cfg.Stdin || cfg.RequestFile == "-" {
+		if cfg.Edit {
+			// the editor needs the terminal
+			return fmt.Errorf("--edit can't be used with a request read from stdin")
+		}
+		var in io.Reader = os.Stdin
+		if cfg.Template.Stdin {
+			if in, err = cfg.Template.Reader("stdin", os.Stdin); err != nil {
+				return fmt.Errorf("request template: %v", err)
+			}
+		}
+		d = iocodec.DefaultDecoders["json"].NewDecoder(in)
+	} else if cfg.RequestFile != "" {
+		f, err := os.Open(cfg.RequestFile)
+		if err != nil {
+			return fmt.Errorf("request file: %v", err)
+		}
+		defer f.Close()
+		ext := filepath.Ext(cfg.RequestFile)
+		if len(ext) > 0 && ext[0] == '.' {
+			ext = ext[1:]
+		}
+		dm, ok := iocodec.DefaultDecoders[ext]
+		if !ok {
+			return fmt.Errorf("invalid request file format: %q", ext)
+		}
+		in, err := cfg.Template.Reader(cfg.RequestFile, f)
+		if err != nil {
+			return fmt.Errorf("request template: %v", err)
+		}
+		d = dm.NewDecoder(in)
+	} else {
+		d = iocodec.DefaultDecoders["noop"].NewDecoder(os.Stdin)
+	}
+	d = cfg.Set.Decoder(d)
+	if prepare != nil {
+		if err := prepare(d); err != nil {
+			return err
+		}
+	}
+	if cfg.DryRun {
+		// fn prints what it would send instead
+		return fn(nil, nil, d, em.NewEncoder(os.Stdout))
+	}
+	conn, client, err := _DialJobs()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	return fn(conn, client, d, em.NewEncoder(os.Stdout))
+}
+
+// _JobsPrompter returns the prompter asking for the fields of requests,
+// or nil unless --interactive is given and stdin is a terminal.
+func _JobsPrompter() *prompting.Prompter {
+	if !_DefaultJobsClientCommandConfig.Interactive || !prompting.IsTerminal(os.Stdin) {
+		return nil
+	}
+	return prompting.New(os.Stdin, os.Stderr)
+}
+
+// _JobsConfirmDestructive asks for confirmation of a call of the
+// destructive method, showing req unless nil. It's called once the server is
+// dialed, so sample requests and dry runs, which send nothing, aren't asked
+// about.
+func _JobsConfirmDestructive(command, method string, req proto.Message) error {
+	em, err := _JobsEncoderMaker()
+	if err != nil {
+		return err
+	}
+	return _DefaultJobsClientCommandConfig.Destructive.Confirm(command, method, req, em)
+}
+
+// _JobsEdit opens the editor on m, or on a sample of it when it's empty,
+// in the format of the request file, or else the response format.
+func _JobsEdit(m proto.Message, check func(proto.Message) error) error {
+	cfg := _DefaultJobsClientCommandConfig
+	format := cfg.ResponseFormat
+	if ext := filepath.Ext(cfg.RequestFile); len(ext) > 1 {
+		format = ext[1:]
+	}
+	var v interface{} = m
+	if proto.Size(m) == 0 {
+		v = iocodec.NewSample(m)
+	}
+	return editing.Edit(format, v, m, check)
+}
+
+// _JobsDryRun prints reqs, along with where and how they would be sent.
+func _JobsDryRun(out iocodec.Encoder, reqs ...proto.Message) error {
+	cfg := _DefaultJobsClientCommandConfig
+	plan := dryrun.Plan{
+		Target:   cfg.ServerAddr,
+		Timeout:  cfg.Timeout,
+		Metadata: metadata.MD{},
+		Requests: reqs,
+	}
+	if cfg.TLS {
+		plan.TLS = &dryrun.TLS{
+			ServerName:         cfg.ServerName,
+			InsecureSkipVerify: cfg.InsecureSkipVerify,
+			CACertFile:         cfg.CACertFile,
+			CertFile:           cfg.CertFile,
+			KeyFile:            cfg.KeyFile,
+		}
+		if plan.TLS.ServerName == "" {
+			plan.TLS.ServerName, _, _ = net.SplitHostPort(cfg.ServerAddr)
+		}
+	}
+	if cfg.AuthToken != "" {
+		plan.Metadata.Append("authorization", dryrun.Redact(cfg.AuthTokenType+" "+cfg.AuthToken))
+	}
+	if cfg.JWTKey != "" || cfg.JWTKeyFile != "" {
+		plan.Metadata.Append("authorization", "Bearer "+dryrun.Redacted)
+	}
+	return dryrun.Print(plan, out)
+}
+
+// _JobsLoadTest makes calls with call, as many and as fast as the load
+// test flags say, over conn and the connections it dials, and prints a report
+// of them.
+func _JobsLoadTest(conn *grpc.ClientConn, call func(ctx context.Context, cli JobsClient) error) error {
+	dial := func() (*grpc.ClientConn, error) {
+		conn, _, err := _DialJobs()
+		return conn, err
+	}
+	return loadtest.Run(_DefaultJobsClientCommandConfig.LoadTest, conn, dial, func(ctx context.Context, conn *grpc.ClientConn) error {
+		return call(ctx, NewJobsClient(conn))
+	}, os.Stdout)
+}
+
+func _JobsValidate(m proto.Message, flags *pflag.FlagSet) error {
+	if _DefaultJobsClientCommandConfig.SkipValidation {
+		return nil
+	}
+	return validation.Validate(m, flags)
+}
+
+func _JobsRunClientCommand() *cobra.Command {
+	reqArgs := &RunRequest{}
+	var wait bool
+	var pollInterval, waitTimeout time.Duration
+	var batchOpts batch.Options
+
+	cmd := &cobra.Command{
+		Use:     "run",
+		Long:    "Run client; call by piping a request in to stdin (--stdin), reading a file (--file), or via flags per field",
+		Example: "TODO: print protobuf method comments here",
+		Run: func(cmd *cobra.Command, args []string) {
+			var v RunRequest
+
+			prepare := func(in iocodec.Decoder) error {
+				err := in.Decode(&v)
+				if err != nil {
+					return err
+				}
+
+				proto.Merge(&v, reqArgs)
+
+				prompter := _JobsPrompter()
+				if prompter != nil {
+					err = prompter.Fields(&v)
+					if err != nil {
+						return err
+					}
+				}
+				if _DefaultJobsClientCommandConfig.Edit {
+					err = _JobsEdit(&v, func(m proto.Message) error {
+						return _JobsValidate(m, cmd.Flags())
+					})
+				} else {
+					err = _JobsValidate(&v, cmd.Flags())
+				}
+				if err != nil {
+					return err
+				}
+				if prompter == nil || _DefaultJobsClientCommandConfig.DryRun {
+					return nil
+				}
+				em, err := _JobsEncoderMaker()
+				if err != nil {
+					return err
+				}
+				return prompter.Confirm(&v, em)
+			}
+
+			if batchOpts.File != "" {
+				// the requests of the batch are read and checked one by one
+				prepare = nil
+			}
+
+			err := _JobsRoundTrip(&v, prepare, func(conn *grpc.ClientConn, cli JobsClient, in iocodec.Decoder, out iocodec.Encoder) error {
+
+				if batchOpts.File != "" {
+					if _DefaultJobsClientCommandConfig.DryRun {
+						return fmt.Errorf("--dry-run can't be used with --batch")
+					}
+					if _DefaultJobsClientCommandConfig.Edit {
+						return fmt.Errorf("--edit can't be used with --batch")
+					}
+					return batch.Run(batchOpts, func(ctx context.Context, r *batch.Record) (proto.Message, error) {
+						var v RunRequest
+						err := r.Decode(&v)
+						if err != nil {
+							return nil, err
+						}
+						err = _DefaultJobsClientCommandConfig.Set.Apply(&v)
+						if err != nil {
+							return nil, batch.Invalid(err)
+						}
+						// the defaults of the record must not leak into
+						// the next ones
+						reqArgs := proto.Clone(reqArgs).(*RunRequest)
+
+						proto.Merge(&v, reqArgs)
+						err = _JobsValidate(&v, cmd.Flags())
+						if err != nil {
+							return nil, batch.Invalid(err)
+						}
+						return cli.Run(ctx, &v)
+					}, out)
+				}
+
+				if _DefaultJobsClientCommandConfig.DryRun {
+					return _JobsDryRun(out, &v)
+				}
+				if _DefaultJobsClientCommandConfig.LoadTest.Enabled() {
+					return _JobsLoadTest(conn, func(ctx context.Context, cli JobsClient) error {
+						_, err := cli.Run(ctx, &v)
+						return err
+					})
+				}
+
+				resp, err := cli.Run(context.Background(), &v)
+				if err != nil {
+					return err
+				}
+
+				if wait {
+					res, err := operation.Wait(conn, resp, pollInterval, waitTimeout)
+					if err != nil {
+						return err
+					}
+					return out.Encode(res)
+				}
+
+				return out.Encode(resp)
+
+			})
+			if err != nil {
+				log.Fatal(err)
+			}
+		},
+	}
+
+	cmd.PersistentFlags().StringVar(&reqArgs.Job, "job", "", "get-comment-from-proto")
+	cmd.PersistentFlags().BoolVar(&wait, "wait", false, "wait for the operation to complete and print its result (RunResponse)")
+	cmd.PersistentFlags().DurationVar(&pollInterval, "poll-interval", time.Second, "interval between polls of the operation")
+	cmd.PersistentFlags().DurationVar(&waitTimeout, "wait-timeout", 0, "how long to wait for the operation, 0 for no limit")
+	batchOpts.AddFlags(cmd.PersistentFlags())
+
+	return cmd
+}
+
+func _JobsStartClientCommand() *cobra.Command {
+	reqArgs := &RunRequest{}
+	var wait bool
+	var pollInterval, waitTimeout time.Duration
+	var batchOpts batch.Options
+
+	cmd := &cobra.Command{
+		Use:     "start",
+		Long:    "Start client; call by piping a request in to stdin (--stdin), reading a file (--file), or via flags per field",
+		Example: "TODO: print protobuf method comments here",
+		Run: func(cmd *cobra.Command, args []string) {
+			var v RunRequest
+
+			prepare := func(in iocodec.Decoder) error {
+				err := in.Decode(&v)
+				if err != nil {
+					return err
+				}
+
+				proto.Merge(&v, reqArgs)
+
+				prompter := _JobsPrompter()
+				if prompter != nil {
+					err = prompter.Fields(&v)
+					if err != nil {
+						return err
+					}
+				}
+				if _DefaultJobsClientCommandConfig.Edit {
+					err = _JobsEdit(&v, func(m proto.Message) error {
+						return _JobsValidate(m, cmd.Flags())
+					})
+				} else {
+					err = _JobsValidate(&v, cmd.Flags())
+				}
+				if err != nil {
+					return err
+				}
+				if prompter == nil || _DefaultJobsClientCommandConfig.DryRun {
+					return nil
+				}
+				em, err := _JobsEncoderMaker()
+				if err != nil {
+					return err
+				}
+				return prompter.Confirm(&v, em)
+			}
+
+			if batchOpts.File != "" {
+				// the requests of the batch are read and checked one by one
+				prepare = nil
+			}
+
+			err := _JobsRoundTrip(&v, prepare, func(conn *grpc.ClientConn, cli JobsClient, in iocodec.Decoder, out iocodec.Encoder) error {
+
+				if batchOpts.File != "" {
+					if _DefaultJobsClientCommandConfig.DryRun {
+						return fmt.Errorf("--dry-run can't be used with --batch")
+					}
+					if _DefaultJobsClientCommandConfig.Edit {
+						return fmt.Errorf("--edit can't be used with --batch")
+					}
+					return batch.Run(batchOpts, func(ctx context.Context, r *batch.Record) (proto.Message, error) {
+						var v RunRequest
+						err := r.Decode(&v)
+						if err != nil {
+							return nil, err
+						}
+						err = _DefaultJobsClientCommandConfig.Set.Apply(&v)
+						if err != nil {
+							return nil, batch.Invalid(err)
+						}
+						// the defaults of the record must not leak into
+						// the next ones
+						reqArgs := proto.Clone(reqArgs).(*RunRequest)
+
+						proto.Merge(&v, reqArgs)
+						err = _JobsValidate(&v, cmd.Flags())
+						if err != nil {
+							return nil, batch.Invalid(err)
+						}
+						return cli.Start(ctx, &v)
+					}, out)
+				}
+
+				if _DefaultJobsClientCommandConfig.DryRun {
+					return _JobsDryRun(out, &v)
+				}
+				if _DefaultJobsClientCommandConfig.LoadTest.Enabled() {
+					return _JobsLoadTest(conn, func(ctx context.Context, cli JobsClient) error {
+						_, err := cli.Start(ctx, &v)
+						return err
+					})
+				}
+
+				resp, err := cli.Start(context.Background(), &v)
+				if err != nil {
+					return err
+				}
+
+				if wait {
+					res, err := operation.Wait(conn, resp, pollInterval, waitTimeout)
+					if err != nil {
+						return err
+					}
+					return out.Encode(res)
+				}
+
+				return out.Encode(resp)
+
+			})
+			if err != nil {
+				log.Fatal(err)
+			}
+		},
+	}
+
+	cmd.PersistentFlags().StringVar(&reqArgs.Job, "job", "", "get-comment-from-proto")
+	cmd.PersistentFlags().BoolVar(&wait, "wait", false, "wait for the operation to complete and print its result")
+	cmd.PersistentFlags().DurationVar(&pollInterval, "poll-interval", time.Second, "interval between polls of the operation")
+	cmd.PersistentFlags().DurationVar(&waitTimeout, "wait-timeout", 0, "how long to wait for the operation, 0 for no limit")
+	batchOpts.AddFlags(cmd.PersistentFlags())
+
+	return cmd
+}
+
+var _JobsClientSubCommands = []func() *cobra.Command{
+	_JobsRunClientCommand,
+	_JobsStartClientCommand,
+}
+
+func init() { describe.Register(_descriptorSet_Lro_d36d8e83d7b673a0) }
+
+var _descriptorSet_Lro_d36d8e83d7b673a0 = []byte{
+	// 4845 bytes of a gzipped FileDescriptorSet
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0x5d, 0x6c, 0x5c, 0xc7,
+	0x75, 0xbe, 0x73, 0xef, 0xe5, 0x72, 0x39, 0x24, 0x25, 0x6a, 0x44, 0x52, 0xd4, 0xca, 0x92, 0x46,
+	0xa4, 0x64, 0x91, 0xea, 0x6a, 0x29, 0xcb, 0x49, 0x14, 0x53, 0x51, 0x6b, 0x8a, 0x52, 0x25, 0xca,
+	0xfa, 0x21, 0x96, 0x74, 0x54, 0xc7, 0x95, 0xc5, 0xe1, 0xdd, 0xd9, 0xdd, 0x6b, 0xdf, 0xbd, 0xb3,
+	0xbe, 0x77, 0x96, 0xd4, 0xda, 0x70, 0x52, 0x17, 0x89, 0x80, 0x14, 0x45, 0x1b, 0xc0, 0x30, 0x6a,
+	0x03, 0x81, 0x53, 0x18, 0x2e, 0x10, 0x08, 0x08, 0x92, 0xf6, 0xb5, 0x71, 0xdf, 0xfc, 0x62, 0xc0,
+	0x29, 0xd0, 0xbe, 0xd6, 0x6f, 0x7d, 0x28, 0x90, 0x20, 0x0f, 0x7d, 0x28, 0x02, 0x14, 0x05, 0x8a,
+	0x33, 0x3f, 0xbb, 0x77, 0x97, 0x3f, 0x62, 0x1e, 0xfc, 0x16, 0xbe, 0x70, 0xce, 0xdc, 0x99, 0x33,
+	0xf3, 0x9d, 0x39, 0xe7, 0xcc, 0x99, 0x73, 0x16, 0xff, 0x4d, 0x0e, 0x8f, 0x46, 0x89, 0x98, 0x8f,
+	0x12, 0x51, 0x6a, 0x26, 0x42, 0x0a, 0xe2, 0x45, 0x89, 0x28, 0xcc, 0xd4, 0x84, 0xa8, 0x45, 0x7c,
+	0x3e, 0x12, 0x71, 0x2d, 0x69, 0xc5, 0x71, 0x18, 0xd7, 0xe6, 0x45, 0x93, 0x27, 0x4c, 0x86, 0x22,
+	0x4e, 0xf5, 0xc8, 0xe9, 0x13, 0x18, 0x97, 0x5b, 0x71, 0x99, 0xbf, 0xd9, 0xe2, 0xa9, 0x24, 0x63,
+	0xd8, 0x7b, 0x5d, 0x6c, 0x4c, 0x21, 0x8a, 0x66, 0x87, 0xca, 0xd0, 0x9c, 0x3e, 0x87, 0x87, 0xd5,
+	0xf7, 0xb4, 0x29, 0xe2, 0x94, 0x93, 0x63, 0x78, 0x88, 0x3f, 0x0a, 0xe5, 0xc3, 0x40, 0x54, 0xb8,
+	0x1a, 0x36, 0x50, 0xce, 0x43, 0xc7, 0x92, 0xa8, 0xf0, 0xe9, 0x39, 0x35, 0xf6, 0x0e, 0x97, 0xac,
+	0xc2, 0x24, 0x23, 0x05, 0x9c, 0x6f, 0x26, 0xa2, 0x96, 0xf0, 0x34, 0xb5, 0x43, 0x2d, 0x7d, 0xf1,
+	0x7d, 0x84, 0xfd, 0x5b, 0x62, 0x23, 0x25, 0x6b, 0xd8, 0x2b, 0xb7, 0x62, 0x72, 0xb0, 0x04, 0x9b,
+	0xef, 0xee, 0xa4, 0x70, 0xbc, 0xa4, 0x77, 0x5f, 0xca, 0xec, 0xbe, 0x74, 0xcf, 0xee, 0x7e, 0xfa,
+	0xf8, 0xe7, 0x8b, 0x85, 0xde, 0x9d, 0xf5, 0x2c, 0x7d, 0x09, 0x0f, 0xac, 0x4a, 0x96, 0xc8, 0xdf,
+	0x97, 0xef, 0xad, 0x7f, 0xf6, 0x70, 0x8e, 0xf8, 0x8e, 0x53, 0x40, 0x38, 0x8f, 0xd1, 0x08, 0xf1,
+	0x1c, 0x87, 0x40, 0xcb, 0x25, 0x9e, 0xeb, 0x8c, 0xe0, 0x21, 0xec, 0x7a, 0x0e, 0xf1, 0x7c, 0xe7,
+	0x3c, 0xc6, 0xd8, 0xcd, 0x39, 0xc4, 0xcf, 0x39, 0x07, 0x10, 0xc6, 0xd8, 0xcb, 0x39, 0x88, 0x78,
+	0xb9, 0xfc, 0x08, 0x1e, 0xc1, 0x7e, 0xce, 0x71, 0x1d, 0xe2, 0x0f, 0xba, 0x23, 0x1e, 0x1e, 0xc1,
+	0x03, 0x40, 0x21, 0xe2, 0x0d, 0xe6, 0x86, 0x2c, 0xe5, 0x12, 0x6f, 0x10, 0x8f, 0x5b, 0xca, 0x23,
+	0xde, 0xe0, 0xc9, 0xcb, 0x78, 0x54, 0x53, 0x3e, 0xf1, 0xf3, 0xfe, 0x70, 0x0e, 0x1f, 0xc4, 0x83,
+	0x8a, 0xfc, 0x30, 0x6f, 0x3a, 0x86, 0x15, 0x5f, 0x44, 0xbc, 0x51, 0xf7, 0x4f, 0xcc, 0x54, 0x04,
+	0x54, 0x6e, 0xd8, 0x52, 0x2e, 0xf1, 0x46, 0x47, 0x26, 0x2d, 0xe5, 0x11, 0x6f, 0xf4, 0xd4, 0x15,
+	0xd8, 0xa6, 0xef, 0x10, 0x7f, 0xcc, 0x21, 0x6a, 0x9b, 0x3e, 0x6c, 0x65, 0x2c, 0x4f, 0x80, 0x9d,
+	0x0f, 0xdb, 0xf4, 0x0e, 0xb9, 0x87, 0x60, 0x0a, 0x10, 0x03, 0x40, 0xe5, 0x2d, 0x85, 0x88, 0x77,
+	0x68, 0x68, 0xc4, 0x52, 0x1e, 0xf1, 0x0e, 0x1d, 0x1c, 0x53, 0xec, 0x10, 0xf1, 0xc7, 0x9d, 0x49,
+	0xcd, 0x0e, 0xb6, 0x30, 0x9e, 0x3f, 0xac, 0xd8, 0x21, 0x60, 0x37, 0xe1, 0xaa, 0x1d, 0x00, 0x31,
+	0x00, 0xd4, 0xa0, 0xa5, 0x10, 0xf1, 0x26, 0xf2, 0x87, 0x2c, 0xe5, 0x11, 0x6f, 0x62, 0x7c, 0x42,
+	0xb1, 0x73, 0x89, 0x3f, 0xe5, 0x14, 0x34, 0x3b, 0xc0, 0x37, 0x65, 0xd8, 0xb9, 0xc0, 0xee, 0xa8,
+	0x3b, 0xa1, 0xa6, 0xb8, 0x8a, 0xdd, 0x51, 0xc3, 0xce, 0x55, 0xec, 0x8e, 0xe6, 0xc7, 0x2c, 0xe5,
+	0x11, 0xef, 0xe8, 0xe1, 0xf1, 0x8d, 0x9c, 0xd2, 0xe9, 0xe7, 0xf1, 0x0f, 0x6e, 0xe2, 0xfd, 0x28,
+	0x3f, 0x21, 0xdb, 0x75, 0xa1, 0xf0, 0x8c, 0x99, 0xc8, 0x9a, 0xe1, 0x3c, 0x8b, 0x63, 0x21, 0xb3,
+	0x33, 0x0a, 0x47, 0xcd, 0x57, 0x45, 0x6d, 0xb4, 0xaa, 0xf3, 0x2c, 0x6e, 0x9b, 0x4f, 0x27, 0xfa,
+	0x3f, 0x55, 0x5a, 0x7a, 0x35, 0xf3, 0xfd, 0x58, 0xff, 0x77, 0xde, 0x68, 0x4a, 0x3b, 0xf9, 0x88,
+	0xf9, 0x98, 0x34, 0x83, 0xf9, 0x54, 0x32, 0xd9, 0xb2, 0x0b, 0xd2, 0x6d, 0x5c, 0x79, 0x1a, 0x24,
+	0x61, 0x53, 0x8a, 0xc4, 0x58, 0xf0, 0xbf, 0x22, 0x3c, 0xd4, 0x51, 0x60, 0x42, 0xb0, 0x1f, 0xb3,
+	0x06, 0x37, 0x26, 0xac, 0xda, 0xe4, 0x02, 0xce, 0x37, 0x8c, 0x65, 0x4c, 0xb9, 0x14, 0xcd, 0x0e,
+	0x5f, 0x1c, 0xb7, 0x56, 0x60, 0xd9, 0x96, 0x16, 0xe3, 0x76, 0xb9, 0x33, 0x0a, 0xb8, 0x54, 0x44,
+	0xcc, 0xa7, 0x3c, 0x8a, 0x66, 0xf3, 0x65, 0xd5, 0x26, 0xe7, 0xf0, 0x00, 0x4f, 0x12, 0x91, 0x4c,
+	0xf9, 0x8a, 0x05, 0xb1, 0x2c, 0x92, 0x66, 0x50, 0x5a, 0x55, 0x5b, 0xbe, 0xe9, 0x94, 0xf5, 0x10,
+	0x72, 0x11, 0xe7, 0x13, 0x63, 0x98, 0x53, 0x03, 0xbb, 0xaf, 0x78, 0xd3, 0x29, 0x77, 0xc6, 0x5d,
+	0xcd, 0xe3, 0x5c, 0xc2, 0xd3, 0x56, 0x24, 0xa7, 0xe7, 0xf0, 0xe1, 0x1b, 0x5c, 0x76, 0x30, 0x59,
+	0xe7, 0xb4, 0x03, 0xb4, 0xe9, 0xef, 0xe1, 0x89, 0xdb, 0x61, 0xda, 0x1d, 0x9b, 0xf6, 0x0f, 0xf6,
+	0x33, 0x72, 0x98, 0xc4, 0xb9, 0x6a, 0x18, 0x49, 0x9e, 0x18, 0x16, 0x86, 0x02, 0xa7, 0xd6, 0x64,
+	0x35, 0xfe, 0x30, 0x0d, 0xdf, 0xe2, 0x53, 0xae, 0xf1, 0x54, 0xac, 0xc6, 0x57, 0xc3, 0xb7, 0x38,
+	0x39, 0x8e, 0xb1, 0xfa, 0x28, 0xc5, 0x1b, 0x3c, 0x56, 0x02, 0x19, 0x2a, 0xab, 0xe1, 0x6b, 0xd0,
+	0x31, 0xfd, 0x3d, 0x3c, 0xd9, 0xbf, 0x01, 0xe3, 0x90, 0xae, 0x60, 0xdc, 0x55, 0xb8, 0x29, 0x44,
+	0xbd, 0xd9, 0xe1, 0x8b, 0x7b, 0x7b, 0x9f, 0x72, 0x66, 0x02, 0x79, 0x16, 0x1f, 0x8c, 0xf9, 0x23,
+	0xf9, 0x30, 0xb3, 0xb8, 0xab, 0x16, 0x1f, 0x85, 0xee, 0x95, 0xce, 0x06, 0x8a, 0x78, 0x72, 0x89,
+	0xc5, 0x01, 0x8f, 0xf6, 0x25, 0xaf, 0x22, 0x9e, 0xbc, 0xc6, 0x23, 0x2e, 0xf9, 0xbe, 0x46, 0x3f,
+	0xc4, 0xe3, 0xf7, 0x59, 0xb8, 0xaf, 0x93, 0x20, 0xcf, 0xe3, 0x41, 0x19, 0x36, 0xb8, 0x68, 0x49,
+	0xa3, 0x63, 0x47, 0xb7, 0x9d, 0xf8, 0x35, 0x63, 0x10, 0x65, 0x3b, 0x72, 0xfa, 0x15, 0x3c, 0xda,
+	0x61, 0xbe, 0x1c, 0x57, 0x05, 0x99, 0xc1, 0xa3, 0x56, 0x21, 0x1e, 0xca, 0x76, 0xd3, 0x2e, 0x31,
+	0x62, 0x3b, 0xd7, 0xda, 0x4d, 0x0e, 0x83, 0xac, 0xa6, 0xea, 0x41, 0x5a, 0x30, 0x23, 0xb6, 0x13,
+	0x06, 0x5d, 0xfc, 0x2f, 0x1f, 0xe3, 0xee, 0xa9, 0x90, 0xc7, 0x08, 0x1f, 0xe8, 0x3d, 0x28, 0x32,
+	0xb7, 0xd3, 0x61, 0xec, 0xa8, 0x4d, 0x85, 0x73, 0xfb, 0x19, 0xaa, 0x77, 0x38, 0x7d, 0xfc, 0x2f,
+	0xff, 0xfd, 0x3f, 0xdf, 0x73, 0x8f, 0x90, 0x89, 0xf9, 0xcd, 0xe7, 0xe6, 0xdf, 0x06, 0xd9, 0x5c,
+	0xe9, 0x1e, 0xeb, 0x3b, 0xe4, 0x11, 0x1e, 0xc9, 0x2a, 0x37, 0x39, 0xbb, 0x13, 0xeb, 0x1d, 0xd4,
+	0xff, 0x69, 0x37, 0x22, 0x55, 0xcb, 0x16, 0xc8, 0xd4, 0x4e, 0xcb, 0xce, 0x9f, 0x3b, 0xf7, 0x0e,
+	0xd9, 0xc2, 0x07, 0xfb, 0xce, 0x9e, 0xec, 0x88, 0x6b, 0x67, 0x05, 0x29, 0x4c, 0x6e, 0x3b, 0xcf,
+	0xeb, 0xe0, 0xc0, 0xec, 0xc2, 0xe7, 0x76, 0x5f, 0xf8, 0x5d, 0x84, 0x0f, 0xf6, 0xe9, 0xe8, 0xce,
+	0x2b, 0xef, 0xac, 0xc8, 0xbb, 0xae, 0x7c, 0x4e, 0xad, 0x7c, 0x7a, 0xfa, 0xe4, 0x6e, 0x2b, 0x2f,
+	0x04, 0x8a, 0xe1, 0x02, 0x3a, 0x47, 0xbe, 0x83, 0x47, 0x7b, 0x54, 0x99, 0xcc, 0xee, 0xb4, 0x81,
+	0x9d, 0xb4, 0xfd, 0x69, 0x82, 0x77, 0x16, 0x42, 0x7c, 0xa0, 0xb3, 0xee, 0xc3, 0x10, 0xd4, 0xf8,
+	0xc4, 0xb6, 0x1d, 0xdf, 0xe1, 0xb2, 0x2e, 0x2a, 0xf7, 0x9a, 0xda, 0x1b, 0x7c, 0x98, 0x57, 0x26,
+	0x72, 0x6a, 0x4f, 0xce, 0x60, 0x10, 0xe5, 0x51, 0x91, 0x25, 0xaf, 0xfe, 0x1d, 0xc2, 0x93, 0x81,
+	0x68, 0xec, 0x30, 0xef, 0xea, 0xc1, 0xae, 0x2e, 0xae, 0xc0, 0xaa, 0x2b, 0xe8, 0x3b, 0x57, 0xcc,
+	0xb0, 0x9a, 0x88, 0x58, 0x5c, 0x2b, 0x89, 0xa4, 0x36, 0x5f, 0xe3, 0xb1, 0xda, 0xd3, 0xbc, 0xfe,
+	0xc4, 0x9a, 0x61, 0x9a, 0xbd, 0x23, 0x2f, 0x67, 0xda, 0xbf, 0x43, 0xe8, 0x89, 0x4b, 0x6e, 0x68,
+	0x16, 0xb7, 0x45, 0x5c, 0x2b, 0xeb, 0xfe, 0xcf, 0x6d, 0xe7, 0x9f, 0x67, 0x3a, 0x6f, 0xfd, 0xf0,
+	0x05, 0x3c, 0x48, 0x06, 0x0e, 0x38, 0xbf, 0x42, 0x08, 0x7f, 0xea, 0xab, 0xd8, 0xe9, 0x80, 0x43,
+	0x2e, 0xfe, 0x93, 0x4f, 0x97, 0x44, 0xb3, 0x9d, 0x84, 0xb5, 0xba, 0xa4, 0x17, 0x2f, 0x3c, 0xf7,
+	0x02, 0xd5, 0x73, 0xe9, 0xed, 0xdb, 0x4b, 0x25, 0x8c, 0xe9, 0xed, 0x30, 0xe0, 0x71, 0xca, 0x2b,
+	0xb4, 0x15, 0x57, 0x78, 0x42, 0x65, 0x9d, 0xd3, 0xc5, 0x26, 0x0b, 0xea, 0xdc, 0x7e, 0x29, 0xd2,
+	0x6f, 0xf3, 0x24, 0x0d, 0x45, 0x4c, 0x2f, 0x96, 0x2e, 0xd0, 0x59, 0x18, 0x30, 0x6d, 0x3e, 0x4d,
+	0xcf, 0x5d, 0xc6, 0xb4, 0x2d, 0x5a, 0xb4, 0xc1, 0xda, 0x34, 0x16, 0x92, 0xb6, 0x52, 0x4e, 0x65,
+	0x3d, 0x4c, 0x69, 0x35, 0x8c, 0x38, 0xe5, 0x8f, 0x02, 0xde, 0x94, 0x34, 0x8c, 0x69, 0x20, 0x1a,
+	0xcd, 0x28, 0x04, 0x5d, 0xa0, 0x5b, 0xa1, 0xac, 0xab, 0x55, 0x0c, 0x8f, 0x12, 0xa6, 0xaf, 0x18,
+	0x0e, 0x62, 0x43, 0xb2, 0x30, 0xa6, 0x8c, 0x06, 0xa2, 0xd9, 0xa6, 0xa2, 0x9a, 0x1d, 0x46, 0x99,
+	0xc4, 0x98, 0xc2, 0x5f, 0x5d, 0xca, 0xe6, 0xc2, 0xfc, 0xfc, 0xd6, 0xd6, 0x56, 0x89, 0xa9, 0x8d,
+	0x2a, 0xb1, 0x46, 0x7a, 0x58, 0x3a, 0x7f, 0x7b, 0x79, 0xe9, 0xfa, 0xdd, 0xd5, 0xeb, 0xe7, 0x2f,
+	0x96, 0x2e, 0x60, 0x4c, 0x5f, 0x8e, 0x23, 0x9e, 0xa6, 0x34, 0xe1, 0x6f, 0xb6, 0xc2, 0x84, 0x57,
+	0xe8, 0x46, 0x9b, 0xb2, 0x66, 0x33, 0x0a, 0x03, 0xb6, 0x11, 0x71, 0x1a, 0xb1, 0x2d, 0x2a, 0x12,
+	0xca, 0x6a, 0x09, 0xe7, 0x15, 0x2a, 0x05, 0x6c, 0x75, 0x2b, 0x09, 0x65, 0x18, 0xd7, 0x8a, 0x34,
+	0x15, 0x55, 0xb9, 0xc5, 0x12, 0x8e, 0x69, 0x25, 0x4c, 0x65, 0x12, 0x6e, 0xb4, 0x64, 0x8f, 0x94,
+	0xec, 0xc6, 0xc2, 0xb4, 0x67, 0x80, 0x88, 0x29, 0x8b, 0xe9, 0xf4, 0xe2, 0x2a, 0x5d, 0x5e, 0x9d,
+	0xa6, 0x57, 0x17, 0x57, 0x97, 0x57, 0x8b, 0x98, 0xde, 0x5f, 0x5e, 0xbb, 0x79, 0xef, 0xe5, 0x35,
+	0x7a, 0x7f, 0xb1, 0x5c, 0x5e, 0xbc, 0xbb, 0xb6, 0x7c, 0x7d, 0x95, 0xde, 0x2b, 0xd3, 0xa5, 0x7b,
+	0x77, 0xaf, 0x2d, 0xaf, 0x2d, 0xdf, 0xbb, 0xbb, 0x4a, 0xef, 0xfd, 0x29, 0x5d, 0xbc, 0xfb, 0x0a,
+	0x7d, 0x69, 0xf9, 0xee, 0xb5, 0x22, 0xe5, 0xa1, 0xac, 0xf3, 0x84, 0xf2, 0x47, 0x4d, 0x88, 0xd5,
+	0x61, 0x8b, 0x21, 0xc8, 0x8f, 0x57, 0x4a, 0x98, 0xae, 0x72, 0xde, 0xb3, 0x7c, 0x55, 0xe8, 0xed,
+	0xa4, 0x4d, 0x1e, 0x84, 0xd5, 0x30, 0xa0, 0xa0, 0x69, 0x2d, 0x56, 0xe3, 0xb4, 0x26, 0x36, 0x79,
+	0x02, 0xaa, 0x41, 0x9b, 0x3c, 0x69, 0x84, 0x29, 0x9c, 0x61, 0x4a, 0x59, 0x5c, 0xc1, 0x34, 0x0a,
+	0x1b, 0xa1, 0x89, 0xab, 0xb6, 0x23, 0x2a, 0x61, 0x13, 0x70, 0x8f, 0x39, 0xc7, 0x6c, 0xc0, 0x4d,
+	0x9c, 0x67, 0x55, 0x13, 0x11, 0xef, 0xb0, 0x33, 0xa3, 0x9a, 0x2e, 0xf1, 0xc6, 0x9d, 0x59, 0xd5,
+	0x84, 0x60, 0xd2, 0x39, 0xa3, 0x9a, 0x3e, 0xf1, 0x26, 0x9d, 0x53, 0xaa, 0x39, 0x40, 0xbc, 0x23,
+	0xce, 0x39, 0xe0, 0x95, 0x27, 0xde, 0x51, 0xe7, 0x24, 0x74, 0xe6, 0x4f, 0xea, 0xa6, 0xee, 0x2c,
+	0x38, 0xf3, 0xaa, 0xf3, 0x8c, 0x6e, 0xea, 0xce, 0x63, 0xce, 0x9a, 0xea, 0x1c, 0xd6, 0x4d, 0xdd,
+	0xf9, 0x8c, 0x33, 0xad, 0x3a, 0xb1, 0x6e, 0xea, 0xce, 0xe3, 0xce, 0x05, 0xd5, 0x69, 0x9a, 0xba,
+	0xf3, 0x84, 0xe1, 0x89, 0x74, 0x53, 0x77, 0x9e, 0x74, 0xce, 0xab, 0xce, 0x39, 0xdb, 0x44, 0x83,
+	0xc4, 0x3f, 0xe5, 0xcc, 0x21, 0xfc, 0x3b, 0x84, 0xdd, 0x41, 0x87, 0x78, 0xb3, 0xee, 0x0b, 0x85,
+	0xdf, 0x20, 0xba, 0x58, 0xa9, 0x84, 0x20, 0x16, 0x16, 0x51, 0xf0, 0x23, 0x49, 0x43, 0x09, 0x89,
+	0x26, 0xbc, 0xc6, 0x92, 0x0a, 0x88, 0x12, 0xcc, 0xf3, 0xbc, 0xb1, 0x4f, 0x9a, 0x89, 0x67, 0x31,
+	0x5d, 0x8e, 0x69, 0x93, 0x25, 0x32, 0x0c, 0x5a, 0x11, 0x4b, 0x8a, 0xda, 0x0c, 0xcc, 0xa1, 0xf0,
+	0x54, 0xc9, 0x17, 0xae, 0x4a, 0x68, 0x31, 0x49, 0x59, 0xc2, 0x69, 0xc2, 0x65, 0x2b, 0x89, 0x79,
+	0x85, 0x56, 0x13, 0xd1, 0xc0, 0xbb, 0x73, 0xc6, 0xb4, 0x6c, 0xd5, 0x17, 0x0e, 0xbb, 0xa1, 0x1c,
+	0x99, 0xe1, 0xa3, 0x79, 0xd0, 0xf5, 0xbd, 0xdc, 0xd8, 0xfa, 0x65, 0x1a, 0xc6, 0x9b, 0x2c, 0x0a,
+	0x2b, 0x98, 0x0a, 0x50, 0xac, 0xad, 0x50, 0x1d, 0x32, 0xc6, 0xde, 0x20, 0x3c, 0x85, 0x4e, 0x0d,
+	0x9e, 0xd6, 0xed, 0x1c, 0x88, 0x61, 0x5a, 0xb7, 0x11, 0xf1, 0x66, 0x67, 0x9e, 0xd3, 0x6d, 0x8f,
+	0x78, 0xb3, 0x5f, 0xfb, 0x26, 0xfe, 0x37, 0x5f, 0xbf, 0xb8, 0xbe, 0xe6, 0x3c, 0x44, 0x85, 0xcf,
+	0x7c, 0x7a, 0x87, 0xc5, 0xac, 0xc6, 0xd3, 0xdd, 0x36, 0xae, 0x2d, 0x9d, 0xc5, 0x74, 0x71, 0x65,
+	0x99, 0xa6, 0x3c, 0xd9, 0x0c, 0x03, 0x58, 0x95, 0xde, 0xaf, 0xf3, 0xd8, 0x76, 0x6b, 0x2c, 0x34,
+	0x06, 0x41, 0x47, 0x51, 0x9b, 0x4a, 0xf6, 0x86, 0x61, 0x48, 0x21, 0x42, 0x01, 0x93, 0x54, 0xae,
+	0x83, 0x4b, 0x5e, 0xa4, 0xa1, 0xa4, 0x01, 0x8b, 0xe9, 0x06, 0xa7, 0x15, 0x9e, 0x86, 0xb5, 0x98,
+	0x57, 0x30, 0x0c, 0x30, 0x22, 0x78, 0xb5, 0x83, 0xf7, 0xc1, 0xab, 0x7b, 0x49, 0xe3, 0x01, 0xcc,
+	0x81, 0xd3, 0x08, 0xa2, 0x90, 0xc7, 0xb2, 0x08, 0x06, 0x91, 0xa1, 0xd5, 0x12, 0xd6, 0x8d, 0x61,
+	0x1a, 0xc6, 0x92, 0x27, 0x55, 0x16, 0x70, 0xbd, 0x52, 0xc0, 0xc3, 0x4d, 0x6d, 0x7e, 0x09, 0x67,
+	0x11, 0xb5, 0x41, 0x11, 0x65, 0x69, 0x3b, 0x0e, 0xea, 0x89, 0x88, 0x45, 0x2b, 0x8d, 0xda, 0xe0,
+	0x65, 0x9a, 0x22, 0x8a, 0x40, 0x1e, 0xb2, 0xce, 0x71, 0x57, 0x28, 0x30, 0x43, 0xb4, 0x92, 0x80,
+	0x17, 0xc1, 0xa8, 0x9b, 0x2c, 0xd5, 0x8a, 0xb1, 0xfd, 0x3b, 0x2c, 0xc7, 0x62, 0x75, 0x5a, 0x4a,
+	0x50, 0xb3, 0x69, 0x2b, 0xa8, 0x53, 0x96, 0x62, 0xeb, 0xbd, 0x97, 0x22, 0xd1, 0xaa, 0xd0, 0x95,
+	0xd6, 0xc6, 0xfc, 0x6a, 0x6b, 0x03, 0x86, 0xcc, 0x6d, 0xdf, 0xa1, 0xde, 0x5c, 0x89, 0xd2, 0xc5,
+	0xb8, 0x9d, 0x3d, 0x05, 0xa5, 0x3a, 0xd8, 0x08, 0x6e, 0xf7, 0xf3, 0x4b, 0xeb, 0xa2, 0x15, 0x55,
+	0x94, 0xeb, 0xe1, 0x0d, 0x10, 0x0d, 0x70, 0x5d, 0xef, 0x5e, 0x72, 0xeb, 0x5d, 0xe9, 0x60, 0x9a,
+	0x0a, 0x5a, 0xe1, 0x9b, 0x3c, 0x82, 0xf9, 0xa9, 0x12, 0x62, 0x9d, 0x6d, 0x72, 0xe5, 0xcb, 0xe3,
+	0x34, 0x4c, 0xa5, 0x12, 0xad, 0x96, 0x30, 0x7f, 0xd4, 0xe4, 0x49, 0xc8, 0xe3, 0xc0, 0x28, 0xa1,
+	0x7a, 0xb9, 0x7f, 0x2d, 0x4f, 0xf0, 0x87, 0xc8, 0x3e, 0xdd, 0x2f, 0xb9, 0x97, 0xbd, 0xc2, 0x5f,
+	0x21, 0x0a, 0x11, 0x5e, 0x9a, 0xdd, 0x93, 0x52, 0xfa, 0x06, 0x93, 0x41, 0x3d, 0xeb, 0xf4, 0xc0,
+	0x32, 0xd4, 0x4b, 0x02, 0x1c, 0xb8, 0xc6, 0xae, 0x22, 0x87, 0x12, 0x5d, 0xae, 0xea, 0x13, 0x00,
+	0xe4, 0x3c, 0xa1, 0x15, 0xc1, 0xd3, 0xf8, 0xac, 0xa4, 0x69, 0xab, 0xd9, 0x14, 0x89, 0xd4, 0x46,
+	0xaa, 0x95, 0x50, 0xe9, 0x97, 0x15, 0xc9, 0xfa, 0xcb, 0x77, 0x97, 0xef, 0xac, 0xdc, 0xbe, 0x7e,
+	0xe7, 0xfa, 0xdd, 0xb5, 0xeb, 0xd7, 0xd6, 0x4b, 0x38, 0x93, 0x47, 0xb8, 0x94, 0x1b, 0xcf, 0xe4,
+	0x11, 0x2e, 0x4d, 0x9c, 0xcb, 0xe4, 0x11, 0x2e, 0x7d, 0xfd, 0xa5, 0x4c, 0x1e, 0xe1, 0x9b, 0xfe,
+	0x42, 0x0e, 0x1f, 0xc2, 0x43, 0x8a, 0xfc, 0xc5, 0xe7, 0xbf, 0x9c, 0x36, 0x5d, 0x3f, 0x47, 0x26,
+	0x95, 0xe0, 0xbf, 0xe8, 0x5e, 0xf3, 0x0a, 0x7f, 0x8f, 0xe8, 0x0d, 0x2e, 0xb5, 0x26, 0x44, 0x4c,
+	0xf2, 0x54, 0x52, 0x78, 0x81, 0x72, 0xb8, 0x01, 0xd9, 0x2e, 0xa7, 0x53, 0xa2, 0x74, 0x49, 0x49,
+	0x33, 0xed, 0x53, 0x58, 0x63, 0x51, 0x52, 0x28, 0x0d, 0xdc, 0xae, 0x5d, 0xad, 0x48, 0x52, 0x26,
+	0xf5, 0xc9, 0x6d, 0xb2, 0x28, 0xa5, 0x0c, 0x6e, 0xc7, 0x40, 0x34, 0x1a, 0x3c, 0xae, 0xe8, 0x0b,
+	0x52, 0x85, 0x00, 0x2b, 0xcb, 0x38, 0x63, 0xb4, 0xdd, 0x74, 0xc7, 0x8b, 0x39, 0x92, 0x49, 0x77,
+	0xbc, 0x78, 0xf8, 0xd9, 0x4c, 0xba, 0xe3, 0xc5, 0xe7, 0x16, 0x0c, 0x7a, 0xe4, 0x13, 0x7f, 0xd1,
+	0x5f, 0xb2, 0xe8, 0x91, 0x41, 0xaf, 0xba, 0x7e, 0xe0, 0x2a, 0xf4, 0x2e, 0xf1, 0x6f, 0xb9, 0x77,
+	0xbd, 0xc2, 0xff, 0x20, 0xaa, 0xe3, 0xdd, 0x74, 0x0f, 0xb0, 0x6b, 0xdd, 0x73, 0xa2, 0x61, 0x5c,
+	0x09, 0x03, 0x10, 0x93, 0x56, 0x86, 0x8c, 0xe9, 0x02, 0xfe, 0x58, 0x28, 0x26, 0x4a, 0x15, 0x24,
+	0x4f, 0x78, 0x0a, 0x77, 0xb2, 0xd1, 0x8a, 0x7e, 0x41, 0x94, 0xe8, 0xb2, 0x54, 0x2a, 0xa1, 0x62,
+	0x17, 0x1d, 0xaa, 0xf6, 0x19, 0xac, 0xd5, 0xa0, 0xdf, 0x53, 0x81, 0x30, 0x5d, 0xcf, 0x3c, 0xce,
+	0x21, 0x37, 0x57, 0xda, 0x45, 0xa3, 0x20, 0xe1, 0x72, 0x2b, 0x37, 0x61, 0x29, 0x97, 0x78, 0xb7,
+	0x26, 0x8b, 0x96, 0xf2, 0x88, 0x77, 0xeb, 0xd2, 0x6d, 0x23, 0x53, 0xd7, 0x27, 0xfe, 0x4b, 0xfe,
+	0x1d, 0x2b, 0x53, 0xd7, 0xc8, 0x54, 0x75, 0xbd, 0xab, 0x65, 0xea, 0x11, 0x7f, 0xcd, 0x7d, 0xc5,
+	0x2b, 0xfc, 0x37, 0xa2, 0x2a, 0xfb, 0x96, 0xf6, 0x38, 0x26, 0x83, 0x30, 0xd2, 0x22, 0x10, 0xf1,
+	0x5e, 0xca, 0xb5, 0xd6, 0x81, 0x8c, 0x69, 0x43, 0x39, 0x65, 0x46, 0x37, 0x40, 0x33, 0x79, 0xb5,
+	0xaa, 0x70, 0x8b, 0x8c, 0xbc, 0xba, 0x33, 0x8b, 0x74, 0xa3, 0x05, 0xc2, 0x09, 0x02, 0x88, 0x5b,
+	0x42, 0x25, 0x58, 0x4c, 0x6b, 0x2d, 0x96, 0xb0, 0x58, 0x72, 0x5e, 0x29, 0xd1, 0xaf, 0x5a, 0x9e,
+	0x10, 0xa1, 0xac, 0x75, 0xe4, 0x09, 0x41, 0xca, 0x5a, 0x47, 0x9e, 0x10, 0xa7, 0xac, 0x75, 0xe4,
+	0xe9, 0xf9, 0xc4, 0x7f, 0xd9, 0xff, 0x33, 0x2b, 0x4f, 0xcf, 0xc8, 0x53, 0x75, 0xbd, 0xaf, 0x2d,
+	0xd4, 0x27, 0xfe, 0x03, 0xf7, 0x35, 0xaf, 0xf0, 0x18, 0x51, 0x78, 0x98, 0xa4, 0xfd, 0xc1, 0x16,
+	0xaf, 0xec, 0x22, 0x41, 0xda, 0x8a, 0x65, 0x18, 0x01, 0x00, 0x88, 0x0e, 0x45, 0xcc, 0xc1, 0xdd,
+	0x27, 0x1c, 0xa2, 0xd5, 0x14, 0x83, 0xfd, 0x35, 0x44, 0x2a, 0x29, 0xcb, 0xf0, 0x31, 0x0f, 0xf2,
+	0xa2, 0x41, 0x6c, 0xae, 0x8e, 0x1e, 0x7f, 0xd0, 0xc1, 0xe8, 0x23, 0xe2, 0x3d, 0xc8, 0x1d, 0xb6,
+	0x94, 0x4b, 0xbc, 0x07, 0xe3, 0xb3, 0x96, 0xf2, 0x88, 0xf7, 0xe0, 0xf9, 0x6f, 0xe1, 0xd7, 0x75,
+	0xda, 0x31, 0x70, 0xde, 0x46, 0x85, 0xd7, 0xb4, 0xfd, 0x74, 0xee, 0x96, 0x84, 0x43, 0x5c, 0xa9,
+	0x9c, 0xc7, 0x6e, 0x2a, 0xa0, 0x6d, 0x2b, 0x4c, 0xed, 0x4d, 0x02, 0x6e, 0x03, 0xdc, 0x11, 0xa6,
+	0x31, 0x97, 0x5b, 0x22, 0x79, 0x43, 0xdd, 0x27, 0x01, 0x8b, 0xa2, 0x12, 0xee, 0xa4, 0x35, 0x83,
+	0xfc, 0x21, 0x9c, 0xd8, 0xb4, 0x66, 0xd5, 0x25, 0x05, 0x9e, 0xd1, 0xa3, 0xf3, 0x2c, 0xd5, 0x97,
+	0x36, 0x85, 0x77, 0x62, 0x91, 0x6e, 0xd5, 0xc3, 0xa0, 0x0e, 0x2b, 0x88, 0x38, 0x6a, 0xd3, 0x56,
+	0x1c, 0xbe, 0xd9, 0xd2, 0x8f, 0x03, 0x63, 0xac, 0x29, 0x6b, 0xf0, 0xbe, 0x0b, 0x4b, 0x24, 0x61,
+	0x2d, 0x8c, 0x55, 0xa0, 0x60, 0x1d, 0x75, 0x28, 0xb5, 0x54, 0x4c, 0xf6, 0xb4, 0xda, 0x93, 0x3d,
+	0xad, 0x0e, 0x8d, 0x66, 0xb2, 0xa7, 0xd5, 0xb1, 0x43, 0xf8, 0x23, 0xa4, 0xb6, 0x87, 0x88, 0xf7,
+	0xba, 0x3b, 0x53, 0xf8, 0x11, 0xa2, 0xab, 0x7a, 0x81, 0xf3, 0x9d, 0xe8, 0xd9, 0xa6, 0x30, 0x28,
+	0x4b, 0x53, 0x11, 0x84, 0x0c, 0xdc, 0x47, 0xe7, 0xc9, 0x92, 0xb5, 0x90, 0x65, 0x09, 0xf1, 0x5c,
+	0x08, 0x22, 0x68, 0x63, 0xb8, 0xe5, 0xe0, 0xed, 0x92, 0x52, 0x9b, 0x60, 0xef, 0x09, 0x1f, 0x21,
+	0xc4, 0x00, 0x17, 0x2b, 0xe2, 0x2e, 0x7f, 0x73, 0xa1, 0xd3, 0x20, 0xe1, 0xe0, 0xeb, 0xe1, 0xfc,
+	0x3b, 0x50, 0x50, 0x0e, 0x36, 0x38, 0x61, 0x29, 0xd8, 0xee, 0xe4, 0x09, 0x4b, 0x79, 0xc4, 0x7b,
+	0xfd, 0xd4, 0x34, 0xfe, 0x99, 0x86, 0xe2, 0x12, 0x4f, 0xb8, 0x63, 0x85, 0x9f, 0x20, 0x6b, 0x58,
+	0x9b, 0x2c, 0x6a, 0xa9, 0x47, 0xc9, 0x7a, 0x95, 0x45, 0x29, 0x5f, 0x57, 0x66, 0xd4, 0xe0, 0x2c,
+	0xee, 0x0f, 0x35, 0x20, 0x3c, 0x95, 0x61, 0x04, 0x81, 0x6e, 0x67, 0xd7, 0x10, 0xc6, 0x56, 0xe9,
+	0xba, 0x4c, 0x5a, 0x30, 0x71, 0xdb, 0x78, 0x1b, 0x8b, 0x55, 0x74, 0xd4, 0x64, 0x9e, 0x29, 0xeb,
+	0x2a, 0xe5, 0xb8, 0x0e, 0x2a, 0xbe, 0x6e, 0x83, 0x8e, 0x75, 0xe5, 0x8c, 0xd9, 0x26, 0x0b, 0x23,
+	0x78, 0x69, 0x75, 0x90, 0xb9, 0x03, 0xb0, 0xdf, 0x9c, 0xa5, 0x10, 0xf1, 0xc4, 0xe0, 0xb0, 0xa5,
+	0x3c, 0xe2, 0x89, 0x03, 0x07, 0xf1, 0x97, 0x0a, 0x59, 0xde, 0x21, 0xbe, 0x74, 0xdf, 0xf2, 0x0a,
+	0xbf, 0x42, 0x74, 0xad, 0x67, 0x23, 0x5a, 0x1d, 0xad, 0x06, 0x99, 0x90, 0xd0, 0xec, 0x85, 0xc5,
+	0xd9, 0xed, 0x30, 0xaa, 0xc2, 0xde, 0xcc, 0xb6, 0x0c, 0x40, 0x30, 0xc9, 0x75, 0x7a, 0xe5, 0x4a,
+	0x57, 0x48, 0x71, 0x1f, 0x98, 0xb8, 0x1f, 0x0d, 0x4d, 0xb9, 0xdc, 0x36, 0xdb, 0x08, 0x8a, 0x3f,
+	0x62, 0x81, 0x8c, 0xda, 0x54, 0x19, 0x7a, 0x75, 0x57, 0x81, 0x68, 0x16, 0x1a, 0x6d, 0x1e, 0x14,
+	0x54, 0xe6, 0x0f, 0xe0, 0x35, 0x75, 0x8c, 0x1e, 0xf1, 0x36, 0x7d, 0x5a, 0xb8, 0xa1, 0xa0, 0xaa,
+	0xe9, 0x19, 0xab, 0xeb, 0x3b, 0x87, 0x98, 0x06, 0x2c, 0x55, 0x0b, 0x55, 0x59, 0x18, 0xb5, 0x12,
+	0xe5, 0x5c, 0xb2, 0xbe, 0xbd, 0x23, 0x6d, 0x2f, 0x07, 0x6c, 0xad, 0x1e, 0x81, 0x6b, 0xdc, 0x9c,
+	0x3c, 0x66, 0x29, 0x58, 0xf2, 0xc4, 0x49, 0x7c, 0x43, 0xad, 0xef, 0x13, 0xaf, 0xed, 0x9f, 0x29,
+	0x2c, 0xa8, 0xf5, 0x75, 0x38, 0xde, 0x8d, 0x6e, 0xf7, 0xda, 0x82, 0xf1, 0xf5, 0x9d, 0x25, 0xfd,
+	0x1c, 0x70, 0x3a, 0x62, 0x29, 0x44, 0xbc, 0xf6, 0x14, 0xb5, 0x94, 0x47, 0xbc, 0xf6, 0xcc, 0x69,
+	0xdc, 0x50, 0x35, 0x8c, 0x81, 0xef, 0x3a, 0xef, 0x22, 0x54, 0x58, 0xa7, 0x6b, 0xdd, 0x80, 0x8d,
+	0x36, 0x78, 0x9a, 0xc2, 0xdb, 0x15, 0x1c, 0x6d, 0x37, 0xa2, 0x4f, 0x7b, 0xf2, 0x71, 0x7b, 0xc7,
+	0xf7, 0x7d, 0x63, 0x8d, 0x7b, 0x02, 0x1b, 0xfa, 0x6e, 0xfe, 0x18, 0x7e, 0xde, 0x94, 0x49, 0xfc,
+	0xbf, 0x40, 0x2e, 0x29, 0x9c, 0xd1, 0x70, 0x59, 0x63, 0x07, 0x8c, 0xd6, 0x5b, 0x96, 0x30, 0xdc,
+	0x17, 0xba, 0x9c, 0x02, 0xb3, 0xf2, 0x96, 0x44, 0x40, 0x0e, 0x8d, 0x5a, 0xd2, 0x03, 0x72, 0x0c,
+	0x1c, 0x20, 0x78, 0xe4, 0xdc, 0xf7, 0x91, 0xf3, 0x23, 0x84, 0x0a, 0x95, 0x7d, 0xa1, 0xeb, 0x4d,
+	0x64, 0x3e, 0x0d, 0x5f, 0xdf, 0xe8, 0x12, 0xc6, 0xc3, 0xba, 0x72, 0xe3, 0x7f, 0x1f, 0xe5, 0x8f,
+	0xe3, 0x6f, 0x99, 0xd2, 0x8d, 0xff, 0x18, 0x20, 0x96, 0x76, 0x87, 0x78, 0x36, 0x85, 0x07, 0x2b,
+	0x8f, 0x65, 0x3f, 0x56, 0x55, 0xeb, 0xf1, 0x1f, 0x5b, 0xac, 0xaa, 0xd8, 0xe3, 0x3f, 0xb6, 0x58,
+	0x55, 0xb5, 0xc7, 0x7f, 0x0c, 0x58, 0xe7, 0xd4, 0x52, 0x88, 0xf8, 0x3f, 0x44, 0xee, 0x78, 0xe1,
+	0x98, 0xf6, 0xf6, 0x92, 0xc5, 0x15, 0x96, 0x54, 0x68, 0x14, 0xa6, 0xd2, 0x84, 0xe5, 0x1d, 0xbe,
+	0x68, 0x40, 0x8d, 0xb5, 0x7c, 0x91, 0x9a, 0x3a, 0x74, 0xd0, 0x92, 0x1e, 0x90, 0xe4, 0x30, 0x2e,
+	0x2a, 0xbe, 0x2e, 0xf1, 0xff, 0x1a, 0xb9, 0x93, 0x85, 0x13, 0x3b, 0xf0, 0x85, 0xa4, 0x3c, 0x85,
+	0x72, 0x41, 0x87, 0xb5, 0x3b, 0xa0, 0x86, 0x0f, 0x5a, 0x12, 0x01, 0x99, 0x3f, 0x64, 0x49, 0x0f,
+	0xc8, 0xf1, 0x09, 0x7c, 0x5e, 0xb1, 0xf6, 0x88, 0xff, 0xb7, 0xc8, 0x9d, 0x2a, 0x9c, 0xdc, 0x8d,
+	0xb5, 0xca, 0xf7, 0x77, 0x78, 0x7b, 0x03, 0x6a, 0xbc, 0xdd, 0xb6, 0x87, 0x80, 0x1c, 0x3a, 0x6c,
+	0x49, 0xc5, 0x6d, 0xf2, 0x08, 0x4e, 0x31, 0xa8, 0x78, 0xee, 0x3d, 0xe4, 0x7c, 0x88, 0x90, 0xb9,
+	0xfc, 0x3a, 0x46, 0xf4, 0x55, 0x9e, 0x3d, 0x6c, 0xe8, 0x3d, 0x94, 0x3f, 0x81, 0xbf, 0x8d, 0x7d,
+	0xdf, 0x83, 0xb3, 0x7f, 0x1f, 0xb9, 0xa7, 0x0b, 0x37, 0xe9, 0xa2, 0x86, 0x24, 0xaa, 0x3b, 0xbf,
+	0xa1, 0x4c, 0x5e, 0xe2, 0x69, 0xaf, 0x28, 0x2d, 0x06, 0x4f, 0xbd, 0x69, 0xde, 0x47, 0x6e, 0x87,
+	0xcc, 0x01, 0x39, 0x3c, 0x6e, 0x49, 0x04, 0xe4, 0xc4, 0x49, 0x4b, 0x7a, 0x40, 0x4e, 0xcf, 0xe0,
+	0x8b, 0x6a, 0x4f, 0x88, 0xf8, 0x1f, 0x20, 0xf7, 0x78, 0xe1, 0x74, 0xaf, 0xc4, 0x01, 0x0f, 0x85,
+	0x7a, 0xca, 0xf9, 0x6d, 0x62, 0xf7, 0x94, 0xb6, 0x7c, 0x60, 0xc5, 0xee, 0x29, 0x6d, 0xf9, 0x00,
+	0x0d, 0x4d, 0x59, 0xd2, 0x03, 0xf2, 0xd8, 0x33, 0x58, 0x62, 0xd7, 0xf7, 0x49, 0xee, 0xc7, 0xc8,
+	0xf9, 0x09, 0x42, 0x85, 0xea, 0xbe, 0x2c, 0xae, 0x2f, 0xd1, 0xfd, 0x34, 0xb1, 0xf7, 0x0f, 0x37,
+	0x72, 0x87, 0x4a, 0xec, 0x8f, 0x41, 0xee, 0x4b, 0xd8, 0xf7, 0x7d, 0x90, 0xfb, 0x47, 0x60, 0x73,
+	0x5f, 0xdf, 0x87, 0x5b, 0xa1, 0x52, 0xc0, 0xb5, 0x65, 0xdc, 0x36, 0x64, 0xf6, 0x14, 0x2c, 0x5f,
+	0x99, 0xde, 0x47, 0x16, 0xb4, 0xaf, 0xa4, 0xfa, 0x91, 0x35, 0x3d, 0x5f, 0x49, 0xf5, 0x23, 0x30,
+	0x3d, 0x00, 0x3d, 0x40, 0x72, 0x1f, 0x23, 0xe7, 0x1f, 0xf6, 0x0d, 0xba, 0xaf, 0xae, 0xf0, 0x34,
+	0xd0, 0xfd, 0xc3, 0x0d, 0xe8, 0x01, 0x44, 0xfc, 0x8f, 0x01, 0xf4, 0x22, 0xf6, 0xfd, 0x01, 0x00,
+	0xfd, 0x09, 0x80, 0x7e, 0x7e, 0xff, 0xa0, 0x2b, 0x8a, 0xb1, 0x85, 0x3c, 0xa0, 0x20, 0x7f, 0x62,
+	0x21, 0x0f, 0x28, 0xc8, 0x9f, 0x58, 0xc8, 0x03, 0x0a, 0xf2, 0x27, 0x00, 0xb9, 0x89, 0xe1, 0x76,
+	0xc9, 0xfd, 0x14, 0x39, 0xbf, 0x40, 0xa8, 0xb0, 0xb1, 0x2f, 0xc8, 0x3d, 0xf5, 0x84, 0xa7, 0x01,
+	0xee, 0x1d, 0x6c, 0xe0, 0xe6, 0x10, 0xf1, 0x7f, 0x8a, 0xf2, 0xcf, 0xe0, 0x3f, 0xc6, 0xbe, 0x9f,
+	0x03, 0xb8, 0x4f, 0x00, 0xee, 0x85, 0x7d, 0xc2, 0xdd, 0x62, 0xa1, 0xa4, 0xc2, 0xea, 0x74, 0x4e,
+	0x61, 0x7d, 0x62, 0xb1, 0xe6, 0x14, 0xd6, 0x27, 0x16, 0x6b, 0x4e, 0x61, 0x7d, 0x02, 0x58, 0x7f,
+	0x8d, 0xd4, 0x6a, 0x88, 0xf8, 0x3f, 0x47, 0xee, 0xd9, 0xc2, 0x7f, 0xe8, 0x18, 0xa8, 0xc1, 0x1e,
+	0x85, 0x8d, 0x56, 0x83, 0xda, 0xc2, 0x76, 0x67, 0x81, 0x0d, 0x5e, 0x15, 0x89, 0x0a, 0x22, 0x55,
+	0x14, 0xdf, 0xd2, 0x99, 0x8f, 0x88, 0x57, 0x25, 0xdd, 0x88, 0x58, 0xfc, 0x86, 0x8e, 0xe5, 0x60,
+	0x28, 0xa6, 0x5b, 0x10, 0xf3, 0x6d, 0xf0, 0xce, 0x2b, 0x44, 0xd6, 0x75, 0xf4, 0xa9, 0xb3, 0xc4,
+	0x52, 0x76, 0x93, 0x00, 0x2a, 0x33, 0x1c, 0xb5, 0x81, 0xe5, 0xcd, 0xb5, 0xb5, 0x95, 0xf9, 0xf2,
+	0xca, 0x12, 0x55, 0xb5, 0x8b, 0x40, 0x44, 0x3a, 0x14, 0x82, 0x1e, 0x08, 0x7d, 0xf9, 0x23, 0x49,
+	0x2b, 0x9c, 0x55, 0xa2, 0x30, 0x56, 0xb1, 0x27, 0x8b, 0x52, 0xd1, 0x75, 0x2b, 0x7a, 0xf5, 0xb4,
+	0x2e, 0x12, 0x70, 0x2e, 0x22, 0xe6, 0x9d, 0x3d, 0xb4, 0xd2, 0x8e, 0x1a, 0xe4, 0x20, 0xea, 0x05,
+	0xb4, 0x05, 0x4b, 0x2a, 0xf0, 0xc7, 0xa6, 0x2d, 0xe9, 0x01, 0x79, 0xe6, 0x59, 0xfc, 0xbf, 0x2e,
+	0x76, 0xfd, 0x41, 0x92, 0xfb, 0x14, 0x41, 0xa9, 0xa3, 0xf0, 0x1b, 0x97, 0x2e, 0x76, 0x8e, 0xbf,
+	0xf3, 0xb2, 0xb1, 0xef, 0x27, 0xfb, 0x41, 0xa7, 0x5e, 0x5b, 0xa9, 0x06, 0xb7, 0xeb, 0xc3, 0x17,
+	0x63, 0x7a, 0xfd, 0x11, 0x83, 0x20, 0x77, 0x41, 0x55, 0x1b, 0x92, 0x66, 0x40, 0x33, 0x35, 0x96,
+	0x32, 0x0f, 0x44, 0x2d, 0x0e, 0xdf, 0xe2, 0xb3, 0x3b, 0x75, 0x9a, 0x72, 0xd5, 0x9c, 0x2e, 0x53,
+	0xd0, 0xce, 0x1b, 0x65, 0x76, 0x2f, 0xad, 0x9b, 0xa3, 0x6f, 0xeb, 0xf1, 0x42, 0x55, 0xa6, 0x76,
+	0x1c, 0xdc, 0x5b, 0xdb, 0x9a, 0xa3, 0x57, 0xe8, 0xdb, 0xdd, 0x35, 0x32, 0xb5, 0xda, 0x05, 0x3a,
+	0xbd, 0xf3, 0xbe, 0x4c, 0x61, 0xd4, 0x4e, 0xea, 0xa9, 0xdd, 0xee, 0x32, 0xc9, 0xfe, 0x92, 0xc7,
+	0x4c, 0x7a, 0xe7, 0x32, 0xfc, 0x7f, 0x47, 0x9b, 0xc4, 0x20, 0x22, 0xfe, 0xa7, 0x28, 0x3f, 0x81,
+	0xff, 0x0f, 0xb4, 0x74, 0x10, 0x6c, 0xe2, 0x33, 0xe4, 0x1e, 0x2b, 0xfc, 0x16, 0x75, 0x32, 0xd5,
+	0x3a, 0xec, 0xb0, 0xd2, 0xcf, 0x9a, 0x49, 0x33, 0x09, 0x1b, 0x2c, 0xb1, 0x2f, 0x38, 0x75, 0x30,
+	0xe6, 0x59, 0x0d, 0x2f, 0x86, 0xdd, 0xce, 0x45, 0xbf, 0x60, 0xd5, 0xe0, 0xac, 0xee, 0x80, 0x01,
+	0x54, 0x78, 0xca, 0x93, 0x90, 0x45, 0xe1, 0x5b, 0xa6, 0x30, 0x52, 0xbe, 0x77, 0x36, 0xed, 0x66,
+	0x3f, 0xb1, 0x52, 0x54, 0xa1, 0x35, 0xdb, 0xa4, 0xe5, 0x41, 0x43, 0x63, 0x1d, 0x66, 0xc3, 0xf7,
+	0xbb, 0x42, 0xf2, 0x05, 0xba, 0x08, 0x57, 0x9f, 0xd6, 0x9b, 0x30, 0x35, 0xcf, 0x28, 0x48, 0x5e,
+	0xca, 0x50, 0xb6, 0x24, 0x4f, 0xe9, 0x46, 0xc2, 0xd9, 0x1b, 0xf0, 0x3d, 0xa8, 0xb3, 0xb8, 0xc6,
+	0x53, 0xa3, 0xb7, 0x83, 0xca, 0xa4, 0x3f, 0xb3, 0x26, 0x3d, 0xa8, 0x4c, 0xfa, 0x33, 0x34, 0x34,
+	0x69, 0x49, 0x0f, 0xc8, 0xa3, 0x05, 0xfc, 0x4b, 0x2d, 0x2c, 0x44, 0xfc, 0x2f, 0x40, 0x58, 0x3f,
+	0xdb, 0x8f, 0xb0, 0x3a, 0xaf, 0xc5, 0x1e, 0x31, 0xf5, 0x48, 0x09, 0xf7, 0xaa, 0xef, 0x57, 0x8c,
+	0x15, 0xae, 0xe4, 0x2f, 0xba, 0x58, 0x91, 0x42, 0xd3, 0xc1, 0x0a, 0x36, 0xfa, 0x05, 0x3a, 0x5a,
+	0xe8, 0xfc, 0x0e, 0xe8, 0x4b, 0x84, 0x77, 0xff, 0xc1, 0x0e, 0x39, 0xd8, 0x57, 0xa3, 0x9d, 0xfe,
+	0x06, 0xf6, 0x16, 0xe3, 0x36, 0x39, 0x8a, 0xf3, 0x80, 0xf7, 0x61, 0x2b, 0x89, 0xcc, 0xcf, 0x0f,
+	0x06, 0x81, 0x7e, 0x39, 0x89, 0xc8, 0x38, 0x1e, 0x50, 0xbb, 0x55, 0xbf, 0x38, 0x18, 0x29, 0x6b,
+	0xe2, 0xaa, 0xc0, 0x87, 0x33, 0x35, 0x59, 0xcb, 0xee, 0x6a, 0x7e, 0x31, 0x6e, 0xdb, 0x4a, 0xec,
+	0x99, 0x5a, 0x28, 0xeb, 0xad, 0x8d, 0x52, 0x20, 0x1a, 0xf3, 0xba, 0x1a, 0xdb, 0xdd, 0x51, 0x53,
+	0x79, 0x07, 0xd8, 0xd8, 0xc7, 0xae, 0x77, 0x63, 0xe5, 0xea, 0x13, 0xf7, 0x84, 0x29, 0xba, 0xae,
+	0x98, 0x21, 0xa5, 0xfb, 0x3c, 0x8a, 0x5e, 0x8a, 0xc5, 0x56, 0x0c, 0x3f, 0x6d, 0x48, 0x3b, 0xf8,
+	0xde, 0x1d, 0xc3, 0xbb, 0xfd, 0x70, 0x88, 0xe0, 0x6e, 0xc6, 0x6a, 0x8f, 0x5f, 0x2d, 0x4d, 0x57,
+	0x71, 0x4e, 0xff, 0x78, 0x87, 0x10, 0xec, 0x67, 0x7e, 0xd5, 0xa7, 0xda, 0x64, 0x0a, 0x0f, 0x1a,
+	0xbd, 0x30, 0xbf, 0xb1, 0xb0, 0x24, 0x29, 0xe1, 0xc1, 0x0a, 0x97, 0x2c, 0x8c, 0xd2, 0x29, 0x8f,
+	0x7a, 0xbb, 0xfd, 0xc0, 0xa7, 0x6c, 0x07, 0x5d, 0x7d, 0x0d, 0x1f, 0xc8, 0xc8, 0x28, 0x69, 0x06,
+	0x57, 0x87, 0xf5, 0xba, 0x56, 0x42, 0x97, 0xf6, 0x57, 0xab, 0xee, 0xe2, 0xbc, 0xac, 0xff, 0x7d,
+	0xec, 0x7a, 0xe5, 0x95, 0xa5, 0x5b, 0xbf, 0x1d, 0x85, 0x1f, 0xee, 0x1d, 0x70, 0xce, 0xef, 0x59,
+	0x7d, 0xfe, 0xc6, 0x1f, 0xaa, 0xcf, 0x7f, 0xa8, 0x3e, 0xef, 0xb7, 0xfa, 0x7c, 0xb8, 0x5b, 0x7d,
+	0x9e, 0x31, 0x85, 0xdc, 0x71, 0xe7, 0xae, 0x2d, 0x0e, 0x43, 0x53, 0x77, 0x4e, 0x74, 0x8b, 0xc3,
+	0x13, 0x9d, 0xe2, 0xf0, 0xa4, 0x53, 0xb4, 0xc5, 0x61, 0x68, 0xea, 0xce, 0x23, 0xce, 0x59, 0x5b,
+	0x1c, 0x86, 0xa6, 0xee, 0x9c, 0xd2, 0xf5, 0xea, 0xfc, 0x69, 0xdd, 0xfc, 0x17, 0x4f, 0xa7, 0x4e,
+	0x4f, 0x39, 0xe7, 0x51, 0xe1, 0x1f, 0x3d, 0xe5, 0x9b, 0xd7, 0xb5, 0xa9, 0xac, 0x6b, 0x3f, 0x5c,
+	0xe1, 0xd5, 0x30, 0x36, 0xf5, 0x8a, 0x1a, 0x64, 0x00, 0x4d, 0xc6, 0xa6, 0x21, 0x2a, 0x3c, 0xea,
+	0x64, 0x4e, 0xd3, 0x56, 0x28, 0xd5, 0x39, 0x57, 0x45, 0x02, 0xa7, 0x58, 0xad, 0x72, 0xf5, 0xd8,
+	0x56, 0xb9, 0x36, 0xd6, 0x50, 0x91, 0x1a, 0x8f, 0x37, 0xc3, 0x44, 0xc4, 0x50, 0x5d, 0x4b, 0x8b,
+	0x34, 0x8c, 0x83, 0xa8, 0xa5, 0xaa, 0xcc, 0xe5, 0xeb, 0xab, 0x6b, 0x90, 0x61, 0x55, 0x02, 0x53,
+	0x51, 0x16, 0x10, 0xaa, 0x5e, 0x01, 0xb7, 0xa4, 0x8d, 0x63, 0x5e, 0xad, 0x95, 0x57, 0x96, 0x1e,
+	0xcc, 0x82, 0x4e, 0xa6, 0x0b, 0xf3, 0xf3, 0x59, 0x17, 0x97, 0x34, 0x83, 0xb9, 0x12, 0xbd, 0xce,
+	0x82, 0x7a, 0x77, 0xf3, 0xf6, 0x86, 0xb1, 0xc9, 0x4a, 0x4c, 0x65, 0x3d, 0xe1, 0x9c, 0x36, 0x43,
+	0x1e, 0xf0, 0x14, 0x54, 0x1e, 0xee, 0x9a, 0x05, 0x83, 0x06, 0xfc, 0x4f, 0xd1, 0x22, 0xd3, 0x33,
+	0x4d, 0xda, 0x4f, 0x75, 0x19, 0xbf, 0x52, 0xc2, 0xda, 0x82, 0x20, 0x0d, 0x57, 0x0d, 0xe3, 0x0a,
+	0x04, 0x9f, 0xb4, 0x21, 0x12, 0x4e, 0xd9, 0x06, 0x34, 0x95, 0x3d, 0x66, 0xc5, 0x03, 0x1c, 0xea,
+	0x62, 0x4b, 0x85, 0xae, 0x90, 0x47, 0x56, 0x16, 0x19, 0x4a, 0xf3, 0xe6, 0xc4, 0xf4, 0x55, 0x48,
+	0x2c, 0x5f, 0x53, 0x05, 0x5e, 0x7a, 0xa3, 0x15, 0x56, 0x78, 0x17, 0x5f, 0x00, 0x35, 0x4f, 0xeb,
+	0xc1, 0x00, 0xa5, 0x72, 0x48, 0xba, 0x18, 0x3c, 0xaf, 0xd6, 0x48, 0xe7, 0x32, 0xe9, 0xe8, 0x53,
+	0xf9, 0x03, 0xb8, 0x62, 0xd3, 0xd1, 0x33, 0xee, 0xa1, 0xc2, 0x7d, 0xfb, 0xf6, 0x94, 0xad, 0xd4,
+	0xc0, 0xd3, 0x39, 0x44, 0x53, 0xe9, 0x84, 0xa0, 0x38, 0xa6, 0x3c, 0x6e, 0x35, 0xcc, 0x95, 0x28,
+	0xaa, 0xf4, 0xd5, 0xbe, 0xba, 0xc3, 0x83, 0x6d, 0x1d, 0x3d, 0x09, 0xe8, 0x19, 0x77, 0x30, 0x93,
+	0x80, 0x9e, 0xc9, 0x67, 0x7f, 0xbe, 0x3b, 0x73, 0x70, 0x0c, 0xff, 0xba, 0x93, 0x80, 0x9e, 0x75,
+	0x27, 0x0a, 0x5f, 0x22, 0xba, 0xd8, 0x2d, 0x9b, 0x9e, 0xaf, 0xb2, 0x40, 0x29, 0x45, 0xaf, 0xc8,
+	0xfb, 0xb7, 0x18, 0xc6, 0xf4, 0x7a, 0x5c, 0x8b, 0xc2, 0xb4, 0x5e, 0x82, 0xc2, 0xae, 0xd2, 0x86,
+	0x9d, 0xe7, 0x66, 0x26, 0x45, 0x22, 0x50, 0x11, 0x52, 0x45, 0xc9, 0x3f, 0xe5, 0x71, 0x46, 0xe0,
+	0xdb, 0x7e, 0x46, 0x5a, 0x32, 0x67, 0xfb, 0x60, 0x8f, 0x4f, 0xb4, 0x1a, 0xf2, 0xa8, 0xa2, 0xca,
+	0xd8, 0x5d, 0xde, 0x1b, 0xed, 0x4c, 0x39, 0xae, 0x9b, 0xcd, 0x1e, 0x00, 0xb4, 0xf9, 0x4c, 0x36,
+	0x7b, 0x76, 0x68, 0x2c, 0x93, 0xcd, 0x9e, 0x3d, 0x3c, 0x8e, 0xdb, 0x36, 0x99, 0x5d, 0x74, 0xff,
+	0xa8, 0x10, 0x65, 0xf2, 0x16, 0x06, 0x8a, 0xc9, 0x5a, 0x04, 0x2c, 0x49, 0xf4, 0x1a, 0xbd, 0x4a,
+	0xa8, 0x0a, 0x56, 0x89, 0x7e, 0x7a, 0xd8, 0x4c, 0x7b, 0xca, 0x81, 0x01, 0xee, 0x7b, 0x02, 0x80,
+	0xc3, 0x52, 0x86, 0x25, 0x05, 0x08, 0xae, 0xb3, 0x49, 0xc8, 0x80, 0x16, 0xdd, 0x0e, 0x95, 0x23,
+	0x5e, 0x71, 0xf8, 0x44, 0x26, 0x4d, 0x5d, 0x3c, 0xf9, 0x6c, 0x26, 0x4d, 0x5d, 0x9c, 0x3b, 0x67,
+	0x63, 0x80, 0xff, 0x1f, 0x00, 0x40, 0x9d, 0x32, 0xe9, 0xfd, 0x2f, 0x00, 0x00,
+}
@@ -0,0 +1,1455 @@
+// Code generated by tetratelabs/protoc-gen-cobra.
+// source: options/options.proto
+// DO NOT EDIT!
+
+/*
+Package options is a generated protocol buffer package.
+
+It is generated from these files:
+	options/options.proto
+
+It has these top-level commands:
+	AccountsClientCommand
+*/
+
+package options
+
+import (
+	proto "github.com/golang/protobuf/proto"
+	batch "github.com/tetratelabs/protoc-gen-cobra/batch"
+	cobra "github.com/spf13/cobra"
+	context "golang.org/x/net/context"
+	credentials "google.golang.org/grpc/credentials"
+	describe "github.com/tetratelabs/protoc-gen-cobra/describe"
+	destructive "github.com/tetratelabs/protoc-gen-cobra/destructive"
+	dryrun "github.com/tetratelabs/protoc-gen-cobra/dryrun"
+	editing "github.com/tetratelabs/protoc-gen-cobra/editing"
+	filepath "path/filepath"
+	fmt1 "fmt"
+	grpc "google.golang.org/grpc"
+	health "github.com/tetratelabs/protoc-gen-cobra/health"
+	io "io"
+	iocodec "github.com/tetratelabs/protoc-gen-cobra/iocodec"
+	ioutil "io/ioutil"
+	json "encoding/json"
+	loadtest "github.com/tetratelabs/protoc-gen-cobra/loadtest"
+	log "log"
+	metadata "google.golang.org/grpc/metadata"
+	net "net"
+	oauth "google.golang.org/grpc/credentials/oauth"
+	oauth2 "golang.org/x/oauth2"
+	operation "github.com/tetratelabs/protoc-gen-cobra/operation"
+	os "os"
+	override "github.com/tetratelabs/protoc-gen-cobra/override"
+	pagination "github.com/tetratelabs/protoc-gen-cobra/pagination"
+	pflag "github.com/spf13/pflag"
+	prompting "github.com/tetratelabs/protoc-gen-cobra/prompting"
+	recording "github.com/tetratelabs/protoc-gen-cobra/recording"
+	streaming "github.com/tetratelabs/protoc-gen-cobra/streaming"
+	template "text/template"
+	templating "github.com/tetratelabs/protoc-gen-cobra/templating"
+	time "time"
+	tls "crypto/tls"
+	tracing "github.com/tetratelabs/protoc-gen-cobra/tracing"
+	validation "github.com/tetratelabs/protoc-gen-cobra/validation"
+	verbose "github.com/tetratelabs/protoc-gen-cobra/verbose"
+	x509 "crypto/x509"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+var _DefaultAccountsClientCommandConfig = _NewAccountsClientCommandConfig()
+
+type _AccountsClientCommandConfig struct {
+	ServerAddr         string
+	RequestFile        string
+	Stdin              bool
+	PrintSampleRequest bool
+	ResponseFormat     string
+	Timeout            time.Duration
+	TLS                bool
+	ServerName         string
+	InsecureSkipVerify bool
+	CACertFile         string
+	CertFile           string
+	KeyFile            string
+	AuthToken          string
+	AuthTokenType      string
+	JWTKey             string
+	JWTKeyFile         string
+	SkipValidation     bool
+	LoadTest           loadtest.Options
+	Record             string
+	DryRun             bool
+	Verbose            bool
+	Trace              tracing.Options
+	Set                override.Options
+	Template           templating.Options
+	Edit               bool
+	Interactive        bool
+	Destructive        destructive.Options
+}
+
+func _NewAccountsClientCommandConfig() *_AccountsClientCommandConfig {
+	c := &_AccountsClientCommandConfig{
+		ServerAddr:     "localhost:8080",
+		ResponseFormat: "json",
+		Timeout:        10 * time.Second,
+		AuthTokenType:  "Bearer",
+	}
+	return c
+}
+
+func (o *_AccountsClientCommandConfig) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVarP(&o.ServerAddr, "server-addr", "s", o.ServerAddr, "server address in form of host:port")
+	fs.StringVarP(&o.RequestFile, "request-file", "f", o.RequestFile, "client request file (must be json, yaml, or xml); use \"-\" for stdin + json")
+	fs.BoolVar(&o.Stdin, "stdin", o.Stdin, "read client request from STDIN; alternative for '-f -'")
+	fs.BoolVarP(&o.PrintSampleRequest, "print-sample-request", "p", o.PrintSampleRequest, "print sample request file and exit")
+	fs.StringVarP(&o.ResponseFormat, "response-format", "o", o.ResponseFormat, "response format (json, prettyjson, yaml, or xml)")
+	fs.DurationVar(&o.Timeout, "timeout", o.Timeout, "client connection timeout")
+	fs.BoolVar(&o.TLS, "tls", o.TLS, "enable tls")
+	fs.StringVar(&o.ServerName, "tls-server-name", o.ServerName, "tls server name override")
+	fs.BoolVar(&o.InsecureSkipVerify, "tls-insecure-skip-verify", o.InsecureSkipVerify, "INSECURE: skip tls checks")
+	fs.StringVar(&o.CACertFile, "tls-ca-cert-file", o.CACertFile, "ca certificate file")
+	fs.StringVar(&o.CertFile, "tls-cert-file", o.CertFile, "client certificate file")
+	fs.StringVar(&o.KeyFile, "tls-key-file", o.KeyFile, "client key file")
+	fs.StringVar(&o.AuthToken, "auth-token", o.AuthToken, "authorization token")
+	fs.StringVar(&o.AuthTokenType, "auth-token-type", o.AuthTokenType, "authorization token type")
+	fs.StringVar(&o.JWTKey, "jwt-key", o.JWTKey, "jwt key")
+	fs.StringVar(&o.JWTKeyFile, "jwt-key-file", o.JWTKeyFile, "jwt key file")
+	fs.BoolVar(&o.SkipValidation, "skip-validation", o.SkipValidation, "skip the client-side validation of requests")
+	o.LoadTest.AddFlags(fs)
+	fs.StringVar(&o.Record, "record", o.Record, "record the calls, with their metadata, messages, statuses and timings, to this session file")
+	fs.BoolVar(&o.DryRun, "dry-run", o.DryRun, "print the requests, where they would be sent and the metadata, with secrets redacted, instead of sending them")
+	fs.BoolVarP(&o.Verbose, "verbose", "v", o.Verbose, "log the connection, the tls handshake, and the metadata, message sizes and timings of the calls to stderr, with secrets redacted")
+	o.Trace.AddFlags(fs)
+	o.Set.AddFlags(fs)
+	o.Template.AddFlags(fs)
+	fs.BoolVar(&o.Edit, "edit", o.Edit, "open the request, or a sample of it without one, in $EDITOR before sending it")
+	fs.BoolVar(&o.Interactive, "interactive", o.Interactive, "when stdin is a terminal, prompt for the fields the request doesn't set, and for confirmation before sending it")
+	o.Destructive.AddFlags(fs)
+}
+
+func AccountsClientCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "acct",
+		Aliases: []string{"a", "account"},
+		Short:   "Manage accounts",
+	}
+	_DefaultAccountsClientCommandConfig.AddFlags(cmd.PersistentFlags())
+
+	for _, s := range _AccountsClientSubCommands {
+		cmd.AddCommand(s())
+	}
+	cmd.AddCommand(_AccountsHealthCommand())
+	return cmd
+}
+
+func _AccountsReplayCommand() *cobra.Command {
+	var compare bool
+	cmd := &cobra.Command{
+		Use:   "replay [file]",
+		Short: "Replay the calls of a session recorded with --record",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			f, err := os.Open(args[0])
+			if err != nil {
+				log.Fatal(err)
+			}
+			defer f.Close()
+			err = _AccountsRoundTrip(nil, nil, func(conn *grpc.ClientConn, cli AccountsClient, in iocodec.Decoder, out iocodec.Encoder) error {
+				if _DefaultAccountsClientCommandConfig.DryRun {
+					reqs, err := recording.Requests(f)
+					if err != nil {
+						return err
+					}
+					return _AccountsDryRun(out, reqs...)
+				}
+				return recording.Replay(conn, f, compare, out, os.Stderr)
+			})
+			if err != nil {
+				log.Fatal(err)
+			}
+		},
+	}
+	cmd.Flags().BoolVar(&compare, "compare", false, "compare the responses and statuses to the recorded ones, field by field, and fail if they differ")
+	return cmd
+}
+
+func _AccountsHealthCommand() *cobra.Command {
+	opts := health.Options{Service: "options.Accounts"}
+	cmd := &cobra.Command{
+		Use:   "health",
+		Short: "Check the health of options.Accounts with the gRPC health checking protocol, and report how the connection is made",
+		Long:  "Check the health of options.Accounts with the gRPC health checking protocol, and report how the connection is made: its TLS details, the certificate chain of the server and the latency of the check.\n\nExits with 0 if the service is serving, 2 if the connection fails, 3 if the check fails, and 4 if the service isn't serving.",
+		Args:  cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			cfg := _DefaultAccountsClientCommandConfig
+			em, err := _AccountsEncoderMaker()
+			if err != nil {
+				log.Fatal(err)
+			}
+			if cfg.DryRun {
+				if err := _AccountsDryRun(em.NewEncoder(os.Stdout)); err != nil {
+					log.Fatal(err)
+				}
+				return
+			}
+			err = health.Check(opts, cfg.Timeout, func() (*grpc.ClientConn, error) {
+				conn, _, err := _DialAccounts()
+				return conn, err
+			}, em.NewEncoder(os.Stdout))
+			if err != nil {
+				log.Print(err)
+				os.Exit(health.ExitCode(err))
+			}
+		},
+	}
+	opts.AddFlags(cmd.Flags())
+	return cmd
+}
+
+func _AccountsDescribeCommand() *cobra.Command {
+	var raw bool
+	cmd := &cobra.Command{
+		Use:   "describe [name]",
+		Short: "Print the definition of options.Accounts, or of one of its methods, messages or enums, with its comments",
+		Long:  "Print the definition of options.Accounts, or of one of its methods, messages or enums, with its comments, from the descriptors built into the command.\n\nThe name is a method, as named in the proto file or as its command, or the name of a message or enum, relative to the package of the service or in full. A method is followed by its request and response messages.",
+		Args:  cobra.MaximumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			name := ""
+			if len(args) > 0 {
+				name = args[0]
+			}
+			defs, err := describe.Lookup("options.Accounts", name)
+			if err != nil {
+				log.Fatal(err)
+			}
+			if !raw {
+				if err := describe.Print(os.Stdout, defs); err != nil {
+					log.Fatal(err)
+				}
+				return
+			}
+			em, err := _AccountsEncoderMaker()
+			if err != nil {
+				log.Fatal(err)
+			}
+			enc := em.NewEncoder(os.Stdout)
+			for _, d := range defs {
+				if err := enc.Encode(d.Descriptor); err != nil {
+					log.Fatal(err)
+				}
+			}
+		},
+	}
+	cmd.Flags().BoolVar(&raw, "descriptor", false, "print the descriptors of the definitions in the response format instead of proto source")
+	return cmd
+}
+
+func _DialAccounts() (*grpc.ClientConn, AccountsClient, error) {
+	cfg := _DefaultAccountsClientCommandConfig
+	var v *verbose.Logger
+	var opts []grpc.DialOption
+	if cfg.Trace.Enabled() {
+		t, err := tracing.Start(cfg.Trace)
+		if err != nil {
+			return nil, nil, fmt.Errorf("trace: %v", err)
+		}
+		opts = append(opts, t.DialOptions()...)
+	}
+	if cfg.Verbose {
+		v = verbose.New(os.Stderr)
+		opts = append(opts, v.DialOptions()...)
+	}
+	if cfg.TLS {
+		tlsConfig := &tls.Config{}
+		if cfg.InsecureSkipVerify {
+			tlsConfig.InsecureSkipVerify = true
+		}
+		if cfg.CACertFile != "" {
+			cacert, err := ioutil.ReadFile(cfg.CACertFile)
+			if err != nil {
+				return nil, nil, fmt.Errorf("ca cert: %v", err)
+			}
+			certpool := x509.NewCertPool()
+			certpool.AppendCertsFromPEM(cacert)
+			tlsConfig.RootCAs = certpool
+		}
+		if cfg.CertFile != "" {
+			if cfg.KeyFile == "" {
+				return nil, nil, fmt.Errorf("missing key file")
+			}
+			pair, err := tls.LoadX509KeyPair(cfg.CertFile, cfg.KeyFile)
+			if err != nil {
+				return nil, nil, fmt.Errorf("cert/key: %v", err)
+			}
+			tlsConfig.Certificates = []tls.Certificate{pair}
+		}
+		if cfg.ServerName != "" {
+			tlsConfig.ServerName = cfg.ServerName
+		} else {
+			addr, _, _ := net.SplitHostPort(cfg.ServerAddr)
+			tlsConfig.ServerName = addr
+		}
+		//tlsConfig.BuildNameToCertificate()
+		cred := credentials.NewTLS(tlsConfig)
+		if v != nil {
+			cred = v.Credentials(cred)
+		}
+		opts = append(opts, grpc.WithTransportCredentials(cred))
+	} else {
+		opts = append(opts, grpc.WithInsecure())
+	}
+	if cfg.AuthToken != "" {
+		cred := oauth.NewOauthAccess(&oauth2.Token{
+			AccessToken: cfg.AuthToken,
+			TokenType:   cfg.AuthTokenType,
+		})
+		opts = append(opts, grpc.WithPerRPCCredentials(cred))
+	}
+	if cfg.JWTKey != "" {
+		cred, err := oauth.NewJWTAccessFromKey([]byte(cfg.JWTKey))
+		if err != nil {
+			return nil, nil, fmt.Errorf("jwt key: %v", err)
+		}
+		opts = append(opts, grpc.WithPerRPCCredentials(cred))
+	}
+	if cfg.JWTKeyFile != "" {
+		cred, err := oauth.NewJWTAccessFromFile(cfg.JWTKeyFile)
+		if err != nil {
+			return nil, nil, fmt.Errorf("jwt key file: %v", err)
+		}
+		opts = append(opts, grpc.WithPerRPCCredentials(cred))
+	}
+	if cfg.Record != "" {
+		rec, err := recording.Open(cfg.Record)
+		if err != nil {
+			return nil, nil, fmt.Errorf("record: %v", err)
+		}
+		opts = append(opts, rec.DialOptions()...)
+	}
+	var conn *grpc.ClientConn
+	var err error
+	if v != nil {
+		conn, err = v.Dial(cfg.ServerAddr, cfg.Timeout, opts...)
+	} else {
+		conn, err = grpc.Dial(cfg.ServerAddr, append(opts, grpc.WithBlock(), grpc.WithTimeout(cfg.Timeout))...)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	return conn, NewAccountsClient(conn), nil
+}
+
+// _AccountsEncoderMaker returns the encoder maker of the response format.
+func _AccountsEncoderMaker() (iocodec.EncoderMaker, error) {
+	format := _DefaultAccountsClientCommandConfig.ResponseFormat
+	if format == "" {
+		format = "json"
+	}
+	em, ok := iocodec.DefaultEncoders[format]
+	if !ok {
+		return nil, fmt.Errorf("invalid response format: %q", format)
+	}
+	return em, nil
+}
+
+type _AccountsRoundTripFunc func(conn *grpc.ClientConn, cli AccountsClient, in iocodec.Decoder, out iocodec.Encoder) error
+
+// _AccountsRoundTrip reads the request with prepare, when set, before it
+// dials the server and calls fn. Commands without a request have no sample.
+func _AccountsRoundTrip(sample proto.Message, prepare func(in iocodec.Decoder) error, fn _AccountsRoundTripFunc) error {
+	cfg := _DefaultAccountsClientCommandConfig
+	em, err := _AccountsEncoderMaker()
+	if err != nil {
+		return err
+	}
+	if cfg.PrintSampleRequest {
+		if sample == nil {
+			return fmt.Errorf("no sample request")
+		}
+		return em.NewEncoder(os.Stdout).Encode(iocodec.NewSample(sample))
+	}
+	// read the input request, first from stdin, then from a file, otherwise from args only
+	var d iocodec.Decoder
+	if cfg.Stdin || cfg.RequestFile == "-" {
+		if cfg.Edit {
+			// the editor needs the terminal
+			return fmt.Errorf("--edit can't be used with a request read from stdin")
+		}
+		var in io.Reader = os.Stdin
+		if cfg.Template.Stdin {
+			if in, err = cfg.Template.Reader("stdin", os.Stdin); err != nil {
+				return fmt.Errorf("request template: %v", err)
+			}
+		}
+		d = iocodec.DefaultDecoders["json"].NewDecoder(in)
+	} else if cfg.RequestFile != "" {
+		f, err := os.Open(cfg.RequestFile)
+		if err != nil {
+			return fmt.Errorf("request file: %v", err)
+		}
+		defer f.Close()
+		ext := filepath.Ext(cfg.RequestFile)
+		if len(ext) > 0 && ext[0] == '.' {
+			ext = ext[1:]
+		}
+		dm, ok := iocodec.DefaultDecoders[ext]
+		if !ok {
+			return fmt.Errorf("invalid request file format: %q", ext)
+		}
+		in, err := cfg.Template.Reader(cfg.RequestFile, f)
+		if err != nil {
+			return fmt.Errorf("request template: %v", err)
+		}
+		d = dm.NewDecoder(in)
+	} else {
+		d = iocodec.DefaultDecoders["noop"].NewDecoder(os.Stdin)
+	}
+	d = cfg.Set.Decoder(d)
+	if prepare != nil {
+		if err := prepare(d); err != nil {
+			return err
+		}
+	}
+	if cfg.DryRun {
+		// fn prints what it would send instead
+		return fn(nil, nil, d, em.NewEncoder(os.Stdout))
+	}
+	conn, client, err := _DialAccounts()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	return fn(conn, client, d, em.NewEncoder(os.Stdout))
+}
+
+// _AccountsPrompter returns the prompter asking for the fields of requests,
+// or nil unless --interactive is given and stdin is a terminal.
+func _AccountsPrompter() *prompting.Prompter {
+	if !_DefaultAccountsClientCommandConfig.Interactive || !prompting.IsTerminal(os.Stdin) {
+		return nil
+	}
+	return prompting.New(os.Stdin, os.Stderr)
+}
+
+// _AccountsConfirmDestructive asks for confirmation of a call of the
+// destructive method, showing req unless nil. It's called once the server is
+// dialed, so sample requests and dry runs, which send nothing, aren't asked
+// about.
+func _AccountsConfirmDestructive(command, method string, req proto.Message) error {
+	em, err := _AccountsEncoderMaker()
+	if err != nil {
+		return err
+	}
+	return _DefaultAccountsClientCommandConfig.Destructive.Confirm(command, method, req, em)
+}
+
+// _AccountsEdit opens the editor on m, or on a sample of it when it's empty,
+// in the format of the request file, or else the response format.
+func _AccountsEdit(m proto.Message, check func(proto.Message) error) error {
+	cfg := _DefaultAccountsClientCommandConfig
+	format := cfg.ResponseFormat
+	if ext := filepath.Ext(cfg.RequestFile); len(ext) > 1 {
+		format = ext[1:]
+	}
+	var v interface{} = m
+	if proto.Size(m) == 0 {
+		v = iocodec.NewSample(m)
+	}
+	return editing.Edit(format, v, m, check)
+}
+
+// _AccountsDryRun prints reqs, along with where and how they would be sent.
+func _AccountsDryRun(out iocodec.Encoder, reqs ...proto.Message) error {
+	cfg := _DefaultAccountsClientCommandConfig
+	plan := dryrun.Plan{
+		Target:   cfg.ServerAddr,
+		Timeout:  cfg.Timeout,
+		Metadata: metadata.MD{},
+		Requests: reqs,
+	}
+	if cfg.TLS {
+		plan.TLS = &dryrun.TLS{
+			ServerName:         cfg.ServerName,
+			InsecureSkipVerify: cfg.InsecureSkipVerify,
+			CACertFile:         cfg.CACertFile,
+			CertFile:           cfg.CertFile,
+			KeyFile:            cfg.KeyFile,
+		}
+		if plan.TLS.ServerName == "" {
+			plan.TLS.ServerName, _, _ = net.SplitHostPort(cfg.ServerAddr)
+		}
+	}
+	if cfg.AuthToken != "" {
+		plan.Metadata.Append("authorization", dryrun.Redact(cfg.AuthTokenType+" "+cfg.AuthToken))
+	}
+	if cfg.JWTKey != "" || cfg.JWTKeyFile != "" {
+		plan.Metadata.Append("authorization", "Bearer "+dryrun.Redacted)
+	}
+	return dryrun.Print(plan, out)
+}
+
+// _AccountsLoadTest makes calls with call, as many and as fast as the load
+// test flags say, over conn and the connections it dials, and prints a report
+// of them.
+func _AccountsLoadTest(conn *grpc.ClientConn, call func(ctx context.Context, cli AccountsClient) error) error {
+	dial := func() (*grpc.ClientConn, error) {
+		conn, _, err := _DialAccounts()
+		return conn, err
+	}
+	return loadtest.Run(_DefaultAccountsClientCommandConfig.LoadTest, conn, dial, func(ctx context.Context, conn *grpc.ClientConn) error {
+		return call(ctx, NewAccountsClient(conn))
+	}, os.Stdout)
+}
+
+func _AccountsValidate(m proto.Message, flags *pflag.FlagSet) error {
+	if _DefaultAccountsClientCommandConfig.SkipValidation {
+		return nil
+	}
+	return validation.Validate(m, flags)
+}
+
+func _AccountsCreateClientCommand() *cobra.Command {
+	reqArgs := &CreateRequest{
+		Owner: &Owner{},
+	}
+	var batchOpts batch.Options
+
+	cmd := &cobra.Command{
+		Use:     "new",
+		Short:   "Create an account",
+		Hidden:  true,
+		Long:    "Create client; call by piping a request in to stdin (--stdin), reading a file (--file), or via flags per field",
+		Example: "TODO: print protobuf method comments here",
+		Run: func(cmd *cobra.Command, args []string) {
+			var v CreateRequest
+
+			prepare := func(in iocodec.Decoder) error {
+				err := in.Decode(&v)
+				if err != nil {
+					return err
+				}
+
+				if !cmd.Flags().Changed("name") && v.GetName() != "" {
+					reqArgs.Name = v.GetName()
+				}
+				if !cmd.Flags().Changed("max") && v.GetQuota() != 0 {
+					reqArgs.Quota = v.GetQuota()
+				}
+				if !cmd.Flags().Changed("by-admin") && v.GetOwner().GetAdmin() {
+					reqArgs.Owner.Admin = v.GetOwner().GetAdmin()
+				}
+				proto.Merge(&v, reqArgs)
+
+				prompter := _AccountsPrompter()
+				if prompter != nil {
+					err = prompter.Fields(&v)
+					if err != nil {
+						return err
+					}
+				}
+				if _DefaultAccountsClientCommandConfig.Edit {
+					err = _AccountsEdit(&v, func(m proto.Message) error {
+						return _AccountsValidate(m, cmd.Flags())
+					})
+				} else {
+					err = _AccountsValidate(&v, cmd.Flags())
+				}
+				if err != nil {
+					return err
+				}
+				if prompter == nil || _DefaultAccountsClientCommandConfig.DryRun {
+					return nil
+				}
+				em, err := _AccountsEncoderMaker()
+				if err != nil {
+					return err
+				}
+				return prompter.Confirm(&v, em)
+			}
+
+			if batchOpts.File != "" {
+				// the requests of the batch are read and checked one by one
+				prepare = nil
+			}
+
+			err := _AccountsRoundTrip(&v, prepare, func(conn *grpc.ClientConn, cli AccountsClient, in iocodec.Decoder, out iocodec.Encoder) error {
+
+				if batchOpts.File != "" {
+					if _DefaultAccountsClientCommandConfig.DryRun {
+						return fmt.Errorf("--dry-run can't be used with --batch")
+					}
+					if _DefaultAccountsClientCommandConfig.Edit {
+						return fmt.Errorf("--edit can't be used with --batch")
+					}
+					return batch.Run(batchOpts, func(ctx context.Context, r *batch.Record) (proto.Message, error) {
+						var v CreateRequest
+						err := r.Decode(&v)
+						if err != nil {
+							return nil, err
+						}
+						err = _DefaultAccountsClientCommandConfig.Set.Apply(&v)
+						if err != nil {
+							return nil, batch.Invalid(err)
+						}
+						// the defaults of the record must not leak into
+						// the next ones
+						reqArgs := proto.Clone(reqArgs).(*CreateRequest)
+
+						if !cmd.Flags().Changed("name") && v.GetName() != "" {
+							reqArgs.Name = v.GetName()
+						}
+						if !cmd.Flags().Changed("max") && v.GetQuota() != 0 {
+							reqArgs.Quota = v.GetQuota()
+						}
+						if !cmd.Flags().Changed("by-admin") && v.GetOwner().GetAdmin() {
+							reqArgs.Owner.Admin = v.GetOwner().GetAdmin()
+						}
+						proto.Merge(&v, reqArgs)
+						err = _AccountsValidate(&v, cmd.Flags())
+						if err != nil {
+							return nil, batch.Invalid(err)
+						}
+						return cli.Create(ctx, &v)
+					}, out)
+				}
+
+				if _DefaultAccountsClientCommandConfig.DryRun {
+					return _AccountsDryRun(out, &v)
+				}
+				if _DefaultAccountsClientCommandConfig.LoadTest.Enabled() {
+					return _AccountsLoadTest(conn, func(ctx context.Context, cli AccountsClient) error {
+						_, err := cli.Create(ctx, &v)
+						return err
+					})
+				}
+
+				resp, err := cli.Create(context.Background(), &v)
+				if err != nil {
+					return err
+				}
+
+				return out.Encode(resp)
+
+			})
+			if err != nil {
+				log.Fatal(err)
+			}
+		},
+	}
+
+	cmd.PersistentFlags().StringVarP(&reqArgs.Name, "name", "n", "anonymous", "get-comment-from-proto")
+	cmd.PersistentFlags().Uint32Var(&reqArgs.Quota, "max", 10, "get-comment-from-proto")
+	cmd.PersistentFlags().SetAnnotation("max", validation.FieldAnnotation, []string{"quota"})
+	cmd.PersistentFlags().StringVar(&reqArgs.Owner.Email, "by-email", "", "get-comment-from-proto")
+	cmd.PersistentFlags().SetAnnotation("by-email", validation.FieldAnnotation, []string{"owner.email"})
+	cmd.PersistentFlags().BoolVar(&reqArgs.Owner.Admin, "by-admin", true, "get-comment-from-proto")
+	cmd.PersistentFlags().SetAnnotation("by-admin", validation.FieldAnnotation, []string{"owner.admin"})
+	cmd.PersistentFlags().StringVar(&reqArgs.URLs, "links", "", "get-comment-from-proto")
+	cmd.PersistentFlags().SetAnnotation("links", validation.FieldAnnotation, []string{"URLs"})
+	batchOpts.AddFlags(cmd.PersistentFlags())
+
+	return cmd
+}
+
+func _AccountsCloseClientCommand() *cobra.Command {
+	reqArgs := &CreateRequest{
+		Owner: &Owner{},
+	}
+	var batchOpts batch.Options
+
+	cmd := &cobra.Command{
+		Use:     "close",
+		Long:    "Close client; call by piping a request in to stdin (--stdin), reading a file (--file), or via flags per field",
+		Example: "TODO: print protobuf method comments here",
+		Run: func(cmd *cobra.Command, args []string) {
+			var v CreateRequest
+
+			prepare := func(in iocodec.Decoder) error {
+				err := in.Decode(&v)
+				if err != nil {
+					return err
+				}
+
+				if !cmd.Flags().Changed("name") && v.GetName() != "" {
+					reqArgs.Name = v.GetName()
+				}
+				if !cmd.Flags().Changed("max") && v.GetQuota() != 0 {
+					reqArgs.Quota = v.GetQuota()
+				}
+				if !cmd.Flags().Changed("by-admin") && v.GetOwner().GetAdmin() {
+					reqArgs.Owner.Admin = v.GetOwner().GetAdmin()
+				}
+				proto.Merge(&v, reqArgs)
+
+				prompter := _AccountsPrompter()
+				if prompter != nil {
+					err = prompter.Fields(&v)
+					if err != nil {
+						return err
+					}
+				}
+				if _DefaultAccountsClientCommandConfig.Edit {
+					err = _AccountsEdit(&v, func(m proto.Message) error {
+						return _AccountsValidate(m, cmd.Flags())
+					})
+				} else {
+					err = _AccountsValidate(&v, cmd.Flags())
+				}
+				if err != nil {
+					return err
+				}
+				// the call is confirmed once the server is dialed
+				return nil
+			}
+
+			if batchOpts.File != "" {
+				// the requests of the batch are read and checked one by one
+				prepare = nil
+			}
+
+			err := _AccountsRoundTrip(&v, prepare, func(conn *grpc.ClientConn, cli AccountsClient, in iocodec.Decoder, out iocodec.Encoder) error {
+
+				if batchOpts.File != "" {
+					if _DefaultAccountsClientCommandConfig.DryRun {
+						return fmt.Errorf("--dry-run can't be used with --batch")
+					}
+					if _DefaultAccountsClientCommandConfig.Edit {
+						return fmt.Errorf("--edit can't be used with --batch")
+					}
+					// the batch is confirmed as a whole, and its calls audited one by one
+					err := _AccountsConfirmDestructive("close", "/options.Accounts/Close", nil)
+					if err != nil {
+						return err
+					}
+					return batch.Run(batchOpts, func(ctx context.Context, r *batch.Record) (proto.Message, error) {
+						var v CreateRequest
+						err := r.Decode(&v)
+						if err != nil {
+							return nil, err
+						}
+						err = _DefaultAccountsClientCommandConfig.Set.Apply(&v)
+						if err != nil {
+							return nil, batch.Invalid(err)
+						}
+						// the defaults of the record must not leak into
+						// the next ones
+						reqArgs := proto.Clone(reqArgs).(*CreateRequest)
+
+						if !cmd.Flags().Changed("name") && v.GetName() != "" {
+							reqArgs.Name = v.GetName()
+						}
+						if !cmd.Flags().Changed("max") && v.GetQuota() != 0 {
+							reqArgs.Quota = v.GetQuota()
+						}
+						if !cmd.Flags().Changed("by-admin") && v.GetOwner().GetAdmin() {
+							reqArgs.Owner.Admin = v.GetOwner().GetAdmin()
+						}
+						proto.Merge(&v, reqArgs)
+						err = _AccountsValidate(&v, cmd.Flags())
+						if err != nil {
+							return nil, batch.Invalid(err)
+						}
+						err = _DefaultAccountsClientCommandConfig.Destructive.Audit(_DefaultAccountsClientCommandConfig.ServerAddr, "/options.Accounts/Close", &v)
+						if err != nil {
+							return nil, err
+						}
+						return cli.Close(ctx, &v)
+					}, out)
+				}
+
+				if _DefaultAccountsClientCommandConfig.DryRun {
+					return _AccountsDryRun(out, &v)
+				}
+				err := _AccountsConfirmDestructive("close", "/options.Accounts/Close", &v)
+				if err != nil {
+					return err
+				}
+				err = _DefaultAccountsClientCommandConfig.Destructive.Audit(_DefaultAccountsClientCommandConfig.ServerAddr, "/options.Accounts/Close", &v)
+				if err != nil {
+					return err
+				}
+				if _DefaultAccountsClientCommandConfig.LoadTest.Enabled() {
+					return _AccountsLoadTest(conn, func(ctx context.Context, cli AccountsClient) error {
+						_, err := cli.Close(ctx, &v)
+						return err
+					})
+				}
+
+				resp, err := cli.Close(context.Background(), &v)
+				if err != nil {
+					return err
+				}
+
+				return out.Encode(resp)
+
+			})
+			if err != nil {
+				log.Fatal(err)
+			}
+		},
+	}
+
+	cmd.PersistentFlags().StringVarP(&reqArgs.Name, "name", "n", "anonymous", "get-comment-from-proto")
+	cmd.PersistentFlags().Uint32Var(&reqArgs.Quota, "max", 10, "get-comment-from-proto")
+	cmd.PersistentFlags().SetAnnotation("max", validation.FieldAnnotation, []string{"quota"})
+	cmd.PersistentFlags().StringVar(&reqArgs.Owner.Email, "by-email", "", "get-comment-from-proto")
+	cmd.PersistentFlags().SetAnnotation("by-email", validation.FieldAnnotation, []string{"owner.email"})
+	cmd.PersistentFlags().BoolVar(&reqArgs.Owner.Admin, "by-admin", true, "get-comment-from-proto")
+	cmd.PersistentFlags().SetAnnotation("by-admin", validation.FieldAnnotation, []string{"owner.admin"})
+	cmd.PersistentFlags().StringVar(&reqArgs.URLs, "links", "", "get-comment-from-proto")
+	cmd.PersistentFlags().SetAnnotation("links", validation.FieldAnnotation, []string{"URLs"})
+	batchOpts.AddFlags(cmd.PersistentFlags())
+
+	return cmd
+}
+
+func _AccountsCloseAllClientCommand() *cobra.Command {
+	reqArgs := &CreateRequest{
+		Owner: &Owner{},
+	}
+
+	cmd := &cobra.Command{
+		Use:     "closeall",
+		Long:    "CloseAll client; call by piping a request in to stdin (--stdin), reading a file (--file), or via flags per field",
+		Example: "TODO: print protobuf method comments here",
+		Run: func(cmd *cobra.Command, args []string) {
+			var v CreateRequest
+
+			if _DefaultAccountsClientCommandConfig.Edit {
+				log.Fatal("--edit can't be used with client streams")
+			}
+			err := _AccountsRoundTrip(&v, nil, func(conn *grpc.ClientConn, cli AccountsClient, in iocodec.Decoder, out iocodec.Encoder) error {
+				if !_DefaultAccountsClientCommandConfig.DryRun {
+					// the requests are streamed, so the stream is confirmed as a whole
+					err := _AccountsConfirmDestructive("closeall", "/options.Accounts/CloseAll", nil)
+					if err != nil {
+						return err
+					}
+					err = _DefaultAccountsClientCommandConfig.Destructive.Audit(_DefaultAccountsClientCommandConfig.ServerAddr, "/options.Accounts/CloseAll", nil)
+					if err != nil {
+						return err
+					}
+				}
+				if _DefaultAccountsClientCommandConfig.LoadTest.Enabled() || _DefaultAccountsClientCommandConfig.DryRun {
+					// every request is read up front
+					var reqs []*CreateRequest
+					for {
+						req := new(CreateRequest)
+						err := in.Decode(req)
+						if err == io.EOF {
+							break
+						}
+						if err != nil {
+							return err
+						}
+						err = _AccountsValidate(req, cmd.Flags())
+						if err != nil {
+							return err
+						}
+						reqs = append(reqs, req)
+					}
+					if _DefaultAccountsClientCommandConfig.DryRun {
+						msgs := make([]proto.Message, len(reqs))
+						for i, req := range reqs {
+							msgs[i] = req
+						}
+						return _AccountsDryRun(out, msgs...)
+					}
+					// every call sends the same requests
+					return _AccountsLoadTest(conn, func(ctx context.Context, cli AccountsClient) error {
+						stream, err := cli.CloseAll(ctx)
+						if err != nil {
+							return err
+						}
+
+						for _, req := range reqs {
+							err := stream.Send(req)
+							if err == io.EOF {
+								// CloseAndRecv tells why
+								break
+							}
+							if err != nil {
+								return err
+							}
+						}
+						_, err = stream.CloseAndRecv()
+						return err
+
+					})
+				}
+
+				stream, err := cli.CloseAll(context.Background())
+				if err != nil {
+					return err
+				}
+				for {
+					err = in.Decode(&v)
+					if err == io.EOF {
+						stream.CloseSend()
+						break
+					}
+					if err != nil {
+						return err
+					}
+					err = _AccountsValidate(&v, cmd.Flags())
+					if err != nil {
+						return err
+					}
+					err = stream.Send(&v)
+					if err != nil {
+						return err
+					}
+				}
+
+				resp, err := stream.CloseAndRecv()
+				if err != nil {
+					return err
+				}
+
+				return out.Encode(resp)
+
+			})
+			if err != nil {
+				log.Fatal(err)
+			}
+		},
+	}
+
+	cmd.PersistentFlags().StringVarP(&reqArgs.Name, "name", "n", "anonymous", "get-comment-from-proto")
+	cmd.PersistentFlags().Uint32Var(&reqArgs.Quota, "max", 10, "get-comment-from-proto")
+	cmd.PersistentFlags().SetAnnotation("max", validation.FieldAnnotation, []string{"quota"})
+	cmd.PersistentFlags().StringVar(&reqArgs.Owner.Email, "by-email", "", "get-comment-from-proto")
+	cmd.PersistentFlags().SetAnnotation("by-email", validation.FieldAnnotation, []string{"owner.email"})
+	cmd.PersistentFlags().BoolVar(&reqArgs.Owner.Admin, "by-admin", true, "get-comment-from-proto")
+	cmd.PersistentFlags().SetAnnotation("by-admin", validation.FieldAnnotation, []string{"owner.admin"})
+	cmd.PersistentFlags().StringVar(&reqArgs.URLs, "links", "", "get-comment-from-proto")
+	cmd.PersistentFlags().SetAnnotation("links", validation.FieldAnnotation, []string{"URLs"})
+
+	return cmd
+}
+
+func _AccountsReplayClientCommand() *cobra.Command {
+	reqArgs := &CreateRequest{
+		Owner: &Owner{},
+	}
+	var batchOpts batch.Options
+
+	cmd := &cobra.Command{
+		Use:     "replay",
+		Long:    "Replay client; call by piping a request in to stdin (--stdin), reading a file (--file), or via flags per field",
+		Example: "TODO: print protobuf method comments here",
+		Run: func(cmd *cobra.Command, args []string) {
+			var v CreateRequest
+
+			prepare := func(in iocodec.Decoder) error {
+				err := in.Decode(&v)
+				if err != nil {
+					return err
+				}
+
+				if !cmd.Flags().Changed("name") && v.GetName() != "" {
+					reqArgs.Name = v.GetName()
+				}
+				if !cmd.Flags().Changed("max") && v.GetQuota() != 0 {
+					reqArgs.Quota = v.GetQuota()
+				}
+				if !cmd.Flags().Changed("by-admin") && v.GetOwner().GetAdmin() {
+					reqArgs.Owner.Admin = v.GetOwner().GetAdmin()
+				}
+				proto.Merge(&v, reqArgs)
+
+				prompter := _AccountsPrompter()
+				if prompter != nil {
+					err = prompter.Fields(&v)
+					if err != nil {
+						return err
+					}
+				}
+				if _DefaultAccountsClientCommandConfig.Edit {
+					err = _AccountsEdit(&v, func(m proto.Message) error {
+						return _AccountsValidate(m, cmd.Flags())
+					})
+				} else {
+					err = _AccountsValidate(&v, cmd.Flags())
+				}
+				if err != nil {
+					return err
+				}
+				if prompter == nil || _DefaultAccountsClientCommandConfig.DryRun {
+					return nil
+				}
+				em, err := _AccountsEncoderMaker()
+				if err != nil {
+					return err
+				}
+				return prompter.Confirm(&v, em)
+			}
+
+			if batchOpts.File != "" {
+				// the requests of the batch are read and checked one by one
+				prepare = nil
+			}
+
+			err := _AccountsRoundTrip(&v, prepare, func(conn *grpc.ClientConn, cli AccountsClient, in iocodec.Decoder, out iocodec.Encoder) error {
+
+				if batchOpts.File != "" {
+					if _DefaultAccountsClientCommandConfig.DryRun {
+						return fmt.Errorf("--dry-run can't be used with --batch")
+					}
+					if _DefaultAccountsClientCommandConfig.Edit {
+						return fmt.Errorf("--edit can't be used with --batch")
+					}
+					return batch.Run(batchOpts, func(ctx context.Context, r *batch.Record) (proto.Message, error) {
+						var v CreateRequest
+						err := r.Decode(&v)
+						if err != nil {
+							return nil, err
+						}
+						err = _DefaultAccountsClientCommandConfig.Set.Apply(&v)
+						if err != nil {
+							return nil, batch.Invalid(err)
+						}
+						// the defaults of the record must not leak into
+						// the next ones
+						reqArgs := proto.Clone(reqArgs).(*CreateRequest)
+
+						if !cmd.Flags().Changed("name") && v.GetName() != "" {
+							reqArgs.Name = v.GetName()
+						}
+						if !cmd.Flags().Changed("max") && v.GetQuota() != 0 {
+							reqArgs.Quota = v.GetQuota()
+						}
+						if !cmd.Flags().Changed("by-admin") && v.GetOwner().GetAdmin() {
+							reqArgs.Owner.Admin = v.GetOwner().GetAdmin()
+						}
+						proto.Merge(&v, reqArgs)
+						err = _AccountsValidate(&v, cmd.Flags())
+						if err != nil {
+							return nil, batch.Invalid(err)
+						}
+						return cli.Replay(ctx, &v)
+					}, out)
+				}
+
+				if _DefaultAccountsClientCommandConfig.DryRun {
+					return _AccountsDryRun(out, &v)
+				}
+				if _DefaultAccountsClientCommandConfig.LoadTest.Enabled() {
+					return _AccountsLoadTest(conn, func(ctx context.Context, cli AccountsClient) error {
+						_, err := cli.Replay(ctx, &v)
+						return err
+					})
+				}
+
+				resp, err := cli.Replay(context.Background(), &v)
+				if err != nil {
+					return err
+				}
+
+				return out.Encode(resp)
+
+			})
+			if err != nil {
+				log.Fatal(err)
+			}
+		},
+	}
+
+	cmd.PersistentFlags().StringVarP(&reqArgs.Name, "name", "n", "anonymous", "get-comment-from-proto")
+	cmd.PersistentFlags().Uint32Var(&reqArgs.Quota, "max", 10, "get-comment-from-proto")
+	cmd.PersistentFlags().SetAnnotation("max", validation.FieldAnnotation, []string{"quota"})
+	cmd.PersistentFlags().StringVar(&reqArgs.Owner.Email, "by-email", "", "get-comment-from-proto")
+	cmd.PersistentFlags().SetAnnotation("by-email", validation.FieldAnnotation, []string{"owner.email"})
+	cmd.PersistentFlags().BoolVar(&reqArgs.Owner.Admin, "by-admin", true, "get-comment-from-proto")
+	cmd.PersistentFlags().SetAnnotation("by-admin", validation.FieldAnnotation, []string{"owner.admin"})
+	cmd.PersistentFlags().StringVar(&reqArgs.URLs, "links", "", "get-comment-from-proto")
+	cmd.PersistentFlags().SetAnnotation("links", validation.FieldAnnotation, []string{"URLs"})
+	batchOpts.AddFlags(cmd.PersistentFlags())
+
+	return cmd
+}
+
+func _AccountsCheckClientCommand() *cobra.Command {
+	reqArgs := &CreateRequest{
+		Owner: &Owner{},
+	}
+	var batchOpts batch.Options
+
+	cmd := &cobra.Command{
+		Use:     "check",
+		Aliases: []string{"ping"},
+		Long:    "Check client; call by piping a request in to stdin (--stdin), reading a file (--file), or via flags per field",
+		Example: "TODO: print protobuf method comments here",
+		Run: func(cmd *cobra.Command, args []string) {
+			var v CreateRequest
+
+			prepare := func(in iocodec.Decoder) error {
+				err := in.Decode(&v)
+				if err != nil {
+					return err
+				}
+
+				if !cmd.Flags().Changed("name") && v.GetName() != "" {
+					reqArgs.Name = v.GetName()
+				}
+				if !cmd.Flags().Changed("max") && v.GetQuota() != 0 {
+					reqArgs.Quota = v.GetQuota()
+				}
+				if !cmd.Flags().Changed("by-admin") && v.GetOwner().GetAdmin() {
+					reqArgs.Owner.Admin = v.GetOwner().GetAdmin()
+				}
+				proto.Merge(&v, reqArgs)
+
+				prompter := _AccountsPrompter()
+				if prompter != nil {
+					err = prompter.Fields(&v)
+					if err != nil {
+						return err
+					}
+				}
+				if _DefaultAccountsClientCommandConfig.Edit {
+					err = _AccountsEdit(&v, func(m proto.Message) error {
+						return _AccountsValidate(m, cmd.Flags())
+					})
+				} else {
+					err = _AccountsValidate(&v, cmd.Flags())
+				}
+				if err != nil {
+					return err
+				}
+				if prompter == nil || _DefaultAccountsClientCommandConfig.DryRun {
+					return nil
+				}
+				em, err := _AccountsEncoderMaker()
+				if err != nil {
+					return err
+				}
+				return prompter.Confirm(&v, em)
+			}
+
+			if batchOpts.File != "" {
+				// the requests of the batch are read and checked one by one
+				prepare = nil
+			}
+
+			err := _AccountsRoundTrip(&v, prepare, func(conn *grpc.ClientConn, cli AccountsClient, in iocodec.Decoder, out iocodec.Encoder) error {
+
+				if batchOpts.File != "" {
+					if _DefaultAccountsClientCommandConfig.DryRun {
+						return fmt.Errorf("--dry-run can't be used with --batch")
+					}
+					if _DefaultAccountsClientCommandConfig.Edit {
+						return fmt.Errorf("--edit can't be used with --batch")
+					}
+					return batch.Run(batchOpts, func(ctx context.Context, r *batch.Record) (proto.Message, error) {
+						var v CreateRequest
+						err := r.Decode(&v)
+						if err != nil {
+							return nil, err
+						}
+						err = _DefaultAccountsClientCommandConfig.Set.Apply(&v)
+						if err != nil {
+							return nil, batch.Invalid(err)
+						}
+						// the defaults of the record must not leak into
+						// the next ones
+						reqArgs := proto.Clone(reqArgs).(*CreateRequest)
+
+						if !cmd.Flags().Changed("name") && v.GetName() != "" {
+							reqArgs.Name = v.GetName()
+						}
+						if !cmd.Flags().Changed("max") && v.GetQuota() != 0 {
+							reqArgs.Quota = v.GetQuota()
+						}
+						if !cmd.Flags().Changed("by-admin") && v.GetOwner().GetAdmin() {
+							reqArgs.Owner.Admin = v.GetOwner().GetAdmin()
+						}
+						proto.Merge(&v, reqArgs)
+						err = _AccountsValidate(&v, cmd.Flags())
+						if err != nil {
+							return nil, batch.Invalid(err)
+						}
+						return cli.Check(ctx, &v)
+					}, out)
+				}
+
+				if _DefaultAccountsClientCommandConfig.DryRun {
+					return _AccountsDryRun(out, &v)
+				}
+				if _DefaultAccountsClientCommandConfig.LoadTest.Enabled() {
+					return _AccountsLoadTest(conn, func(ctx context.Context, cli AccountsClient) error {
+						_, err := cli.Check(ctx, &v)
+						return err
+					})
+				}
+
+				resp, err := cli.Check(context.Background(), &v)
+				if err != nil {
+					return err
+				}
+
+				return out.Encode(resp)
+
+			})
+			if err != nil {
+				log.Fatal(err)
+			}
+		},
+	}
+
+	cmd.PersistentFlags().StringVarP(&reqArgs.Name, "name", "n", "anonymous", "get-comment-from-proto")
+	cmd.PersistentFlags().Uint32Var(&reqArgs.Quota, "max", 10, "get-comment-from-proto")
+	cmd.PersistentFlags().SetAnnotation("max", validation.FieldAnnotation, []string{"quota"})
+	cmd.PersistentFlags().StringVar(&reqArgs.Owner.Email, "by-email", "", "get-comment-from-proto")
+	cmd.PersistentFlags().SetAnnotation("by-email", validation.FieldAnnotation, []string{"owner.email"})
+	cmd.PersistentFlags().BoolVar(&reqArgs.Owner.Admin, "by-admin", true, "get-comment-from-proto")
+	cmd.PersistentFlags().SetAnnotation("by-admin", validation.FieldAnnotation, []string{"owner.admin"})
+	cmd.PersistentFlags().StringVar(&reqArgs.URLs, "links", "", "get-comment-from-proto")
+	cmd.PersistentFlags().SetAnnotation("links", validation.FieldAnnotation, []string{"URLs"})
+	batchOpts.AddFlags(cmd.PersistentFlags())
+
+	return cmd
+}
+
+func _AccountsDescribeClientCommand() *cobra.Command {
+	reqArgs := &CreateRequest{
+		Owner: &Owner{},
+	}
+	var batchOpts batch.Options
+
+	cmd := &cobra.Command{
+		Use:     "describe",
+		Long:    "Describe client; call by piping a request in to stdin (--stdin), reading a file (--file), or via flags per field",
+		Example: "TODO: print protobuf method comments here",
+		Run: func(cmd *cobra.Command, args []string) {
+			var v CreateRequest
+
+			prepare := func(in iocodec.Decoder) error {
+				err := in.Decode(&v)
+				if err != nil {
+					return err
+				}
+
+				if !cmd.Flags().Changed("name") && v.GetName() != "" {
+					reqArgs.Name = v.GetName()
+				}
+				if !cmd.Flags().Changed("max") && v.GetQuota() != 0 {
+					reqArgs.Quota = v.GetQuota()
+				}
+				if !cmd.Flags().Changed("by-admin") && v.GetOwner().GetAdmin() {
+					reqArgs.Owner.Admin = v.GetOwner().GetAdmin()
+				}
+				proto.Merge(&v, reqArgs)
+
+				prompter := _AccountsPrompter()
+				if prompter != nil {
+					err = prompter.Fields(&v)
+					if err != nil {
+						return err
+					}
+				}
+				if _DefaultAccountsClientCommandConfig.Edit {
+					err = _AccountsEdit(&v, func(m proto.Message) error {
+						return _AccountsValidate(m, cmd.Flags())
+					})
+				} else {
+					err = _AccountsValidate(&v, cmd.Flags())
+				}
+				if err != nil {
+					return err
+				}
+				if prompter == nil || _DefaultAccountsClientCommandConfig.DryRun {
+					return nil
+				}
+				em, err := _AccountsEncoderMaker()
+				if err != nil {
+					return err
+				}
+				return prompter.Confirm(&v, em)
+			}
+
+			if batchOpts.File != "" {
+				// the requests of the batch are read and checked one by one
+				prepare = nil
+			}
+
+			err := _AccountsRoundTrip(&v, prepare, func(conn *grpc.ClientConn, cli AccountsClient, in iocodec.Decoder, out iocodec.Encoder) error {
+
+				if batchOpts.File != "" {
+					if _DefaultAccountsClientCommandConfig.DryRun {
+						return fmt.Errorf("--dry-run can't be used with --batch")
+					}
+					if _DefaultAccountsClientCommandConfig.Edit {
+						return fmt.Errorf("--edit can't be used with --batch")
+					}
+					return batch.Run(batchOpts, func(ctx context.Context, r *batch.Record) (proto.Message, error) {
+						var v CreateRequest
+						err := r.Decode(&v)
+						if err != nil {
+							return nil, err
+						}
+						err = _DefaultAccountsClientCommandConfig.Set.Apply(&v)
+						if err != nil {
+							return nil, batch.Invalid(err)
+						}
+						// the defaults of the record must not leak into
+						// the next ones
+						reqArgs := proto.Clone(reqArgs).(*CreateRequest)
+
+						if !cmd.Flags().Changed("name") && v.GetName() != "" {
+							reqArgs.Name = v.GetName()
+						}
+						if !cmd.Flags().Changed("max") && v.GetQuota() != 0 {
+							reqArgs.Quota = v.GetQuota()
+						}
+						if !cmd.Flags().Changed("by-admin") && v.GetOwner().GetAdmin() {
+							reqArgs.Owner.Admin = v.GetOwner().GetAdmin()
+						}
+						proto.Merge(&v, reqArgs)
+						err = _AccountsValidate(&v, cmd.Flags())
+						if err != nil {
+							return nil, batch.Invalid(err)
+						}
+						return cli.Describe(ctx, &v)
+					}, out)
+				}
+
+				if _DefaultAccountsClientCommandConfig.DryRun {
+					return _AccountsDryRun(out, &v)
+				}
+				if _DefaultAccountsClientCommandConfig.LoadTest.Enabled() {
+					return _AccountsLoadTest(conn, func(ctx context.Context, cli AccountsClient) error {
+						_, err := cli.Describe(ctx, &v)
+						return err
+					})
+				}
+
+				resp, err := cli.Describe(context.Background(), &v)
+				if err != nil {
+					return err
+				}
+
+				return out.Encode(resp)
+
+			})
+			if err != nil {
+				log.Fatal(err)
+			}
+		},
+	}
+
+	cmd.PersistentFlags().StringVarP(&reqArgs.Name, "name", "n", "anonymous", "get-comment-from-proto")
+	cmd.PersistentFlags().Uint32Var(&reqArgs.Quota, "max", 10, "get-comment-from-proto")
+	cmd.PersistentFlags().SetAnnotation("max", validation.FieldAnnotation, []string{"quota"})
+	cmd.PersistentFlags().StringVar(&reqArgs.Owner.Email, "by-email", "", "get-comment-from-proto")
+	cmd.PersistentFlags().SetAnnotation("by-email", validation.FieldAnnotation, []string{"owner.email"})
+	cmd.PersistentFlags().BoolVar(&reqArgs.Owner.Admin, "by-admin", true, "get-comment-from-proto")
+	cmd.PersistentFlags().SetAnnotation("by-admin", validation.FieldAnnotation, []string{"owner.admin"})
+	cmd.PersistentFlags().StringVar(&reqArgs.URLs, "links", "", "get-comment-from-proto")
+	cmd.PersistentFlags().SetAnnotation("links", validation.FieldAnnotation, []string{"URLs"})
+	batchOpts.AddFlags(cmd.PersistentFlags())
+
+	return cmd
+}
+
+var _AccountsClientSubCommands = []func() *cobra.Command{
+	_AccountsCreateClientCommand,
+	_AccountsCloseClientCommand,
+	_AccountsCloseAllClientCommand,
+	_AccountsReplayClientCommand,
+	_AccountsCheckClientCommand,
+	_AccountsDescribeClientCommand,
+}
+
+func init() { describe.Register(_descriptorSet_Options_fa3ac5190829870e) }
+
+var _descriptorSet_Options_fa3ac5190829870e = []byte{
+	// 1201 bytes of a gzipped FileDescriptorSet
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x96, 0x5d, 0x6e, 0xdb, 0x46,
+	0x10, 0xc7, 0xb9, 0x3b, 0x4b, 0x8a, 0x1a, 0x4b, 0x96, 0x34, 0x72, 0x0c, 0x89, 0x70, 0x1a, 0x42,
+	0x8e, 0x25, 0xf9, 0x43, 0x1f, 0xb1, 0xd3, 0x87, 0x16, 0x68, 0xda, 0x38, 0xed, 0x43, 0xd2, 0x16,
+	0x2d, 0x84, 0xf6, 0xb9, 0xa0, 0x15, 0xd6, 0x16, 0x22, 0x91, 0x8e, 0x48, 0x35, 0xf5, 0xab, 0x4e,
+	0xe0, 0x4b, 0xf8, 0x45, 0x47, 0xe8, 0x15, 0x7a, 0x81, 0x9e, 0xa0, 0xe7, 0x28, 0xf6, 0x8b, 0x68,
+	0x80, 0x3e, 0x54, 0x4f, 0xe2, 0x6f, 0x67, 0xf7, 0x3f, 0xff, 0x9d, 0x99, 0x05, 0x84, 0x7f, 0x35,
+	0xf1, 0x51, 0x7a, 0x9b, 0xcf, 0xd2, 0x24, 0x1b, 0x99, 0xdf, 0xe1, 0xed, 0x32, 0xcd, 0x53, 0x2a,
+	0x19, 0x0c, 0x9a, 0x36, 0x3e, 0x4d, 0xaf, 0x96, 0x91, 0x8e, 0x76, 0xfe, 0x64, 0x58, 0x7d, 0xb5,
+	0x8c, 0xa3, 0x3c, 0x9e, 0xc4, 0xef, 0x57, 0x71, 0x96, 0x53, 0x17, 0x45, 0x12, 0x2d, 0xe2, 0x16,
+	0x0b, 0x59, 0xbf, 0x7c, 0x49, 0x9b, 0x75, 0x7b, 0x97, 0x58, 0x12, 0x94, 0xa3, 0x24, 0x4d, 0xee,
+	0x16, 0xe9, 0x2a, 0x9b, 0xa8, 0x38, 0x1d, 0xa2, 0xfb, 0x7e, 0x95, 0xe6, 0x51, 0x8b, 0x87, 0xac,
+	0x5f, 0xbd, 0xac, 0x6e, 0xd6, 0xed, 0x32, 0xc2, 0x22, 0xfa, 0x3d, 0xe0, 0xcf, 0xc6, 0x13, 0x1d,
+	0xa3, 0x21, 0xba, 0xe9, 0x87, 0x24, 0x5e, 0xb6, 0x20, 0x64, 0xfd, 0x9d, 0xf3, 0xdd, 0xa1, 0xf5,
+	0xf6, 0x83, 0x5c, 0xbd, 0xf4, 0x37, 0xeb, 0xb6, 0x40, 0x7e, 0x75, 0x37, 0xd1, 0xdb, 0xe8, 0x00,
+	0xdd, 0x3c, 0x7d, 0x17, 0x27, 0x2d, 0xa1, 0xb2, 0x7b, 0x9b, 0x75, 0x9b, 0x87, 0x6c, 0xa2, 0x17,
+	0xe9, 0x09, 0x8a, 0x9f, 0x27, 0xdf, 0x65, 0x2d, 0x57, 0x05, 0x77, 0x36, 0xeb, 0x76, 0x09, 0xdd,
+	0xf9, 0x2c, 0x79, 0x97, 0x4d, 0x54, 0xa0, 0xf3, 0x25, 0xba, 0x4a, 0x98, 0xf6, 0xd0, 0x8d, 0x17,
+	0xd1, 0x6c, 0xae, 0x6f, 0x31, 0xd1, 0x40, 0x21, 0xba, 0xd1, 0xdb, 0xc5, 0x2c, 0x51, 0x96, 0xfd,
+	0x4b, 0xdc, 0xac, 0xdb, 0x5e, 0x20, 0xf2, 0xe5, 0x2a, 0x9e, 0xe8, 0x40, 0xe7, 0x31, 0x96, 0x5e,
+	0x4e, 0xa7, 0xe9, 0x2a, 0xc9, 0x89, 0xfe, 0x5d, 0x07, 0x7d, 0xe7, 0xf3, 0xbf, 0x01, 0x7d, 0x13,
+	0xcf, 0x68, 0x82, 0x9e, 0xae, 0x1c, 0xed, 0x17, 0xd7, 0xfa, 0xa8, 0x94, 0x41, 0xbd, 0x58, 0x37,
+	0x87, 0x3a, 0x9f, 0x6c, 0xd6, 0xed, 0x00, 0x21, 0x89, 0x3f, 0x04, 0x0d, 0xbd, 0x37, 0x8c, 0x92,
+	0x30, 0xd2, 0xd1, 0x90, 0xd1, 0x67, 0xe8, 0xfe, 0xb8, 0x5a, 0x5e, 0x6f, 0x23, 0xa9, 0x6a, 0xd4,
+	0x57, 0x47, 0x5f, 0xcd, 0xd3, 0x6c, 0xeb, 0xa3, 0x63, 0x46, 0x2f, 0xd0, 0x57, 0x47, 0x5f, 0xce,
+	0xe7, 0xdb, 0x9f, 0xee, 0x33, 0x3a, 0x47, 0x6f, 0x12, 0xdf, 0xce, 0xa3, 0xbb, 0xff, 0x7f, 0x9a,
+	0xbe, 0x40, 0xf7, 0xd5, 0x4d, 0x3c, 0x7d, 0xb7, 0x45, 0x42, 0xd5, 0x2f, 0x12, 0xb7, 0xb3, 0xe4,
+	0x9a, 0x9e, 0xa3, 0xff, 0x75, 0x9c, 0x4d, 0x97, 0xb3, 0xab, 0x2d, 0x2e, 0x1c, 0xf4, 0x36, 0xeb,
+	0xf6, 0x21, 0x8a, 0x68, 0x3a, 0xcd, 0x89, 0x45, 0x54, 0x32, 0x85, 0x0f, 0x6a, 0xdf, 0x47, 0x49,
+	0x74, 0x1d, 0xdb, 0x46, 0x64, 0xe7, 0xdf, 0xa0, 0xff, 0x3a, 0xc9, 0xe3, 0x65, 0x12, 0xcd, 0xe9,
+	0x19, 0xba, 0x93, 0x38, 0x8b, 0xf3, 0x2d, 0xf2, 0x98, 0x9e, 0xbc, 0xf9, 0xa3, 0x82, 0x1e, 0x09,
+	0xc7, 0x79, 0xce, 0xd0, 0x47, 0x56, 0x21, 0x70, 0x1c, 0x92, 0x5f, 0x9c, 0x80, 0x3b, 0x75, 0x2c,
+	0x23, 0x07, 0x87, 0x40, 0x38, 0x8f, 0x11, 0x91, 0x7b, 0x0e, 0x09, 0xcf, 0x79, 0xcc, 0x10, 0x11,
+	0x3c, 0x87, 0x11, 0x78, 0x7e, 0x5d, 0x7f, 0x03, 0x41, 0x89, 0xff, 0x82, 0x55, 0xf4, 0x3c, 0x07,
+	0x1e, 0xee, 0x0d, 0x56, 0x50, 0x78, 0x0e, 0x77, 0x48, 0x94, 0xf9, 0x0e, 0x60, 0x05, 0x5d, 0x49,
+	0x8c, 0xa0, 0xec, 0x55, 0x2c, 0x71, 0x82, 0x72, 0x35, 0xb0, 0x04, 0x04, 0xe5, 0xa3, 0x33, 0x4b,
+	0x82, 0x00, 0xc5, 0x4f, 0x58, 0x43, 0x5f, 0x91, 0x92, 0x95, 0x0b, 0x5a, 0x96, 0x91, 0xa8, 0xf0,
+	0x5d, 0x2b, 0xcb, 0x18, 0x41, 0xc5, 0xdb, 0xb1, 0xc4, 0x09, 0x2a, 0x95, 0xb6, 0x25, 0x20, 0xa8,
+	0x3c, 0x3d, 0xb5, 0x24, 0x08, 0xaa, 0xa2, 0x8b, 0x75, 0x2c, 0x2b, 0x7a, 0xb8, 0x07, 0x57, 0xaf,
+	0x68, 0x5d, 0x4e, 0xa2, 0xc6, 0x1b, 0x56, 0x97, 0x33, 0x82, 0x5a, 0xa1, 0xcb, 0x39, 0x41, 0xad,
+	0xd0, 0xe5, 0x40, 0x50, 0x2b, 0x74, 0xb9, 0x20, 0xa8, 0x8b, 0x81, 0xd1, 0xe5, 0x52, 0xd7, 0xd3,
+	0x2b, 0x5a, 0x17, 0x48, 0x10, 0xdf, 0xb3, 0xba, 0xc0, 0x08, 0xc8, 0xdb, 0xb5, 0xe4, 0x12, 0x50,
+	0xed, 0x91, 0x25, 0x4e, 0x40, 0xfb, 0x87, 0x96, 0x80, 0x80, 0x86, 0x9f, 0x5a, 0x12, 0x04, 0xcd,
+	0x22, 0x0b, 0x98, 0x2c, 0x72, 0xe5, 0x73, 0x95, 0x45, 0x10, 0xec, 0xf3, 0x61, 0x30, 0x08, 0xf3,
+	0x9b, 0x38, 0xbc, 0x5a, 0xcd, 0xe6, 0xf9, 0x60, 0x96, 0x84, 0x4b, 0x35, 0xfb, 0xe1, 0x34, 0x5d,
+	0x2c, 0xa2, 0xe4, 0x6d, 0x78, 0x3d, 0xfb, 0x2d, 0xce, 0xc2, 0x0f, 0xd1, 0x5d, 0x98, 0xa7, 0xe1,
+	0x2c, 0x47, 0xa3, 0x2d, 0x18, 0xc1, 0x7e, 0xd1, 0x1a, 0xc1, 0x09, 0xf6, 0x8b, 0xd6, 0x08, 0x20,
+	0xd8, 0x3f, 0x3a, 0xc3, 0x17, 0x2a, 0x8b, 0x4b, 0xa2, 0xc5, 0x03, 0x08, 0xc6, 0xa1, 0x94, 0x93,
+	0xa9, 0xe4, 0xc8, 0x87, 0xd1, 0x7c, 0x16, 0x65, 0x61, 0xfa, 0xab, 0x5a, 0xb9, 0x89, 0xa3, 0x79,
+	0x7e, 0x53, 0xe4, 0xfc, 0x28, 0x93, 0xcb, 0x08, 0x5a, 0x45, 0x55, 0x5d, 0x4e, 0xd0, 0x2a, 0xaa,
+	0xea, 0x02, 0x41, 0xab, 0xa8, 0xaa, 0x2b, 0x08, 0xda, 0xe2, 0x14, 0x1b, 0x88, 0x8a, 0x1e, 0xee,
+	0x81, 0x3b, 0x7a, 0x69, 0xa0, 0xac, 0x78, 0x04, 0x07, 0x7c, 0x1c, 0x84, 0x85, 0x93, 0xb7, 0xe6,
+	0xd1, 0xfd, 0x77, 0x66, 0x8f, 0x11, 0x1c, 0x14, 0x75, 0xf7, 0x38, 0xc1, 0x41, 0xed, 0xc0, 0x12,
+	0x10, 0x1c, 0xf4, 0x86, 0x6a, 0xd2, 0x19, 0x89, 0x27, 0xce, 0xa1, 0x9e, 0x74, 0x39, 0x5b, 0x4f,
+	0xcc, 0xa4, 0xcb, 0x59, 0x0a, 0xf9, 0x11, 0xee, 0x62, 0xc9, 0x63, 0xa0, 0x67, 0x47, 0xf2, 0x0e,
+	0x0a, 0x8f, 0x49, 0x67, 0x1d, 0x3e, 0x50, 0x72, 0x4c, 0x4d, 0x7a, 0xc7, 0x5c, 0x92, 0xa9, 0x49,
+	0xef, 0x98, 0x4b, 0x32, 0x35, 0xe9, 0x9d, 0xa7, 0xa7, 0x32, 0x95, 0x70, 0x48, 0x1c, 0x39, 0xa7,
+	0x2a, 0x95, 0x90, 0x67, 0x8e, 0xfc, 0x47, 0x52, 0x4e, 0xc8, 0x97, 0x03, 0x5d, 0xfe, 0xad, 0x3c,
+	0x22, 0xc1, 0x95, 0xe4, 0x5b, 0x62, 0x04, 0xdd, 0x72, 0xd5, 0x12, 0x10, 0x74, 0xeb, 0x0d, 0x4b,
+	0x3e, 0x41, 0x97, 0xde, 0xc8, 0x87, 0xa3, 0x48, 0x3d, 0x9c, 0x6e, 0xf3, 0xb5, 0x51, 0x65, 0x04,
+	0x3d, 0x7e, 0x69, 0xf6, 0x32, 0x57, 0x92, 0x55, 0x95, 0x37, 0xed, 0x95, 0x77, 0x2d, 0x01, 0x41,
+	0xaf, 0x41, 0x96, 0x7c, 0x82, 0x5e, 0xf3, 0xa5, 0x51, 0x65, 0x5a, 0xb5, 0xb7, 0xf7, 0x95, 0x51,
+	0xe5, 0x04, 0x7d, 0x7d, 0x75, 0x09, 0x9e, 0xa4, 0x92, 0x25, 0x46, 0xd0, 0xf7, 0xad, 0x57, 0xf9,
+	0x6a, 0xfa, 0x85, 0x57, 0xee, 0x13, 0xf4, 0xe9, 0x4c, 0xce, 0xb3, 0xa2, 0x87, 0x7b, 0xf9, 0x32,
+	0xfa, 0xcd, 0x53, 0x23, 0x0b, 0x04, 0xc7, 0x7c, 0x68, 0x36, 0xcb, 0x62, 0x1f, 0x17, 0x66, 0xe5,
+	0xc6, 0xe3, 0xc2, 0xac, 0x7c, 0x26, 0xc7, 0x85, 0x59, 0xf0, 0x09, 0x8e, 0x9b, 0x03, 0x23, 0x0b,
+	0x52, 0x56, 0x10, 0x1c, 0xef, 0x9d, 0x19, 0x59, 0x41, 0x70, 0xc2, 0xc7, 0x66, 0xb3, 0x70, 0x25,
+	0x59, 0x59, 0xf9, 0x0a, 0x4e, 0x8a, 0xca, 0xca, 0xb9, 0x3f, 0x29, 0xdc, 0x0a, 0x9f, 0xe0, 0x84,
+	0x46, 0x46, 0x56, 0x18, 0xb7, 0x27, 0x4d, 0x35, 0x33, 0x82, 0x91, 0x18, 0x38, 0x63, 0xdd, 0x48,
+	0x59, 0xc9, 0x81, 0x5f, 0x55, 0xe9, 0xd4, 0x5c, 0x0c, 0x79, 0x53, 0x89, 0x30, 0xd5, 0xc8, 0xa1,
+	0x49, 0xa7, 0xa7, 0x64, 0x68, 0x6e, 0xa1, 0xe7, 0x62, 0xd8, 0x20, 0x73, 0x8c, 0x11, 0x8c, 0xf8,
+	0x33, 0x13, 0x92, 0x9d, 0x1a, 0x71, 0xcf, 0x92, 0x8c, 0x95, 0x2a, 0x96, 0x80, 0x60, 0x54, 0xab,
+	0x5b, 0xf2, 0x09, 0x46, 0x8d, 0xb1, 0x72, 0xc9, 0x74, 0xa7, 0x64, 0x9c, 0x46, 0xca, 0x25, 0x27,
+	0x71, 0xee, 0x3c, 0xd7, 0x2e, 0x65, 0x86, 0x73, 0xbf, 0xa6, 0xd2, 0x71, 0xe9, 0xf2, 0x82, 0xeb,
+	0x0a, 0x72, 0xe5, 0xf2, 0xc2, 0xb8, 0xe4, 0xca, 0xe5, 0x85, 0x29, 0x0a, 0x57, 0x2e, 0x2f, 0xea,
+	0x8d, 0x2b, 0x4f, 0xfd, 0x43, 0xbb, 0xf8, 0x67, 0x00, 0xaf, 0x5f, 0x23, 0x39, 0xdb, 0x09, 0x00,
+	0x00,
+}
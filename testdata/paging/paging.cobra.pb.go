@@ -0,0 +1,826 @@
+// Code generated by tetratelabs/protoc-gen-cobra.
+// source: paging/paging.proto
+// DO NOT EDIT!
+
+/*
+Package paging is a generated protocol buffer package.
+
+It is generated from these files:
+	paging/paging.proto
+
+It has these top-level commands:
+	ShelvesClientCommand
+*/
+
+package paging
+
+import (
+	proto "github.com/golang/protobuf/proto"
+	batch "github.com/tetratelabs/protoc-gen-cobra/batch"
+	cobra "github.com/spf13/cobra"
+	context "golang.org/x/net/context"
+	credentials "google.golang.org/grpc/credentials"
+	describe "github.com/tetratelabs/protoc-gen-cobra/describe"
+	destructive "github.com/tetratelabs/protoc-gen-cobra/destructive"
+	dryrun "github.com/tetratelabs/protoc-gen-cobra/dryrun"
+	editing "github.com/tetratelabs/protoc-gen-cobra/editing"
+	filepath "path/filepath"
+	fmt1 "fmt"
+	grpc "google.golang.org/grpc"
+	health "github.com/tetratelabs/protoc-gen-cobra/health"
+	io "io"
+	iocodec "github.com/tetratelabs/protoc-gen-cobra/iocodec"
+	ioutil "io/ioutil"
+	json "encoding/json"
+	loadtest "github.com/tetratelabs/protoc-gen-cobra/loadtest"
+	log "log"
+	metadata "google.golang.org/grpc/metadata"
+	net "net"
+	oauth "google.golang.org/grpc/credentials/oauth"
+	oauth2 "golang.org/x/oauth2"
+	operation "github.com/tetratelabs/protoc-gen-cobra/operation"
+	os "os"
+	override "github.com/tetratelabs/protoc-gen-cobra/override"
+	pagination "github.com/tetratelabs/protoc-gen-cobra/pagination"
+	pflag "github.com/spf13/pflag"
+	prompting "github.com/tetratelabs/protoc-gen-cobra/prompting"
+	recording "github.com/tetratelabs/protoc-gen-cobra/recording"
+	streaming "github.com/tetratelabs/protoc-gen-cobra/streaming"
+	template "text/template"
+	templating "github.com/tetratelabs/protoc-gen-cobra/templating"
+	time "time"
+	tls "crypto/tls"
+	tracing "github.com/tetratelabs/protoc-gen-cobra/tracing"
+	validation "github.com/tetratelabs/protoc-gen-cobra/validation"
+	verbose "github.com/tetratelabs/protoc-gen-cobra/verbose"
+	x509 "crypto/x509"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+var _DefaultShelvesClientCommandConfig = _NewShelvesClientCommandConfig()
+
+type _ShelvesClientCommandConfig struct {
+	ServerAddr         string
+	RequestFile        string
+	Stdin              bool
+	PrintSampleRequest bool
+	ResponseFormat     string
+	Timeout            time.Duration
+	TLS                bool
+	ServerName         string
+	InsecureSkipVerify bool
+	CACertFile         string
+	CertFile           string
+	KeyFile            string
+	AuthToken          string
+	AuthTokenType      string
+	JWTKey             string
+	JWTKeyFile         string
+	SkipValidation     bool
+	LoadTest           loadtest.Options
+	Record             string
+	DryRun             bool
+	Verbose            bool
+	Trace              tracing.Options
+	Set                override.Options
+	Template           templating.Options
+	Edit               bool
+	Interactive        bool
+	Destructive        destructive.Options
+}
+
+func _NewShelvesClientCommandConfig() *_ShelvesClientCommandConfig {
+	c := &_ShelvesClientCommandConfig{
+		ServerAddr:     "localhost:8080",
+		ResponseFormat: "json",
+		Timeout:        10 * time.Second,
+		AuthTokenType:  "Bearer",
+	}
+	return c
+}
+
+func (o *_ShelvesClientCommandConfig) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVarP(&o.ServerAddr, "server-addr", "s", o.ServerAddr, "server address in form of host:port")
+	fs.StringVarP(&o.RequestFile, "request-file", "f", o.RequestFile, "client request file (must be json, yaml, or xml); use \"-\" for stdin + json")
+	fs.BoolVar(&o.Stdin, "stdin", o.Stdin, "read client request from STDIN; alternative for '-f -'")
+	fs.BoolVarP(&o.PrintSampleRequest, "print-sample-request", "p", o.PrintSampleRequest, "print sample request file and exit")
+	fs.StringVarP(&o.ResponseFormat, "response-format", "o", o.ResponseFormat, "response format (json, prettyjson, yaml, or xml)")
+	fs.DurationVar(&o.Timeout, "timeout", o.Timeout, "client connection timeout")
+	fs.BoolVar(&o.TLS, "tls", o.TLS, "enable tls")
+	fs.StringVar(&o.ServerName, "tls-server-name", o.ServerName, "tls server name override")
+	fs.BoolVar(&o.InsecureSkipVerify, "tls-insecure-skip-verify", o.InsecureSkipVerify, "INSECURE: skip tls checks")
+	fs.StringVar(&o.CACertFile, "tls-ca-cert-file", o.CACertFile, "ca certificate file")
+	fs.StringVar(&o.CertFile, "tls-cert-file", o.CertFile, "client certificate file")
+	fs.StringVar(&o.KeyFile, "tls-key-file", o.KeyFile, "client key file")
+	fs.StringVar(&o.AuthToken, "auth-token", o.AuthToken, "authorization token")
+	fs.StringVar(&o.AuthTokenType, "auth-token-type", o.AuthTokenType, "authorization token type")
+	fs.StringVar(&o.JWTKey, "jwt-key", o.JWTKey, "jwt key")
+	fs.StringVar(&o.JWTKeyFile, "jwt-key-file", o.JWTKeyFile, "jwt key file")
+	fs.BoolVar(&o.SkipValidation, "skip-validation", o.SkipValidation, "skip the client-side validation of requests")
+	o.LoadTest.AddFlags(fs)
+	fs.StringVar(&o.Record, "record", o.Record, "record the calls, with their metadata, messages, statuses and timings, to this session file")
+	fs.BoolVar(&o.DryRun, "dry-run", o.DryRun, "print the requests, where they would be sent and the metadata, with secrets redacted, instead of sending them")
+	fs.BoolVarP(&o.Verbose, "verbose", "v", o.Verbose, "log the connection, the tls handshake, and the metadata, message sizes and timings of the calls to stderr, with secrets redacted")
+	o.Trace.AddFlags(fs)
+	o.Set.AddFlags(fs)
+	o.Template.AddFlags(fs)
+	fs.BoolVar(&o.Edit, "edit", o.Edit, "open the request, or a sample of it without one, in $EDITOR before sending it")
+	fs.BoolVar(&o.Interactive, "interactive", o.Interactive, "when stdin is a terminal, prompt for the fields the request doesn't set, and for confirmation before sending it")
+	o.Destructive.AddFlags(fs)
+}
+
+func ShelvesClientCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "shelves",
+	}
+	_DefaultShelvesClientCommandConfig.AddFlags(cmd.PersistentFlags())
+
+	for _, s := range _ShelvesClientSubCommands {
+		cmd.AddCommand(s())
+	}
+	cmd.AddCommand(_ShelvesReplayCommand())
+	cmd.AddCommand(_ShelvesHealthCommand())
+	cmd.AddCommand(_ShelvesDescribeCommand())
+	return cmd
+}
+
+func _ShelvesReplayCommand() *cobra.Command {
+	var compare bool
+	cmd := &cobra.Command{
+		Use:   "replay [file]",
+		Short: "Replay the calls of a session recorded with --record",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			f, err := os.Open(args[0])
+			if err != nil {
+				log.Fatal(err)
+			}
+			defer f.Close()
+			err = _ShelvesRoundTrip(nil, nil, func(conn *grpc.ClientConn, cli ShelvesClient, in iocodec.Decoder, out iocodec.Encoder) error {
+				if _DefaultShelvesClientCommandConfig.DryRun {
+					reqs, err := recording.Requests(f)
+					if err != nil {
+						return err
+					}
+					return _ShelvesDryRun(out, reqs...)
+				}
+				return recording.Replay(conn, f, compare, out, os.Stderr)
+			})
+			if err != nil {
+				log.Fatal(err)
+			}
+		},
+	}
+	cmd.Flags().BoolVar(&compare, "compare", false, "compare the responses and statuses to the recorded ones, field by field, and fail if they differ")
+	return cmd
+}
+
+func _ShelvesHealthCommand() *cobra.Command {
+	opts := health.Options{Service: "paging.Shelves"}
+	cmd := &cobra.Command{
+		Use:     "health",
+		Aliases: []string{"ping"},
+		Short:   "Check the health of paging.Shelves with the gRPC health checking protocol, and report how the connection is made",
+		Long:    "Check the health of paging.Shelves with the gRPC health checking protocol, and report how the connection is made: its TLS details, the certificate chain of the server and the latency of the check.\n\nExits with 0 if the service is serving, 2 if the connection fails, 3 if the check fails, and 4 if the service isn't serving.",
+		Args:    cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			cfg := _DefaultShelvesClientCommandConfig
+			em, err := _ShelvesEncoderMaker()
+			if err != nil {
+				log.Fatal(err)
+			}
+			if cfg.DryRun {
+				if err := _ShelvesDryRun(em.NewEncoder(os.Stdout)); err != nil {
+					log.Fatal(err)
+				}
+				return
+			}
+			err = health.Check(opts, cfg.Timeout, func() (*grpc.ClientConn, error) {
+				conn, _, err := _DialShelves()
+				return conn, err
+			}, em.NewEncoder(os.Stdout))
+			if err != nil {
+				log.Print(err)
+				os.Exit(health.ExitCode(err))
+			}
+		},
+	}
+	opts.AddFlags(cmd.Flags())
+	return cmd
+}
+
+func _ShelvesDescribeCommand() *cobra.Command {
+	var raw bool
+	cmd := &cobra.Command{
+		Use:   "describe [name]",
+		Short: "Print the definition of paging.Shelves, or of one of its methods, messages or enums, with its comments",
+		Long:  "Print the definition of paging.Shelves, or of one of its methods, messages or enums, with its comments, from the descriptors built into the command.\n\nThe name is a method, as named in the proto file or as its command, or the name of a message or enum, relative to the package of the service or in full. A method is followed by its request and response messages.",
+		Args:  cobra.MaximumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			name := ""
+			if len(args) > 0 {
+				name = args[0]
+			}
+			defs, err := describe.Lookup("paging.Shelves", name)
+			if err != nil {
+				log.Fatal(err)
+			}
+			if !raw {
+				if err := describe.Print(os.Stdout, defs); err != nil {
+					log.Fatal(err)
+				}
+				return
+			}
+			em, err := _ShelvesEncoderMaker()
+			if err != nil {
+				log.Fatal(err)
+			}
+			enc := em.NewEncoder(os.Stdout)
+			for _, d := range defs {
+				if err := enc.Encode(d.Descriptor); err != nil {
+					log.Fatal(err)
+				}
+			}
+		},
+	}
+	cmd.Flags().BoolVar(&raw, "descriptor", false, "print the descriptors of the definitions in the response format instead of proto source")
+	return cmd
+}
+
+func _DialShelves() (*grpc.ClientConn, ShelvesClient, error) {
+	cfg := _DefaultShelvesClientCommandConfig
+	var v *verbose.Logger
+	var opts []grpc.DialOption
+	if cfg.Trace.Enabled() {
+		t, err := tracing.Start(cfg.Trace)
+		if err != nil {
+			return nil, nil, fmt.Errorf("trace: %v", err)
+		}
+		opts = append(opts, t.DialOptions()...)
+	}
+	if cfg.Verbose {
+		v = verbose.New(os.Stderr)
+		opts = append(opts, v.DialOptions()...)
+	}
+	if cfg.TLS {
+		tlsConfig := &tls.Config{}
+		if cfg.InsecureSkipVerify {
+			tlsConfig.InsecureSkipVerify = true
+		}
+		if cfg.CACertFile != "" {
+			cacert, err := ioutil.ReadFile(cfg.CACertFile)
+			if err != nil {
+				return nil, nil, fmt.Errorf("ca cert: %v", err)
+			}
+			certpool := x509.NewCertPool()
+			certpool.AppendCertsFromPEM(cacert)
+			tlsConfig.RootCAs = certpool
+		}
+		if cfg.CertFile != "" {
+			if cfg.KeyFile == "" {
+				return nil, nil, fmt.Errorf("missing key file")
+			}
+			pair, err := tls.LoadX509KeyPair(cfg.CertFile, cfg.KeyFile)
+			if err != nil {
+				return nil, nil, fmt.Errorf("cert/key: %v", err)
+			}
+			tlsConfig.Certificates = []tls.Certificate{pair}
+		}
+		if cfg.ServerName != "" {
+			tlsConfig.ServerName = cfg.ServerName
+		} else {
+			addr, _, _ := net.SplitHostPort(cfg.ServerAddr)
+			tlsConfig.ServerName = addr
+		}
+		//tlsConfig.BuildNameToCertificate()
+		cred := credentials.NewTLS(tlsConfig)
+		if v != nil {
+			cred = v.Credentials(cred)
+		}
+		opts = append(opts, grpc.WithTransportCredentials(cred))
+	} else {
+		opts = append(opts, grpc.WithInsecure())
+	}
+	if cfg.AuthToken != "" {
+		cred := oauth.NewOauthAccess(&oauth2.Token{
+			AccessToken: cfg.AuthToken,
+			TokenType:   cfg.AuthTokenType,
+		})
+		opts = append(opts, grpc.WithPerRPCCredentials(cred))
+	}
+	if cfg.JWTKey != "" {
+		cred, err := oauth.NewJWTAccessFromKey([]byte(cfg.JWTKey))
+		if err != nil {
+			return nil, nil, fmt.Errorf("jwt key: %v", err)
+		}
+		opts = append(opts, grpc.WithPerRPCCredentials(cred))
+	}
+	if cfg.JWTKeyFile != "" {
+		cred, err := oauth.NewJWTAccessFromFile(cfg.JWTKeyFile)
+		if err != nil {
+			return nil, nil, fmt.Errorf("jwt key file: %v", err)
+		}
+		opts = append(opts, grpc.WithPerRPCCredentials(cred))
+	}
+	if cfg.Record != "" {
+		rec, err := recording.Open(cfg.Record)
+		if err != nil {
+			return nil, nil, fmt.Errorf("record: %v", err)
+		}
+		opts = append(opts, rec.DialOptions()...)
+	}
+	var conn *grpc.ClientConn
+	var err error
+	if v != nil {
+		conn, err = v.Dial(cfg.ServerAddr, cfg.Timeout, opts...)
+	} else {
+		conn, err = grpc.Dial(cfg.ServerAddr, append(opts, grpc.WithBlock(), grpc.WithTimeout(cfg.Timeout))...)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	return conn, NewShelvesClient(conn), nil
+}
+
+// _ShelvesEncoderMaker returns the encoder maker of the response format.
+func _ShelvesEncoderMaker() (iocodec.EncoderMaker, error) {
+	format := _DefaultShelvesClientCommandConfig.ResponseFormat
+	if format == "" {
+		format = "json"
+	}
+	em, ok := iocodec.DefaultEncoders[format]
+	if !ok {
+		return nil, fmt.Errorf("invalid response format: %q", format)
+	}
+	return em, nil
+}
+
+type _ShelvesRoundTripFunc func(conn *grpc.ClientConn, cli ShelvesClient, in iocodec.Decoder, out iocodec.Encoder) error
+
+// _ShelvesRoundTrip reads the request with prepare, when set, before it
+// dials the server and calls fn. Commands without a request have no sample.
+func _ShelvesRoundTrip(sample proto.Message, prepare func(in iocodec.Decoder) error, fn _ShelvesRoundTripFunc) error {
+	cfg := _DefaultShelvesClientCommandConfig
+	em, err := _ShelvesEncoderMaker()
+	if err != nil {
+		return err
+	}
+	if cfg.PrintSampleRequest {
+		if sample == nil {
+			return fmt.Errorf("no sample request")
+		}
+		return em.NewEncoder(os.Stdout).Encode(iocodec.NewSample(sample))
+	}
+	// read the input request, first from stdin, then from a file, otherwise from args only
+	var d iocodec.Decoder
+	if cfg.Stdin || cfg.RequestFile == "-" {
+		if cfg.Edit {
+			// the editor needs the terminal
+			return fmt.Errorf("--edit can't be used with a request read from stdin")
+		}
+		var in io.Reader = os.Stdin
+		if cfg.Template.Stdin {
+			if in, err = cfg.Template.Reader("stdin", os.Stdin); err != nil {
+				return fmt.Errorf("request template: %v", err)
+			}
+		}
+		d = iocodec.DefaultDecoders["json"].NewDecoder(in)
+	} else if cfg.RequestFile != "" {
+		f, err := os.Open(cfg.RequestFile)
+		if err != nil {
+			return fmt.Errorf("request file: %v", err)
+		}
+		defer f.Close()
+		ext := filepath.Ext(cfg.RequestFile)
+		if len(ext) > 0 && ext[0] == '.' {
+			ext = ext[1:]
+		}
+		dm, ok := iocodec.DefaultDecoders[ext]
+		if !ok {
+			return fmt.Errorf("invalid request file format: %q", ext)
+		}
+		in, err := cfg.Template.Reader(cfg.RequestFile, f)
+		if err != nil {
+			return fmt.Errorf("request template: %v", err)
+		}
+		d = dm.NewDecoder(in)
+	} else {
+		d = iocodec.DefaultDecoders["noop"].NewDecoder(os.Stdin)
+	}
+	d = cfg.Set.Decoder(d)
+	if prepare != nil {
+		if err := prepare(d); err != nil {
+			return err
+		}
+	}
+	if cfg.DryRun {
+		// fn prints what it would send instead
+		return fn(nil, nil, d, em.NewEncoder(os.Stdout))
+	}
+	conn, client, err := _DialShelves()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	return fn(conn, client, d, em.NewEncoder(os.Stdout))
+}
+
+// _ShelvesPrompter returns the prompter asking for the fields of requests,
+// or nil unless --interactive is given and stdin is a terminal.
+func _ShelvesPrompter() *prompting.Prompter {
+	if !_DefaultShelvesClientCommandConfig.Interactive || !prompting.IsTerminal(os.Stdin) {
+		return nil
+	}
+	return prompting.New(os.Stdin, os.Stderr)
+}
+
+// _ShelvesConfirmDestructive asks for confirmation of a call of the
+// destructive method, showing req unless nil. It's called once the server is
+// dialed, so sample requests and dry runs, which send nothing, aren't asked
+// about.
+func _ShelvesConfirmDestructive(command, method string, req proto.Message) error {
+	em, err := _ShelvesEncoderMaker()
+	if err != nil {
+		return err
+	}
+	return _DefaultShelvesClientCommandConfig.Destructive.Confirm(command, method, req, em)
+}
+
+// _ShelvesEdit opens the editor on m, or on a sample of it when it's empty,
+// in the format of the request file, or else the response format.
+func _ShelvesEdit(m proto.Message, check func(proto.Message) error) error {
+	cfg := _DefaultShelvesClientCommandConfig
+	format := cfg.ResponseFormat
+	if ext := filepath.Ext(cfg.RequestFile); len(ext) > 1 {
+		format = ext[1:]
+	}
+	var v interface{} = m
+	if proto.Size(m) == 0 {
+		v = iocodec.NewSample(m)
+	}
+	return editing.Edit(format, v, m, check)
+}
+
+// _ShelvesDryRun prints reqs, along with where and how they would be sent.
+func _ShelvesDryRun(out iocodec.Encoder, reqs ...proto.Message) error {
+	cfg := _DefaultShelvesClientCommandConfig
+	plan := dryrun.Plan{
+		Target:   cfg.ServerAddr,
+		Timeout:  cfg.Timeout,
+		Metadata: metadata.MD{},
+		Requests: reqs,
+	}
+	if cfg.TLS {
+		plan.TLS = &dryrun.TLS{
+			ServerName:         cfg.ServerName,
+			InsecureSkipVerify: cfg.InsecureSkipVerify,
+			CACertFile:         cfg.CACertFile,
+			CertFile:           cfg.CertFile,
+			KeyFile:            cfg.KeyFile,
+		}
+		if plan.TLS.ServerName == "" {
+			plan.TLS.ServerName, _, _ = net.SplitHostPort(cfg.ServerAddr)
+		}
+	}
+	if cfg.AuthToken != "" {
+		plan.Metadata.Append("authorization", dryrun.Redact(cfg.AuthTokenType+" "+cfg.AuthToken))
+	}
+	if cfg.JWTKey != "" || cfg.JWTKeyFile != "" {
+		plan.Metadata.Append("authorization", "Bearer "+dryrun.Redacted)
+	}
+	return dryrun.Print(plan, out)
+}
+
+// _ShelvesLoadTest makes calls with call, as many and as fast as the load
+// test flags say, over conn and the connections it dials, and prints a report
+// of them.
+func _ShelvesLoadTest(conn *grpc.ClientConn, call func(ctx context.Context, cli ShelvesClient) error) error {
+	dial := func() (*grpc.ClientConn, error) {
+		conn, _, err := _DialShelves()
+		return conn, err
+	}
+	return loadtest.Run(_DefaultShelvesClientCommandConfig.LoadTest, conn, dial, func(ctx context.Context, conn *grpc.ClientConn) error {
+		return call(ctx, NewShelvesClient(conn))
+	}, os.Stdout)
+}
+
+func _ShelvesValidate(m proto.Message, flags *pflag.FlagSet) error {
+	if _DefaultShelvesClientCommandConfig.SkipValidation {
+		return nil
+	}
+	return validation.Validate(m, flags)
+}
+
+func _ShelvesListBooksClientCommand() *cobra.Command {
+	reqArgs := &ListBooksRequest{}
+	var all bool
+	var maxPages int
+	var batchOpts batch.Options
+
+	cmd := &cobra.Command{
+		Use:     "listbooks",
+		Long:    "ListBooks client; call by piping a request in to stdin (--stdin), reading a file (--file), or via flags per field",
+		Example: "TODO: print protobuf method comments here",
+		Run: func(cmd *cobra.Command, args []string) {
+			var v ListBooksRequest
+
+			prepare := func(in iocodec.Decoder) error {
+				err := in.Decode(&v)
+				if err != nil {
+					return err
+				}
+
+				proto.Merge(&v, reqArgs)
+
+				prompter := _ShelvesPrompter()
+				if prompter != nil {
+					err = prompter.Fields(&v)
+					if err != nil {
+						return err
+					}
+				}
+				if _DefaultShelvesClientCommandConfig.Edit {
+					err = _ShelvesEdit(&v, func(m proto.Message) error {
+						return _ShelvesValidate(m, cmd.Flags())
+					})
+				} else {
+					err = _ShelvesValidate(&v, cmd.Flags())
+				}
+				if err != nil {
+					return err
+				}
+				if prompter == nil || _DefaultShelvesClientCommandConfig.DryRun {
+					return nil
+				}
+				em, err := _ShelvesEncoderMaker()
+				if err != nil {
+					return err
+				}
+				return prompter.Confirm(&v, em)
+			}
+
+			if batchOpts.File != "" {
+				// the requests of the batch are read and checked one by one
+				prepare = nil
+			}
+
+			err := _ShelvesRoundTrip(&v, prepare, func(conn *grpc.ClientConn, cli ShelvesClient, in iocodec.Decoder, out iocodec.Encoder) error {
+
+				if batchOpts.File != "" {
+					if _DefaultShelvesClientCommandConfig.DryRun {
+						return fmt.Errorf("--dry-run can't be used with --batch")
+					}
+					if _DefaultShelvesClientCommandConfig.Edit {
+						return fmt.Errorf("--edit can't be used with --batch")
+					}
+					return batch.Run(batchOpts, func(ctx context.Context, r *batch.Record) (proto.Message, error) {
+						var v ListBooksRequest
+						err := r.Decode(&v)
+						if err != nil {
+							return nil, err
+						}
+						err = _DefaultShelvesClientCommandConfig.Set.Apply(&v)
+						if err != nil {
+							return nil, batch.Invalid(err)
+						}
+						// the defaults of the record must not leak into
+						// the next ones
+						reqArgs := proto.Clone(reqArgs).(*ListBooksRequest)
+
+						proto.Merge(&v, reqArgs)
+						err = _ShelvesValidate(&v, cmd.Flags())
+						if err != nil {
+							return nil, batch.Invalid(err)
+						}
+						return cli.ListBooks(ctx, &v)
+					}, out)
+				}
+
+				if _DefaultShelvesClientCommandConfig.DryRun {
+					return _ShelvesDryRun(out, &v)
+				}
+				if _DefaultShelvesClientCommandConfig.LoadTest.Enabled() {
+					return _ShelvesLoadTest(conn, func(ctx context.Context, cli ShelvesClient) error {
+						_, err := cli.ListBooks(ctx, &v)
+						return err
+					})
+				}
+
+				if all || maxPages > 0 {
+					// stream the results of every page, or the first maxPages
+					return pagination.Follow(func(token string) ([]proto.Message, string, error) {
+						v.PageToken = token
+						resp, err := cli.ListBooks(context.Background(), &v)
+						if err != nil {
+							return nil, "", err
+						}
+						items := make([]proto.Message, len(resp.GetBooks()))
+						for i, item := range resp.GetBooks() {
+							items[i] = item
+						}
+						return items, resp.GetNextPageToken(), nil
+					}, v.PageToken, maxPages, out)
+				}
+
+				resp, err := cli.ListBooks(context.Background(), &v)
+				if err != nil {
+					return err
+				}
+
+				return out.Encode(resp)
+
+			})
+			if err != nil {
+				log.Fatal(err)
+			}
+		},
+	}
+
+	cmd.PersistentFlags().StringVar(&reqArgs.Parent, "parent", "", "get-comment-from-proto")
+	cmd.PersistentFlags().Int32Var(&reqArgs.PageSize, "pagesize", 0, "get-comment-from-proto")
+	cmd.PersistentFlags().StringVar(&reqArgs.PageToken, "pagetoken", "", "get-comment-from-proto")
+	cmd.PersistentFlags().BoolVar(&all, "all", false, "follow the page tokens and print the results of every page")
+	cmd.PersistentFlags().IntVar(&maxPages, "max-pages", 0, "follow the page tokens and print the results of at most this many pages")
+	batchOpts.AddFlags(cmd.PersistentFlags())
+
+	return cmd
+}
+
+func _ShelvesSearchBooksClientCommand() *cobra.Command {
+	reqArgs := &SearchBooksRequest{}
+	var batchOpts batch.Options
+
+	cmd := &cobra.Command{
+		Use:     "searchbooks",
+		Long:    "SearchBooks client; call by piping a request in to stdin (--stdin), reading a file (--file), or via flags per field",
+		Example: "TODO: print protobuf method comments here",
+		Run: func(cmd *cobra.Command, args []string) {
+			var v SearchBooksRequest
+
+			prepare := func(in iocodec.Decoder) error {
+				err := in.Decode(&v)
+				if err != nil {
+					return err
+				}
+
+				proto.Merge(&v, reqArgs)
+
+				prompter := _ShelvesPrompter()
+				if prompter != nil {
+					err = prompter.Fields(&v)
+					if err != nil {
+						return err
+					}
+				}
+				if _DefaultShelvesClientCommandConfig.Edit {
+					err = _ShelvesEdit(&v, func(m proto.Message) error {
+						return _ShelvesValidate(m, cmd.Flags())
+					})
+				} else {
+					err = _ShelvesValidate(&v, cmd.Flags())
+				}
+				if err != nil {
+					return err
+				}
+				if prompter == nil || _DefaultShelvesClientCommandConfig.DryRun {
+					return nil
+				}
+				em, err := _ShelvesEncoderMaker()
+				if err != nil {
+					return err
+				}
+				return prompter.Confirm(&v, em)
+			}
+
+			if batchOpts.File != "" {
+				// the requests of the batch are read and checked one by one
+				prepare = nil
+			}
+
+			err := _ShelvesRoundTrip(&v, prepare, func(conn *grpc.ClientConn, cli ShelvesClient, in iocodec.Decoder, out iocodec.Encoder) error {
+
+				if batchOpts.File != "" {
+					if _DefaultShelvesClientCommandConfig.DryRun {
+						return fmt.Errorf("--dry-run can't be used with --batch")
+					}
+					if _DefaultShelvesClientCommandConfig.Edit {
+						return fmt.Errorf("--edit can't be used with --batch")
+					}
+					return batch.Run(batchOpts, func(ctx context.Context, r *batch.Record) (proto.Message, error) {
+						var v SearchBooksRequest
+						err := r.Decode(&v)
+						if err != nil {
+							return nil, err
+						}
+						err = _DefaultShelvesClientCommandConfig.Set.Apply(&v)
+						if err != nil {
+							return nil, batch.Invalid(err)
+						}
+						// the defaults of the record must not leak into
+						// the next ones
+						reqArgs := proto.Clone(reqArgs).(*SearchBooksRequest)
+
+						proto.Merge(&v, reqArgs)
+						err = _ShelvesValidate(&v, cmd.Flags())
+						if err != nil {
+							return nil, batch.Invalid(err)
+						}
+						return cli.SearchBooks(ctx, &v)
+					}, out)
+				}
+
+				if _DefaultShelvesClientCommandConfig.DryRun {
+					return _ShelvesDryRun(out, &v)
+				}
+				if _DefaultShelvesClientCommandConfig.LoadTest.Enabled() {
+					return _ShelvesLoadTest(conn, func(ctx context.Context, cli ShelvesClient) error {
+						_, err := cli.SearchBooks(ctx, &v)
+						return err
+					})
+				}
+
+				resp, err := cli.SearchBooks(context.Background(), &v)
+				if err != nil {
+					return err
+				}
+
+				return out.Encode(resp)
+
+			})
+			if err != nil {
+				log.Fatal(err)
+			}
+		},
+	}
+
+	cmd.PersistentFlags().StringVar(&reqArgs.Query, "query", "", "get-comment-from-proto")
+	cmd.PersistentFlags().StringVar(&reqArgs.PageToken, "pagetoken", "", "get-comment-from-proto")
+	batchOpts.AddFlags(cmd.PersistentFlags())
+
+	return cmd
+}
+
+var _ShelvesClientSubCommands = []func() *cobra.Command{
+	_ShelvesListBooksClientCommand,
+	_ShelvesSearchBooksClientCommand,
+}
+
+func init() { describe.Register(_descriptorSet_Paging_8624950c8e5d6f39) }
+
+var _descriptorSet_Paging_8624950c8e5d6f39 = []byte{
+	// 708 bytes of a gzipped FileDescriptorSet
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x94, 0xcf, 0x4e, 0xeb, 0x46,
+	0x14, 0xc6, 0x67, 0xe6, 0xd8, 0x8e, 0x7d, 0x9c, 0x90, 0xf8, 0x84, 0x24, 0x26, 0x7f, 0x54, 0x13,
+	0xb5, 0x15, 0x52, 0xa5, 0x20, 0xd1, 0x4d, 0x8b, 0xda, 0x0a, 0xa1, 0x76, 0xd1, 0x8a, 0x45, 0x15,
+	0xba, 0x47, 0xa6, 0x9d, 0x42, 0x44, 0xb0, 0x43, 0x6c, 0x50, 0xe1, 0x0d, 0xba, 0xec, 0x2b, 0xf4,
+	0xad, 0xee, 0xdb, 0x5c, 0x9d, 0xf1, 0x0c, 0xe2, 0xc2, 0xbd, 0x77, 0x85, 0x7f, 0x3e, 0xf3, 0x7d,
+	0xe7, 0xfb, 0xc6, 0x28, 0xf8, 0x6f, 0x8c, 0xfd, 0x4d, 0x7e, 0xb5, 0x2a, 0xae, 0x0e, 0x9b, 0x3f,
+	0x8b, 0xcd, 0xb6, 0xac, 0x4b, 0x0a, 0x1a, 0x9a, 0xff, 0x8d, 0xbd, 0xb3, 0x55, 0x55, 0x9f, 0x96,
+	0xe5, 0x4d, 0xb5, 0xd4, 0x77, 0xf7, 0xba, 0xaa, 0x69, 0x88, 0xc1, 0x26, 0xdf, 0xea, 0xa2, 0x4e,
+	0x65, 0x26, 0x0f, 0xa2, 0xa5, 0x25, 0x9a, 0x60, 0xb4, 0xc9, 0xaf, 0xf4, 0x45, 0xb5, 0x7a, 0xd2,
+	0xa9, 0xca, 0xe4, 0x81, 0xbf, 0x0c, 0xf9, 0xc5, 0xf9, 0xea, 0x49, 0xd3, 0x0c, 0xd1, 0x0c, 0xeb,
+	0xf2, 0x46, 0x17, 0x29, 0x18, 0xa1, 0x39, 0xfe, 0x07, 0xbf, 0x98, 0xff, 0x8a, 0x74, 0xae, 0xf3,
+	0xed, 0x9f, 0xd7, 0x1f, 0x6c, 0xda, 0x45, 0xff, 0xee, 0x5e, 0x6f, 0x1f, 0xed, 0xa2, 0x06, 0x5e,
+	0x59, 0xa9, 0xd7, 0x56, 0xef, 0x24, 0x26, 0x2f, 0x32, 0x57, 0x9b, 0xb2, 0xa8, 0x34, 0xfd, 0x88,
+	0xc1, 0x3a, 0xbf, 0xd4, 0xeb, 0x2a, 0x95, 0x19, 0x1c, 0xc4, 0x47, 0x5f, 0x2d, 0x6c, 0xdf, 0x37,
+	0x47, 0x17, 0x67, 0xe6, 0xdc, 0x2f, 0x45, 0xbd, 0x7d, 0x5c, 0x5a, 0x11, 0xcd, 0xd1, 0xbf, 0xe4,
+	0x43, 0xa9, 0x32, 0xea, 0xb6, 0x53, 0xb3, 0x72, 0xd9, 0x8c, 0xe8, 0x6b, 0xec, 0x16, 0xfa, 0x9f,
+	0xfa, 0xe2, 0x4d, 0xcf, 0x0e, 0xbf, 0xfe, 0xdd, 0x05, 0x1c, 0x7f, 0x8f, 0xf1, 0x8b, 0x15, 0xd4,
+	0x43, 0xb8, 0xd1, 0xae, 0x22, 0x3f, 0x72, 0xed, 0x87, 0x7c, 0x7d, 0xaf, 0x6d, 0xb7, 0x06, 0x8e,
+	0xd5, 0x77, 0x72, 0x3e, 0x46, 0x8f, 0x37, 0x12, 0xa1, 0x57, 0xe4, 0xb7, 0xda, 0x8a, 0xcc, 0xf3,
+	0xd1, 0x7f, 0x12, 0x5b, 0xe7, 0xd7, 0x7a, 0xfd, 0xa0, 0x2b, 0x3a, 0xc1, 0xe8, 0xb9, 0x17, 0xa5,
+	0x1f, 0xa9, 0x6a, 0xee, 0x77, 0xbc, 0xf7, 0xc9, 0x4b, 0xa0, 0x9f, 0x31, 0x7e, 0xf1, 0x41, 0x68,
+	0xec, 0x4e, 0xbe, 0xfd, 0x4a, 0x9f, 0x71, 0xf9, 0xed, 0xff, 0x00, 0x03, 0xf2, 0x84, 0x98, 0x49,
+	0x0c, 0x51, 0xb6, 0x09, 0x84, 0x20, 0x7e, 0x52, 0x04, 0x4a, 0x74, 0x11, 0x51, 0x05, 0x82, 0x3c,
+	0x4f, 0x84, 0x12, 0x11, 0x21, 0x10, 0x92, 0xc0, 0x0b, 0xbb, 0x18, 0xa3, 0x17, 0x08, 0x25, 0x08,
+	0x7c, 0xf5, 0x13, 0xb6, 0xd1, 0x67, 0x90, 0x04, 0x7e, 0xd0, 0x75, 0xa4, 0x08, 0xfc, 0x5e, 0xe6,
+	0x08, 0x08, 0xfc, 0x6f, 0x7e, 0xc0, 0x85, 0x91, 0x49, 0x82, 0x96, 0x3a, 0x1d, 0xef, 0x67, 0x45,
+	0x59, 0x67, 0x79, 0xb6, 0x5e, 0x55, 0x75, 0x76, 0xab, 0xeb, 0xeb, 0xf2, 0xaf, 0xe3, 0xac, 0x28,
+	0xb3, 0xe7, 0x7f, 0x51, 0xb4, 0x6a, 0xc9, 0x82, 0x20, 0x71, 0xa4, 0x08, 0x5a, 0xf4, 0xa5, 0x23,
+	0x20, 0x68, 0x1d, 0x9e, 0x20, 0xa2, 0xf2, 0x04, 0x79, 0x28, 0x76, 0x4c, 0x50, 0x8f, 0xd3, 0x60,
+	0x98, 0x72, 0x50, 0xcf, 0x04, 0x8d, 0xd5, 0x2e, 0x4b, 0x18, 0x7c, 0xa6, 0xd0, 0x91, 0x24, 0x88,
+	0xa3, 0xae, 0x23, 0x20, 0x88, 0xa9, 0x6f, 0x65, 0x92, 0xa0, 0xad, 0x86, 0x76, 0x24, 0x7d, 0xa6,
+	0x96, 0x23, 0x9e, 0x85, 0x89, 0x23, 0x20, 0x68, 0xef, 0x0e, 0xac, 0x4c, 0x11, 0x74, 0x54, 0x6a,
+	0x47, 0xca, 0x67, 0x72, 0xdb, 0xd8, 0xb2, 0x13, 0xf5, 0x1d, 0x01, 0x41, 0x67, 0x38, 0x32, 0xe1,
+	0x25, 0x79, 0x3d, 0xd1, 0x6f, 0xc2, 0xb3, 0x79, 0x2f, 0x1c, 0x1b, 0x3b, 0xc9, 0xe1, 0x13, 0xd5,
+	0x48, 0xa4, 0x09, 0x9f, 0x58, 0x3b, 0x69, 0xc2, 0x27, 0xd1, 0x8e, 0x23, 0x20, 0x48, 0x12, 0xb2,
+	0x32, 0x49, 0x40, 0x36, 0x85, 0x34, 0xe1, 0xe9, 0x59, 0xc6, 0xfe, 0x14, 0x39, 0x4b, 0x0e, 0x4f,
+	0x36, 0x85, 0x22, 0x6f, 0x20, 0xf6, 0x9a, 0x14, 0xec, 0x30, 0x08, 0xf7, 0x8c, 0x9d, 0xe2, 0x14,
+	0x43, 0xb5, 0x6f, 0x24, 0x4a, 0x89, 0x80, 0x69, 0xe0, 0x48, 0x12, 0x0c, 0x87, 0x53, 0x47, 0x40,
+	0x30, 0xfc, 0x22, 0xb3, 0x32, 0x49, 0x30, 0x52, 0x63, 0x3b, 0x92, 0x1e, 0x13, 0x3a, 0x0a, 0x08,
+	0x46, 0x71, 0xd7, 0x11, 0x9f, 0xec, 0x39, 0x4b, 0xce, 0x34, 0x4a, 0xdd, 0x6e, 0x45, 0x90, 0xaa,
+	0x99, 0x1d, 0xf1, 0x85, 0xa6, 0xb6, 0x8a, 0x32, 0x0b, 0xd2, 0x28, 0x75, 0x04, 0x04, 0xe9, 0x64,
+	0x6a, 0xaa, 0x00, 0x79, 0x13, 0x31, 0x6b, 0xaa, 0x80, 0x24, 0x98, 0x84, 0x6d, 0x63, 0x07, 0x5c,
+	0x65, 0xaa, 0xc8, 0x48, 0xc0, 0x5c, 0xe8, 0xd4, 0xda, 0x81, 0xa9, 0x32, 0x8d, 0x3a, 0x8e, 0x80,
+	0x60, 0xda, 0x4b, 0x2e, 0x03, 0xf3, 0x93, 0xfb, 0xed, 0xfb, 0x01, 0x00, 0x27, 0x03, 0x36, 0xb3,
+	0x8c, 0x05, 0x00, 0x00,
+}
@@ -16,25 +16,43 @@ package pb
 
 import (
 	proto "github.com/golang/protobuf/proto"
+	batch "github.com/tetratelabs/protoc-gen-cobra/batch"
 	cobra "github.com/spf13/cobra"
 	context "golang.org/x/net/context"
 	credentials "google.golang.org/grpc/credentials"
+	describe "github.com/tetratelabs/protoc-gen-cobra/describe"
+	destructive "github.com/tetratelabs/protoc-gen-cobra/destructive"
+	dryrun "github.com/tetratelabs/protoc-gen-cobra/dryrun"
+	editing "github.com/tetratelabs/protoc-gen-cobra/editing"
 	filepath "path/filepath"
 	fmt1 "fmt"
 	grpc "google.golang.org/grpc"
+	health "github.com/tetratelabs/protoc-gen-cobra/health"
 	io "io"
 	iocodec "github.com/tetratelabs/protoc-gen-cobra/iocodec"
 	ioutil "io/ioutil"
 	json "encoding/json"
+	loadtest "github.com/tetratelabs/protoc-gen-cobra/loadtest"
 	log "log"
+	metadata "google.golang.org/grpc/metadata"
 	net "net"
 	oauth "google.golang.org/grpc/credentials/oauth"
 	oauth2 "golang.org/x/oauth2"
+	operation "github.com/tetratelabs/protoc-gen-cobra/operation"
 	os "os"
+	override "github.com/tetratelabs/protoc-gen-cobra/override"
+	pagination "github.com/tetratelabs/protoc-gen-cobra/pagination"
 	pflag "github.com/spf13/pflag"
+	prompting "github.com/tetratelabs/protoc-gen-cobra/prompting"
+	recording "github.com/tetratelabs/protoc-gen-cobra/recording"
+	streaming "github.com/tetratelabs/protoc-gen-cobra/streaming"
 	template "text/template"
+	templating "github.com/tetratelabs/protoc-gen-cobra/templating"
 	time "time"
 	tls "crypto/tls"
+	tracing "github.com/tetratelabs/protoc-gen-cobra/tracing"
+	validation "github.com/tetratelabs/protoc-gen-cobra/validation"
+	verbose "github.com/tetratelabs/protoc-gen-cobra/verbose"
 	x509 "crypto/x509"
 )
 
@@ -67,6 +85,17 @@ type _BankClientCommandConfig struct {
 	AuthTokenType      string
 	JWTKey             string
 	JWTKeyFile         string
+	SkipValidation     bool
+	LoadTest           loadtest.Options
+	Record             string
+	DryRun             bool
+	Verbose            bool
+	Trace              tracing.Options
+	Set                override.Options
+	Template           templating.Options
+	Edit               bool
+	Interactive        bool
+	Destructive        destructive.Options
 }
 
 func _NewBankClientCommandConfig() *_BankClientCommandConfig {
@@ -96,6 +125,17 @@ func (o *_BankClientCommandConfig) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&o.AuthTokenType, "auth-token-type", o.AuthTokenType, "authorization token type")
 	fs.StringVar(&o.JWTKey, "jwt-key", o.JWTKey, "jwt key")
 	fs.StringVar(&o.JWTKeyFile, "jwt-key-file", o.JWTKeyFile, "jwt key file")
+	fs.BoolVar(&o.SkipValidation, "skip-validation", o.SkipValidation, "skip the client-side validation of requests")
+	o.LoadTest.AddFlags(fs)
+	fs.StringVar(&o.Record, "record", o.Record, "record the calls, with their metadata, messages, statuses and timings, to this session file")
+	fs.BoolVar(&o.DryRun, "dry-run", o.DryRun, "print the requests, where they would be sent and the metadata, with secrets redacted, instead of sending them")
+	fs.BoolVarP(&o.Verbose, "verbose", "v", o.Verbose, "log the connection, the tls handshake, and the metadata, message sizes and timings of the calls to stderr, with secrets redacted")
+	o.Trace.AddFlags(fs)
+	o.Set.AddFlags(fs)
+	o.Template.AddFlags(fs)
+	fs.BoolVar(&o.Edit, "edit", o.Edit, "open the request, or a sample of it without one, in $EDITOR before sending it")
+	fs.BoolVar(&o.Interactive, "interactive", o.Interactive, "when stdin is a terminal, prompt for the fields the request doesn't set, and for confirmation before sending it")
+	o.Destructive.AddFlags(fs)
 }
 
 func BankClientCommand() *cobra.Command {
@@ -107,14 +147,129 @@ func BankClientCommand() *cobra.Command {
 	for _, s := range _BankClientSubCommands {
 		cmd.AddCommand(s())
 	}
+	cmd.AddCommand(_BankReplayCommand())
+	cmd.AddCommand(_BankHealthCommand())
+	cmd.AddCommand(_BankDescribeCommand())
+	return cmd
+}
+
+func _BankReplayCommand() *cobra.Command {
+	var compare bool
+	cmd := &cobra.Command{
+		Use:   "replay [file]",
+		Short: "Replay the calls of a session recorded with --record",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			f, err := os.Open(args[0])
+			if err != nil {
+				log.Fatal(err)
+			}
+			defer f.Close()
+			err = _BankRoundTrip(nil, nil, func(conn *grpc.ClientConn, cli BankClient, in iocodec.Decoder, out iocodec.Encoder) error {
+				if _DefaultBankClientCommandConfig.DryRun {
+					reqs, err := recording.Requests(f)
+					if err != nil {
+						return err
+					}
+					return _BankDryRun(out, reqs...)
+				}
+				return recording.Replay(conn, f, compare, out, os.Stderr)
+			})
+			if err != nil {
+				log.Fatal(err)
+			}
+		},
+	}
+	cmd.Flags().BoolVar(&compare, "compare", false, "compare the responses and statuses to the recorded ones, field by field, and fail if they differ")
+	return cmd
+}
+
+func _BankHealthCommand() *cobra.Command {
+	opts := health.Options{Service: "pb.Bank"}
+	cmd := &cobra.Command{
+		Use:     "health",
+		Aliases: []string{"ping"},
+		Short:   "Check the health of pb.Bank with the gRPC health checking protocol, and report how the connection is made",
+		Long:    "Check the health of pb.Bank with the gRPC health checking protocol, and report how the connection is made: its TLS details, the certificate chain of the server and the latency of the check.\n\nExits with 0 if the service is serving, 2 if the connection fails, 3 if the check fails, and 4 if the service isn't serving.",
+		Args:    cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			cfg := _DefaultBankClientCommandConfig
+			em, err := _BankEncoderMaker()
+			if err != nil {
+				log.Fatal(err)
+			}
+			if cfg.DryRun {
+				if err := _BankDryRun(em.NewEncoder(os.Stdout)); err != nil {
+					log.Fatal(err)
+				}
+				return
+			}
+			err = health.Check(opts, cfg.Timeout, func() (*grpc.ClientConn, error) {
+				conn, _, err := _DialBank()
+				return conn, err
+			}, em.NewEncoder(os.Stdout))
+			if err != nil {
+				log.Print(err)
+				os.Exit(health.ExitCode(err))
+			}
+		},
+	}
+	opts.AddFlags(cmd.Flags())
+	return cmd
+}
+
+func _BankDescribeCommand() *cobra.Command {
+	var raw bool
+	cmd := &cobra.Command{
+		Use:   "describe [name]",
+		Short: "Print the definition of pb.Bank, or of one of its methods, messages or enums, with its comments",
+		Long:  "Print the definition of pb.Bank, or of one of its methods, messages or enums, with its comments, from the descriptors built into the command.\n\nThe name is a method, as named in the proto file or as its command, or the name of a message or enum, relative to the package of the service or in full. A method is followed by its request and response messages.",
+		Args:  cobra.MaximumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			name := ""
+			if len(args) > 0 {
+				name = args[0]
+			}
+			defs, err := describe.Lookup("pb.Bank", name)
+			if err != nil {
+				log.Fatal(err)
+			}
+			if !raw {
+				if err := describe.Print(os.Stdout, defs); err != nil {
+					log.Fatal(err)
+				}
+				return
+			}
+			em, err := _BankEncoderMaker()
+			if err != nil {
+				log.Fatal(err)
+			}
+			enc := em.NewEncoder(os.Stdout)
+			for _, d := range defs {
+				if err := enc.Encode(d.Descriptor); err != nil {
+					log.Fatal(err)
+				}
+			}
+		},
+	}
+	cmd.Flags().BoolVar(&raw, "descriptor", false, "print the descriptors of the definitions in the response format instead of proto source")
 	return cmd
 }
 
 func _DialBank() (*grpc.ClientConn, BankClient, error) {
 	cfg := _DefaultBankClientCommandConfig
-	opts := []grpc.DialOption{
-		grpc.WithBlock(),
-		grpc.WithTimeout(cfg.Timeout),
+	var v *verbose.Logger
+	var opts []grpc.DialOption
+	if cfg.Trace.Enabled() {
+		t, err := tracing.Start(cfg.Trace)
+		if err != nil {
+			return nil, nil, fmt.Errorf("trace: %v", err)
+		}
+		opts = append(opts, t.DialOptions()...)
+	}
+	if cfg.Verbose {
+		v = verbose.New(os.Stderr)
+		opts = append(opts, v.DialOptions()...)
 	}
 	if cfg.TLS {
 		tlsConfig := &tls.Config{}
@@ -148,6 +303,9 @@ func _DialBank() (*grpc.ClientConn, BankClient, error) {
 		}
 		//tlsConfig.BuildNameToCertificate()
 		cred := credentials.NewTLS(tlsConfig)
+		if v != nil {
+			cred = v.Credentials(cred)
+		}
 		opts = append(opts, grpc.WithTransportCredentials(cred))
 	} else {
 		opts = append(opts, grpc.WithInsecure())
@@ -173,34 +331,69 @@ func _DialBank() (*grpc.ClientConn, BankClient, error) {
 		}
 		opts = append(opts, grpc.WithPerRPCCredentials(cred))
 	}
-	conn, err := grpc.Dial(cfg.ServerAddr, opts...)
+	if cfg.Record != "" {
+		rec, err := recording.Open(cfg.Record)
+		if err != nil {
+			return nil, nil, fmt.Errorf("record: %v", err)
+		}
+		opts = append(opts, rec.DialOptions()...)
+	}
+	var conn *grpc.ClientConn
+	var err error
+	if v != nil {
+		conn, err = v.Dial(cfg.ServerAddr, cfg.Timeout, opts...)
+	} else {
+		conn, err = grpc.Dial(cfg.ServerAddr, append(opts, grpc.WithBlock(), grpc.WithTimeout(cfg.Timeout))...)
+	}
 	if err != nil {
 		return nil, nil, err
 	}
 	return conn, NewBankClient(conn), nil
 }
 
-type _BankRoundTripFunc func(cli BankClient, in iocodec.Decoder, out iocodec.Encoder) error
+// _BankEncoderMaker returns the encoder maker of the response format.
+func _BankEncoderMaker() (iocodec.EncoderMaker, error) {
+	format := _DefaultBankClientCommandConfig.ResponseFormat
+	if format == "" {
+		format = "json"
+	}
+	em, ok := iocodec.DefaultEncoders[format]
+	if !ok {
+		return nil, fmt.Errorf("invalid response format: %q", format)
+	}
+	return em, nil
+}
+
+type _BankRoundTripFunc func(conn *grpc.ClientConn, cli BankClient, in iocodec.Decoder, out iocodec.Encoder) error
 
-func _BankRoundTrip(sample interface{}, fn _BankRoundTripFunc) error {
+// _BankRoundTrip reads the request with prepare, when set, before it
+// dials the server and calls fn. Commands without a request have no sample.
+func _BankRoundTrip(sample proto.Message, prepare func(in iocodec.Decoder) error, fn _BankRoundTripFunc) error {
 	cfg := _DefaultBankClientCommandConfig
-	var em iocodec.EncoderMaker
-	var ok bool
-	if cfg.ResponseFormat == "" {
-		em = iocodec.DefaultEncoders["json"]
-	} else {
-		em, ok = iocodec.DefaultEncoders[cfg.ResponseFormat]
-		if !ok {
-			return fmt.Errorf("invalid response format: %q", cfg.ResponseFormat)
-		}
+	em, err := _BankEncoderMaker()
+	if err != nil {
+		return err
 	}
 	if cfg.PrintSampleRequest {
-		return em.NewEncoder(os.Stdout).Encode(sample)
+		if sample == nil {
+			return fmt.Errorf("no sample request")
+		}
+		return em.NewEncoder(os.Stdout).Encode(iocodec.NewSample(sample))
 	}
 	// read the input request, first from stdin, then from a file, otherwise from args only
 	var d iocodec.Decoder
 	if cfg.Stdin || cfg.RequestFile == "-" {
-		d = iocodec.DefaultDecoders["json"].NewDecoder(os.Stdin)
+		if cfg.Edit {
+			// the editor needs the terminal
+			return fmt.Errorf("--edit can't be used with a request read from stdin")
+		}
+		var in io.Reader = os.Stdin
+		if cfg.Template.Stdin {
+			if in, err = cfg.Template.Reader("stdin", os.Stdin); err != nil {
+				return fmt.Errorf("request template: %v", err)
+			}
+		}
+		d = iocodec.DefaultDecoders["json"].NewDecoder(in)
 	} else if cfg.RequestFile != "" {
 		f, err := os.Open(cfg.RequestFile)
 		if err != nil {
@@ -215,16 +408,116 @@ func _BankRoundTrip(sample interface{}, fn _BankRoundTripFunc) error {
 		if !ok {
 			return fmt.Errorf("invalid request file format: %q", ext)
 		}
-		d = dm.NewDecoder(f)
+		in, err := cfg.Template.Reader(cfg.RequestFile, f)
+		if err != nil {
+			return fmt.Errorf("request template: %v", err)
+		}
+		d = dm.NewDecoder(in)
 	} else {
 		d = iocodec.DefaultDecoders["noop"].NewDecoder(os.Stdin)
 	}
+	d = cfg.Set.Decoder(d)
+	if prepare != nil {
+		if err := prepare(d); err != nil {
+			return err
+		}
+	}
+	if cfg.DryRun {
+		// fn prints what it would send instead
+		return fn(nil, nil, d, em.NewEncoder(os.Stdout))
+	}
 	conn, client, err := _DialBank()
 	if err != nil {
 		return err
 	}
 	defer conn.Close()
-	return fn(client, d, em.NewEncoder(os.Stdout))
+	return fn(conn, client, d, em.NewEncoder(os.Stdout))
+}
+
+// _BankPrompter returns the prompter asking for the fields of requests,
+// or nil unless --interactive is given and stdin is a terminal.
+func _BankPrompter() *prompting.Prompter {
+	if !_DefaultBankClientCommandConfig.Interactive || !prompting.IsTerminal(os.Stdin) {
+		return nil
+	}
+	return prompting.New(os.Stdin, os.Stderr)
+}
+
+// _BankConfirmDestructive asks for confirmation of a call of the
+// destructive method, showing req unless nil. It's called once the server is
+// dialed, so sample requests and dry runs, which send nothing, aren't asked
+// about.
+func _BankConfirmDestructive(command, method string, req proto.Message) error {
+	em, err := _BankEncoderMaker()
+	if err != nil {
+		return err
+	}
+	return _DefaultBankClientCommandConfig.Destructive.Confirm(command, method, req, em)
+}
+
+// _BankEdit opens the editor on m, or on a sample of it when it's empty,
+// in the format of the request file, or else the response format.
+func _BankEdit(m proto.Message, check func(proto.Message) error) error {
+	cfg := _DefaultBankClientCommandConfig
+	format := cfg.ResponseFormat
+	if ext := filepath.Ext(cfg.RequestFile); len(ext) > 1 {
+		format = ext[1:]
+	}
+	var v interface{} = m
+	if proto.Size(m) == 0 {
+		v = iocodec.NewSample(m)
+	}
+	return editing.Edit(format, v, m, check)
+}
+
+// _BankDryRun prints reqs, along with where and how they would be sent.
+func _BankDryRun(out iocodec.Encoder, reqs ...proto.Message) error {
+	cfg := _DefaultBankClientCommandConfig
+	plan := dryrun.Plan{
+		Target:   cfg.ServerAddr,
+		Timeout:  cfg.Timeout,
+		Metadata: metadata.MD{},
+		Requests: reqs,
+	}
+	if cfg.TLS {
+		plan.TLS = &dryrun.TLS{
+			ServerName:         cfg.ServerName,
+			InsecureSkipVerify: cfg.InsecureSkipVerify,
+			CACertFile:         cfg.CACertFile,
+			CertFile:           cfg.CertFile,
+			KeyFile:            cfg.KeyFile,
+		}
+		if plan.TLS.ServerName == "" {
+			plan.TLS.ServerName, _, _ = net.SplitHostPort(cfg.ServerAddr)
+		}
+	}
+	if cfg.AuthToken != "" {
+		plan.Metadata.Append("authorization", dryrun.Redact(cfg.AuthTokenType+" "+cfg.AuthToken))
+	}
+	if cfg.JWTKey != "" || cfg.JWTKeyFile != "" {
+		plan.Metadata.Append("authorization", "Bearer "+dryrun.Redacted)
+	}
+	return dryrun.Print(plan, out)
+}
+
+// _BankLoadTest makes calls with call, as many and as fast as the load
+// test flags say, over conn and the connections it dials, and prints a report
+// of them.
+func _BankLoadTest(conn *grpc.ClientConn, call func(ctx context.Context, cli BankClient) error) error {
+	dial := func() (*grpc.ClientConn, error) {
+		conn, _, err := _DialBank()
+		return conn, err
+	}
+	return loadtest.Run(_DefaultBankClientCommandConfig.LoadTest, conn, dial, func(ctx context.Context, conn *grpc.ClientConn) error {
+		return call(ctx, NewBankClient(conn))
+	}, os.Stdout)
+}
+
+func _BankValidate(m proto.Message, flags *pflag.FlagSet) error {
+	if _DefaultBankClientCommandConfig.SkipValidation {
+		return nil
+	}
+	return validation.Validate(m, flags)
 }
 
 func _BankDepositClientCommand() *cobra.Command {
@@ -235,6 +528,7 @@ func _BankDepositClientCommand() *cobra.Command {
 		},
 		Clusters: []*DepositRequest_ClusterWithNamespaces{},
 	}
+	var batchOpts batch.Options
 
 	cmd := &cobra.Command{
 		Use:     "deposit",
@@ -242,16 +536,90 @@ func _BankDepositClientCommand() *cobra.Command {
 		Example: "TODO: print protobuf method comments here",
 		Run: func(cmd *cobra.Command, args []string) {
 			var v DepositRequest
-			err := _BankRoundTrip(v, func(cli BankClient, in iocodec.Decoder, out iocodec.Encoder) error {
 
+			prepare := func(in iocodec.Decoder) error {
 				err := in.Decode(&v)
 				if err != nil {
 					return err
 				}
 
 				proto.Merge(&v, reqArgs)
-				resp, err := cli.Deposit(context.Background(), &v)
 
+				prompter := _BankPrompter()
+				if prompter != nil {
+					err = prompter.Fields(&v)
+					if err != nil {
+						return err
+					}
+				}
+				if _DefaultBankClientCommandConfig.Edit {
+					err = _BankEdit(&v, func(m proto.Message) error {
+						return _BankValidate(m, cmd.Flags())
+					})
+				} else {
+					err = _BankValidate(&v, cmd.Flags())
+				}
+				if err != nil {
+					return err
+				}
+				if prompter == nil || _DefaultBankClientCommandConfig.DryRun {
+					return nil
+				}
+				em, err := _BankEncoderMaker()
+				if err != nil {
+					return err
+				}
+				return prompter.Confirm(&v, em)
+			}
+
+			if batchOpts.File != "" {
+				// the requests of the batch are read and checked one by one
+				prepare = nil
+			}
+
+			err := _BankRoundTrip(&v, prepare, func(conn *grpc.ClientConn, cli BankClient, in iocodec.Decoder, out iocodec.Encoder) error {
+
+				if batchOpts.File != "" {
+					if _DefaultBankClientCommandConfig.DryRun {
+						return fmt.Errorf("--dry-run can't be used with --batch")
+					}
+					if _DefaultBankClientCommandConfig.Edit {
+						return fmt.Errorf("--edit can't be used with --batch")
+					}
+					return batch.Run(batchOpts, func(ctx context.Context, r *batch.Record) (proto.Message, error) {
+						var v DepositRequest
+						err := r.Decode(&v)
+						if err != nil {
+							return nil, err
+						}
+						err = _DefaultBankClientCommandConfig.Set.Apply(&v)
+						if err != nil {
+							return nil, batch.Invalid(err)
+						}
+						// the defaults of the record must not leak into
+						// the next ones
+						reqArgs := proto.Clone(reqArgs).(*DepositRequest)
+
+						proto.Merge(&v, reqArgs)
+						err = _BankValidate(&v, cmd.Flags())
+						if err != nil {
+							return nil, batch.Invalid(err)
+						}
+						return cli.Deposit(ctx, &v)
+					}, out)
+				}
+
+				if _DefaultBankClientCommandConfig.DryRun {
+					return _BankDryRun(out, &v)
+				}
+				if _DefaultBankClientCommandConfig.LoadTest.Enabled() {
+					return _BankLoadTest(conn, func(ctx context.Context, cli BankClient) error {
+						_, err := cli.Deposit(ctx, &v)
+						return err
+					})
+				}
+
+				resp, err := cli.Deposit(context.Background(), &v)
 				if err != nil {
 					return err
 				}
@@ -270,6 +638,7 @@ func _BankDepositClientCommand() *cobra.Command {
 	cmd.PersistentFlags().StringVar(&reqArgs.Environment, "environment", "", "get-comment-from-proto")
 	cmd.PersistentFlags() // Warning: list flags are not yet supported (field "Clusters")
 	cmd.PersistentFlags() // Warning: list flags are not yet supported (field "Namespaces")
+	batchOpts.AddFlags(cmd.PersistentFlags())
 
 	return cmd
 }
@@ -277,3 +646,77 @@ func _BankDepositClientCommand() *cobra.Command {
 var _BankClientSubCommands = []func() *cobra.Command{
 	_BankDepositClientCommand,
 }
+
+func init() {
+	iocodec.RegisterComments(map[string]string{
+		"pb.DepositRequest": " DepositRequest deposits into the accounts of a tenant.",
+		"pb.DepositRequest.ClusterWithNamespaces.cluster": " The cluster the namespaces belong to.",
+		"pb.DepositRequest.parent":                        " The parent resource of the deposit.",
+	})
+}
+
+func init() { describe.Register(_descriptorSet_Bank_927112c770921067) }
+
+var _descriptorSet_Bank_927112c770921067 = []byte{
+	// 957 bytes of a gzipped FileDescriptorSet
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x96, 0xcf, 0x52, 0xe3, 0x46,
+	0x10, 0xc6, 0x35, 0xd3, 0x63, 0x59, 0x6a, 0xd9, 0x86, 0xb4, 0xc1, 0x12, 0xc6, 0x06, 0x61, 0xc0,
+	0xb0, 0xbb, 0x89, 0xd9, 0x02, 0xf2, 0xe7, 0x98, 0xda, 0x90, 0x4b, 0x92, 0xca, 0xc1, 0x95, 0xaa,
+	0x1c, 0x37, 0xb6, 0x51, 0x82, 0x6b, 0x59, 0x49, 0xb1, 0x44, 0x52, 0x3c, 0x43, 0xce, 0x79, 0x86,
+	0xe4, 0x85, 0xf2, 0x3e, 0xa9, 0x1e, 0x8f, 0x34, 0xa6, 0xc2, 0x1e, 0x72, 0xf3, 0xaf, 0xfb, 0xfb,
+	0xba, 0xbf, 0x19, 0x24, 0x15, 0xf8, 0xf7, 0x36, 0xb6, 0xf3, 0xf9, 0xc5, 0x7c, 0x96, 0xbe, 0x9b,
+	0xe4, 0xab, 0xac, 0xcc, 0x48, 0xe6, 0xf3, 0xd1, 0x5f, 0x0d, 0xec, 0xdc, 0x24, 0x79, 0x56, 0x2c,
+	0xcb, 0x69, 0xf2, 0xeb, 0x43, 0x52, 0x94, 0xd4, 0x43, 0x37, 0x9f, 0xad, 0x92, 0xb4, 0x8c, 0x44,
+	0x2c, 0xce, 0xfd, 0xa9, 0x21, 0xae, 0x97, 0x49, 0x3a, 0x4b, 0xcb, 0x48, 0xae, 0xeb, 0x6b, 0xa2,
+	0x18, 0x83, 0x24, 0xfd, 0x6d, 0xb9, 0xca, 0xd2, 0xf7, 0x6c, 0x02, 0xdd, 0xdc, 0x2c, 0xd1, 0x0d,
+	0x7a, 0x8b, 0xfb, 0x87, 0xa2, 0x4c, 0x56, 0x45, 0xa4, 0x62, 0x38, 0x0f, 0x2e, 0xcf, 0x27, 0xf9,
+	0x7c, 0xf2, 0x74, 0xef, 0xe4, 0xab, 0xb5, 0xe4, 0xc7, 0x65, 0x79, 0xf7, 0xfd, 0xec, 0x7d, 0x52,
+	0xe4, 0xb3, 0x45, 0x52, 0x4c, 0x6b, 0x27, 0xfd, 0x84, 0xa1, 0xf9, 0xfd, 0xf6, 0xf7, 0x65, 0x79,
+	0xf7, 0x36, 0xad, 0x45, 0x51, 0x23, 0x16, 0xff, 0x6b, 0xe8, 0xee, 0xe2, 0xb9, 0x72, 0xff, 0x0f,
+	0x81, 0xbb, 0xcf, 0x1a, 0xe8, 0x14, 0x9b, 0xc6, 0xa2, 0x2f, 0x25, 0xb8, 0x0c, 0x78, 0x97, 0xd1,
+	0x4e, 0xab, 0x1e, 0x7d, 0x8b, 0xb8, 0x91, 0x4a, 0xea, 0xa3, 0xbe, 0x7a, 0x26, 0x55, 0x3d, 0x99,
+	0xd7, 0xdc, 0x24, 0xf9, 0x7d, 0xf6, 0xc8, 0x17, 0x55, 0x4c, 0x37, 0xec, 0xfd, 0x3f, 0x05, 0x46,
+	0x1f, 0x12, 0xd2, 0x2b, 0xf4, 0x6b, 0xa9, 0x89, 0xd4, 0xe6, 0x45, 0xb5, 0x61, 0x6a, 0xfb, 0xf4,
+	0x1d, 0x06, 0xb7, 0xd6, 0x6b, 0x72, 0xbd, 0x7c, 0x26, 0x97, 0xdd, 0xc0, 0xfb, 0xbe, 0x4e, 0x6f,
+	0xf3, 0x6c, 0xc9, 0xb1, 0x36, 0xed, 0xfd, 0x07, 0x0c, 0x3f, 0xa0, 0xa3, 0x09, 0xa2, 0x55, 0x9a,
+	0x58, 0x1d, 0xb3, 0xc7, 0x54, 0xa7, 0x1b, 0x0a, 0x7a, 0x89, 0x7e, 0x52, 0x99, 0x4d, 0xac, 0x16,
+	0xcb, 0xab, 0x89, 0x53, 0xdb, 0x1e, 0xb5, 0x10, 0xed, 0x94, 0x11, 0xa2, 0x57, 0x89, 0x46, 0x01,
+	0xfa, 0xf5, 0xb1, 0x47, 0x3e, 0x36, 0xcd, 0x9f, 0x65, 0x74, 0x80, 0xad, 0xfa, 0x7c, 0xf9, 0xfd,
+	0x23, 0x75, 0x50, 0x2e, 0x6f, 0xcd, 0x43, 0x2d, 0x97, 0xb7, 0x97, 0x9f, 0xa3, 0x7a, 0x33, 0x4b,
+	0xdf, 0xd1, 0x05, 0x36, 0x8d, 0x8e, 0xe8, 0xbf, 0x97, 0xd2, 0xdf, 0x7e, 0x52, 0xcb, 0xef, 0x1f,
+	0xbf, 0xf9, 0xc7, 0x47, 0x97, 0x94, 0xe3, 0x8c, 0xcf, 0xd0, 0x43, 0xd1, 0x22, 0x70, 0x1c, 0xe2,
+	0x5f, 0x92, 0x40, 0x3a, 0x01, 0xfa, 0x28, 0x5d, 0x87, 0x40, 0x39, 0x37, 0x88, 0x08, 0xae, 0x23,
+	0x08, 0x94, 0xd7, 0xc2, 0x00, 0x95, 0xeb, 0x48, 0x6e, 0x6c, 0xbd, 0xc1, 0x16, 0x36, 0x18, 0xb8,
+	0xd5, 0xed, 0x57, 0x24, 0x09, 0xd4, 0xfe, 0x8b, 0x8a, 0x80, 0x40, 0x5d, 0x7f, 0x89, 0x37, 0x28,
+	0x95, 0x43, 0xaa, 0xe9, 0x1c, 0x8a, 0xfe, 0x17, 0xf1, 0xd3, 0x6c, 0xf1, 0xed, 0x1a, 0x8b, 0x78,
+	0x99, 0x96, 0x59, 0x5c, 0xde, 0x25, 0xf1, 0x6c, 0xb1, 0xc8, 0x1e, 0xd2, 0xb2, 0x88, 0xb3, 0x9f,
+	0xe3, 0x59, 0xbc, 0x7e, 0x45, 0x27, 0x88, 0x88, 0xa0, 0x78, 0x5b, 0xd3, 0xeb, 0xe1, 0x25, 0x2a,
+	0xa5, 0x83, 0xf8, 0x72, 0xa7, 0x7f, 0x1a, 0xff, 0x70, 0x97, 0xc4, 0xeb, 0x57, 0x3c, 0x5e, 0x25,
+	0x45, 0xf6, 0xb0, 0x5a, 0x24, 0x6c, 0xe6, 0x61, 0x66, 0xfc, 0x04, 0x39, 0x13, 0x7b, 0x1a, 0x6c,
+	0xf2, 0x2a, 0x12, 0x04, 0xbe, 0xbf, 0x55, 0x11, 0x10, 0xf8, 0xd4, 0xe5, 0x63, 0x2a, 0x47, 0x0a,
+	0x02, 0x94, 0x3b, 0xa6, 0x25, 0x1a, 0x4c, 0x95, 0x4d, 0x70, 0xaf, 0xb6, 0x09, 0x20, 0xc0, 0xda,
+	0x26, 0x09, 0x02, 0xb9, 0x67, 0x5a, 0xb2, 0xc1, 0x54, 0xd9, 0x78, 0x64, 0xe0, 0x57, 0x23, 0x25,
+	0x10, 0x04, 0x61, 0x64, 0x6c, 0x40, 0xd0, 0x96, 0x13, 0xd3, 0x02, 0xc5, 0x54, 0x45, 0x06, 0x97,
+	0xa0, 0x1d, 0xc4, 0x15, 0x09, 0x82, 0xf6, 0xd1, 0x8b, 0x8a, 0xd8, 0xf7, 0xf1, 0x27, 0x66, 0x88,
+	0x22, 0xe8, 0xc8, 0x6b, 0xd3, 0x52, 0x2e, 0x53, 0x58, 0x91, 0x20, 0xe8, 0x44, 0x17, 0x15, 0x01,
+	0x41, 0xe7, 0xf2, 0x0a, 0x5b, 0x6c, 0x03, 0x87, 0xd4, 0xb6, 0xdc, 0x81, 0x75, 0x0f, 0xf8, 0x4e,
+	0xb6, 0xf1, 0x10, 0x3f, 0x43, 0x97, 0x89, 0x6f, 0x99, 0x54, 0xd4, 0x3f, 0xd3, 0xb7, 0x6c, 0x3e,
+	0x13, 0xfa, 0x6a, 0xed, 0x8b, 0x1e, 0xcf, 0x93, 0xfb, 0x2c, 0xfd, 0x25, 0x2e, 0xb3, 0x09, 0x62,
+	0x07, 0x9b, 0x6b, 0x9f, 0xcb, 0xc6, 0xc0, 0xb2, 0x20, 0xa0, 0x56, 0xd7, 0x32, 0x10, 0x50, 0x2f,
+	0xc4, 0xb6, 0xd9, 0x23, 0x08, 0xba, 0xea, 0xd3, 0xba, 0x2d, 0x14, 0x73, 0xcb, 0xb2, 0x4b, 0xd0,
+	0x6d, 0x9f, 0x5a, 0x66, 0xfd, 0xf8, 0xb5, 0x65, 0x20, 0xe8, 0x5e, 0x5d, 0x9b, 0x23, 0x09, 0x52,
+	0x3d, 0xb9, 0x57, 0x1d, 0x89, 0xb5, 0x3d, 0x1c, 0x99, 0x55, 0x82, 0x8f, 0x14, 0xaa, 0x81, 0xb1,
+	0x0a, 0x9d, 0x34, 0x54, 0x6d, 0xcb, 0x82, 0x20, 0xec, 0x84, 0x96, 0x81, 0x20, 0xec, 0xef, 0xd7,
+	0x76, 0x41, 0x10, 0xd5, 0x49, 0x85, 0x4e, 0x1a, 0xd5, 0x49, 0x85, 0x4e, 0x1a, 0xb5, 0x4f, 0x2c,
+	0xb3, 0xfe, 0xf4, 0xb5, 0x65, 0x20, 0x88, 0xea, 0xa4, 0x92, 0xd4, 0xbe, 0x3c, 0xa8, 0x92, 0xf2,
+	0xec, 0x7d, 0x3c, 0x32, 0xab, 0x24, 0x27, 0x1d, 0xa8, 0x03, 0x63, 0x95, 0x3a, 0xe9, 0x40, 0x75,
+	0x2c, 0x0b, 0x82, 0xc1, 0xd6, 0x9e, 0x65, 0x20, 0x18, 0x0c, 0x86, 0xb5, 0x5d, 0x10, 0x0c, 0xd5,
+	0x49, 0xdd, 0xe6, 0xa4, 0xc3, 0x3a, 0xa9, 0xd4, 0x49, 0x87, 0xed, 0x5d, 0xcb, 0xac, 0xef, 0x1d,
+	0x5a, 0x06, 0x82, 0xe1, 0xe8, 0x98, 0x3f, 0x07, 0xfc, 0xfc, 0x1c, 0x39, 0xbb, 0xfa, 0x2d, 0x64,
+	0xd9, 0x91, 0x47, 0xba, 0x2c, 0x09, 0x46, 0x4e, 0x57, 0x97, 0x79, 0xdb, 0xc8, 0xdb, 0xd6, 0x65,
+	0x20, 0x38, 0x76, 0x76, 0x74, 0x99, 0x1f, 0xd8, 0x63, 0xef, 0x23, 0x5d, 0x56, 0x04, 0x27, 0x0e,
+	0xe9, 0x32, 0x0f, 0x3c, 0xf1, 0xb6, 0x74, 0xb9, 0x41, 0x30, 0x76, 0xce, 0x74, 0xb9, 0x21, 0x08,
+	0xc6, 0xde, 0x8e, 0x7e, 0xa0, 0x1b, 0x7c, 0xfc, 0x71, 0x78, 0xaa, 0xef, 0xa6, 0xa1, 0x5f, 0xdd,
+	0x71, 0x38, 0xac, 0x88, 0x85, 0x07, 0x71, 0x45, 0x40, 0x30, 0x3e, 0x3e, 0x99, 0xbb, 0xfa, 0xff,
+	0x82, 0xab, 0x7f, 0x07, 0x00, 0x84, 0x12, 0xc0, 0x11, 0x2b, 0x08, 0x00, 0x00,
+}
@@ -0,0 +1,717 @@
+// Code generated by tetratelabs/protoc-gen-cobra.
+// source: rules/rules.proto
+// DO NOT EDIT!
+
+/*
+Package rules is a generated protocol buffer package.
+
+It is generated from these files:
+	rules/rules.proto
+
+It has these top-level commands:
+	AccountsClientCommand
+*/
+
+package rules
+
+import (
+	proto "github.com/golang/protobuf/proto"
+	batch "github.com/tetratelabs/protoc-gen-cobra/batch"
+	cobra "github.com/spf13/cobra"
+	context "golang.org/x/net/context"
+	credentials "google.golang.org/grpc/credentials"
+	describe "github.com/tetratelabs/protoc-gen-cobra/describe"
+	destructive "github.com/tetratelabs/protoc-gen-cobra/destructive"
+	dryrun "github.com/tetratelabs/protoc-gen-cobra/dryrun"
+	editing "github.com/tetratelabs/protoc-gen-cobra/editing"
+	filepath "path/filepath"
+	fmt1 "fmt"
+	grpc "google.golang.org/grpc"
+	health "github.com/tetratelabs/protoc-gen-cobra/health"
+	io "io"
+	iocodec "github.com/tetratelabs/protoc-gen-cobra/iocodec"
+	ioutil "io/ioutil"
+	json "encoding/json"
+	loadtest "github.com/tetratelabs/protoc-gen-cobra/loadtest"
+	log "log"
+	metadata "google.golang.org/grpc/metadata"
+	net "net"
+	oauth "google.golang.org/grpc/credentials/oauth"
+	oauth2 "golang.org/x/oauth2"
+	operation "github.com/tetratelabs/protoc-gen-cobra/operation"
+	os "os"
+	override "github.com/tetratelabs/protoc-gen-cobra/override"
+	pagination "github.com/tetratelabs/protoc-gen-cobra/pagination"
+	pflag "github.com/spf13/pflag"
+	prompting "github.com/tetratelabs/protoc-gen-cobra/prompting"
+	recording "github.com/tetratelabs/protoc-gen-cobra/recording"
+	streaming "github.com/tetratelabs/protoc-gen-cobra/streaming"
+	template "text/template"
+	templating "github.com/tetratelabs/protoc-gen-cobra/templating"
+	time "time"
+	tls "crypto/tls"
+	tracing "github.com/tetratelabs/protoc-gen-cobra/tracing"
+	validation "github.com/tetratelabs/protoc-gen-cobra/validation"
+	verbose "github.com/tetratelabs/protoc-gen-cobra/verbose"
+	x509 "crypto/x509"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+var _DefaultAccountsClientCommandConfig = _NewAccountsClientCommandConfig()
+
+type _AccountsClientCommandConfig struct {
+	ServerAddr         string
+	RequestFile        string
+	Stdin              bool
+	PrintSampleRequest bool
+	ResponseFormat     string
+	Timeout            time.Duration
+	TLS                bool
+	ServerName         string
+	InsecureSkipVerify bool
+	CACertFile         string
+	CertFile           string
+	KeyFile            string
+	AuthToken          string
+	AuthTokenType      string
+	JWTKey             string
+	JWTKeyFile         string
+	SkipValidation     bool
+	LoadTest           loadtest.Options
+	Record             string
+	DryRun             bool
+	Verbose            bool
+	Trace              tracing.Options
+	Set                override.Options
+	Template           templating.Options
+	Edit               bool
+	Interactive        bool
+	Destructive        destructive.Options
+}
+
+func _NewAccountsClientCommandConfig() *_AccountsClientCommandConfig {
+	c := &_AccountsClientCommandConfig{
+		ServerAddr:     "localhost:8080",
+		ResponseFormat: "json",
+		Timeout:        10 * time.Second,
+		AuthTokenType:  "Bearer",
+	}
+	return c
+}
+
+func (o *_AccountsClientCommandConfig) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVarP(&o.ServerAddr, "server-addr", "s", o.ServerAddr, "server address in form of host:port")
+	fs.StringVarP(&o.RequestFile, "request-file", "f", o.RequestFile, "client request file (must be json, yaml, or xml); use \"-\" for stdin + json")
+	fs.BoolVar(&o.Stdin, "stdin", o.Stdin, "read client request from STDIN; alternative for '-f -'")
+	fs.BoolVarP(&o.PrintSampleRequest, "print-sample-request", "p", o.PrintSampleRequest, "print sample request file and exit")
+	fs.StringVarP(&o.ResponseFormat, "response-format", "o", o.ResponseFormat, "response format (json, prettyjson, yaml, or xml)")
+	fs.DurationVar(&o.Timeout, "timeout", o.Timeout, "client connection timeout")
+	fs.BoolVar(&o.TLS, "tls", o.TLS, "enable tls")
+	fs.StringVar(&o.ServerName, "tls-server-name", o.ServerName, "tls server name override")
+	fs.BoolVar(&o.InsecureSkipVerify, "tls-insecure-skip-verify", o.InsecureSkipVerify, "INSECURE: skip tls checks")
+	fs.StringVar(&o.CACertFile, "tls-ca-cert-file", o.CACertFile, "ca certificate file")
+	fs.StringVar(&o.CertFile, "tls-cert-file", o.CertFile, "client certificate file")
+	fs.StringVar(&o.KeyFile, "tls-key-file", o.KeyFile, "client key file")
+	fs.StringVar(&o.AuthToken, "auth-token", o.AuthToken, "authorization token")
+	fs.StringVar(&o.AuthTokenType, "auth-token-type", o.AuthTokenType, "authorization token type")
+	fs.StringVar(&o.JWTKey, "jwt-key", o.JWTKey, "jwt key")
+	fs.StringVar(&o.JWTKeyFile, "jwt-key-file", o.JWTKeyFile, "jwt key file")
+	fs.BoolVar(&o.SkipValidation, "skip-validation", o.SkipValidation, "skip the client-side validation of requests")
+	o.LoadTest.AddFlags(fs)
+	fs.StringVar(&o.Record, "record", o.Record, "record the calls, with their metadata, messages, statuses and timings, to this session file")
+	fs.BoolVar(&o.DryRun, "dry-run", o.DryRun, "print the requests, where they would be sent and the metadata, with secrets redacted, instead of sending them")
+	fs.BoolVarP(&o.Verbose, "verbose", "v", o.Verbose, "log the connection, the tls handshake, and the metadata, message sizes and timings of the calls to stderr, with secrets redacted")
+	o.Trace.AddFlags(fs)
+	o.Set.AddFlags(fs)
+	o.Template.AddFlags(fs)
+	fs.BoolVar(&o.Edit, "edit", o.Edit, "open the request, or a sample of it without one, in $EDITOR before sending it")
+	fs.BoolVar(&o.Interactive, "interactive", o.Interactive, "when stdin is a terminal, prompt for the fields the request doesn't set, and for confirmation before sending it")
+	o.Destructive.AddFlags(fs)
+}
+
+func AccountsClientCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "accounts",
+	}
+	_DefaultAccountsClientCommandConfig.AddFlags(cmd.PersistentFlags())
+
+	for _, s := range _AccountsClientSubCommands {
+		cmd.AddCommand(s())
+	}
+	cmd.AddCommand(_AccountsReplayCommand())
+	cmd.AddCommand(_AccountsHealthCommand())
+	cmd.AddCommand(_AccountsDescribeCommand())
+	return cmd
+}
+
+func _AccountsReplayCommand() *cobra.Command {
+	var compare bool
+	cmd := &cobra.Command{
+		Use:   "replay [file]",
+		Short: "Replay the calls of a session recorded with --record",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			f, err := os.Open(args[0])
+			if err != nil {
+				log.Fatal(err)
+			}
+			defer f.Close()
+			err = _AccountsRoundTrip(nil, nil, func(conn *grpc.ClientConn, cli AccountsClient, in iocodec.Decoder, out iocodec.Encoder) error {
+				if _DefaultAccountsClientCommandConfig.DryRun {
+					reqs, err := recording.Requests(f)
+					if err != nil {
+						return err
+					}
+					return _AccountsDryRun(out, reqs...)
+				}
+				return recording.Replay(conn, f, compare, out, os.Stderr)
+			})
+			if err != nil {
+				log.Fatal(err)
+			}
+		},
+	}
+	cmd.Flags().BoolVar(&compare, "compare", false, "compare the responses and statuses to the recorded ones, field by field, and fail if they differ")
+	return cmd
+}
+
+func _AccountsHealthCommand() *cobra.Command {
+	opts := health.Options{Service: "rules.Accounts"}
+	cmd := &cobra.Command{
+		Use:     "health",
+		Aliases: []string{"ping"},
+		Short:   "Check the health of rules.Accounts with the gRPC health checking protocol, and report how the connection is made",
+		Long:    "Check the health of rules.Accounts with the gRPC health checking protocol, and report how the connection is made: its TLS details, the certificate chain of the server and the latency of the check.\n\nExits with 0 if the service is serving, 2 if the connection fails, 3 if the check fails, and 4 if the service isn't serving.",
+		Args:    cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			cfg := _DefaultAccountsClientCommandConfig
+			em, err := _AccountsEncoderMaker()
+			if err != nil {
+				log.Fatal(err)
+			}
+			if cfg.DryRun {
+				if err := _AccountsDryRun(em.NewEncoder(os.Stdout)); err != nil {
+					log.Fatal(err)
+				}
+				return
+			}
+			err = health.Check(opts, cfg.Timeout, func() (*grpc.ClientConn, error) {
+				conn, _, err := _DialAccounts()
+				return conn, err
+			}, em.NewEncoder(os.Stdout))
+			if err != nil {
+				log.Print(err)
+				os.Exit(health.ExitCode(err))
+			}
+		},
+	}
+	opts.AddFlags(cmd.Flags())
+	return cmd
+}
+
+func _AccountsDescribeCommand() *cobra.Command {
+	var raw bool
+	cmd := &cobra.Command{
+		Use:   "describe [name]",
+		Short: "Print the definition of rules.Accounts, or of one of its methods, messages or enums, with its comments",
+		Long:  "Print the definition of rules.Accounts, or of one of its methods, messages or enums, with its comments, from the descriptors built into the command.\n\nThe name is a method, as named in the proto file or as its command, or the name of a message or enum, relative to the package of the service or in full. A method is followed by its request and response messages.",
+		Args:  cobra.MaximumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			name := ""
+			if len(args) > 0 {
+				name = args[0]
+			}
+			defs, err := describe.Lookup("rules.Accounts", name)
+			if err != nil {
+				log.Fatal(err)
+			}
+			if !raw {
+				if err := describe.Print(os.Stdout, defs); err != nil {
+					log.Fatal(err)
+				}
+				return
+			}
+			em, err := _AccountsEncoderMaker()
+			if err != nil {
+				log.Fatal(err)
+			}
+			enc := em.NewEncoder(os.Stdout)
+			for _, d := range defs {
+				if err := enc.Encode(d.Descriptor); err != nil {
+					log.Fatal(err)
+				}
+			}
+		},
+	}
+	cmd.Flags().BoolVar(&raw, "descriptor", false, "print the descriptors of the definitions in the response format instead of proto source")
+	return cmd
+}
+
+func _DialAccounts() (*grpc.ClientConn, AccountsClient, error) {
+	cfg := _DefaultAccountsClientCommandConfig
+	var v *verbose.Logger
+	var opts []grpc.DialOption
+	if cfg.Trace.Enabled() {
+		t, err := tracing.Start(cfg.Trace)
+		if err != nil {
+			return nil, nil, fmt.Errorf("trace: %v", err)
+		}
+		opts = append(opts, t.DialOptions()...)
+	}
+	if cfg.Verbose {
+		v = verbose.New(os.Stderr)
+		opts = append(opts, v.DialOptions()...)
+	}
+	if cfg.TLS {
+		tlsConfig := &tls.Config{}
+		if cfg.InsecureSkipVerify {
+			tlsConfig.InsecureSkipVerify = true
+		}
+		if cfg.CACertFile != "" {
+			cacert, err := ioutil.ReadFile(cfg.CACertFile)
+			if err != nil {
+				return nil, nil, fmt.Errorf("ca cert: %v", err)
+			}
+			certpool := x509.NewCertPool()
+			certpool.AppendCertsFromPEM(cacert)
+			tlsConfig.RootCAs = certpool
+		}
+		if cfg.CertFile != "" {
+			if cfg.KeyFile == "" {
+				return nil, nil, fmt.Errorf("missing key file")
+			}
+			pair, err := tls.LoadX509KeyPair(cfg.CertFile, cfg.KeyFile)
+			if err != nil {
+				return nil, nil, fmt.Errorf("cert/key: %v", err)
+			}
+			tlsConfig.Certificates = []tls.Certificate{pair}
+		}
+		if cfg.ServerName != "" {
+			tlsConfig.ServerName = cfg.ServerName
+		} else {
+			addr, _, _ := net.SplitHostPort(cfg.ServerAddr)
+			tlsConfig.ServerName = addr
+		}
+		//tlsConfig.BuildNameToCertificate()
+		cred := credentials.NewTLS(tlsConfig)
+		if v != nil {
+			cred = v.Credentials(cred)
+		}
+		opts = append(opts, grpc.WithTransportCredentials(cred))
+	} else {
+		opts = append(opts, grpc.WithInsecure())
+	}
+	if cfg.AuthToken != "" {
+		cred := oauth.NewOauthAccess(&oauth2.Token{
+			AccessToken: cfg.AuthToken,
+			TokenType:   cfg.AuthTokenType,
+		})
+		opts = append(opts, grpc.WithPerRPCCredentials(cred))
+	}
+	if cfg.JWTKey != "" {
+		cred, err := oauth.NewJWTAccessFromKey([]byte(cfg.JWTKey))
+		if err != nil {
+			return nil, nil, fmt.Errorf("jwt key: %v", err)
+		}
+		opts = append(opts, grpc.WithPerRPCCredentials(cred))
+	}
+	if cfg.JWTKeyFile != "" {
+		cred, err := oauth.NewJWTAccessFromFile(cfg.JWTKeyFile)
+		if err != nil {
+			return nil, nil, fmt.Errorf("jwt key file: %v", err)
+		}
+		opts = append(opts, grpc.WithPerRPCCredentials(cred))
+	}
+	if cfg.Record != "" {
+		rec, err := recording.Open(cfg.Record)
+		if err != nil {
+			return nil, nil, fmt.Errorf("record: %v", err)
+		}
+		opts = append(opts, rec.DialOptions()...)
+	}
+	var conn *grpc.ClientConn
+	var err error
+	if v != nil {
+		conn, err = v.Dial(cfg.ServerAddr, cfg.Timeout, opts...)
+	} else {
+		conn, err = grpc.Dial(cfg.ServerAddr, append(opts, grpc.WithBlock(), grpc.WithTimeout(cfg.Timeout))...)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	return conn, NewAccountsClient(conn), nil
+}
+
+// _AccountsEncoderMaker returns the encoder maker of the response format.
+func _AccountsEncoderMaker() (iocodec.EncoderMaker, error) {
+	format := _DefaultAccountsClientCommandConfig.ResponseFormat
+	if format == "" {
+		format = "json"
+	}
+	em, ok := iocodec.DefaultEncoders[format]
+	if !ok {
+		return nil, fmt.Errorf("invalid response format: %q", format)
+	}
+	return em, nil
+}
+
+type _AccountsRoundTripFunc func(conn *grpc.ClientConn, cli AccountsClient, in iocodec.Decoder, out iocodec.Encoder) error
+
+// _AccountsRoundTrip reads the request with prepare, when set, before it
+// dials the server and calls fn. Commands without a request have no sample.
+func _AccountsRoundTrip(sample proto.Message, prepare func(in iocodec.Decoder) error, fn _AccountsRoundTripFunc) error {
+	cfg := _DefaultAccountsClientCommandConfig
+	em, err := _AccountsEncoderMaker()
+	if err != nil {
+		return err
+	}
+	if cfg.PrintSampleRequest {
+		if sample == nil {
+			return fmt.Errorf("no sample request")
+		}
+		return em.NewEncoder(os.Stdout).Encode(iocodec.NewSample(sample))
+	}
+	// read the input request, first from stdin, then from a file, otherwise from args only
+	var d iocodec.Decoder
+	if cfg.Stdin || cfg.RequestFile == "-" {
+		if cfg.Edit {
+			// the editor needs the terminal
+			return fmt.Errorf("--edit can't be used with a request read from stdin")
+		}
+		var in io.Reader = os.Stdin
+		if cfg.Template.Stdin {
+			if in, err = cfg.Template.Reader("stdin", os.Stdin); err != nil {
+				return fmt.Errorf("request template: %v", err)
+			}
+		}
+		d = iocodec.DefaultDecoders["json"].NewDecoder(in)
+	} else if cfg.RequestFile != "" {
+		f, err := os.Open(cfg.RequestFile)
+		if err != nil {
+			return fmt.Errorf("request file: %v", err)
+		}
+		defer f.Close()
+		ext := filepath.Ext(cfg.RequestFile)
+		if len(ext) > 0 && ext[0] == '.' {
+			ext = ext[1:]
+		}
+		dm, ok := iocodec.DefaultDecoders[ext]
+		if !ok {
+			return fmt.Errorf("invalid request file format: %q", ext)
+		}
+		in, err := cfg.Template.Reader(cfg.RequestFile, f)
+		if err != nil {
+			return fmt.Errorf("request template: %v", err)
+		}
+		d = dm.NewDecoder(in)
+	} else {
+		d = iocodec.DefaultDecoders["noop"].NewDecoder(os.Stdin)
+	}
+	d = cfg.Set.Decoder(d)
+	if prepare != nil {
+		if err := prepare(d); err != nil {
+			return err
+		}
+	}
+	if cfg.DryRun {
+		// fn prints what it would send instead
+		return fn(nil, nil, d, em.NewEncoder(os.Stdout))
+	}
+	conn, client, err := _DialAccounts()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	return fn(conn, client, d, em.NewEncoder(os.Stdout))
+}
+
+// _AccountsPrompter returns the prompter asking for the fields of requests,
+// or nil unless --interactive is given and stdin is a terminal.
+func _AccountsPrompter() *prompting.Prompter {
+	if !_DefaultAccountsClientCommandConfig.Interactive || !prompting.IsTerminal(os.Stdin) {
+		return nil
+	}
+	return prompting.New(os.Stdin, os.Stderr)
+}
+
+// _AccountsConfirmDestructive asks for confirmation of a call of the
+// destructive method, showing req unless nil. It's called once the server is
+// dialed, so sample requests and dry runs, which send nothing, aren't asked
+// about.
+func _AccountsConfirmDestructive(command, method string, req proto.Message) error {
+	em, err := _AccountsEncoderMaker()
+	if err != nil {
+		return err
+	}
+	return _DefaultAccountsClientCommandConfig.Destructive.Confirm(command, method, req, em)
+}
+
+// _AccountsEdit opens the editor on m, or on a sample of it when it's empty,
+// in the format of the request file, or else the response format.
+func _AccountsEdit(m proto.Message, check func(proto.Message) error) error {
+	cfg := _DefaultAccountsClientCommandConfig
+	format := cfg.ResponseFormat
+	if ext := filepath.Ext(cfg.RequestFile); len(ext) > 1 {
+		format = ext[1:]
+	}
+	var v interface{} = m
+	if proto.Size(m) == 0 {
+		v = iocodec.NewSample(m)
+	}
+	return editing.Edit(format, v, m, check)
+}
+
+// _AccountsDryRun prints reqs, along with where and how they would be sent.
+func _AccountsDryRun(out iocodec.Encoder, reqs ...proto.Message) error {
+	cfg := _DefaultAccountsClientCommandConfig
+	plan := dryrun.Plan{
+		Target:   cfg.ServerAddr,
+		Timeout:  cfg.Timeout,
+		Metadata: metadata.MD{},
+		Requests: reqs,
+	}
+	if cfg.TLS {
+		plan.TLS = &dryrun.TLS{
+			ServerName:         cfg.ServerName,
+			InsecureSkipVerify: cfg.InsecureSkipVerify,
+			CACertFile:         cfg.CACertFile,
+			CertFile:           cfg.CertFile,
+			KeyFile:            cfg.KeyFile,
+		}
+		if plan.TLS.ServerName == "" {
+			plan.TLS.ServerName, _, _ = net.SplitHostPort(cfg.ServerAddr)
+		}
+	}
+	if cfg.AuthToken != "" {
+		plan.Metadata.Append("authorization", dryrun.Redact(cfg.AuthTokenType+" "+cfg.AuthToken))
+	}
+	if cfg.JWTKey != "" || cfg.JWTKeyFile != "" {
+		plan.Metadata.Append("authorization", "Bearer "+dryrun.Redacted)
+	}
+	return dryrun.Print(plan, out)
+}
+
+// _AccountsLoadTest makes calls with call, as many and as fast as the load
+// test flags say, over conn and the connections it dials, and prints a report
+// of them.
+func _AccountsLoadTest(conn *grpc.ClientConn, call func(ctx context.Context, cli AccountsClient) error) error {
+	dial := func() (*grpc.ClientConn, error) {
+		conn, _, err := _DialAccounts()
+		return conn, err
+	}
+	return loadtest.Run(_DefaultAccountsClientCommandConfig.LoadTest, conn, dial, func(ctx context.Context, conn *grpc.ClientConn) error {
+		return call(ctx, NewAccountsClient(conn))
+	}, os.Stdout)
+}
+
+func _AccountsValidate(m proto.Message, flags *pflag.FlagSet) error {
+	if _DefaultAccountsClientCommandConfig.SkipValidation {
+		return nil
+	}
+	return validation.Validate(m, flags)
+}
+
+func _AccountsCreateClientCommand() *cobra.Command {
+	reqArgs := &CreateRequest{
+		Owner: &Owner{},
+	}
+	var batchOpts batch.Options
+
+	cmd := &cobra.Command{
+		Use:     "create",
+		Long:    "Create client; call by piping a request in to stdin (--stdin), reading a file (--file), or via flags per field",
+		Example: "TODO: print protobuf method comments here",
+		Run: func(cmd *cobra.Command, args []string) {
+			var v CreateRequest
+
+			prepare := func(in iocodec.Decoder) error {
+				err := in.Decode(&v)
+				if err != nil {
+					return err
+				}
+
+				proto.Merge(&v, reqArgs)
+
+				prompter := _AccountsPrompter()
+				if prompter != nil {
+					err = prompter.Fields(&v)
+					if err != nil {
+						return err
+					}
+				}
+				if _DefaultAccountsClientCommandConfig.Edit {
+					err = _AccountsEdit(&v, func(m proto.Message) error {
+						return _AccountsValidate(m, cmd.Flags())
+					})
+				} else {
+					err = _AccountsValidate(&v, cmd.Flags())
+				}
+				if err != nil {
+					return err
+				}
+				if prompter == nil || _DefaultAccountsClientCommandConfig.DryRun {
+					return nil
+				}
+				em, err := _AccountsEncoderMaker()
+				if err != nil {
+					return err
+				}
+				return prompter.Confirm(&v, em)
+			}
+
+			if batchOpts.File != "" {
+				// the requests of the batch are read and checked one by one
+				prepare = nil
+			}
+
+			err := _AccountsRoundTrip(&v, prepare, func(conn *grpc.ClientConn, cli AccountsClient, in iocodec.Decoder, out iocodec.Encoder) error {
+
+				if batchOpts.File != "" {
+					if _DefaultAccountsClientCommandConfig.DryRun {
+						return fmt.Errorf("--dry-run can't be used with --batch")
+					}
+					if _DefaultAccountsClientCommandConfig.Edit {
+						return fmt.Errorf("--edit can't be used with --batch")
+					}
+					return batch.Run(batchOpts, func(ctx context.Context, r *batch.Record) (proto.Message, error) {
+						var v CreateRequest
+						err := r.Decode(&v)
+						if err != nil {
+							return nil, err
+						}
+						err = _DefaultAccountsClientCommandConfig.Set.Apply(&v)
+						if err != nil {
+							return nil, batch.Invalid(err)
+						}
+						// the defaults of the record must not leak into
+						// the next ones
+						reqArgs := proto.Clone(reqArgs).(*CreateRequest)
+
+						proto.Merge(&v, reqArgs)
+						err = _AccountsValidate(&v, cmd.Flags())
+						if err != nil {
+							return nil, batch.Invalid(err)
+						}
+						return cli.Create(ctx, &v)
+					}, out)
+				}
+
+				if _DefaultAccountsClientCommandConfig.DryRun {
+					return _AccountsDryRun(out, &v)
+				}
+				if _DefaultAccountsClientCommandConfig.LoadTest.Enabled() {
+					return _AccountsLoadTest(conn, func(ctx context.Context, cli AccountsClient) error {
+						_, err := cli.Create(ctx, &v)
+						return err
+					})
+				}
+
+				resp, err := cli.Create(context.Background(), &v)
+				if err != nil {
+					return err
+				}
+
+				return out.Encode(resp)
+
+			})
+			if err != nil {
+				log.Fatal(err)
+			}
+		},
+	}
+
+	cmd.PersistentFlags().StringVar(&reqArgs.Name, "name", "", "get-comment-from-proto")
+	cmd.PersistentFlags().Uint32Var(&reqArgs.Quota, "quota", 0, "get-comment-from-proto")
+	cmd.PersistentFlags().StringVar(&reqArgs.Owner.Email, "owner-email", "", "get-comment-from-proto")
+	cmd.PersistentFlags() // Warning: list flags are not yet supported (field "Tags")
+	batchOpts.AddFlags(cmd.PersistentFlags())
+
+	return cmd
+}
+
+var _AccountsClientSubCommands = []func() *cobra.Command{
+	_AccountsCreateClientCommand,
+}
+
+func init() {
+	validation.RegisterRules(map[string]string{
+		"rules.CreateRequest.name":  "string:<min_len:3 max_len:64 pattern:\"^[a-z][a-z0-9-]*$\" >",
+		"rules.CreateRequest.owner": "message:<required:true >",
+		"rules.CreateRequest.quota": "uint32:<lte:100 gte:1 >",
+		"rules.CreateRequest.tags":  "repeated:<min_items:1 items:<string:<min_len:1 > > >",
+		"rules.CreateRequest.tier":  "enum:<defined_only:true not_in:0 >",
+		"rules.Owner.email":         "string:<email:true >",
+	})
+}
+
+func init() { describe.Register(_descriptorSet_Rules_0addb16c7cb9da8e) }
+
+var _descriptorSet_Rules_0addb16c7cb9da8e = []byte{
+	// 903 bytes of a gzipped FileDescriptorSet
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x95, 0xcf, 0x6e, 0x1c, 0x45,
+	0x10, 0xc6, 0xbb, 0xab, 0x7a, 0x66, 0x7b, 0x6a, 0xfe, 0xec, 0x6c, 0x8d, 0x63, 0x4f, 0xfc, 0x2f,
+	0xeb, 0xc5, 0x48, 0x2b, 0x0b, 0x3b, 0xc1, 0x48, 0xe0, 0x18, 0x90, 0xe2, 0x4d, 0x1c, 0xc9, 0x01,
+	0x41, 0xb4, 0x24, 0x27, 0x14, 0x60, 0xb1, 0x27, 0xc1, 0xc2, 0xf1, 0x92, 0xf5, 0x1a, 0x24, 0x8e,
+	0x1c, 0x91, 0x72, 0xe1, 0x92, 0xa7, 0xe0, 0x8e, 0x38, 0x45, 0xe2, 0x69, 0x22, 0x5e, 0x02, 0x55,
+	0x4f, 0xb7, 0x45, 0x2e, 0xab, 0xf9, 0x4d, 0x7f, 0xf5, 0xd5, 0x37, 0x55, 0x6d, 0x99, 0xfe, 0xc9,
+	0xa8, 0x37, 0xbb, 0x3c, 0x6b, 0x2e, 0x6e, 0xba, 0xdf, 0x9d, 0x9f, 0x66, 0xd3, 0xf9, 0x94, 0x23,
+	0x07, 0xcb, 0x4b, 0x3f, 0x4f, 0xce, 0x4e, 0x4f, 0x26, 0xf3, 0xe6, 0x66, 0x78, 0x68, 0xcf, 0x07,
+	0xff, 0x6a, 0xca, 0xef, 0xce, 0x9a, 0xc9, 0xbc, 0x19, 0x37, 0x2f, 0x2e, 0x9b, 0x8b, 0x39, 0xef,
+	0x92, 0x39, 0x9f, 0x3c, 0x6f, 0x6a, 0xdd, 0xd7, 0xc3, 0x64, 0xb4, 0xfe, 0xf7, 0x9b, 0xd7, 0x78,
+	0x7d, 0xb6, 0x54, 0x62, 0x7d, 0x67, 0xb7, 0xf7, 0xcd, 0xd7, 0x93, 0xed, 0x5f, 0x9f, 0xc8, 0xcf,
+	0xad, 0xed, 0xdb, 0xdb, 0x4f, 0xb6, 0x36, 0xc7, 0x4e, 0xcb, 0x1b, 0x14, 0xbd, 0xb8, 0x9c, 0xce,
+	0x27, 0x35, 0xf4, 0xf5, 0x30, 0x1f, 0xa5, 0x52, 0x14, 0x6f, 0x99, 0xfa, 0x64, 0xa8, 0xc7, 0xed,
+	0x09, 0xef, 0x50, 0x34, 0xfd, 0xe5, 0xbc, 0x99, 0xd5, 0xd8, 0xd7, 0xc3, 0x74, 0x37, 0xdb, 0x69,
+	0x53, 0x7e, 0x29, 0xef, 0x46, 0x24, 0x05, 0xd1, 0xef, 0x1a, 0x4a, 0x3d, 0x6e, 0x65, 0xbc, 0x49,
+	0x66, 0x3e, 0x79, 0x76, 0x51, 0x9b, 0x3e, 0x0e, 0x93, 0x51, 0x29, 0x82, 0xf4, 0x0f, 0x6d, 0xad,
+	0x1e, 0x98, 0x99, 0xc8, 0xdc, 0x29, 0x6f, 0x93, 0x99, 0x9f, 0x36, 0xb3, 0x3a, 0xea, 0xeb, 0x61,
+	0xb1, 0x9b, 0x7a, 0xd3, 0x47, 0xa7, 0xcd, 0x6c, 0x94, 0x49, 0x49, 0xe7, 0x37, 0x6d, 0x4a, 0xdd,
+	0x57, 0x63, 0x27, 0x1b, 0x0c, 0x29, 0x72, 0x0d, 0xf9, 0x06, 0x45, 0xcd, 0xf3, 0xc9, 0xe9, 0x99,
+	0xff, 0xca, 0x44, 0xb4, 0x66, 0x06, 0xdf, 0xe9, 0x71, 0xfb, 0x7e, 0xf0, 0x3e, 0x25, 0x8f, 0xcf,
+	0x8f, 0x7f, 0x68, 0x8e, 0x7f, 0x6c, 0x4e, 0x78, 0xed, 0xad, 0x91, 0x04, 0xb1, 0x84, 0x90, 0xd7,
+	0xfb, 0xd1, 0x5f, 0x6f, 0x5e, 0xa3, 0x1e, 0xac, 0x51, 0xe7, 0xe0, 0xf8, 0x78, 0x7a, 0x79, 0x3e,
+	0x67, 0xfe, 0x7f, 0x41, 0xab, 0xda, 0xba, 0x45, 0x46, 0x72, 0xf1, 0x02, 0x95, 0x8f, 0x8e, 0x0e,
+	0xc7, 0xdf, 0x3e, 0xfe, 0xe2, 0xab, 0x87, 0x87, 0x77, 0x8f, 0xee, 0x1f, 0x1d, 0xde, 0x2b, 0x15,
+	0x5b, 0x32, 0xf7, 0xc7, 0x87, 0x87, 0xa5, 0x96, 0xa7, 0x87, 0x07, 0x47, 0xf7, 0x4a, 0xd8, 0xdd,
+	0x27, 0xeb, 0x0d, 0x2f, 0x78, 0x87, 0xe2, 0x76, 0x4d, 0xbc, 0xe0, 0x3f, 0xf2, 0xad, 0xad, 0x2d,
+	0x17, 0xfe, 0xad, 0x2f, 0x78, 0xf0, 0xa7, 0xa5, 0x98, 0x8d, 0x52, 0xef, 0x68, 0xb2, 0xa4, 0x33,
+	0x46, 0xa5, 0x58, 0x9e, 0x80, 0x11, 0x54, 0x41, 0x09, 0x01, 0x2a, 0x46, 0xa3, 0x36, 0x88, 0x08,
+	0x62, 0xc5, 0x26, 0x56, 0x56, 0x13, 0x11, 0xc6, 0x4a, 0x33, 0xc6, 0xb6, 0xa4, 0x94, 0x4c, 0xac,
+	0x40, 0x31, 0x76, 0x60, 0x87, 0x32, 0x8a, 0x04, 0x34, 0x63, 0x27, 0xce, 0x02, 0x01, 0x63, 0x27,
+	0x5f, 0x0e, 0x84, 0x8c, 0x9d, 0x77, 0xdf, 0x13, 0x3b, 0xa3, 0xd8, 0x90, 0x2a, 0x9d, 0x9d, 0x91,
+	0x1a, 0xb2, 0xd7, 0xc4, 0xce, 0x38, 0xbb, 0x14, 0x9e, 0x4a, 0x89, 0x40, 0x24, 0x64, 0x03, 0x69,
+	0xc6, 0x34, 0xc9, 0x03, 0x21, 0x63, 0x5a, 0xf6, 0x02, 0x59, 0xc6, 0x94, 0x1b, 0x2a, 0x29, 0x71,
+	0xf4, 0xea, 0xe5, 0x5e, 0xc1, 0x98, 0x56, 0x27, 0xde, 0x56, 0x33, 0x66, 0x30, 0xf2, 0x62, 0x1d,
+	0x09, 0x05, 0x5b, 0x2d, 0x67, 0x49, 0x11, 0x08, 0x19, 0xb3, 0x1e, 0x07, 0xb2, 0x8c, 0x59, 0x75,
+	0xe0, 0x6d, 0xb5, 0xd8, 0x4a, 0xed, 0xc2, 0x1d, 0x6f, 0x0b, 0x8c, 0x39, 0x7c, 0xea, 0xc5, 0x10,
+	0x0b, 0x75, 0x02, 0x69, 0xc6, 0xdc, 0x86, 0xb4, 0x80, 0x8c, 0xf9, 0x55, 0x5a, 0xb0, 0x8c, 0x39,
+	0x7f, 0x42, 0x3d, 0x22, 0x47, 0xaf, 0x5e, 0xee, 0xf5, 0xc4, 0xaa, 0xfa, 0xd8, 0xfb, 0x22, 0x63,
+	0x01, 0xcf, 0xbc, 0x1a, 0x8d, 0x10, 0x05, 0x8a, 0x18, 0x8b, 0x34, 0x38, 0xa1, 0x66, 0x2c, 0x78,
+	0x31, 0x90, 0xd4, 0x5d, 0x5f, 0x0e, 0x64, 0x19, 0x8b, 0x95, 0xa7, 0x3e, 0x3c, 0x4a, 0x17, 0x66,
+	0x2c, 0x56, 0x1b, 0xdf, 0xc4, 0x30, 0x76, 0xe1, 0x73, 0x2f, 0x36, 0xb1, 0x50, 0x1c, 0x48, 0x33,
+	0x76, 0x3b, 0x69, 0x20, 0x64, 0xec, 0x16, 0xdd, 0x40, 0x96, 0xb1, 0x5b, 0x7e, 0xe6, 0x6d, 0x8d,
+	0xd8, 0x96, 0x8c, 0xdd, 0xde, 0x03, 0xb7, 0x59, 0xcd, 0x86, 0xd5, 0x42, 0xbb, 0x59, 0x99, 0x2c,
+	0xdb, 0xdc, 0xb5, 0xd3, 0xb2, 0xd9, 0x0a, 0xf6, 0x9d, 0x89, 0x76, 0x9b, 0xad, 0xfc, 0x0a, 0xb4,
+	0xdb, 0x6c, 0xe5, 0x57, 0xa0, 0xdd, 0x66, 0x2b, 0xbf, 0x02, 0xed, 0x36, 0x5b, 0x55, 0xb7, 0xdd,
+	0xac, 0xb4, 0xdf, 0x6c, 0xc6, 0x58, 0x2d, 0xec, 0xb9, 0x7e, 0xc0, 0x66, 0x51, 0x5d, 0x6f, 0xfb,
+	0xc9, 0xc8, 0x17, 0x6d, 0xaf, 0x7d, 0xee, 0x30, 0x2e, 0xc1, 0x26, 0xe5, 0x14, 0x1b, 0xe8, 0xc8,
+	0xa7, 0x3b, 0x94, 0x28, 0x20, 0x51, 0x6a, 0xd8, 0x73, 0x0d, 0xc0, 0x45, 0xa9, 0x7d, 0x14, 0x70,
+	0x51, 0x6a, 0x7f, 0xc9, 0xc0, 0x45, 0xa9, 0xfd, 0xda, 0xc0, 0x45, 0xa9, 0xf9, 0x23, 0x17, 0x05,
+	0x7c, 0x14, 0x60, 0xac, 0xab, 0x0f, 0x89, 0x08, 0x22, 0xc5, 0x66, 0x45, 0xdd, 0x70, 0x51, 0x22,
+	0xb1, 0x59, 0x89, 0x12, 0xe9, 0x17, 0xb9, 0x4b, 0xbd, 0x0a, 0x4b, 0xe2, 0x12, 0xb5, 0xd7, 0x78,
+	0x15, 0x38, 0x10, 0x30, 0xae, 0x5e, 0x5b, 0xf4, 0x42, 0xcd, 0xb8, 0x06, 0xa9, 0x3f, 0xd2, 0x8e,
+	0xe2, 0x40, 0xc0, 0xb8, 0x96, 0x90, 0x17, 0x02, 0xe3, 0xfa, 0x95, 0x50, 0xca, 0xd6, 0xaf, 0x84,
+	0xee, 0x2c, 0x21, 0x37, 0x1d, 0x64, 0xb3, 0x21, 0x7f, 0xe1, 0x44, 0x68, 0xe4, 0xaa, 0x6c, 0xd8,
+	0xae, 0x1b, 0x01, 0x4a, 0xa4, 0x41, 0x1b, 0x42, 0x20, 0x12, 0xb2, 0x81, 0x34, 0xe3, 0xc0, 0x8f,
+	0x00, 0xdd, 0x08, 0x06, 0x65, 0xef, 0xfb, 0xd8, 0xfd, 0x3b, 0xf8, 0xe0, 0xbf, 0x01, 0x00, 0xcb,
+	0x55, 0x91, 0x81, 0x46, 0x06, 0x00, 0x00,
+}
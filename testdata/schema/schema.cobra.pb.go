@@ -0,0 +1,745 @@
+// Code generated by tetratelabs/protoc-gen-cobra.
+// source: schema/schema.proto
+// DO NOT EDIT!
+
+/*
+Package schema is a generated protocol buffer package.
+
+It is generated from these files:
+	schema/schema.proto
+
+It has these top-level commands:
+	CatalogClientCommand
+*/
+
+package schema
+
+import (
+	proto "github.com/golang/protobuf/proto"
+	batch "github.com/tetratelabs/protoc-gen-cobra/batch"
+	cobra "github.com/spf13/cobra"
+	context "golang.org/x/net/context"
+	credentials "google.golang.org/grpc/credentials"
+	describe "github.com/tetratelabs/protoc-gen-cobra/describe"
+	destructive "github.com/tetratelabs/protoc-gen-cobra/destructive"
+	dryrun "github.com/tetratelabs/protoc-gen-cobra/dryrun"
+	editing "github.com/tetratelabs/protoc-gen-cobra/editing"
+	filepath "path/filepath"
+	fmt1 "fmt"
+	grpc "google.golang.org/grpc"
+	health "github.com/tetratelabs/protoc-gen-cobra/health"
+	io "io"
+	iocodec "github.com/tetratelabs/protoc-gen-cobra/iocodec"
+	ioutil "io/ioutil"
+	json "encoding/json"
+	loadtest "github.com/tetratelabs/protoc-gen-cobra/loadtest"
+	log "log"
+	metadata "google.golang.org/grpc/metadata"
+	net "net"
+	oauth "google.golang.org/grpc/credentials/oauth"
+	oauth2 "golang.org/x/oauth2"
+	operation "github.com/tetratelabs/protoc-gen-cobra/operation"
+	os "os"
+	override "github.com/tetratelabs/protoc-gen-cobra/override"
+	pagination "github.com/tetratelabs/protoc-gen-cobra/pagination"
+	pflag "github.com/spf13/pflag"
+	prompting "github.com/tetratelabs/protoc-gen-cobra/prompting"
+	recording "github.com/tetratelabs/protoc-gen-cobra/recording"
+	streaming "github.com/tetratelabs/protoc-gen-cobra/streaming"
+	template "text/template"
+	templating "github.com/tetratelabs/protoc-gen-cobra/templating"
+	time "time"
+	tls "crypto/tls"
+	tracing "github.com/tetratelabs/protoc-gen-cobra/tracing"
+	validation "github.com/tetratelabs/protoc-gen-cobra/validation"
+	verbose "github.com/tetratelabs/protoc-gen-cobra/verbose"
+	x509 "crypto/x509"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+var _DefaultCatalogClientCommandConfig = _NewCatalogClientCommandConfig()
+
+type _CatalogClientCommandConfig struct {
+	ServerAddr         string
+	RequestFile        string
+	Stdin              bool
+	PrintSampleRequest bool
+	ResponseFormat     string
+	Timeout            time.Duration
+	TLS                bool
+	ServerName         string
+	InsecureSkipVerify bool
+	CACertFile         string
+	CertFile           string
+	KeyFile            string
+	AuthToken          string
+	AuthTokenType      string
+	JWTKey             string
+	JWTKeyFile         string
+	SkipValidation     bool
+	LoadTest           loadtest.Options
+	Record             string
+	DryRun             bool
+	Verbose            bool
+	Trace              tracing.Options
+	Set                override.Options
+	Template           templating.Options
+	Edit               bool
+	Interactive        bool
+	Destructive        destructive.Options
+}
+
+func _NewCatalogClientCommandConfig() *_CatalogClientCommandConfig {
+	c := &_CatalogClientCommandConfig{
+		ServerAddr:     "localhost:8080",
+		ResponseFormat: "json",
+		Timeout:        10 * time.Second,
+		AuthTokenType:  "Bearer",
+	}
+	return c
+}
+
+func (o *_CatalogClientCommandConfig) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVarP(&o.ServerAddr, "server-addr", "s", o.ServerAddr, "server address in form of host:port")
+	fs.StringVarP(&o.RequestFile, "request-file", "f", o.RequestFile, "client request file (must be json, yaml, or xml); use \"-\" for stdin + json")
+	fs.BoolVar(&o.Stdin, "stdin", o.Stdin, "read client request from STDIN; alternative for '-f -'")
+	fs.BoolVarP(&o.PrintSampleRequest, "print-sample-request", "p", o.PrintSampleRequest, "print sample request file and exit")
+	fs.StringVarP(&o.ResponseFormat, "response-format", "o", o.ResponseFormat, "response format (json, prettyjson, yaml, or xml)")
+	fs.DurationVar(&o.Timeout, "timeout", o.Timeout, "client connection timeout")
+	fs.BoolVar(&o.TLS, "tls", o.TLS, "enable tls")
+	fs.StringVar(&o.ServerName, "tls-server-name", o.ServerName, "tls server name override")
+	fs.BoolVar(&o.InsecureSkipVerify, "tls-insecure-skip-verify", o.InsecureSkipVerify, "INSECURE: skip tls checks")
+	fs.StringVar(&o.CACertFile, "tls-ca-cert-file", o.CACertFile, "ca certificate file")
+	fs.StringVar(&o.CertFile, "tls-cert-file", o.CertFile, "client certificate file")
+	fs.StringVar(&o.KeyFile, "tls-key-file", o.KeyFile, "client key file")
+	fs.StringVar(&o.AuthToken, "auth-token", o.AuthToken, "authorization token")
+	fs.StringVar(&o.AuthTokenType, "auth-token-type", o.AuthTokenType, "authorization token type")
+	fs.StringVar(&o.JWTKey, "jwt-key", o.JWTKey, "jwt key")
+	fs.StringVar(&o.JWTKeyFile, "jwt-key-file", o.JWTKeyFile, "jwt key file")
+	fs.BoolVar(&o.SkipValidation, "skip-validation", o.SkipValidation, "skip the client-side validation of requests")
+	o.LoadTest.AddFlags(fs)
+	fs.StringVar(&o.Record, "record", o.Record, "record the calls, with their metadata, messages, statuses and timings, to this session file")
+	fs.BoolVar(&o.DryRun, "dry-run", o.DryRun, "print the requests, where they would be sent and the metadata, with secrets redacted, instead of sending them")
+	fs.BoolVarP(&o.Verbose, "verbose", "v", o.Verbose, "log the connection, the tls handshake, and the metadata, message sizes and timings of the calls to stderr, with secrets redacted")
+	o.Trace.AddFlags(fs)
+	o.Set.AddFlags(fs)
+	o.Template.AddFlags(fs)
+	fs.BoolVar(&o.Edit, "edit", o.Edit, "open the request, or a sample of it without one, in $EDITOR before sending it")
+	fs.BoolVar(&o.Interactive, "interactive", o.Interactive, "when stdin is a terminal, prompt for the fields the request doesn't set, and for confirmation before sending it")
+	o.Destructive.AddFlags(fs)
+}
+
+func CatalogClientCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "catalog",
+	}
+	_DefaultCatalogClientCommandConfig.AddFlags(cmd.PersistentFlags())
+
+	for _, s := range _CatalogClientSubCommands {
+		cmd.AddCommand(s())
+	}
+	cmd.AddCommand(_CatalogReplayCommand())
+	cmd.AddCommand(_CatalogHealthCommand())
+	cmd.AddCommand(_CatalogDescribeCommand())
+	return cmd
+}
+
+func _CatalogReplayCommand() *cobra.Command {
+	var compare bool
+	cmd := &cobra.Command{
+		Use:   "replay [file]",
+		Short: "Replay the calls of a session recorded with --record",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			f, err := os.Open(args[0])
+			if err != nil {
+				log.Fatal(err)
+			}
+			defer f.Close()
+			err = _CatalogRoundTrip(nil, nil, func(conn *grpc.ClientConn, cli CatalogClient, in iocodec.Decoder, out iocodec.Encoder) error {
+				if _DefaultCatalogClientCommandConfig.DryRun {
+					reqs, err := recording.Requests(f)
+					if err != nil {
+						return err
+					}
+					return _CatalogDryRun(out, reqs...)
+				}
+				return recording.Replay(conn, f, compare, out, os.Stderr)
+			})
+			if err != nil {
+				log.Fatal(err)
+			}
+		},
+	}
+	cmd.Flags().BoolVar(&compare, "compare", false, "compare the responses and statuses to the recorded ones, field by field, and fail if they differ")
+	return cmd
+}
+
+func _CatalogHealthCommand() *cobra.Command {
+	opts := health.Options{Service: "schema.Catalog"}
+	cmd := &cobra.Command{
+		Use:     "health",
+		Aliases: []string{"ping"},
+		Short:   "Check the health of schema.Catalog with the gRPC health checking protocol, and report how the connection is made",
+		Long:    "Check the health of schema.Catalog with the gRPC health checking protocol, and report how the connection is made: its TLS details, the certificate chain of the server and the latency of the check.\n\nExits with 0 if the service is serving, 2 if the connection fails, 3 if the check fails, and 4 if the service isn't serving.",
+		Args:    cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			cfg := _DefaultCatalogClientCommandConfig
+			em, err := _CatalogEncoderMaker()
+			if err != nil {
+				log.Fatal(err)
+			}
+			if cfg.DryRun {
+				if err := _CatalogDryRun(em.NewEncoder(os.Stdout)); err != nil {
+					log.Fatal(err)
+				}
+				return
+			}
+			err = health.Check(opts, cfg.Timeout, func() (*grpc.ClientConn, error) {
+				conn, _, err := _DialCatalog()
+				return conn, err
+			}, em.NewEncoder(os.Stdout))
+			if err != nil {
+				log.Print(err)
+				os.Exit(health.ExitCode(err))
+			}
+		},
+	}
+	opts.AddFlags(cmd.Flags())
+	return cmd
+}
+
+func _CatalogDescribeCommand() *cobra.Command {
+	var raw bool
+	cmd := &cobra.Command{
+		Use:   "describe [name]",
+		Short: "Print the definition of schema.Catalog, or of one of its methods, messages or enums, with its comments",
+		Long:  "Print the definition of schema.Catalog, or of one of its methods, messages or enums, with its comments, from the descriptors built into the command.\n\nThe name is a method, as named in the proto file or as its command, or the name of a message or enum, relative to the package of the service or in full. A method is followed by its request and response messages.",
+		Args:  cobra.MaximumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			name := ""
+			if len(args) > 0 {
+				name = args[0]
+			}
+			defs, err := describe.Lookup("schema.Catalog", name)
+			if err != nil {
+				log.Fatal(err)
+			}
+			if !raw {
+				if err := describe.Print(os.Stdout, defs); err != nil {
+					log.Fatal(err)
+				}
+				return
+			}
+			em, err := _CatalogEncoderMaker()
+			if err != nil {
+				log.Fatal(err)
+			}
+			enc := em.NewEncoder(os.Stdout)
+			for _, d := range defs {
+				if err := enc.Encode(d.Descriptor); err != nil {
+					log.Fatal(err)
+				}
+			}
+		},
+	}
+	cmd.Flags().BoolVar(&raw, "descriptor", false, "print the descriptors of the definitions in the response format instead of proto source")
+	return cmd
+}
+
+func _DialCatalog() (*grpc.ClientConn, CatalogClient, error) {
+	cfg := _DefaultCatalogClientCommandConfig
+	var v *verbose.Logger
+	var opts []grpc.DialOption
+	if cfg.Trace.Enabled() {
+		t, err := tracing.Start(cfg.Trace)
+		if err != nil {
+			return nil, nil, fmt.Errorf("trace: %v", err)
+		}
+		opts = append(opts, t.DialOptions()...)
+	}
+	if cfg.Verbose {
+		v = verbose.New(os.Stderr)
+		opts = append(opts, v.DialOptions()...)
+	}
+	if cfg.TLS {
+		tlsConfig := &tls.Config{}
+		if cfg.InsecureSkipVerify {
+			tlsConfig.InsecureSkipVerify = true
+		}
+		if cfg.CACertFile != "" {
+			cacert, err := ioutil.ReadFile(cfg.CACertFile)
+			if err != nil {
+				return nil, nil, fmt.Errorf("ca cert: %v", err)
+			}
+			certpool := x509.NewCertPool()
+			certpool.AppendCertsFromPEM(cacert)
+			tlsConfig.RootCAs = certpool
+		}
+		if cfg.CertFile != "" {
+			if cfg.KeyFile == "" {
+				return nil, nil, fmt.Errorf("missing key file")
+			}
+			pair, err := tls.LoadX509KeyPair(cfg.CertFile, cfg.KeyFile)
+			if err != nil {
+				return nil, nil, fmt.Errorf("cert/key: %v", err)
+			}
+			tlsConfig.Certificates = []tls.Certificate{pair}
+		}
+		if cfg.ServerName != "" {
+			tlsConfig.ServerName = cfg.ServerName
+		} else {
+			addr, _, _ := net.SplitHostPort(cfg.ServerAddr)
+			tlsConfig.ServerName = addr
+		}
+		//tlsConfig.BuildNameToCertificate()
+		cred := credentials.NewTLS(tlsConfig)
+		if v != nil {
+			cred = v.Credentials(cred)
+		}
+		opts = append(opts, grpc.WithTransportCredentials(cred))
+	} else {
+		opts = append(opts, grpc.WithInsecure())
+	}
+	if cfg.AuthToken != "" {
+		cred := oauth.NewOauthAccess(&oauth2.Token{
+			AccessToken: cfg.AuthToken,
+			TokenType:   cfg.AuthTokenType,
+		})
+		opts = append(opts, grpc.WithPerRPCCredentials(cred))
+	}
+	if cfg.JWTKey != "" {
+		cred, err := oauth.NewJWTAccessFromKey([]byte(cfg.JWTKey))
+		if err != nil {
+			return nil, nil, fmt.Errorf("jwt key: %v", err)
+		}
+		opts = append(opts, grpc.WithPerRPCCredentials(cred))
+	}
+	if cfg.JWTKeyFile != "" {
+		cred, err := oauth.NewJWTAccessFromFile(cfg.JWTKeyFile)
+		if err != nil {
+			return nil, nil, fmt.Errorf("jwt key file: %v", err)
+		}
+		opts = append(opts, grpc.WithPerRPCCredentials(cred))
+	}
+	if cfg.Record != "" {
+		rec, err := recording.Open(cfg.Record)
+		if err != nil {
+			return nil, nil, fmt.Errorf("record: %v", err)
+		}
+		opts = append(opts, rec.DialOptions()...)
+	}
+	var conn *grpc.ClientConn
+	var err error
+	if v != nil {
+		conn, err = v.Dial(cfg.ServerAddr, cfg.Timeout, opts...)
+	} else {
+		conn, err = grpc.Dial(cfg.ServerAddr, append(opts, grpc.WithBlock(), grpc.WithTimeout(cfg.Timeout))...)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	return conn, NewCatalogClient(conn), nil
+}
+
+// _CatalogEncoderMaker returns the encoder maker of the response format.
+func _CatalogEncoderMaker() (iocodec.EncoderMaker, error) {
+	format := _DefaultCatalogClientCommandConfig.ResponseFormat
+	if format == "" {
+		format = "json"
+	}
+	em, ok := iocodec.DefaultEncoders[format]
+	if !ok {
+		return nil, fmt.Errorf("invalid response format: %q", format)
+	}
+	return em, nil
+}
+
+type _CatalogRoundTripFunc func(conn *grpc.ClientConn, cli CatalogClient, in iocodec.Decoder, out iocodec.Encoder) error
+
+// _CatalogRoundTrip reads the request with prepare, when set, before it
+// dials the server and calls fn. Commands without a request have no sample.
+func _CatalogRoundTrip(sample proto.Message, prepare func(in iocodec.Decoder) error, fn _CatalogRoundTripFunc) error {
+	cfg := _DefaultCatalogClientCommandConfig
+	em, err := _CatalogEncoderMaker()
+	if err != nil {
+		return err
+	}
+	if cfg.PrintSampleRequest {
+		if sample == nil {
+			return fmt.Errorf("no sample request")
+		}
+		return em.NewEncoder(os.Stdout).Encode(iocodec.NewSample(sample))
+	}
+	// read the input request, first from stdin, then from a file, otherwise from args only
+	var d iocodec.Decoder
+	if cfg.Stdin || cfg.RequestFile == "-" {
+		if cfg.Edit {
+			// the editor needs the terminal
+			return fmt.Errorf("--edit can't be used with a request read from stdin")
+		}
+		var in io.Reader = os.Stdin
+		if cfg.Template.Stdin {
+			if in, err = cfg.Template.Reader("stdin", os.Stdin); err != nil {
+				return fmt.Errorf("request template: %v", err)
+			}
+		}
+		d = iocodec.DefaultDecoders["json"].NewDecoder(in)
+	} else if cfg.RequestFile != "" {
+		f, err := os.Open(cfg.RequestFile)
+		if err != nil {
+			return fmt.Errorf("request file: %v", err)
+		}
+		defer f.Close()
+		ext := filepath.Ext(cfg.RequestFile)
+		if len(ext) > 0 && ext[0] == '.' {
+			ext = ext[1:]
+		}
+		dm, ok := iocodec.DefaultDecoders[ext]
+		if !ok {
+			return fmt.Errorf("invalid request file format: %q", ext)
+		}
+		in, err := cfg.Template.Reader(cfg.RequestFile, f)
+		if err != nil {
+			return fmt.Errorf("request template: %v", err)
+		}
+		d = dm.NewDecoder(in)
+	} else {
+		d = iocodec.DefaultDecoders["noop"].NewDecoder(os.Stdin)
+	}
+	d = cfg.Set.Decoder(d)
+	if prepare != nil {
+		if err := prepare(d); err != nil {
+			return err
+		}
+	}
+	if cfg.DryRun {
+		// fn prints what it would send instead
+		return fn(nil, nil, d, em.NewEncoder(os.Stdout))
+	}
+	conn, client, err := _DialCatalog()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	return fn(conn, client, d, em.NewEncoder(os.Stdout))
+}
+
+// _CatalogPrompter returns the prompter asking for the fields of requests,
+// or nil unless --interactive is given and stdin is a terminal.
+func _CatalogPrompter() *prompting.Prompter {
+	if !_DefaultCatalogClientCommandConfig.Interactive || !prompting.IsTerminal(os.Stdin) {
+		return nil
+	}
+	return prompting.New(os.Stdin, os.Stderr)
+}
+
+// _CatalogConfirmDestructive asks for confirmation of a call of the
+// destructive method, showing req unless nil. It's called once the server is
+// dialed, so sample requests and dry runs, which send nothing, aren't asked
+// about.
+func _CatalogConfirmDestructive(command, method string, req proto.Message) error {
+	em, err := _CatalogEncoderMaker()
+	if err != nil {
+		return err
+	}
+	return _DefaultCatalogClientCommandConfig.Destructive.Confirm(command, method, req, em)
+}
+
+// _CatalogEdit opens the editor on m, or on a sample of it when it's empty,
+// in the format of the request file, or else the response format.
+func _CatalogEdit(m proto.Message, check func(proto.Message) error) error {
+	cfg := _DefaultCatalogClientCommandConfig
+	format := cfg.ResponseFormat
+	if ext := filepath.Ext(cfg.RequestFile); len(ext) > 1 {
+		format = ext[1:]
+	}
+	var v interface{} = m
+	if proto.Size(m) == 0 {
+		v = iocodec.NewSample(m)
+	}
+	return editing.Edit(format, v, m, check)
+}
+
+// _CatalogDryRun prints reqs, along with where and how they would be sent.
+func _CatalogDryRun(out iocodec.Encoder, reqs ...proto.Message) error {
+	cfg := _DefaultCatalogClientCommandConfig
+	plan := dryrun.Plan{
+		Target:   cfg.ServerAddr,
+		Timeout:  cfg.Timeout,
+		Metadata: metadata.MD{},
+		Requests: reqs,
+	}
+	if cfg.TLS {
+		plan.TLS = &dryrun.TLS{
+			ServerName:         cfg.ServerName,
+			InsecureSkipVerify: cfg.InsecureSkipVerify,
+			CACertFile:         cfg.CACertFile,
+			CertFile:           cfg.CertFile,
+			KeyFile:            cfg.KeyFile,
+		}
+		if plan.TLS.ServerName == "" {
+			plan.TLS.ServerName, _, _ = net.SplitHostPort(cfg.ServerAddr)
+		}
+	}
+	if cfg.AuthToken != "" {
+		plan.Metadata.Append("authorization", dryrun.Redact(cfg.AuthTokenType+" "+cfg.AuthToken))
+	}
+	if cfg.JWTKey != "" || cfg.JWTKeyFile != "" {
+		plan.Metadata.Append("authorization", "Bearer "+dryrun.Redacted)
+	}
+	return dryrun.Print(plan, out)
+}
+
+// _CatalogLoadTest makes calls with call, as many and as fast as the load
+// test flags say, over conn and the connections it dials, and prints a report
+// of them.
+func _CatalogLoadTest(conn *grpc.ClientConn, call func(ctx context.Context, cli CatalogClient) error) error {
+	dial := func() (*grpc.ClientConn, error) {
+		conn, _, err := _DialCatalog()
+		return conn, err
+	}
+	return loadtest.Run(_DefaultCatalogClientCommandConfig.LoadTest, conn, dial, func(ctx context.Context, conn *grpc.ClientConn) error {
+		return call(ctx, NewCatalogClient(conn))
+	}, os.Stdout)
+}
+
+func _CatalogValidate(m proto.Message, flags *pflag.FlagSet) error {
+	if _DefaultCatalogClientCommandConfig.SkipValidation {
+		return nil
+	}
+	return validation.Validate(m, flags)
+}
+
+func _CatalogPutClientCommand() *cobra.Command {
+	reqArgs := &PutRequest{
+		Item: &Item{
+			Parts: []*Item{},
+		},
+	}
+	var batchOpts batch.Options
+
+	cmd := &cobra.Command{
+		Use:     "put",
+		Long:    "Put client; call by piping a request in to stdin (--stdin), reading a file (--file), or via flags per field",
+		Example: "TODO: print protobuf method comments here",
+		Run: func(cmd *cobra.Command, args []string) {
+			var v PutRequest
+
+			prepare := func(in iocodec.Decoder) error {
+				err := in.Decode(&v)
+				if err != nil {
+					return err
+				}
+
+				proto.Merge(&v, reqArgs)
+
+				prompter := _CatalogPrompter()
+				if prompter != nil {
+					err = prompter.Fields(&v)
+					if err != nil {
+						return err
+					}
+				}
+				if _DefaultCatalogClientCommandConfig.Edit {
+					err = _CatalogEdit(&v, func(m proto.Message) error {
+						return _CatalogValidate(m, cmd.Flags())
+					})
+				} else {
+					err = _CatalogValidate(&v, cmd.Flags())
+				}
+				if err != nil {
+					return err
+				}
+				if prompter == nil || _DefaultCatalogClientCommandConfig.DryRun {
+					return nil
+				}
+				em, err := _CatalogEncoderMaker()
+				if err != nil {
+					return err
+				}
+				return prompter.Confirm(&v, em)
+			}
+
+			if batchOpts.File != "" {
+				// the requests of the batch are read and checked one by one
+				prepare = nil
+			}
+
+			err := _CatalogRoundTrip(&v, prepare, func(conn *grpc.ClientConn, cli CatalogClient, in iocodec.Decoder, out iocodec.Encoder) error {
+
+				if batchOpts.File != "" {
+					if _DefaultCatalogClientCommandConfig.DryRun {
+						return fmt.Errorf("--dry-run can't be used with --batch")
+					}
+					if _DefaultCatalogClientCommandConfig.Edit {
+						return fmt.Errorf("--edit can't be used with --batch")
+					}
+					return batch.Run(batchOpts, func(ctx context.Context, r *batch.Record) (proto.Message, error) {
+						var v PutRequest
+						err := r.Decode(&v)
+						if err != nil {
+							return nil, err
+						}
+						err = _DefaultCatalogClientCommandConfig.Set.Apply(&v)
+						if err != nil {
+							return nil, batch.Invalid(err)
+						}
+						// the defaults of the record must not leak into
+						// the next ones
+						reqArgs := proto.Clone(reqArgs).(*PutRequest)
+
+						proto.Merge(&v, reqArgs)
+						err = _CatalogValidate(&v, cmd.Flags())
+						if err != nil {
+							return nil, batch.Invalid(err)
+						}
+						return cli.Put(ctx, &v)
+					}, out)
+				}
+
+				if _DefaultCatalogClientCommandConfig.DryRun {
+					return _CatalogDryRun(out, &v)
+				}
+				if _DefaultCatalogClientCommandConfig.LoadTest.Enabled() {
+					return _CatalogLoadTest(conn, func(ctx context.Context, cli CatalogClient) error {
+						_, err := cli.Put(ctx, &v)
+						return err
+					})
+				}
+
+				resp, err := cli.Put(context.Background(), &v)
+				if err != nil {
+					return err
+				}
+
+				return out.Encode(resp)
+
+			})
+			if err != nil {
+				log.Fatal(err)
+			}
+		},
+	}
+
+	cmd.PersistentFlags().StringVar(&reqArgs.Item.Name, "item-name", "", "get-comment-from-proto")
+	cmd.PersistentFlags() // Warning: list flags are not yet supported (field "Tags")
+	cmd.PersistentFlags().Int64Var(&reqArgs.Item.Count, "item-count", 0, "get-comment-from-proto")
+	cmd.PersistentFlags().Uint32Var(&reqArgs.Item.Shelf, "item-shelf", 0, "get-comment-from-proto")
+	cmd.PersistentFlags().Float64Var(&reqArgs.Item.Price, "item-price", 0, "get-comment-from-proto")
+	cmd.PersistentFlags().BoolVar(&reqArgs.Item.Available, "item-available", false, "get-comment-from-proto")
+	cmd.PersistentFlags() // Warning: list flags are not yet supported (field "Parts")
+	cmd.PersistentFlags() // Warning: list flags are not yet supported (field "Related")
+	cmd.PersistentFlags() // Warning: list flags are not yet supported (field "Notes")
+	cmd.PersistentFlags().StringVar(&reqArgs.Url, "url", "", "get-comment-from-proto")
+	cmd.PersistentFlags().BytesBase64Var(&reqArgs.Raw, "raw", []byte{}, "get-comment-from-proto")
+	batchOpts.AddFlags(cmd.PersistentFlags())
+
+	return cmd
+}
+
+var _CatalogClientSubCommands = []func() *cobra.Command{
+	_CatalogPutClientCommand,
+}
+
+func init() {
+	iocodec.RegisterComments(map[string]string{
+		"schema.PutRequest":      " PutRequest puts an item into the catalog.",
+		"schema.PutRequest.item": " The item to put.",
+		"schema.PutRequest.url":  " The URL the item was imported from.",
+	})
+}
+
+func init() { describe.Register(_descriptorSet_Schema_98b0d2c3e7e0142d) }
+
+var _descriptorSet_Schema_98b0d2c3e7e0142d = []byte{
+	// 1254 bytes of a gzipped FileDescriptorSet
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x96, 0xdb, 0x6e, 0xdb, 0x46,
+	0x13, 0xc7, 0xb9, 0x3b, 0xcb, 0x83, 0x46, 0xb2, 0x2c, 0x8f, 0x15, 0x47, 0x96, 0x0f, 0xa2, 0x65,
+	0x3b, 0x56, 0x10, 0x40, 0xc6, 0xe7, 0x7c, 0x87, 0x7c, 0x6d, 0xaf, 0x9c, 0xb8, 0x89, 0xeb, 0x22,
+	0x11, 0xd8, 0x04, 0x05, 0x7a, 0x13, 0xd0, 0x32, 0x2d, 0x0b, 0x91, 0x48, 0x55, 0xa2, 0x62, 0xf8,
+	0xa6, 0x2f, 0x50, 0xf4, 0xae, 0x4f, 0xd0, 0xcb, 0xbe, 0x40, 0xdf, 0xa4, 0xaf, 0xd2, 0x5e, 0x16,
+	0xb3, 0xe4, 0x52, 0x46, 0x1c, 0x34, 0xe8, 0x95, 0xf8, 0xdb, 0x39, 0xec, 0x7f, 0x66, 0x87, 0x5c,
+	0xe1, 0xef, 0x35, 0x5c, 0x9d, 0xf5, 0xaf, 0xa2, 0x71, 0x78, 0x98, 0xfd, 0x74, 0x27, 0xd3, 0x24,
+	0x4d, 0xc8, 0xc9, 0xa8, 0xb9, 0x31, 0x48, 0x92, 0xc1, 0x28, 0x3a, 0xd4, 0xab, 0xe7, 0xf3, 0xcb,
+	0xc3, 0x68, 0x3c, 0x49, 0x6f, 0x32, 0xa7, 0x66, 0xeb, 0x43, 0x63, 0x3a, 0x1c, 0x47, 0xb3, 0x34,
+	0x1c, 0x4f, 0x32, 0x87, 0xf6, 0x8f, 0x80, 0xd8, 0x9b, 0xa7, 0x41, 0xf4, 0xfd, 0x3c, 0x9a, 0xa5,
+	0xe4, 0xa3, 0x1a, 0xa6, 0xd1, 0xb8, 0x21, 0x7c, 0xd1, 0x29, 0x1f, 0x55, 0xba, 0xf9, 0x8e, 0xa7,
+	0x69, 0x34, 0x0e, 0xb4, 0x85, 0xfe, 0x8f, 0xee, 0x34, 0x1a, 0x85, 0x69, 0x74, 0xd1, 0x90, 0x3e,
+	0x74, 0xca, 0x47, 0x2d, 0xe3, 0xb4, 0x48, 0xd3, 0x0d, 0x32, 0x8f, 0x93, 0x38, 0x9d, 0xde, 0x04,
+	0xc6, 0x9f, 0x1e, 0xa3, 0x1d, 0x27, 0x69, 0x34, 0x6b, 0x80, 0x0e, 0xdc, 0xfa, 0x48, 0xe0, 0x4b,
+	0xb6, 0x67, 0x61, 0x99, 0x2f, 0x11, 0xc2, 0x7c, 0x3a, 0x6a, 0x28, 0x5f, 0x74, 0x4a, 0x2f, 0xac,
+	0x80, 0x81, 0xd7, 0xa6, 0xe1, 0x75, 0xc3, 0xf6, 0x45, 0xa7, 0xc2, 0x6b, 0xd3, 0xf0, 0x9a, 0xfe,
+	0x83, 0xde, 0x64, 0x9e, 0xbe, 0xe5, 0xfa, 0x1a, 0x8e, 0x56, 0xdf, 0xec, 0x66, 0xc5, 0x77, 0x4d,
+	0xf1, 0xdd, 0xd7, 0xa6, 0xf8, 0xc0, 0x9d, 0xcc, 0x53, 0xa6, 0xe6, 0x0b, 0xac, 0xdc, 0x16, 0x4b,
+	0x35, 0x84, 0x77, 0xd1, 0x8d, 0xae, 0xbf, 0x14, 0xf0, 0x23, 0xb5, 0xd1, 0x7e, 0x1f, 0x8e, 0xe6,
+	0x51, 0x43, 0x7e, 0xa4, 0x27, 0x99, 0xe9, 0x33, 0xf9, 0x44, 0x34, 0x9f, 0x20, 0x2e, 0xd4, 0xdf,
+	0xce, 0x03, 0x59, 0x9e, 0xfa, 0xed, 0x3c, 0xa5, 0x5b, 0x91, 0xc7, 0x1e, 0x3a, 0xb3, 0x64, 0x3e,
+	0xed, 0x47, 0xed, 0x9f, 0x24, 0x2a, 0xce, 0x4b, 0x84, 0x2a, 0x0e, 0xc7, 0x51, 0xae, 0x43, 0x3f,
+	0xd3, 0x3e, 0xaa, 0x77, 0xc3, 0xf8, 0x42, 0xc7, 0x57, 0x8f, 0x56, 0x6e, 0xeb, 0xe8, 0x9e, 0x0d,
+	0xe3, 0x8b, 0x40, 0x9b, 0x39, 0x34, 0x0d, 0x07, 0x59, 0x93, 0x4b, 0x81, 0x7e, 0xe6, 0xbd, 0xfb,
+	0xc9, 0x3c, 0x4e, 0x75, 0x1b, 0x21, 0xc8, 0x80, 0x57, 0x67, 0x57, 0xd1, 0xe8, 0x52, 0x37, 0x72,
+	0x29, 0xc8, 0x80, 0x57, 0x27, 0xd3, 0x61, 0x3f, 0xeb, 0xa2, 0x08, 0x32, 0xa0, 0x4d, 0x2c, 0x85,
+	0xef, 0xc3, 0xe1, 0x28, 0x3c, 0x1f, 0x45, 0x0d, 0xd7, 0x17, 0x1d, 0x2f, 0x58, 0x2c, 0x70, 0x8f,
+	0x26, 0xe1, 0x34, 0x9d, 0x35, 0x3c, 0x1f, 0xee, 0xf6, 0x48, 0x9b, 0xda, 0x47, 0xa8, 0x58, 0x25,
+	0xd5, 0xb1, 0x76, 0x76, 0xfa, 0xf2, 0xd9, 0xdb, 0x37, 0x2f, 0xbf, 0xe9, 0x9d, 0x3c, 0x3d, 0xfd,
+	0xf2, 0xf4, 0xe4, 0x59, 0xcd, 0x22, 0x0f, 0xd5, 0xf1, 0xab, 0x57, 0x67, 0x35, 0x41, 0x88, 0x4e,
+	0x70, 0xf2, 0xf4, 0x55, 0xf0, 0xac, 0x26, 0x8f, 0xbe, 0x40, 0xf7, 0x69, 0x98, 0x86, 0xa3, 0x64,
+	0x40, 0xff, 0x42, 0xe8, 0xcd, 0x53, 0xa2, 0xbb, 0x43, 0xd3, 0x5c, 0xbb, 0x73, 0xd0, 0x27, 0xfc,
+	0x0a, 0x7c, 0xf5, 0x33, 0xa2, 0x43, 0xca, 0xb2, 0x3a, 0x02, 0x3d, 0x14, 0x15, 0x02, 0xcb, 0x22,
+	0x7e, 0x92, 0x04, 0xd2, 0x5a, 0xc6, 0x12, 0x4a, 0xb0, 0x08, 0x94, 0xb5, 0xaf, 0x1f, 0x05, 0x81,
+	0x6d, 0x3d, 0x44, 0x44, 0xe9, 0x58, 0xa4, 0x5c, 0xab, 0x24, 0x10, 0x11, 0x1c, 0x4b, 0x10, 0xb8,
+	0xde, 0x32, 0x96, 0x51, 0x39, 0x96, 0xb4, 0x08, 0x3c, 0xf9, 0x5f, 0xac, 0xa0, 0xcd, 0x20, 0x08,
+	0x3c, 0xa7, 0x64, 0x48, 0x12, 0x78, 0x58, 0x37, 0x04, 0x04, 0x5e, 0xeb, 0xdf, 0xf8, 0x3f, 0x94,
+	0xca, 0x22, 0x55, 0xb1, 0xee, 0x8b, 0xe6, 0x23, 0x7f, 0x21, 0xda, 0x9f, 0xcc, 0xd3, 0x99, 0x1f,
+	0xc6, 0x3e, 0xbf, 0x54, 0xfe, 0x30, 0x4e, 0x13, 0x3f, 0xbd, 0x8a, 0xfc, 0x7e, 0x56, 0x6d, 0x17,
+	0x79, 0x6f, 0xc5, 0x1b, 0x54, 0x3c, 0xc2, 0x16, 0x2a, 0xa5, 0xf7, 0xae, 0xca, 0x5a, 0x93, 0xfc,
+	0xd7, 0x57, 0x51, 0x16, 0x96, 0x26, 0x9c, 0xa6, 0x8b, 0xbc, 0x27, 0x3b, 0x38, 0xec, 0xe1, 0x18,
+	0x12, 0x04, 0x55, 0xb7, 0x6c, 0x08, 0x08, 0xaa, 0x55, 0x5d, 0x86, 0xb2, 0xa4, 0x20, 0x58, 0x96,
+	0x7e, 0x6e, 0x12, 0x0e, 0xd3, 0xaa, 0x21, 0xb6, 0xd5, 0x37, 0x0c, 0x01, 0xc1, 0xf2, 0x76, 0x2b,
+	0x0f, 0x93, 0x04, 0x35, 0xd9, 0xca, 0x4d, 0xd2, 0x61, 0xaa, 0x1b, 0x12, 0x04, 0xb5, 0x7b, 0x4d,
+	0x43, 0x40, 0x50, 0xdb, 0xda, 0xc6, 0x0a, 0x87, 0x79, 0x16, 0xa9, 0x15, 0x79, 0x0f, 0x32, 0x9b,
+	0xc7, 0xba, 0x56, 0xbc, 0x2a, 0x1e, 0xe9, 0x94, 0x40, 0xb0, 0xaa, 0x56, 0x9b, 0xfb, 0xba, 0xa8,
+	0x37, 0xc1, 0xd7, 0x7e, 0x6a, 0x8a, 0xbb, 0x0e, 0x67, 0xfe, 0x70, 0x3c, 0x49, 0xa6, 0x69, 0x74,
+	0xe1, 0x5f, 0x4e, 0x93, 0x71, 0x51, 0x27, 0xd8, 0x1c, 0x54, 0x90, 0x20, 0x58, 0x2d, 0x57, 0x0d,
+	0x71, 0xc2, 0x15, 0xca, 0x05, 0x2b, 0x82, 0xba, 0xa2, 0xdc, 0xa4, 0x6c, 0xa6, 0x92, 0x21, 0x41,
+	0x50, 0xc7, 0x25, 0x43, 0x40, 0x50, 0xaf, 0xad, 0xe4, 0x61, 0x36, 0xc1, 0x9a, 0x7c, 0x98, 0x9b,
+	0x6c, 0x87, 0xc9, 0x34, 0xc4, 0x16, 0x04, 0x6b, 0x9b, 0x7b, 0x86, 0x80, 0x60, 0xed, 0xa0, 0xc3,
+	0x43, 0xa3, 0x04, 0xa9, 0x75, 0x1e, 0x35, 0x3e, 0x38, 0x6e, 0xe2, 0xba, 0x57, 0xc1, 0x3d, 0x54,
+	0x4a, 0xf0, 0xf9, 0x6f, 0xc8, 0x16, 0x34, 0xd7, 0x74, 0x91, 0xfc, 0x92, 0xfa, 0xc9, 0xa5, 0x39,
+	0xfb, 0xbc, 0x2a, 0xa1, 0x0f, 0x7b, 0xc3, 0x2d, 0xe3, 0x12, 0x3a, 0x4a, 0x64, 0xc7, 0xbd, 0xa9,
+	0xd6, 0xb1, 0x8a, 0x6e, 0x86, 0x82, 0xb9, 0xbe, 0x60, 0x49, 0xb0, 0x79, 0xbf, 0x51, 0xb8, 0x0b,
+	0x82, 0x2d, 0xb5, 0x54, 0x98, 0x85, 0x66, 0x6f, 0xc1, 0x92, 0x60, 0xab, 0x5c, 0x29, 0xdc, 0x25,
+	0xc1, 0xb6, 0x5a, 0x2e, 0xcc, 0x1c, 0xbe, 0xad, 0x70, 0xc1, 0x6c, 0x5f, 0xaa, 0xea, 0x7e, 0x08,
+	0x96, 0xe2, 0xcb, 0xac, 0x8d, 0x42, 0x5a, 0x36, 0x93, 0x67, 0x48, 0x10, 0xf8, 0xa5, 0x25, 0x43,
+	0x40, 0xe0, 0xe7, 0x6d, 0x14, 0x9c, 0x73, 0x47, 0xd6, 0x72, 0x13, 0x4f, 0xd9, 0x4e, 0x3e, 0x9c,
+	0x42, 0xcb, 0xdb, 0xc9, 0x87, 0x53, 0xe8, 0x29, 0xdb, 0xc9, 0x87, 0x53, 0xf0, 0xd6, 0xed, 0xbc,
+	0xdf, 0x42, 0xf2, 0x11, 0xb6, 0x25, 0x1a, 0xb2, 0x09, 0xda, 0xe5, 0x15, 0x43, 0x82, 0xa0, 0x4d,
+	0x6b, 0x86, 0x80, 0xa0, 0xbd, 0xde, 0xcc, 0x93, 0x00, 0xc1, 0x6e, 0x21, 0x99, 0x07, 0x66, 0x57,
+	0xba, 0x86, 0x04, 0xc1, 0xae, 0x67, 0x24, 0xf3, 0xc0, 0xec, 0x16, 0x92, 0x15, 0xc1, 0x5e, 0xfe,
+	0x2a, 0x08, 0x3d, 0x30, 0x7b, 0x45, 0xa5, 0x3c, 0x30, 0x7b, 0xa5, 0xaa, 0x21, 0x20, 0xd8, 0xcb,
+	0xe7, 0x4c, 0xb0, 0xac, 0xfd, 0x22, 0xcc, 0xd6, 0x64, 0xc2, 0x78, 0x60, 0xf6, 0x8b, 0x30, 0x1e,
+	0x98, 0xfd, 0x22, 0xcc, 0x21, 0x78, 0x20, 0xef, 0xe5, 0x26, 0xc7, 0x66, 0x32, 0x0d, 0x72, 0x04,
+	0xc1, 0x03, 0xd7, 0x34, 0xcf, 0x01, 0x82, 0x07, 0xab, 0xf5, 0x3c, 0xcc, 0x25, 0x38, 0x90, 0xcd,
+	0xdc, 0xe4, 0x2a, 0x26, 0xd3, 0x20, 0xd7, 0x21, 0x38, 0x28, 0x2f, 0x1b, 0x12, 0x04, 0x07, 0x35,
+	0xb3, 0x81, 0x0b, 0x04, 0x07, 0x8d, 0xf5, 0x73, 0x47, 0x7f, 0x26, 0x1f, 0xe3, 0x1f, 0x02, 0x3f,
+	0xf5, 0xf7, 0x80, 0x96, 0x3f, 0xf8, 0xb2, 0xb6, 0x3f, 0xc7, 0x52, 0x71, 0x8b, 0x52, 0x03, 0xdd,
+	0x59, 0xd4, 0x4f, 0xe2, 0x8b, 0x59, 0x7e, 0xd1, 0x19, 0xe4, 0x4b, 0x24, 0x0e, 0xe3, 0x64, 0xa6,
+	0x2f, 0x2b, 0x3b, 0xc8, 0xe0, 0xf8, 0x07, 0x5c, 0xed, 0x27, 0xe3, 0x0f, 0xbf, 0xd6, 0xc7, 0xd5,
+	0x22, 0x63, 0x8f, 0x97, 0x7a, 0xe2, 0xbb, 0x47, 0x83, 0x61, 0x7a, 0x35, 0x3f, 0xef, 0xf6, 0x93,
+	0xf1, 0xe1, 0x20, 0x19, 0x85, 0xf1, 0x60, 0x21, 0x71, 0x92, 0xde, 0x4c, 0xa2, 0xd9, 0x42, 0xe9,
+	0x9f, 0x42, 0xfc, 0x22, 0xe1, 0x79, 0xef, 0xf8, 0x57, 0xb9, 0xfd, 0x3c, 0xcb, 0xdc, 0x33, 0xf7,
+	0xc0, 0xb7, 0xd1, 0x68, 0x74, 0x16, 0x27, 0xd7, 0xf1, 0x6b, 0x8e, 0x29, 0x2a, 0xff, 0x4d, 0xe0,
+	0xdf, 0xfd, 0x6b, 0xba, 0x5b, 0xb5, 0x8b, 0xb6, 0xbe, 0x52, 0x8e, 0xdf, 0x7f, 0xbc, 0x02, 0xd4,
+	0x56, 0xa3, 0xfe, 0xe0, 0xd3, 0xea, 0xf5, 0x6e, 0xff, 0x5c, 0xf9, 0x5f, 0x03, 0x00, 0xc4, 0x8d,
+	0xfc, 0x6e, 0x0d, 0x0a, 0x00, 0x00,
+}
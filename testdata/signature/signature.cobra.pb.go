@@ -0,0 +1,919 @@
+// Code generated by tetratelabs/protoc-gen-cobra.
+// source: signature/signature.proto
+// DO NOT EDIT!
+
+/*
+Package signature is a generated protocol buffer package.
+
+It is generated from these files:
+	signature/signature.proto
+
+It has these top-level commands:
+	CrudClientCommand
+*/
+
+package signature
+
+import (
+	proto "github.com/golang/protobuf/proto"
+	batch "github.com/tetratelabs/protoc-gen-cobra/batch"
+	cobra "github.com/spf13/cobra"
+	context "golang.org/x/net/context"
+	credentials "google.golang.org/grpc/credentials"
+	describe "github.com/tetratelabs/protoc-gen-cobra/describe"
+	destructive "github.com/tetratelabs/protoc-gen-cobra/destructive"
+	dryrun "github.com/tetratelabs/protoc-gen-cobra/dryrun"
+	editing "github.com/tetratelabs/protoc-gen-cobra/editing"
+	filepath "path/filepath"
+	fmt1 "fmt"
+	grpc "google.golang.org/grpc"
+	health "github.com/tetratelabs/protoc-gen-cobra/health"
+	io "io"
+	iocodec "github.com/tetratelabs/protoc-gen-cobra/iocodec"
+	ioutil "io/ioutil"
+	json "encoding/json"
+	loadtest "github.com/tetratelabs/protoc-gen-cobra/loadtest"
+	log "log"
+	metadata "google.golang.org/grpc/metadata"
+	net "net"
+	oauth "google.golang.org/grpc/credentials/oauth"
+	oauth2 "golang.org/x/oauth2"
+	operation "github.com/tetratelabs/protoc-gen-cobra/operation"
+	os "os"
+	override "github.com/tetratelabs/protoc-gen-cobra/override"
+	pagination "github.com/tetratelabs/protoc-gen-cobra/pagination"
+	pflag "github.com/spf13/pflag"
+	prompting "github.com/tetratelabs/protoc-gen-cobra/prompting"
+	recording "github.com/tetratelabs/protoc-gen-cobra/recording"
+	streaming "github.com/tetratelabs/protoc-gen-cobra/streaming"
+	template "text/template"
+	templating "github.com/tetratelabs/protoc-gen-cobra/templating"
+	time "time"
+	tls "crypto/tls"
+	tracing "github.com/tetratelabs/protoc-gen-cobra/tracing"
+	validation "github.com/tetratelabs/protoc-gen-cobra/validation"
+	verbose "github.com/tetratelabs/protoc-gen-cobra/verbose"
+	x509 "crypto/x509"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+var _DefaultCrudClientCommandConfig = _NewCrudClientCommandConfig()
+
+type _CrudClientCommandConfig struct {
+	ServerAddr         string
+	RequestFile        string
+	Stdin              bool
+	PrintSampleRequest bool
+	ResponseFormat     string
+	Timeout            time.Duration
+	TLS                bool
+	ServerName         string
+	InsecureSkipVerify bool
+	CACertFile         string
+	CertFile           string
+	KeyFile            string
+	AuthToken          string
+	AuthTokenType      string
+	JWTKey             string
+	JWTKeyFile         string
+	SkipValidation     bool
+	LoadTest           loadtest.Options
+	Record             string
+	DryRun             bool
+	Verbose            bool
+	Trace              tracing.Options
+	Set                override.Options
+	Template           templating.Options
+	Edit               bool
+	Interactive        bool
+	Destructive        destructive.Options
+}
+
+func _NewCrudClientCommandConfig() *_CrudClientCommandConfig {
+	c := &_CrudClientCommandConfig{
+		ServerAddr:     "localhost:8080",
+		ResponseFormat: "json",
+		Timeout:        10 * time.Second,
+		AuthTokenType:  "Bearer",
+	}
+	return c
+}
+
+func (o *_CrudClientCommandConfig) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVarP(&o.ServerAddr, "server-addr", "s", o.ServerAddr, "server address in form of host:port")
+	fs.StringVarP(&o.RequestFile, "request-file", "f", o.RequestFile, "client request file (must be json, yaml, or xml); use \"-\" for stdin + json")
+	fs.BoolVar(&o.Stdin, "stdin", o.Stdin, "read client request from STDIN; alternative for '-f -'")
+	fs.BoolVarP(&o.PrintSampleRequest, "print-sample-request", "p", o.PrintSampleRequest, "print sample request file and exit")
+	fs.StringVarP(&o.ResponseFormat, "response-format", "o", o.ResponseFormat, "response format (json, prettyjson, yaml, or xml)")
+	fs.DurationVar(&o.Timeout, "timeout", o.Timeout, "client connection timeout")
+	fs.BoolVar(&o.TLS, "tls", o.TLS, "enable tls")
+	fs.StringVar(&o.ServerName, "tls-server-name", o.ServerName, "tls server name override")
+	fs.BoolVar(&o.InsecureSkipVerify, "tls-insecure-skip-verify", o.InsecureSkipVerify, "INSECURE: skip tls checks")
+	fs.StringVar(&o.CACertFile, "tls-ca-cert-file", o.CACertFile, "ca certificate file")
+	fs.StringVar(&o.CertFile, "tls-cert-file", o.CertFile, "client certificate file")
+	fs.StringVar(&o.KeyFile, "tls-key-file", o.KeyFile, "client key file")
+	fs.StringVar(&o.AuthToken, "auth-token", o.AuthToken, "authorization token")
+	fs.StringVar(&o.AuthTokenType, "auth-token-type", o.AuthTokenType, "authorization token type")
+	fs.StringVar(&o.JWTKey, "jwt-key", o.JWTKey, "jwt key")
+	fs.StringVar(&o.JWTKeyFile, "jwt-key-file", o.JWTKeyFile, "jwt key file")
+	fs.BoolVar(&o.SkipValidation, "skip-validation", o.SkipValidation, "skip the client-side validation of requests")
+	o.LoadTest.AddFlags(fs)
+	fs.StringVar(&o.Record, "record", o.Record, "record the calls, with their metadata, messages, statuses and timings, to this session file")
+	fs.BoolVar(&o.DryRun, "dry-run", o.DryRun, "print the requests, where they would be sent and the metadata, with secrets redacted, instead of sending them")
+	fs.BoolVarP(&o.Verbose, "verbose", "v", o.Verbose, "log the connection, the tls handshake, and the metadata, message sizes and timings of the calls to stderr, with secrets redacted")
+	o.Trace.AddFlags(fs)
+	o.Set.AddFlags(fs)
+	o.Template.AddFlags(fs)
+	fs.BoolVar(&o.Edit, "edit", o.Edit, "open the request, or a sample of it without one, in $EDITOR before sending it")
+	fs.BoolVar(&o.Interactive, "interactive", o.Interactive, "when stdin is a terminal, prompt for the fields the request doesn't set, and for confirmation before sending it")
+	o.Destructive.AddFlags(fs)
+}
+
+func CrudClientCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "crud",
+	}
+	_DefaultCrudClientCommandConfig.AddFlags(cmd.PersistentFlags())
+
+	for _, s := range _CrudClientSubCommands {
+		cmd.AddCommand(s())
+	}
+	cmd.AddCommand(_CrudReplayCommand())
+	cmd.AddCommand(_CrudHealthCommand())
+	cmd.AddCommand(_CrudDescribeCommand())
+	return cmd
+}
+
+func _CrudReplayCommand() *cobra.Command {
+	var compare bool
+	cmd := &cobra.Command{
+		Use:   "replay [file]",
+		Short: "Replay the calls of a session recorded with --record",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			f, err := os.Open(args[0])
+			if err != nil {
+				log.Fatal(err)
+			}
+			defer f.Close()
+			err = _CrudRoundTrip(nil, nil, func(conn *grpc.ClientConn, cli CrudClient, in iocodec.Decoder, out iocodec.Encoder) error {
+				if _DefaultCrudClientCommandConfig.DryRun {
+					reqs, err := recording.Requests(f)
+					if err != nil {
+						return err
+					}
+					return _CrudDryRun(out, reqs...)
+				}
+				return recording.Replay(conn, f, compare, out, os.Stderr)
+			})
+			if err != nil {
+				log.Fatal(err)
+			}
+		},
+	}
+	cmd.Flags().BoolVar(&compare, "compare", false, "compare the responses and statuses to the recorded ones, field by field, and fail if they differ")
+	return cmd
+}
+
+func _CrudHealthCommand() *cobra.Command {
+	opts := health.Options{Service: "signature.Crud"}
+	cmd := &cobra.Command{
+		Use:     "health",
+		Aliases: []string{"ping"},
+		Short:   "Check the health of signature.Crud with the gRPC health checking protocol, and report how the connection is made",
+		Long:    "Check the health of signature.Crud with the gRPC health checking protocol, and report how the connection is made: its TLS details, the certificate chain of the server and the latency of the check.\n\nExits with 0 if the service is serving, 2 if the connection fails, 3 if the check fails, and 4 if the service isn't serving.",
+		Args:    cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			cfg := _DefaultCrudClientCommandConfig
+			em, err := _CrudEncoderMaker()
+			if err != nil {
+				log.Fatal(err)
+			}
+			if cfg.DryRun {
+				if err := _CrudDryRun(em.NewEncoder(os.Stdout)); err != nil {
+					log.Fatal(err)
+				}
+				return
+			}
+			err = health.Check(opts, cfg.Timeout, func() (*grpc.ClientConn, error) {
+				conn, _, err := _DialCrud()
+				return conn, err
+			}, em.NewEncoder(os.Stdout))
+			if err != nil {
+				log.Print(err)
+				os.Exit(health.ExitCode(err))
+			}
+		},
+	}
+	opts.AddFlags(cmd.Flags())
+	return cmd
+}
+
+func _CrudDescribeCommand() *cobra.Command {
+	var raw bool
+	cmd := &cobra.Command{
+		Use:   "describe [name]",
+		Short: "Print the definition of signature.Crud, or of one of its methods, messages or enums, with its comments",
+		Long:  "Print the definition of signature.Crud, or of one of its methods, messages or enums, with its comments, from the descriptors built into the command.\n\nThe name is a method, as named in the proto file or as its command, or the name of a message or enum, relative to the package of the service or in full. A method is followed by its request and response messages.",
+		Args:  cobra.MaximumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			name := ""
+			if len(args) > 0 {
+				name = args[0]
+			}
+			defs, err := describe.Lookup("signature.Crud", name)
+			if err != nil {
+				log.Fatal(err)
+			}
+			if !raw {
+				if err := describe.Print(os.Stdout, defs); err != nil {
+					log.Fatal(err)
+				}
+				return
+			}
+			em, err := _CrudEncoderMaker()
+			if err != nil {
+				log.Fatal(err)
+			}
+			enc := em.NewEncoder(os.Stdout)
+			for _, d := range defs {
+				if err := enc.Encode(d.Descriptor); err != nil {
+					log.Fatal(err)
+				}
+			}
+		},
+	}
+	cmd.Flags().BoolVar(&raw, "descriptor", false, "print the descriptors of the definitions in the response format instead of proto source")
+	return cmd
+}
+
+func _DialCrud() (*grpc.ClientConn, CrudClient, error) {
+	cfg := _DefaultCrudClientCommandConfig
+	var v *verbose.Logger
+	var opts []grpc.DialOption
+	if cfg.Trace.Enabled() {
+		t, err := tracing.Start(cfg.Trace)
+		if err != nil {
+			return nil, nil, fmt.Errorf("trace: %v", err)
+		}
+		opts = append(opts, t.DialOptions()...)
+	}
+	if cfg.Verbose {
+		v = verbose.New(os.Stderr)
+		opts = append(opts, v.DialOptions()...)
+	}
+	if cfg.TLS {
+		tlsConfig := &tls.Config{}
+		if cfg.InsecureSkipVerify {
+			tlsConfig.InsecureSkipVerify = true
+		}
+		if cfg.CACertFile != "" {
+			cacert, err := ioutil.ReadFile(cfg.CACertFile)
+			if err != nil {
+				return nil, nil, fmt.Errorf("ca cert: %v", err)
+			}
+			certpool := x509.NewCertPool()
+			certpool.AppendCertsFromPEM(cacert)
+			tlsConfig.RootCAs = certpool
+		}
+		if cfg.CertFile != "" {
+			if cfg.KeyFile == "" {
+				return nil, nil, fmt.Errorf("missing key file")
+			}
+			pair, err := tls.LoadX509KeyPair(cfg.CertFile, cfg.KeyFile)
+			if err != nil {
+				return nil, nil, fmt.Errorf("cert/key: %v", err)
+			}
+			tlsConfig.Certificates = []tls.Certificate{pair}
+		}
+		if cfg.ServerName != "" {
+			tlsConfig.ServerName = cfg.ServerName
+		} else {
+			addr, _, _ := net.SplitHostPort(cfg.ServerAddr)
+			tlsConfig.ServerName = addr
+		}
+		//tlsConfig.BuildNameToCertificate()
+		cred := credentials.NewTLS(tlsConfig)
+		if v != nil {
+			cred = v.Credentials(cred)
+		}
+		opts = append(opts, grpc.WithTransportCredentials(cred))
+	} else {
+		opts = append(opts, grpc.WithInsecure())
+	}
+	if cfg.AuthToken != "" {
+		cred := oauth.NewOauthAccess(&oauth2.Token{
+			AccessToken: cfg.AuthToken,
+			TokenType:   cfg.AuthTokenType,
+		})
+		opts = append(opts, grpc.WithPerRPCCredentials(cred))
+	}
+	if cfg.JWTKey != "" {
+		cred, err := oauth.NewJWTAccessFromKey([]byte(cfg.JWTKey))
+		if err != nil {
+			return nil, nil, fmt.Errorf("jwt key: %v", err)
+		}
+		opts = append(opts, grpc.WithPerRPCCredentials(cred))
+	}
+	if cfg.JWTKeyFile != "" {
+		cred, err := oauth.NewJWTAccessFromFile(cfg.JWTKeyFile)
+		if err != nil {
+			return nil, nil, fmt.Errorf("jwt key file: %v", err)
+		}
+		opts = append(opts, grpc.WithPerRPCCredentials(cred))
+	}
+	if cfg.Record != "" {
+		rec, err := recording.Open(cfg.Record)
+		if err != nil {
+			return nil, nil, fmt.Errorf("record: %v", err)
+		}
+		opts = append(opts, rec.DialOptions()...)
+	}
+	var conn *grpc.ClientConn
+	var err error
+	if v != nil {
+		conn, err = v.Dial(cfg.ServerAddr, cfg.Timeout, opts...)
+	} else {
+		conn, err = grpc.Dial(cfg.ServerAddr, append(opts, grpc.WithBlock(), grpc.WithTimeout(cfg.Timeout))...)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	return conn, NewCrudClient(conn), nil
+}
+
+// _CrudEncoderMaker returns the encoder maker of the response format.
+func _CrudEncoderMaker() (iocodec.EncoderMaker, error) {
+	format := _DefaultCrudClientCommandConfig.ResponseFormat
+	if format == "" {
+		format = "json"
+	}
+	em, ok := iocodec.DefaultEncoders[format]
+	if !ok {
+		return nil, fmt.Errorf("invalid response format: %q", format)
+	}
+	return em, nil
+}
+
+type _CrudRoundTripFunc func(conn *grpc.ClientConn, cli CrudClient, in iocodec.Decoder, out iocodec.Encoder) error
+
+// _CrudRoundTrip reads the request with prepare, when set, before it
+// dials the server and calls fn. Commands without a request have no sample.
+func _CrudRoundTrip(sample proto.Message, prepare func(in iocodec.Decoder) error, fn _CrudRoundTripFunc) error {
+	cfg := _DefaultCrudClientCommandConfig
+	em, err := _CrudEncoderMaker()
+	if err != nil {
+		return err
+	}
+	if cfg.PrintSampleRequest {
+		if sample == nil {
+			return fmt.Errorf("no sample request")
+		}
+		return em.NewEncoder(os.Stdout).Encode(iocodec.NewSample(sample))
+	}
+	// read the input request, first from stdin, then from a file, otherwise from args only
+	var d iocodec.Decoder
+	if cfg.Stdin || cfg.RequestFile == "-" {
+		if cfg.Edit {
+			// the editor needs the terminal
+			return fmt.Errorf("--edit can't be used with a request read from stdin")
+		}
+		var in io.Reader = os.Stdin
+		if cfg.Template.Stdin {
+			if in, err = cfg.Template.Reader("stdin", os.Stdin); err != nil {
+				return fmt.Errorf("request template: %v", err)
+			}
+		}
+		d = iocodec.DefaultDecoders["json"].NewDecoder(in)
+	} else if cfg.RequestFile != "" {
+		f, err := os.Open(cfg.RequestFile)
+		if err != nil {
+			return fmt.Errorf("request file: %v", err)
+		}
+		defer f.Close()
+		ext := filepath.Ext(cfg.RequestFile)
+		if len(ext) > 0 && ext[0] == '.' {
+			ext = ext[1:]
+		}
+		dm, ok := iocodec.DefaultDecoders[ext]
+		if !ok {
+			return fmt.Errorf("invalid request file format: %q", ext)
+		}
+		in, err := cfg.Template.Reader(cfg.RequestFile, f)
+		if err != nil {
+			return fmt.Errorf("request template: %v", err)
+		}
+		d = dm.NewDecoder(in)
+	} else {
+		d = iocodec.DefaultDecoders["noop"].NewDecoder(os.Stdin)
+	}
+	d = cfg.Set.Decoder(d)
+	if prepare != nil {
+		if err := prepare(d); err != nil {
+			return err
+		}
+	}
+	if cfg.DryRun {
+		// fn prints what it would send instead
+		return fn(nil, nil, d, em.NewEncoder(os.Stdout))
+	}
+	conn, client, err := _DialCrud()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	return fn(conn, client, d, em.NewEncoder(os.Stdout))
+}
+
+// _CrudPrompter returns the prompter asking for the fields of requests,
+// or nil unless --interactive is given and stdin is a terminal.
+func _CrudPrompter() *prompting.Prompter {
+	if !_DefaultCrudClientCommandConfig.Interactive || !prompting.IsTerminal(os.Stdin) {
+		return nil
+	}
+	return prompting.New(os.Stdin, os.Stderr)
+}
+
+// _CrudConfirmDestructive asks for confirmation of a call of the
+// destructive method, showing req unless nil. It's called once the server is
+// dialed, so sample requests and dry runs, which send nothing, aren't asked
+// about.
+func _CrudConfirmDestructive(command, method string, req proto.Message) error {
+	em, err := _CrudEncoderMaker()
+	if err != nil {
+		return err
+	}
+	return _DefaultCrudClientCommandConfig.Destructive.Confirm(command, method, req, em)
+}
+
+// _CrudEdit opens the editor on m, or on a sample of it when it's empty,
+// in the format of the request file, or else the response format.
+func _CrudEdit(m proto.Message, check func(proto.Message) error) error {
+	cfg := _DefaultCrudClientCommandConfig
+	format := cfg.ResponseFormat
+	if ext := filepath.Ext(cfg.RequestFile); len(ext) > 1 {
+		format = ext[1:]
+	}
+	var v interface{} = m
+	if proto.Size(m) == 0 {
+		v = iocodec.NewSample(m)
+	}
+	return editing.Edit(format, v, m, check)
+}
+
+// _CrudDryRun prints reqs, along with where and how they would be sent.
+func _CrudDryRun(out iocodec.Encoder, reqs ...proto.Message) error {
+	cfg := _DefaultCrudClientCommandConfig
+	plan := dryrun.Plan{
+		Target:   cfg.ServerAddr,
+		Timeout:  cfg.Timeout,
+		Metadata: metadata.MD{},
+		Requests: reqs,
+	}
+	if cfg.TLS {
+		plan.TLS = &dryrun.TLS{
+			ServerName:         cfg.ServerName,
+			InsecureSkipVerify: cfg.InsecureSkipVerify,
+			CACertFile:         cfg.CACertFile,
+			CertFile:           cfg.CertFile,
+			KeyFile:            cfg.KeyFile,
+		}
+		if plan.TLS.ServerName == "" {
+			plan.TLS.ServerName, _, _ = net.SplitHostPort(cfg.ServerAddr)
+		}
+	}
+	if cfg.AuthToken != "" {
+		plan.Metadata.Append("authorization", dryrun.Redact(cfg.AuthTokenType+" "+cfg.AuthToken))
+	}
+	if cfg.JWTKey != "" || cfg.JWTKeyFile != "" {
+		plan.Metadata.Append("authorization", "Bearer "+dryrun.Redacted)
+	}
+	return dryrun.Print(plan, out)
+}
+
+// _CrudLoadTest makes calls with call, as many and as fast as the load
+// test flags say, over conn and the connections it dials, and prints a report
+// of them.
+func _CrudLoadTest(conn *grpc.ClientConn, call func(ctx context.Context, cli CrudClient) error) error {
+	dial := func() (*grpc.ClientConn, error) {
+		conn, _, err := _DialCrud()
+		return conn, err
+	}
+	return loadtest.Run(_DefaultCrudClientCommandConfig.LoadTest, conn, dial, func(ctx context.Context, conn *grpc.ClientConn) error {
+		return call(ctx, NewCrudClient(conn))
+	}, os.Stdout)
+}
+
+func _CrudValidate(m proto.Message, flags *pflag.FlagSet) error {
+	if _DefaultCrudClientCommandConfig.SkipValidation {
+		return nil
+	}
+	return validation.Validate(m, flags)
+}
+
+func _CrudGetClientCommand() *cobra.Command {
+	reqArgs := &GetRequest{}
+	var batchOpts batch.Options
+
+	cmd := &cobra.Command{
+		Use:     "get [name]",
+		Args:    cobra.MaximumNArgs(1),
+		Long:    "Get client; call by piping a request in to stdin (--stdin), reading a file (--file), or via flags per field",
+		Example: "TODO: print protobuf method comments here",
+		Run: func(cmd *cobra.Command, args []string) {
+			for i, name := range []string{"name"}[:len(args)] {
+				if cmd.Flags().Changed(name) {
+					log.Fatalf("argument %d sets --%s, which is already set", i+1, name)
+				}
+				if err := cmd.Flags().Set(name, args[i]); err != nil {
+					log.Fatalf("argument %d: %v", i+1, err)
+				}
+			}
+
+			var v GetRequest
+
+			prepare := func(in iocodec.Decoder) error {
+				err := in.Decode(&v)
+				if err != nil {
+					return err
+				}
+
+				proto.Merge(&v, reqArgs)
+
+				prompter := _CrudPrompter()
+				if prompter != nil {
+					err = prompter.Fields(&v)
+					if err != nil {
+						return err
+					}
+				}
+				if _DefaultCrudClientCommandConfig.Edit {
+					err = _CrudEdit(&v, func(m proto.Message) error {
+						return _CrudValidate(m, cmd.Flags())
+					})
+				} else {
+					err = _CrudValidate(&v, cmd.Flags())
+				}
+				if err != nil {
+					return err
+				}
+				if prompter == nil || _DefaultCrudClientCommandConfig.DryRun {
+					return nil
+				}
+				em, err := _CrudEncoderMaker()
+				if err != nil {
+					return err
+				}
+				return prompter.Confirm(&v, em)
+			}
+
+			if batchOpts.File != "" {
+				// the requests of the batch are read and checked one by one
+				prepare = nil
+			}
+
+			err := _CrudRoundTrip(&v, prepare, func(conn *grpc.ClientConn, cli CrudClient, in iocodec.Decoder, out iocodec.Encoder) error {
+
+				if batchOpts.File != "" {
+					if _DefaultCrudClientCommandConfig.DryRun {
+						return fmt.Errorf("--dry-run can't be used with --batch")
+					}
+					if _DefaultCrudClientCommandConfig.Edit {
+						return fmt.Errorf("--edit can't be used with --batch")
+					}
+					return batch.Run(batchOpts, func(ctx context.Context, r *batch.Record) (proto.Message, error) {
+						var v GetRequest
+						err := r.Decode(&v)
+						if err != nil {
+							return nil, err
+						}
+						err = _DefaultCrudClientCommandConfig.Set.Apply(&v)
+						if err != nil {
+							return nil, batch.Invalid(err)
+						}
+						// the defaults of the record must not leak into
+						// the next ones
+						reqArgs := proto.Clone(reqArgs).(*GetRequest)
+
+						proto.Merge(&v, reqArgs)
+						err = _CrudValidate(&v, cmd.Flags())
+						if err != nil {
+							return nil, batch.Invalid(err)
+						}
+						return cli.Get(ctx, &v)
+					}, out)
+				}
+
+				if _DefaultCrudClientCommandConfig.DryRun {
+					return _CrudDryRun(out, &v)
+				}
+				if _DefaultCrudClientCommandConfig.LoadTest.Enabled() {
+					return _CrudLoadTest(conn, func(ctx context.Context, cli CrudClient) error {
+						_, err := cli.Get(ctx, &v)
+						return err
+					})
+				}
+
+				resp, err := cli.Get(context.Background(), &v)
+				if err != nil {
+					return err
+				}
+
+				return out.Encode(resp)
+
+			})
+			if err != nil {
+				log.Fatal(err)
+			}
+		},
+	}
+
+	cmd.PersistentFlags().StringVar(&reqArgs.Name, "name", "", "get-comment-from-proto")
+	batchOpts.AddFlags(cmd.PersistentFlags())
+
+	return cmd
+}
+
+func _CrudCreateClientCommand() *cobra.Command {
+	reqArgs := &CreateRequest{
+		Item: &Item{},
+	}
+	var batchOpts batch.Options
+
+	cmd := &cobra.Command{
+		Use:     "create [parent] [new-name] [new-size]",
+		Args:    cobra.MaximumNArgs(3),
+		Long:    "Create client; call by piping a request in to stdin (--stdin), reading a file (--file), or via flags per field",
+		Example: "TODO: print protobuf method comments here",
+		Run: func(cmd *cobra.Command, args []string) {
+			for i, name := range []string{"parent", "new-name", "new-size"}[:len(args)] {
+				if cmd.Flags().Changed(name) {
+					log.Fatalf("argument %d sets --%s, which is already set", i+1, name)
+				}
+				if err := cmd.Flags().Set(name, args[i]); err != nil {
+					log.Fatalf("argument %d: %v", i+1, err)
+				}
+			}
+
+			var v CreateRequest
+
+			prepare := func(in iocodec.Decoder) error {
+				err := in.Decode(&v)
+				if err != nil {
+					return err
+				}
+
+				proto.Merge(&v, reqArgs)
+
+				prompter := _CrudPrompter()
+				if prompter != nil {
+					err = prompter.Fields(&v)
+					if err != nil {
+						return err
+					}
+				}
+				if _DefaultCrudClientCommandConfig.Edit {
+					err = _CrudEdit(&v, func(m proto.Message) error {
+						return _CrudValidate(m, cmd.Flags())
+					})
+				} else {
+					err = _CrudValidate(&v, cmd.Flags())
+				}
+				if err != nil {
+					return err
+				}
+				if prompter == nil || _DefaultCrudClientCommandConfig.DryRun {
+					return nil
+				}
+				em, err := _CrudEncoderMaker()
+				if err != nil {
+					return err
+				}
+				return prompter.Confirm(&v, em)
+			}
+
+			if batchOpts.File != "" {
+				// the requests of the batch are read and checked one by one
+				prepare = nil
+			}
+
+			err := _CrudRoundTrip(&v, prepare, func(conn *grpc.ClientConn, cli CrudClient, in iocodec.Decoder, out iocodec.Encoder) error {
+
+				if batchOpts.File != "" {
+					if _DefaultCrudClientCommandConfig.DryRun {
+						return fmt.Errorf("--dry-run can't be used with --batch")
+					}
+					if _DefaultCrudClientCommandConfig.Edit {
+						return fmt.Errorf("--edit can't be used with --batch")
+					}
+					return batch.Run(batchOpts, func(ctx context.Context, r *batch.Record) (proto.Message, error) {
+						var v CreateRequest
+						err := r.Decode(&v)
+						if err != nil {
+							return nil, err
+						}
+						err = _DefaultCrudClientCommandConfig.Set.Apply(&v)
+						if err != nil {
+							return nil, batch.Invalid(err)
+						}
+						// the defaults of the record must not leak into
+						// the next ones
+						reqArgs := proto.Clone(reqArgs).(*CreateRequest)
+
+						proto.Merge(&v, reqArgs)
+						err = _CrudValidate(&v, cmd.Flags())
+						if err != nil {
+							return nil, batch.Invalid(err)
+						}
+						return cli.Create(ctx, &v)
+					}, out)
+				}
+
+				if _DefaultCrudClientCommandConfig.DryRun {
+					return _CrudDryRun(out, &v)
+				}
+				if _DefaultCrudClientCommandConfig.LoadTest.Enabled() {
+					return _CrudLoadTest(conn, func(ctx context.Context, cli CrudClient) error {
+						_, err := cli.Create(ctx, &v)
+						return err
+					})
+				}
+
+				resp, err := cli.Create(context.Background(), &v)
+				if err != nil {
+					return err
+				}
+
+				return out.Encode(resp)
+
+			})
+			if err != nil {
+				log.Fatal(err)
+			}
+		},
+	}
+
+	cmd.PersistentFlags().StringVar(&reqArgs.Parent, "parent", "", "get-comment-from-proto")
+	cmd.PersistentFlags().StringVar(&reqArgs.Item.Name, "new-name", "", "get-comment-from-proto")
+	cmd.PersistentFlags().SetAnnotation("new-name", validation.FieldAnnotation, []string{"item.name"})
+	cmd.PersistentFlags().Int64Var(&reqArgs.Item.Size, "new-size", 0, "get-comment-from-proto")
+	cmd.PersistentFlags().SetAnnotation("new-size", validation.FieldAnnotation, []string{"item.size"})
+	batchOpts.AddFlags(cmd.PersistentFlags())
+
+	return cmd
+}
+
+func _CrudWatchClientCommand() *cobra.Command {
+	reqArgs := &GetRequest{}
+	var streamOpts streaming.Options
+
+	cmd := &cobra.Command{
+		Use:     "watch",
+		Long:    "Watch client; call by piping a request in to stdin (--stdin), reading a file (--file), or via flags per field",
+		Example: "TODO: print protobuf method comments here",
+		Run: func(cmd *cobra.Command, args []string) {
+			var v GetRequest
+
+			prepare := func(in iocodec.Decoder) error {
+				err := in.Decode(&v)
+				if err != nil {
+					return err
+				}
+
+				proto.Merge(&v, reqArgs)
+
+				prompter := _CrudPrompter()
+				if prompter != nil {
+					err = prompter.Fields(&v)
+					if err != nil {
+						return err
+					}
+				}
+				if _DefaultCrudClientCommandConfig.Edit {
+					err = _CrudEdit(&v, func(m proto.Message) error {
+						return _CrudValidate(m, cmd.Flags())
+					})
+				} else {
+					err = _CrudValidate(&v, cmd.Flags())
+				}
+				if err != nil {
+					return err
+				}
+				if prompter == nil || _DefaultCrudClientCommandConfig.DryRun {
+					return nil
+				}
+				em, err := _CrudEncoderMaker()
+				if err != nil {
+					return err
+				}
+				return prompter.Confirm(&v, em)
+			}
+
+			err := _CrudRoundTrip(&v, prepare, func(conn *grpc.ClientConn, cli CrudClient, in iocodec.Decoder, out iocodec.Encoder) error {
+
+				if _DefaultCrudClientCommandConfig.DryRun {
+					return _CrudDryRun(out, &v)
+				}
+				if _DefaultCrudClientCommandConfig.LoadTest.Enabled() {
+					return _CrudLoadTest(conn, func(ctx context.Context, cli CrudClient) error {
+						stream, err := cli.Watch(ctx, &v)
+						if err != nil {
+							return err
+						}
+						for {
+							_, err := stream.Recv()
+							if err == io.EOF {
+								return nil
+							}
+							if err != nil {
+								return err
+							}
+						}
+					})
+				}
+
+				return streaming.Receive(streamOpts, func(ctx context.Context) (func() (proto.Message, error), error) {
+					stream, err := cli.Watch(ctx, &v)
+					if err != nil {
+						return nil, err
+					}
+					return func() (proto.Message, error) { return stream.Recv() }, nil
+				}, out)
+
+			})
+			if err != nil {
+				log.Fatal(err)
+			}
+		},
+	}
+
+	cmd.PersistentFlags().StringVar(&reqArgs.Name, "name", "", "get-comment-from-proto")
+	streamOpts.AddFlags(cmd.PersistentFlags())
+
+	return cmd
+}
+
+var _CrudClientSubCommands = []func() *cobra.Command{
+	_CrudGetClientCommand,
+	_CrudCreateClientCommand,
+	_CrudWatchClientCommand,
+}
+
+func init() { describe.Register(_descriptorSet_Signature_dc31a2643bd01138) }
+
+var _descriptorSet_Signature_dc31a2643bd01138 = []byte{
+	// 696 bytes of a gzipped FileDescriptorSet
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xcf, 0x6e, 0x22, 0x47,
+	0x10, 0xc6, 0xbb, 0xbb, 0x9a, 0x61, 0xa6, 0x66, 0x30, 0x43, 0x39, 0x60, 0x33, 0x24, 0x66, 0x20,
+	0x8a, 0xe2, 0xc4, 0x08, 0x22, 0x47, 0x8a, 0xe4, 0x1c, 0x22, 0x39, 0x3e, 0x38, 0xc9, 0x25, 0x12,
+	0x17, 0x5f, 0x33, 0xc6, 0x6d, 0x18, 0x09, 0x18, 0xc2, 0x8c, 0x65, 0x29, 0xc7, 0x3c, 0xc1, 0xde,
+	0xf7, 0xb2, 0x97, 0xbd, 0xec, 0x0b, 0xec, 0xbb, 0x58, 0xfb, 0x30, 0xab, 0xee, 0x99, 0xc6, 0xec,
+	0x9f, 0xcb, 0xde, 0xea, 0x57, 0x55, 0xf3, 0xd5, 0x57, 0xd5, 0x08, 0x7c, 0x85, 0xd8, 0xcd, 0xd3,
+	0xf9, 0x3a, 0x29, 0x1e, 0xb6, 0x6a, 0xb2, 0x8b, 0xc6, 0x9b, 0x6d, 0x56, 0x64, 0xe4, 0xed, 0x12,
+	0xd1, 0xd1, 0x3c, 0xcb, 0xe6, 0x4b, 0x35, 0x49, 0x36, 0xe9, 0x64, 0xb6, 0x4c, 0xd5, 0xba, 0x28,
+	0x7b, 0xa2, 0xc3, 0x6c, 0x53, 0xa4, 0xd9, 0x3a, 0x9f, 0xcc, 0xb2, 0xdb, 0x6d, 0x52, 0x26, 0x87,
+	0x31, 0xe2, 0xb5, 0x2a, 0xa6, 0xea, 0xdf, 0x07, 0x95, 0x17, 0x44, 0x28, 0xd7, 0xc9, 0x4a, 0x1d,
+	0xf3, 0x98, 0x9f, 0x7a, 0x53, 0x13, 0x0f, 0x6f, 0xb0, 0x71, 0xb5, 0x55, 0x49, 0xa1, 0x6c, 0x53,
+	0x07, 0x9d, 0x4d, 0xb2, 0x55, 0xeb, 0xa2, 0x6a, 0xab, 0x88, 0xc6, 0x28, 0xd3, 0x42, 0xad, 0x8e,
+	0x45, 0xcc, 0x4f, 0xfd, 0xf3, 0xe6, 0xf8, 0xd9, 0xe3, 0x9f, 0x85, 0x5a, 0xfd, 0xee, 0xbd, 0xf9,
+	0xbf, 0x5b, 0x43, 0x58, 0xab, 0xc7, 0xa9, 0xe9, 0x1b, 0x8e, 0x51, 0xea, 0xc2, 0xe7, 0x86, 0xea,
+	0x5c, 0x9e, 0xfe, 0xa7, 0x8c, 0x16, 0x4c, 0x4d, 0x7c, 0xfe, 0x8e, 0xa3, 0xbc, 0xda, 0x3e, 0xdc,
+	0xd1, 0x2f, 0x08, 0xd7, 0xaa, 0xa0, 0xf6, 0xde, 0x84, 0xe7, 0x1d, 0xa2, 0x8f, 0x07, 0x0f, 0xeb,
+	0x4f, 0x97, 0xa5, 0xe8, 0x3f, 0xe8, 0x94, 0x9b, 0xd0, 0xf1, 0x5e, 0xcf, 0x07, 0xcb, 0x7d, 0xfa,
+	0xf5, 0xd9, 0xd3, 0xa5, 0x5f, 0x2e, 0x38, 0xd2, 0xae, 0x9f, 0x2e, 0xa3, 0x3d, 0x1a, 0x6b, 0xdd,
+	0x32, 0xd2, 0x16, 0xe9, 0x57, 0xac, 0xdd, 0x24, 0xc5, 0x6c, 0xf1, 0xc5, 0xde, 0x7e, 0xe2, 0x7f,
+	0xbd, 0x75, 0xd0, 0x21, 0xc9, 0xd8, 0x80, 0xa3, 0x8b, 0x3c, 0x20, 0x60, 0x8c, 0x74, 0x24, 0x08,
+	0x04, 0x23, 0xf4, 0x50, 0x00, 0x23, 0x90, 0x6c, 0x60, 0x42, 0x4e, 0x50, 0x63, 0xdf, 0x20, 0xa2,
+	0x70, 0x18, 0xc9, 0x3a, 0x3b, 0xe4, 0x88, 0x08, 0x0e, 0xe3, 0x04, 0x75, 0x37, 0xc0, 0x00, 0xa5,
+	0xc3, 0x04, 0x23, 0xe9, 0x0a, 0x04, 0x0c, 0xb0, 0xa6, 0x89, 0x13, 0xb8, 0x8e, 0x67, 0x49, 0x10,
+	0xb8, 0xf8, 0x95, 0x25, 0x20, 0x70, 0xfb, 0xdf, 0x5a, 0x92, 0x04, 0x9e, 0x3c, 0xc7, 0x26, 0xba,
+	0x86, 0x5e, 0xba, 0xac, 0x4c, 0x94, 0xb2, 0x9c, 0xa4, 0x2f, 0x9a, 0x56, 0x96, 0x73, 0x02, 0xdf,
+	0x09, 0x2c, 0x09, 0x02, 0xbf, 0x11, 0x59, 0x02, 0x02, 0xff, 0xbb, 0x1f, 0x2c, 0x49, 0x82, 0x86,
+	0xbc, 0xc0, 0xbf, 0x8d, 0x2c, 0x2f, 0x65, 0x1b, 0xf2, 0x22, 0xfa, 0x2d, 0x2e, 0x16, 0x2a, 0xbe,
+	0x4f, 0xb7, 0x79, 0x11, 0xef, 0x0e, 0x14, 0xeb, 0xbb, 0xe4, 0x71, 0x12, 0xaf, 0x54, 0x9e, 0x27,
+	0x73, 0x5d, 0x57, 0xcb, 0xbb, 0x51, 0xfc, 0xb8, 0x48, 0x67, 0x8b, 0x78, 0x91, 0xe4, 0xf1, 0x3a,
+	0x8b, 0xef, 0x97, 0xc9, 0x1c, 0xf7, 0xe4, 0x0f, 0xe4, 0x1f, 0xd8, 0xdc, 0xc9, 0xf3, 0x32, 0x51,
+	0xba, 0x16, 0x24, 0x43, 0x41, 0xd6, 0xb5, 0xe0, 0x04, 0xa1, 0xe3, 0x5b, 0x12, 0x04, 0x61, 0xd0,
+	0xb1, 0xe4, 0x10, 0x84, 0x83, 0xef, 0x2d, 0x01, 0x41, 0x78, 0x3a, 0xb2, 0x24, 0x09, 0x5a, 0xbb,
+	0xd3, 0x88, 0x72, 0x07, 0x9d, 0x40, 0x14, 0x92, 0x91, 0x6c, 0xb3, 0x23, 0xf3, 0x12, 0x52, 0x5f,
+	0xbb, 0xed, 0x12, 0xfa, 0x28, 0xa5, 0x7e, 0x09, 0xe8, 0x08, 0xd2, 0x1a, 0x1a, 0x6a, 0x9a, 0x5c,
+	0x4b, 0x9c, 0xa0, 0xe3, 0x35, 0x2c, 0x01, 0x41, 0x27, 0x6c, 0x19, 0x39, 0x4e, 0xb2, 0xcb, 0xbe,
+	0x2e, 0xe5, 0xf4, 0x95, 0xbb, 0x6e, 0xdb, 0xc8, 0x71, 0x2d, 0x17, 0x09, 0xf3, 0x76, 0x1a, 0x6a,
+	0x9a, 0x5c, 0x4b, 0x9c, 0x20, 0xf2, 0x9a, 0x96, 0x80, 0x20, 0xa2, 0xc3, 0xea, 0x33, 0x4e, 0xd0,
+	0x13, 0xa3, 0xaa, 0xc4, 0x1d, 0x4d, 0x8e, 0x25, 0x5d, 0xab, 0xfb, 0x96, 0x80, 0xa0, 0x77, 0x60,
+	0x45, 0xb8, 0x4b, 0xd0, 0x0b, 0xcf, 0x30, 0x44, 0xcf, 0xd0, 0xeb, 0x17, 0xfa, 0xe7, 0xd7, 0x6b,
+	0xfd, 0x68, 0x5c, 0x0a, 0x92, 0x27, 0x6c, 0x50, 0xba, 0xd4, 0x13, 0x4e, 0xdc, 0xc0, 0x8c, 0x13,
+	0xda, 0x65, 0xbf, 0x5a, 0x5a, 0x18, 0x97, 0xfd, 0xca, 0xa5, 0x30, 0x2e, 0xfb, 0xd5, 0xd2, 0xc2,
+	0xb8, 0xec, 0x87, 0xad, 0xea, 0x33, 0x4e, 0x10, 0x8b, 0x56, 0x55, 0xe2, 0x35, 0x4d, 0x75, 0x4b,
+	0xba, 0xe6, 0x06, 0x96, 0x80, 0x20, 0x6e, 0x86, 0xb7, 0x8e, 0xf9, 0x2b, 0xfb, 0xf9, 0xfd, 0x00,
+	0xa1, 0xf0, 0x51, 0x61, 0x23, 0x05, 0x00, 0x00,
+}
@@ -0,0 +1,848 @@
+// Code generated by tetratelabs/protoc-gen-cobra.
+// source: streams/streams.proto
+// DO NOT EDIT!
+
+/*
+Package streams is a generated protocol buffer package.
+
+It is generated from these files:
+	streams/streams.proto
+
+It has these top-level commands:
+	ChatClientCommand
+*/
+
+package streams
+
+import (
+	proto "github.com/golang/protobuf/proto"
+	batch "github.com/tetratelabs/protoc-gen-cobra/batch"
+	cobra "github.com/spf13/cobra"
+	context "golang.org/x/net/context"
+	credentials "google.golang.org/grpc/credentials"
+	describe "github.com/tetratelabs/protoc-gen-cobra/describe"
+	destructive "github.com/tetratelabs/protoc-gen-cobra/destructive"
+	dryrun "github.com/tetratelabs/protoc-gen-cobra/dryrun"
+	editing "github.com/tetratelabs/protoc-gen-cobra/editing"
+	filepath "path/filepath"
+	fmt1 "fmt"
+	grpc "google.golang.org/grpc"
+	health "github.com/tetratelabs/protoc-gen-cobra/health"
+	io "io"
+	iocodec "github.com/tetratelabs/protoc-gen-cobra/iocodec"
+	ioutil "io/ioutil"
+	json "encoding/json"
+	loadtest "github.com/tetratelabs/protoc-gen-cobra/loadtest"
+	log "log"
+	metadata "google.golang.org/grpc/metadata"
+	net "net"
+	oauth "google.golang.org/grpc/credentials/oauth"
+	oauth2 "golang.org/x/oauth2"
+	operation "github.com/tetratelabs/protoc-gen-cobra/operation"
+	os "os"
+	override "github.com/tetratelabs/protoc-gen-cobra/override"
+	pagination "github.com/tetratelabs/protoc-gen-cobra/pagination"
+	pflag "github.com/spf13/pflag"
+	prompting "github.com/tetratelabs/protoc-gen-cobra/prompting"
+	recording "github.com/tetratelabs/protoc-gen-cobra/recording"
+	streaming "github.com/tetratelabs/protoc-gen-cobra/streaming"
+	template "text/template"
+	templating "github.com/tetratelabs/protoc-gen-cobra/templating"
+	time "time"
+	tls "crypto/tls"
+	tracing "github.com/tetratelabs/protoc-gen-cobra/tracing"
+	validation "github.com/tetratelabs/protoc-gen-cobra/validation"
+	verbose "github.com/tetratelabs/protoc-gen-cobra/verbose"
+	x509 "crypto/x509"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+var _DefaultChatClientCommandConfig = _NewChatClientCommandConfig()
+
+type _ChatClientCommandConfig struct {
+	ServerAddr         string
+	RequestFile        string
+	Stdin              bool
+	PrintSampleRequest bool
+	ResponseFormat     string
+	Timeout            time.Duration
+	TLS                bool
+	ServerName         string
+	InsecureSkipVerify bool
+	CACertFile         string
+	CertFile           string
+	KeyFile            string
+	AuthToken          string
+	AuthTokenType      string
+	JWTKey             string
+	JWTKeyFile         string
+	SkipValidation     bool
+	LoadTest           loadtest.Options
+	Record             string
+	DryRun             bool
+	Verbose            bool
+	Trace              tracing.Options
+	Set                override.Options
+	Template           templating.Options
+	Edit               bool
+	Interactive        bool
+	Destructive        destructive.Options
+}
+
+func _NewChatClientCommandConfig() *_ChatClientCommandConfig {
+	c := &_ChatClientCommandConfig{
+		ServerAddr:     "localhost:8080",
+		ResponseFormat: "json",
+		Timeout:        10 * time.Second,
+		AuthTokenType:  "Bearer",
+	}
+	return c
+}
+
+func (o *_ChatClientCommandConfig) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVarP(&o.ServerAddr, "server-addr", "s", o.ServerAddr, "server address in form of host:port")
+	fs.StringVarP(&o.RequestFile, "request-file", "f", o.RequestFile, "client request file (must be json, yaml, or xml); use \"-\" for stdin + json")
+	fs.BoolVar(&o.Stdin, "stdin", o.Stdin, "read client request from STDIN; alternative for '-f -'")
+	fs.BoolVarP(&o.PrintSampleRequest, "print-sample-request", "p", o.PrintSampleRequest, "print sample request file and exit")
+	fs.StringVarP(&o.ResponseFormat, "response-format", "o", o.ResponseFormat, "response format (json, prettyjson, yaml, or xml)")
+	fs.DurationVar(&o.Timeout, "timeout", o.Timeout, "client connection timeout")
+	fs.BoolVar(&o.TLS, "tls", o.TLS, "enable tls")
+	fs.StringVar(&o.ServerName, "tls-server-name", o.ServerName, "tls server name override")
+	fs.BoolVar(&o.InsecureSkipVerify, "tls-insecure-skip-verify", o.InsecureSkipVerify, "INSECURE: skip tls checks")
+	fs.StringVar(&o.CACertFile, "tls-ca-cert-file", o.CACertFile, "ca certificate file")
+	fs.StringVar(&o.CertFile, "tls-cert-file", o.CertFile, "client certificate file")
+	fs.StringVar(&o.KeyFile, "tls-key-file", o.KeyFile, "client key file")
+	fs.StringVar(&o.AuthToken, "auth-token", o.AuthToken, "authorization token")
+	fs.StringVar(&o.AuthTokenType, "auth-token-type", o.AuthTokenType, "authorization token type")
+	fs.StringVar(&o.JWTKey, "jwt-key", o.JWTKey, "jwt key")
+	fs.StringVar(&o.JWTKeyFile, "jwt-key-file", o.JWTKeyFile, "jwt key file")
+	fs.BoolVar(&o.SkipValidation, "skip-validation", o.SkipValidation, "skip the client-side validation of requests")
+	o.LoadTest.AddFlags(fs)
+	fs.StringVar(&o.Record, "record", o.Record, "record the calls, with their metadata, messages, statuses and timings, to this session file")
+	fs.BoolVar(&o.DryRun, "dry-run", o.DryRun, "print the requests, where they would be sent and the metadata, with secrets redacted, instead of sending them")
+	fs.BoolVarP(&o.Verbose, "verbose", "v", o.Verbose, "log the connection, the tls handshake, and the metadata, message sizes and timings of the calls to stderr, with secrets redacted")
+	o.Trace.AddFlags(fs)
+	o.Set.AddFlags(fs)
+	o.Template.AddFlags(fs)
+	fs.BoolVar(&o.Edit, "edit", o.Edit, "open the request, or a sample of it without one, in $EDITOR before sending it")
+	fs.BoolVar(&o.Interactive, "interactive", o.Interactive, "when stdin is a terminal, prompt for the fields the request doesn't set, and for confirmation before sending it")
+	o.Destructive.AddFlags(fs)
+}
+
+func ChatClientCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "chat",
+	}
+	_DefaultChatClientCommandConfig.AddFlags(cmd.PersistentFlags())
+
+	for _, s := range _ChatClientSubCommands {
+		cmd.AddCommand(s())
+	}
+	cmd.AddCommand(_ChatReplayCommand())
+	cmd.AddCommand(_ChatHealthCommand())
+	cmd.AddCommand(_ChatDescribeCommand())
+	return cmd
+}
+
+func _ChatReplayCommand() *cobra.Command {
+	var compare bool
+	cmd := &cobra.Command{
+		Use:   "replay [file]",
+		Short: "Replay the calls of a session recorded with --record",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			f, err := os.Open(args[0])
+			if err != nil {
+				log.Fatal(err)
+			}
+			defer f.Close()
+			err = _ChatRoundTrip(nil, nil, func(conn *grpc.ClientConn, cli ChatClient, in iocodec.Decoder, out iocodec.Encoder) error {
+				if _DefaultChatClientCommandConfig.DryRun {
+					reqs, err := recording.Requests(f)
+					if err != nil {
+						return err
+					}
+					return _ChatDryRun(out, reqs...)
+				}
+				return recording.Replay(conn, f, compare, out, os.Stderr)
+			})
+			if err != nil {
+				log.Fatal(err)
+			}
+		},
+	}
+	cmd.Flags().BoolVar(&compare, "compare", false, "compare the responses and statuses to the recorded ones, field by field, and fail if they differ")
+	return cmd
+}
+
+func _ChatHealthCommand() *cobra.Command {
+	opts := health.Options{Service: "streams.Chat"}
+	cmd := &cobra.Command{
+		Use:     "health",
+		Aliases: []string{"ping"},
+		Short:   "Check the health of streams.Chat with the gRPC health checking protocol, and report how the connection is made",
+		Long:    "Check the health of streams.Chat with the gRPC health checking protocol, and report how the connection is made: its TLS details, the certificate chain of the server and the latency of the check.\n\nExits with 0 if the service is serving, 2 if the connection fails, 3 if the check fails, and 4 if the service isn't serving.",
+		Args:    cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			cfg := _DefaultChatClientCommandConfig
+			em, err := _ChatEncoderMaker()
+			if err != nil {
+				log.Fatal(err)
+			}
+			if cfg.DryRun {
+				if err := _ChatDryRun(em.NewEncoder(os.Stdout)); err != nil {
+					log.Fatal(err)
+				}
+				return
+			}
+			err = health.Check(opts, cfg.Timeout, func() (*grpc.ClientConn, error) {
+				conn, _, err := _DialChat()
+				return conn, err
+			}, em.NewEncoder(os.Stdout))
+			if err != nil {
+				log.Print(err)
+				os.Exit(health.ExitCode(err))
+			}
+		},
+	}
+	opts.AddFlags(cmd.Flags())
+	return cmd
+}
+
+func _ChatDescribeCommand() *cobra.Command {
+	var raw bool
+	cmd := &cobra.Command{
+		Use:   "describe [name]",
+		Short: "Print the definition of streams.Chat, or of one of its methods, messages or enums, with its comments",
+		Long:  "Print the definition of streams.Chat, or of one of its methods, messages or enums, with its comments, from the descriptors built into the command.\n\nThe name is a method, as named in the proto file or as its command, or the name of a message or enum, relative to the package of the service or in full. A method is followed by its request and response messages.",
+		Args:  cobra.MaximumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			name := ""
+			if len(args) > 0 {
+				name = args[0]
+			}
+			defs, err := describe.Lookup("streams.Chat", name)
+			if err != nil {
+				log.Fatal(err)
+			}
+			if !raw {
+				if err := describe.Print(os.Stdout, defs); err != nil {
+					log.Fatal(err)
+				}
+				return
+			}
+			em, err := _ChatEncoderMaker()
+			if err != nil {
+				log.Fatal(err)
+			}
+			enc := em.NewEncoder(os.Stdout)
+			for _, d := range defs {
+				if err := enc.Encode(d.Descriptor); err != nil {
+					log.Fatal(err)
+				}
+			}
+		},
+	}
+	cmd.Flags().BoolVar(&raw, "descriptor", false, "print the descriptors of the definitions in the response format instead of proto source")
+	return cmd
+}
+
+func _DialChat() (*grpc.ClientConn, ChatClient, error) {
+	cfg := _DefaultChatClientCommandConfig
+	var v *verbose.Logger
+	var opts []grpc.DialOption
+	if cfg.Trace.Enabled() {
+		t, err := tracing.Start(cfg.Trace)
+		if err != nil {
+			return nil, nil, fmt.Errorf("trace: %v", err)
+		}
+		opts = append(opts, t.DialOptions()...)
+	}
+	if cfg.Verbose {
+		v = verbose.New(os.Stderr)
+		opts = append(opts, v.DialOptions()...)
+	}
+	if cfg.TLS {
+		tlsConfig := &tls.Config{}
+		if cfg.InsecureSkipVerify {
+			tlsConfig.InsecureSkipVerify = true
+		}
+		if cfg.CACertFile != "" {
+			cacert, err := ioutil.ReadFile(cfg.CACertFile)
+			if err != nil {
+				return nil, nil, fmt.Errorf("ca cert: %v", err)
+			}
+			certpool := x509.NewCertPool()
+			certpool.AppendCertsFromPEM(cacert)
+			tlsConfig.RootCAs = certpool
+		}
+		if cfg.CertFile != "" {
+			if cfg.KeyFile == "" {
+				return nil, nil, fmt.Errorf("missing key file")
+			}
+			pair, err := tls.LoadX509KeyPair(cfg.CertFile, cfg.KeyFile)
+			if err != nil {
+				return nil, nil, fmt.Errorf("cert/key: %v", err)
+			}
+			tlsConfig.Certificates = []tls.Certificate{pair}
+		}
+		if cfg.ServerName != "" {
+			tlsConfig.ServerName = cfg.ServerName
+		} else {
+			addr, _, _ := net.SplitHostPort(cfg.ServerAddr)
+			tlsConfig.ServerName = addr
+		}
+		//tlsConfig.BuildNameToCertificate()
+		cred := credentials.NewTLS(tlsConfig)
+		if v != nil {
+			cred = v.Credentials(cred)
+		}
+		opts = append(opts, grpc.WithTransportCredentials(cred))
+	} else {
+		opts = append(opts, grpc.WithInsecure())
+	}
+	if cfg.AuthToken != "" {
+		cred := oauth.NewOauthAccess(&oauth2.Token{
+			AccessToken: cfg.AuthToken,
+			TokenType:   cfg.AuthTokenType,
+		})
+		opts = append(opts, grpc.WithPerRPCCredentials(cred))
+	}
+	if cfg.JWTKey != "" {
+		cred, err := oauth.NewJWTAccessFromKey([]byte(cfg.JWTKey))
+		if err != nil {
+			return nil, nil, fmt.Errorf("jwt key: %v", err)
+		}
+		opts = append(opts, grpc.WithPerRPCCredentials(cred))
+	}
+	if cfg.JWTKeyFile != "" {
+		cred, err := oauth.NewJWTAccessFromFile(cfg.JWTKeyFile)
+		if err != nil {
+			return nil, nil, fmt.Errorf("jwt key file: %v", err)
+		}
+		opts = append(opts, grpc.WithPerRPCCredentials(cred))
+	}
+	if cfg.Record != "" {
+		rec, err := recording.Open(cfg.Record)
+		if err != nil {
+			return nil, nil, fmt.Errorf("record: %v", err)
+		}
+		opts = append(opts, rec.DialOptions()...)
+	}
+	var conn *grpc.ClientConn
+	var err error
+	if v != nil {
+		conn, err = v.Dial(cfg.ServerAddr, cfg.Timeout, opts...)
+	} else {
+		conn, err = grpc.Dial(cfg.ServerAddr, append(opts, grpc.WithBlock(), grpc.WithTimeout(cfg.Timeout))...)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	return conn, NewChatClient(conn), nil
+}
+
+// _ChatEncoderMaker returns the encoder maker of the response format.
+func _ChatEncoderMaker() (iocodec.EncoderMaker, error) {
+	format := _DefaultChatClientCommandConfig.ResponseFormat
+	if format == "" {
+		format = "json"
+	}
+	em, ok := iocodec.DefaultEncoders[format]
+	if !ok {
+		return nil, fmt.Errorf("invalid response format: %q", format)
+	}
+	return em, nil
+}
+
+type _ChatRoundTripFunc func(conn *grpc.ClientConn, cli ChatClient, in iocodec.Decoder, out iocodec.Encoder) error
+
+// _ChatRoundTrip reads the request with prepare, when set, before it
+// dials the server and calls fn. Commands without a request have no sample.
+func _ChatRoundTrip(sample proto.Message, prepare func(in iocodec.Decoder) error, fn _ChatRoundTripFunc) error {
+	cfg := _DefaultChatClientCommandConfig
+	em, err := _ChatEncoderMaker()
+	if err != nil {
+		return err
+	}
+	if cfg.PrintSampleRequest {
+		if sample == nil {
+			return fmt.Errorf("no sample request")
+		}
+		return em.NewEncoder(os.Stdout).Encode(iocodec.NewSample(sample))
+	}
+	// read the input request, first from stdin, then from a file, otherwise from args only
+	var d iocodec.Decoder
+	if cfg.Stdin || cfg.RequestFile == "-" {
+		if cfg.Edit {
+			// the editor needs the terminal
+			return fmt.Errorf("--edit can't be used with a request read from stdin")
+		}
+		var in io.Reader = os.Stdin
+		if cfg.Template.Stdin {
+			if in, err = cfg.Template.Reader("stdin", os.Stdin); err != nil {
+				return fmt.Errorf("request template: %v", err)
+			}
+		}
+		d = iocodec.DefaultDecoders["json"].NewDecoder(in)
+	} else if cfg.RequestFile != "" {
+		f, err := os.Open(cfg.RequestFile)
+		if err != nil {
+			return fmt.Errorf("request file: %v", err)
+		}
+		defer f.Close()
+		ext := filepath.Ext(cfg.RequestFile)
+		if len(ext) > 0 && ext[0] == '.' {
+			ext = ext[1:]
+		}
+		dm, ok := iocodec.DefaultDecoders[ext]
+		if !ok {
+			return fmt.Errorf("invalid request file format: %q", ext)
+		}
+		in, err := cfg.Template.Reader(cfg.RequestFile, f)
+		if err != nil {
+			return fmt.Errorf("request template: %v", err)
+		}
+		d = dm.NewDecoder(in)
+	} else {
+		d = iocodec.DefaultDecoders["noop"].NewDecoder(os.Stdin)
+	}
+	d = cfg.Set.Decoder(d)
+	if prepare != nil {
+		if err := prepare(d); err != nil {
+			return err
+		}
+	}
+	if cfg.DryRun {
+		// fn prints what it would send instead
+		return fn(nil, nil, d, em.NewEncoder(os.Stdout))
+	}
+	conn, client, err := _DialChat()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	return fn(conn, client, d, em.NewEncoder(os.Stdout))
+}
+
+// _ChatPrompter returns the prompter asking for the fields of requests,
+// or nil unless --interactive is given and stdin is a terminal.
+func _ChatPrompter() *prompting.Prompter {
+	if !_DefaultChatClientCommandConfig.Interactive || !prompting.IsTerminal(os.Stdin) {
+		return nil
+	}
+	return prompting.New(os.Stdin, os.Stderr)
+}
+
+// _ChatConfirmDestructive asks for confirmation of a call of the
+// destructive method, showing req unless nil. It's called once the server is
+// dialed, so sample requests and dry runs, which send nothing, aren't asked
+// about.
+func _ChatConfirmDestructive(command, method string, req proto.Message) error {
+	em, err := _ChatEncoderMaker()
+	if err != nil {
+		return err
+	}
+	return _DefaultChatClientCommandConfig.Destructive.Confirm(command, method, req, em)
+}
+
+// _ChatEdit opens the editor on m, or on a sample of it when it's empty,
+// in the format of the request file, or else the response format.
+func _ChatEdit(m proto.Message, check func(proto.Message) error) error {
+	cfg := _DefaultChatClientCommandConfig
+	format := cfg.ResponseFormat
+	if ext := filepath.Ext(cfg.RequestFile); len(ext) > 1 {
+		format = ext[1:]
+	}
+	var v interface{} = m
+	if proto.Size(m) == 0 {
+		v = iocodec.NewSample(m)
+	}
+	return editing.Edit(format, v, m, check)
+}
+
+// _ChatDryRun prints reqs, along with where and how they would be sent.
+func _ChatDryRun(out iocodec.Encoder, reqs ...proto.Message) error {
+	cfg := _DefaultChatClientCommandConfig
+	plan := dryrun.Plan{
+		Target:   cfg.ServerAddr,
+		Timeout:  cfg.Timeout,
+		Metadata: metadata.MD{},
+		Requests: reqs,
+	}
+	if cfg.TLS {
+		plan.TLS = &dryrun.TLS{
+			ServerName:         cfg.ServerName,
+			InsecureSkipVerify: cfg.InsecureSkipVerify,
+			CACertFile:         cfg.CACertFile,
+			CertFile:           cfg.CertFile,
+			KeyFile:            cfg.KeyFile,
+		}
+		if plan.TLS.ServerName == "" {
+			plan.TLS.ServerName, _, _ = net.SplitHostPort(cfg.ServerAddr)
+		}
+	}
+	if cfg.AuthToken != "" {
+		plan.Metadata.Append("authorization", dryrun.Redact(cfg.AuthTokenType+" "+cfg.AuthToken))
+	}
+	if cfg.JWTKey != "" || cfg.JWTKeyFile != "" {
+		plan.Metadata.Append("authorization", "Bearer "+dryrun.Redacted)
+	}
+	return dryrun.Print(plan, out)
+}
+
+// _ChatLoadTest makes calls with call, as many and as fast as the load
+// test flags say, over conn and the connections it dials, and prints a report
+// of them.
+func _ChatLoadTest(conn *grpc.ClientConn, call func(ctx context.Context, cli ChatClient) error) error {
+	dial := func() (*grpc.ClientConn, error) {
+		conn, _, err := _DialChat()
+		return conn, err
+	}
+	return loadtest.Run(_DefaultChatClientCommandConfig.LoadTest, conn, dial, func(ctx context.Context, conn *grpc.ClientConn) error {
+		return call(ctx, NewChatClient(conn))
+	}, os.Stdout)
+}
+
+func _ChatValidate(m proto.Message, flags *pflag.FlagSet) error {
+	if _DefaultChatClientCommandConfig.SkipValidation {
+		return nil
+	}
+	return validation.Validate(m, flags)
+}
+
+func _ChatListenClientCommand() *cobra.Command {
+	reqArgs := &Message{}
+	var streamOpts streaming.Options
+
+	cmd := &cobra.Command{
+		Use:     "listen",
+		Long:    "Listen client; call by piping a request in to stdin (--stdin), reading a file (--file), or via flags per field",
+		Example: "TODO: print protobuf method comments here",
+		Run: func(cmd *cobra.Command, args []string) {
+			var v Message
+
+			prepare := func(in iocodec.Decoder) error {
+				err := in.Decode(&v)
+				if err != nil {
+					return err
+				}
+
+				proto.Merge(&v, reqArgs)
+
+				prompter := _ChatPrompter()
+				if prompter != nil {
+					err = prompter.Fields(&v)
+					if err != nil {
+						return err
+					}
+				}
+				if _DefaultChatClientCommandConfig.Edit {
+					err = _ChatEdit(&v, func(m proto.Message) error {
+						return _ChatValidate(m, cmd.Flags())
+					})
+				} else {
+					err = _ChatValidate(&v, cmd.Flags())
+				}
+				if err != nil {
+					return err
+				}
+				if prompter == nil || _DefaultChatClientCommandConfig.DryRun {
+					return nil
+				}
+				em, err := _ChatEncoderMaker()
+				if err != nil {
+					return err
+				}
+				return prompter.Confirm(&v, em)
+			}
+
+			err := _ChatRoundTrip(&v, prepare, func(conn *grpc.ClientConn, cli ChatClient, in iocodec.Decoder, out iocodec.Encoder) error {
+
+				if _DefaultChatClientCommandConfig.DryRun {
+					return _ChatDryRun(out, &v)
+				}
+				if _DefaultChatClientCommandConfig.LoadTest.Enabled() {
+					return _ChatLoadTest(conn, func(ctx context.Context, cli ChatClient) error {
+						stream, err := cli.Listen(ctx, &v)
+						if err != nil {
+							return err
+						}
+						for {
+							_, err := stream.Recv()
+							if err == io.EOF {
+								return nil
+							}
+							if err != nil {
+								return err
+							}
+						}
+					})
+				}
+
+				return streaming.Receive(streamOpts, func(ctx context.Context) (func() (proto.Message, error), error) {
+					stream, err := cli.Listen(ctx, &v)
+					if err != nil {
+						return nil, err
+					}
+					return func() (proto.Message, error) { return stream.Recv() }, nil
+				}, out)
+
+			})
+			if err != nil {
+				log.Fatal(err)
+			}
+		},
+	}
+
+	cmd.PersistentFlags().StringVar(&reqArgs.Text, "text", "", "get-comment-from-proto")
+	streamOpts.AddFlags(cmd.PersistentFlags())
+
+	return cmd
+}
+
+func _ChatPostClientCommand() *cobra.Command {
+	reqArgs := &Message{}
+
+	cmd := &cobra.Command{
+		Use:     "post",
+		Long:    "Post client; call by piping a request in to stdin (--stdin), reading a file (--file), or via flags per field",
+		Example: "TODO: print protobuf method comments here",
+		Run: func(cmd *cobra.Command, args []string) {
+			var v Message
+
+			if _DefaultChatClientCommandConfig.Edit {
+				log.Fatal("--edit can't be used with client streams")
+			}
+			err := _ChatRoundTrip(&v, nil, func(conn *grpc.ClientConn, cli ChatClient, in iocodec.Decoder, out iocodec.Encoder) error {
+				if _DefaultChatClientCommandConfig.LoadTest.Enabled() || _DefaultChatClientCommandConfig.DryRun {
+					// every request is read up front
+					var reqs []*Message
+					for {
+						req := new(Message)
+						err := in.Decode(req)
+						if err == io.EOF {
+							break
+						}
+						if err != nil {
+							return err
+						}
+						err = _ChatValidate(req, cmd.Flags())
+						if err != nil {
+							return err
+						}
+						reqs = append(reqs, req)
+					}
+					if _DefaultChatClientCommandConfig.DryRun {
+						msgs := make([]proto.Message, len(reqs))
+						for i, req := range reqs {
+							msgs[i] = req
+						}
+						return _ChatDryRun(out, msgs...)
+					}
+					// every call sends the same requests
+					return _ChatLoadTest(conn, func(ctx context.Context, cli ChatClient) error {
+						stream, err := cli.Post(ctx)
+						if err != nil {
+							return err
+						}
+
+						for _, req := range reqs {
+							err := stream.Send(req)
+							if err == io.EOF {
+								// CloseAndRecv tells why
+								break
+							}
+							if err != nil {
+								return err
+							}
+						}
+						_, err = stream.CloseAndRecv()
+						return err
+
+					})
+				}
+
+				stream, err := cli.Post(context.Background())
+				if err != nil {
+					return err
+				}
+				for {
+					err = in.Decode(&v)
+					if err == io.EOF {
+						stream.CloseSend()
+						break
+					}
+					if err != nil {
+						return err
+					}
+					err = _ChatValidate(&v, cmd.Flags())
+					if err != nil {
+						return err
+					}
+					err = stream.Send(&v)
+					if err != nil {
+						return err
+					}
+				}
+
+				resp, err := stream.CloseAndRecv()
+				if err != nil {
+					return err
+				}
+
+				return out.Encode(resp)
+
+			})
+			if err != nil {
+				log.Fatal(err)
+			}
+		},
+	}
+
+	cmd.PersistentFlags().StringVar(&reqArgs.Text, "text", "", "get-comment-from-proto")
+
+	return cmd
+}
+
+func _ChatTalkClientCommand() *cobra.Command {
+	reqArgs := &Message{}
+
+	cmd := &cobra.Command{
+		Use:     "talk",
+		Long:    "Talk client; call by piping a request in to stdin (--stdin), reading a file (--file), or via flags per field",
+		Example: "TODO: print protobuf method comments here",
+		Run: func(cmd *cobra.Command, args []string) {
+			var v Message
+
+			if _DefaultChatClientCommandConfig.Edit {
+				log.Fatal("--edit can't be used with client streams")
+			}
+			err := _ChatRoundTrip(&v, nil, func(conn *grpc.ClientConn, cli ChatClient, in iocodec.Decoder, out iocodec.Encoder) error {
+				if _DefaultChatClientCommandConfig.LoadTest.Enabled() || _DefaultChatClientCommandConfig.DryRun {
+					// every request is read up front
+					var reqs []*Message
+					for {
+						req := new(Message)
+						err := in.Decode(req)
+						if err == io.EOF {
+							break
+						}
+						if err != nil {
+							return err
+						}
+						err = _ChatValidate(req, cmd.Flags())
+						if err != nil {
+							return err
+						}
+						reqs = append(reqs, req)
+					}
+					if _DefaultChatClientCommandConfig.DryRun {
+						msgs := make([]proto.Message, len(reqs))
+						for i, req := range reqs {
+							msgs[i] = req
+						}
+						return _ChatDryRun(out, msgs...)
+					}
+					// every call sends the same requests
+					return _ChatLoadTest(conn, func(ctx context.Context, cli ChatClient) error {
+						stream, err := cli.Talk(ctx)
+						if err != nil {
+							return err
+						}
+
+						go func() {
+							for _, req := range reqs {
+								if stream.Send(req) != nil {
+									// Recv tells why
+									return
+								}
+							}
+							stream.CloseSend()
+						}()
+						for {
+							_, err := stream.Recv()
+							if err == io.EOF {
+								return nil
+							}
+							if err != nil {
+								return err
+							}
+						}
+
+					})
+				}
+
+				// send and receive concurrently, so responses are printed as
+				// they arrive
+				return streaming.Bidi(func(ctx context.Context) (grpc.ClientStream, func() (proto.Message, error), error) {
+					stream, err := cli.Talk(ctx)
+					if err != nil {
+						return nil, nil, err
+					}
+					return stream, func() (proto.Message, error) { return stream.Recv() }, nil
+				}, func() (proto.Message, error) {
+					req := new(Message)
+					err := in.Decode(req)
+					if err != nil {
+						return nil, err
+					}
+					err = _ChatValidate(req, cmd.Flags())
+					if err != nil {
+						return nil, err
+					}
+					return req, nil
+				}, out)
+
+			})
+			if err != nil {
+				log.Fatal(err)
+			}
+		},
+	}
+
+	cmd.PersistentFlags().StringVar(&reqArgs.Text, "text", "", "get-comment-from-proto")
+
+	return cmd
+}
+
+var _ChatClientSubCommands = []func() *cobra.Command{
+	_ChatListenClientCommand,
+	_ChatPostClientCommand,
+	_ChatTalkClientCommand,
+}
+
+func init() { describe.Register(_descriptorSet_Streams_108651d8a5b39604) }
+
+var _descriptorSet_Streams_108651d8a5b39604 = []byte{
+	// 317 bytes of a gzipped FileDescriptorSet
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0xd2, 0x4f, 0x4a, 0xc3, 0x40,
+	0x14, 0x06, 0xf0, 0x99, 0x79, 0x33, 0x93, 0xf4, 0x4b, 0x8a, 0xed, 0x87, 0x82, 0x0a, 0xa2, 0x48,
+	0x95, 0x62, 0x6d, 0x5a, 0xaa, 0x37, 0x70, 0x27, 0x0a, 0x22, 0x5e, 0x20, 0x42, 0x50, 0xf1, 0x4f,
+	0xa5, 0xc9, 0xc2, 0x83, 0x78, 0x21, 0x6f, 0xe2, 0x51, 0x64, 0x42, 0xa6, 0x1b, 0x37, 0x5d, 0x65,
+	0x7e, 0x79, 0xef, 0x7d, 0xe1, 0x85, 0xc1, 0x8f, 0xc5, 0x4e, 0xdd, 0xac, 0xaa, 0xf2, 0xbd, 0x9e,
+	0x75, 0xcf, 0xe2, 0x73, 0xb5, 0x6c, 0x96, 0x4c, 0x3a, 0x1e, 0x1f, 0x20, 0xb9, 0xad, 0xea, 0xba,
+	0x7c, 0xaa, 0x48, 0xd8, 0xa6, 0xfa, 0x6a, 0x76, 0xf5, 0x91, 0x1e, 0xf7, 0xee, 0xdb, 0xf3, 0xe2,
+	0x5b, 0xc3, 0x5e, 0x3d, 0x97, 0x0d, 0x0b, 0xf8, 0x9b, 0x97, 0xba, 0xa9, 0x3e, 0x38, 0x28, 0x62,
+	0x54, 0x37, 0xb8, 0xff, 0xef, 0xcd, 0x5c, 0xf3, 0x1c, 0xf6, 0x6e, 0x59, 0x37, 0x9b, 0x74, 0x8f,
+	0x35, 0x0b, 0xd8, 0x87, 0xf2, 0xed, 0x75, 0xb3, 0xee, 0xb9, 0xbe, 0xfe, 0x35, 0xf0, 0xb4, 0x4a,
+	0xe5, 0x1a, 0x29, 0x74, 0x4e, 0x51, 0x8a, 0xe1, 0x64, 0x28, 0x46, 0x0d, 0x00, 0x18, 0xaf, 0x68,
+	0xad, 0x4a, 0x35, 0x00, 0xf1, 0x4a, 0x53, 0x6c, 0x9a, 0x23, 0x83, 0xf5, 0xca, 0x28, 0x8a, 0x33,
+	0x33, 0xe4, 0x70, 0x01, 0x9a, 0xe2, 0x7c, 0x1e, 0x65, 0x28, 0xae, 0xbf, 0x1d, 0xe5, 0x29, 0xee,
+	0xf0, 0x24, 0x4a, 0x28, 0xee, 0x74, 0xda, 0x85, 0x68, 0x8a, 0x37, 0xd3, 0xae, 0xa4, 0x83, 0x3c,
+	0xa2, 0x1c, 0xc5, 0x67, 0xc3, 0x28, 0x43, 0xf1, 0xdc, 0x8b, 0x12, 0x8a, 0x1f, 0x4d, 0xba, 0x10,
+	0x43, 0x49, 0xcc, 0x65, 0x57, 0x0a, 0x91, 0xc9, 0x3a, 0xc4, 0x38, 0x4a, 0xb2, 0x0e, 0x69, 0x3b,
+	0xd7, 0x21, 0xc6, 0x53, 0x92, 0xd1, 0x59, 0x94, 0x50, 0x92, 0xc9, 0x02, 0x80, 0xb1, 0x8a, 0x16,
+	0xe1, 0xa7, 0x00, 0x62, 0xc3, 0x66, 0x48, 0xb7, 0xc2, 0xa7, 0x6c, 0xbb, 0x74, 0x66, 0x18, 0x46,
+	0x02, 0x5c, 0x50, 0x1a, 0xa5, 0x29, 0x59, 0xaf, 0x1f, 0x25, 0x94, 0x6c, 0x30, 0x7c, 0xf4, 0xed,
+	0x45, 0xb9, 0xf8, 0x1b, 0x00, 0x37, 0xa7, 0xe3, 0xd9, 0x44, 0x02, 0x00, 0x00,
+}
@@ -0,0 +1,383 @@
+// Package tracing joins the calls of generated commands to distributed traces:
+// it sends the W3C trace context of every call in its traceparent metadata,
+// continuing a parent trace or starting a new one, and records a client span
+// per call, which it can export to a local file as OTLP JSON.
+//
+// The calls of a command all belong to the same trace, so printing its ID is
+// enough to find what the servers traced. Nothing needs a collector: the
+// exported file holds an OTLP ExportTraceServiceRequest per line, the format
+// of the OpenTelemetry collector's otlpjsonfile receiver.
+package tracing
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/spf13/pflag"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Options choose the trace the calls join, and where their spans go.
+type Options struct {
+	Parent  string
+	Export  string
+	PrintID bool
+}
+
+// AddFlags adds the flags setting the options to fs. The parent defaults to
+// the TRACEPARENT environment variable.
+func (o *Options) AddFlags(fs *pflag.FlagSet) {
+	if o.Parent == "" {
+		o.Parent = os.Getenv("TRACEPARENT")
+	}
+	fs.StringVar(&o.Parent, "trace-parent", o.Parent, "W3C traceparent of the span the calls are children of, by default $TRACEPARENT")
+	fs.StringVar(&o.Export, "trace-export", o.Export, "append a client span per call to this file as OTLP JSON")
+	fs.BoolVar(&o.PrintID, "trace-id", o.PrintID, "print the ID of the trace the calls belong to on stderr")
+}
+
+// Enabled reports whether the options ask for the calls to be traced.
+func (o *Options) Enabled() bool {
+	return o.Parent != "" || o.Export != "" || o.PrintID
+}
+
+// A Context is a W3C trace context.
+type Context struct {
+	TraceID [16]byte
+	SpanID  [8]byte
+	Flags   byte
+}
+
+// ParseTraceparent parses the value of a W3C traceparent header, such as
+// "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01".
+func ParseTraceparent(s string) (Context, error) {
+	var c Context
+	parts := strings.Split(s, "-")
+	if len(parts) < 4 || len(parts[0]) != 2 || parts[0] == "ff" || (parts[0] == "00" && len(parts) != 4) {
+		return c, fmt.Errorf("invalid traceparent %q", s)
+	}
+	var version [1]byte
+	if err := decodeHex(version[:], parts[0]); err != nil {
+		return c, fmt.Errorf("invalid traceparent %q", s)
+	}
+	var flags [1]byte
+	if decodeHex(c.TraceID[:], parts[1]) != nil || decodeHex(c.SpanID[:], parts[2]) != nil || decodeHex(flags[:], parts[3]) != nil {
+		return c, fmt.Errorf("invalid traceparent %q", s)
+	}
+	if c.TraceID == [16]byte{} || c.SpanID == [8]byte{} {
+		return c, fmt.Errorf("invalid traceparent %q: all-zero ID", s)
+	}
+	c.Flags = flags[0]
+	return c, nil
+}
+
+// decodeHex decodes the lowercase hex s into b, which it must fill exactly.
+func decodeHex(b []byte, s string) error {
+	if len(s) != 2*len(b) || strings.ToLower(s) != s {
+		return fmt.Errorf("want %d lowercase hex digits", 2*len(b))
+	}
+	_, err := hex.Decode(b, []byte(s))
+	return err
+}
+
+// String returns c as the value of a version 00 traceparent header.
+func (c Context) String() string {
+	return fmt.Sprintf("00-%x-%x-%02x", c.TraceID, c.SpanID, c.Flags)
+}
+
+// A Tracer traces the calls of the connections it intercepts.
+type Tracer struct {
+	trace   Context // of the parent span, with no span ID without a parent
+	service string
+	mu      sync.Mutex
+	w       io.Writer // of the exported spans, if any
+}
+
+// New returns a tracer continuing the trace of o.Parent, or starting a new
+// one, which prints the trace ID on w if o.PrintID.
+func New(o Options, w io.Writer) (*Tracer, error) {
+	t := &Tracer{service: filepath.Base(os.Args[0])}
+	if o.Parent != "" {
+		c, err := ParseTraceparent(o.Parent)
+		if err != nil {
+			return nil, err
+		}
+		t.trace = c
+	} else {
+		if _, err := rand.Read(t.trace.TraceID[:]); err != nil {
+			return nil, err
+		}
+		t.trace.Flags = 1 // sampled
+	}
+	if o.Export != "" {
+		f, err := os.OpenFile(o.Export, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0644)
+		if err != nil {
+			return nil, err
+		}
+		t.w = f
+	}
+	if o.PrintID {
+		fmt.Fprintf(w, "trace-id: %x\n", t.trace.TraceID)
+	}
+	return t, nil
+}
+
+// The tracer of the command, so its connections all join the same trace.
+var (
+	mu     sync.Mutex
+	tracer *Tracer
+)
+
+// Start returns the tracer of the command, which it creates with o the first
+// time, printing the trace ID on stderr if asked to.
+func Start(o Options) (*Tracer, error) {
+	mu.Lock()
+	defer mu.Unlock()
+	if tracer != nil {
+		return tracer, nil
+	}
+	t, err := New(o, os.Stderr)
+	if err != nil {
+		return nil, err
+	}
+	tracer = t
+	return t, nil
+}
+
+// DialOptions returns the options intercepting the calls of a connection to
+// trace them.
+func (t *Tracer) DialOptions() []grpc.DialOption {
+	return []grpc.DialOption{
+		grpc.WithChainUnaryInterceptor(t.unary),
+		grpc.WithChainStreamInterceptor(t.stream),
+	}
+}
+
+func (t *Tracer) unary(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	ctx, s, err := t.start(ctx, method, cc.Target())
+	if err != nil {
+		return err
+	}
+	err = invoker(ctx, method, req, reply, cc, opts...)
+	s.end(err)
+	return err
+}
+
+func (t *Tracer) stream(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	ctx, s, err := t.start(ctx, method, cc.Target())
+	if err != nil {
+		return nil, err
+	}
+	cs, err := streamer(ctx, desc, cc, method, opts...)
+	if err != nil {
+		s.end(err)
+		return nil, err
+	}
+	go func() {
+		// a stream its caller stops, such as after enough messages, may
+		// never be received from again
+		select {
+		case <-ctx.Done():
+			s.end(canceled(ctx))
+		case <-s.ended:
+		}
+	}()
+	return &tracedStream{ClientStream: cs, ctx: ctx, span: s, serverStream: desc.ServerStreams}, nil
+}
+
+// canceled returns the error gRPC fails the calls of ctx with once it's done.
+func canceled(ctx context.Context) error {
+	if ctx.Err() == context.DeadlineExceeded {
+		return status.Error(codes.DeadlineExceeded, ctx.Err().Error())
+	}
+	return status.Error(codes.Canceled, ctx.Err().Error())
+}
+
+// start starts the span of a call of method to target, and returns the
+// context sending its trace context.
+func (t *Tracer) start(ctx context.Context, method, target string) (context.Context, *span, error) {
+	s := &span{t: t, method: method, target: target, start: time.Now(), ended: make(chan struct{}), Context: t.trace}
+	if _, err := rand.Read(s.SpanID[:]); err != nil {
+		return nil, nil, err
+	}
+	md, _ := metadata.FromOutgoingContext(ctx)
+	md = md.Copy()
+	md.Set("traceparent", s.Context.String())
+	return metadata.NewOutgoingContext(ctx, md), s, nil
+}
+
+// A span is the span of a call.
+type span struct {
+	t      *Tracer
+	method string
+	target string
+	start  time.Time
+	once   sync.Once
+	ended  chan struct{} // closed once the span is exported
+	Context
+}
+
+// end ends the span, once the call ends with err, and exports it.
+func (s *span) end(callErr error) {
+	s.once.Do(func() {
+		defer close(s.ended)
+		if s.t.w == nil {
+			return
+		}
+		b, err := json.Marshal(s.t.export(s, time.Now(), callErr))
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "trace %s: %v\n", s.method, err)
+			return
+		}
+		s.t.mu.Lock()
+		defer s.t.mu.Unlock()
+		if _, err := s.t.w.Write(append(b, '\n')); err != nil {
+			fmt.Fprintf(os.Stderr, "trace %s: %v\n", s.method, err)
+		}
+	})
+}
+
+type tracedStream struct {
+	grpc.ClientStream
+	ctx          context.Context
+	span         *span
+	serverStream bool
+}
+
+func (s *tracedStream) SendMsg(m interface{}) error {
+	err := s.ClientStream.SendMsg(m)
+	if err != nil && err != io.EOF {
+		s.span.end(err)
+	}
+	return err
+}
+
+func (s *tracedStream) RecvMsg(m interface{}) error {
+	err := s.ClientStream.RecvMsg(m)
+	switch {
+	case s.ctx.Err() != nil:
+		s.span.end(canceled(s.ctx))
+	case err == io.EOF:
+		s.span.end(nil)
+	case err != nil || !s.serverStream:
+		s.span.end(err)
+	}
+	return err
+}
+
+// The OTLP JSON encoding of the spans, as in the OpenTelemetry protocol's
+// ExportTraceServiceRequest: IDs are hex, and 64-bit integers are strings.
+type (
+	otlpRequest struct {
+		ResourceSpans []otlpResourceSpans `json:"resourceSpans"`
+	}
+	otlpResourceSpans struct {
+		Resource   otlpResource     `json:"resource"`
+		ScopeSpans []otlpScopeSpans `json:"scopeSpans"`
+	}
+	otlpResource struct {
+		Attributes []otlpAttribute `json:"attributes"`
+	}
+	otlpScopeSpans struct {
+		Scope otlpScope  `json:"scope"`
+		Spans []otlpSpan `json:"spans"`
+	}
+	otlpScope struct {
+		Name string `json:"name"`
+	}
+	otlpSpan struct {
+		TraceID           string          `json:"traceId"`
+		SpanID            string          `json:"spanId"`
+		ParentSpanID      string          `json:"parentSpanId,omitempty"`
+		Name              string          `json:"name"`
+		Kind              int             `json:"kind"`
+		StartTimeUnixNano string          `json:"startTimeUnixNano"`
+		EndTimeUnixNano   string          `json:"endTimeUnixNano"`
+		Attributes        []otlpAttribute `json:"attributes"`
+		Status            otlpStatus      `json:"status"`
+	}
+	otlpAttribute struct {
+		Key   string    `json:"key"`
+		Value otlpValue `json:"value"`
+	}
+	otlpValue struct {
+		StringValue *string `json:"stringValue,omitempty"`
+		IntValue    *string `json:"intValue,omitempty"`
+	}
+	otlpStatus struct {
+		Code    int    `json:"code,omitempty"`
+		Message string `json:"message,omitempty"`
+	}
+)
+
+const (
+	spanKindClient  = 3
+	statusCodeError = 2
+)
+
+func stringAttribute(key, value string) otlpAttribute {
+	return otlpAttribute{key, otlpValue{StringValue: &value}}
+}
+
+func intAttribute(key string, value int64) otlpAttribute {
+	s := strconv.FormatInt(value, 10)
+	return otlpAttribute{key, otlpValue{IntValue: &s}}
+}
+
+// export returns the export request of the span s, ended at end with err,
+// named and attributed as the OpenTelemetry conventions for RPCs say.
+func (t *Tracer) export(s *span, end time.Time, err error) otlpRequest {
+	name := strings.TrimPrefix(s.method, "/")
+	service, method := name, ""
+	if i := strings.LastIndexByte(name, '/'); i >= 0 {
+		service, method = name[:i], name[i+1:]
+	}
+	st := status.Convert(err)
+	attrs := []otlpAttribute{
+		stringAttribute("rpc.system", "grpc"),
+		stringAttribute("rpc.service", service),
+		stringAttribute("rpc.method", method),
+		intAttribute("rpc.grpc.status_code", int64(st.Code())),
+	}
+	if host, port, err := net.SplitHostPort(s.target); err == nil {
+		attrs = append(attrs, stringAttribute("server.address", host))
+		if p, err := strconv.Atoi(port); err == nil {
+			attrs = append(attrs, intAttribute("server.port", int64(p)))
+		}
+	}
+	sp := otlpSpan{
+		TraceID:           hex.EncodeToString(s.TraceID[:]),
+		SpanID:            hex.EncodeToString(s.SpanID[:]),
+		Name:              name,
+		Kind:              spanKindClient,
+		StartTimeUnixNano: strconv.FormatInt(s.start.UnixNano(), 10),
+		EndTimeUnixNano:   strconv.FormatInt(end.UnixNano(), 10),
+		Attributes:        attrs,
+	}
+	if t.trace.SpanID != [8]byte{} {
+		sp.ParentSpanID = hex.EncodeToString(t.trace.SpanID[:])
+	}
+	if err != nil {
+		sp.Status = otlpStatus{Code: statusCodeError, Message: st.Message()}
+	}
+	return otlpRequest{[]otlpResourceSpans{{
+		Resource: otlpResource{[]otlpAttribute{stringAttribute("service.name", t.service)}},
+		ScopeSpans: []otlpScopeSpans{{
+			Scope: otlpScope{"github.com/tetratelabs/protoc-gen-cobra/tracing"},
+			Spans: []otlpSpan{sp},
+		}},
+	}}}
+}
@@ -0,0 +1,254 @@
+package tracing
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	testpb "google.golang.org/grpc/test/grpc_testing"
+)
+
+// testServer returns the traceparent it receives as the user name, and fails
+// calls asking for a negative response size.
+type testServer struct {
+	testpb.UnimplementedTestServiceServer
+}
+
+func traceparent(ctx context.Context) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	return strings.Join(md["traceparent"], ",")
+}
+
+func (*testServer) UnaryCall(ctx context.Context, req *testpb.SimpleRequest) (*testpb.SimpleResponse, error) {
+	if req.GetResponseSize() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "negative size")
+	}
+	return &testpb.SimpleResponse{Username: traceparent(ctx)}, nil
+}
+
+func (*testServer) StreamingOutputCall(req *testpb.StreamingOutputCallRequest, stream testpb.TestService_StreamingOutputCallServer) error {
+	for range req.GetResponseParameters() {
+		if err := stream.Send(&testpb.StreamingOutputCallResponse{}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func dial(t *testing.T, opts ...grpc.DialOption) (*grpc.ClientConn, func()) {
+	lis := bufconn.Listen(1 << 20)
+	s := grpc.NewServer()
+	testpb.RegisterTestServiceServer(s, &testServer{})
+	go s.Serve(lis)
+	opts = append(opts, grpc.WithInsecure(), grpc.WithDialer(func(string, time.Duration) (net.Conn, error) {
+		return lis.Dial()
+	}))
+	conn, err := grpc.Dial("localhost:50051", opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return conn, func() {
+		conn.Close()
+		s.Stop()
+	}
+}
+
+func TestParseTraceparent(t *testing.T) {
+	const valid = "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+	c, err := ParseTraceparent(valid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := c.String(); got != valid {
+		t.Errorf("got %s, want %s", got, valid)
+	}
+	if _, err := ParseTraceparent("01-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01-future"); err != nil {
+		t.Errorf("future version: %v", err)
+	}
+	for _, s := range []string{
+		"",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01-extra",
+		"ff-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+		"00-4BF92F3577B34DA6A3CE929D0E0E4736-00f067aa0ba902b7-01",
+		"00-4bf92f3577b34da6a3ce929d0e0e473-00f067aa0ba902b7-01",
+		"00-00000000000000000000000000000000-00f067aa0ba902b7-01",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-0000000000000000-01",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-x1",
+	} {
+		if _, err := ParseTraceparent(s); err == nil {
+			t.Errorf("ParseTraceparent(%q) succeeded", s)
+		}
+	}
+}
+
+func TestTracer(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tracing")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "spans.json")
+
+	const parent = "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+	var printed bytes.Buffer
+	tr, err := New(Options{Parent: parent, Export: path, PrintID: true}, &printed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := printed.String(), "trace-id: 4bf92f3577b34da6a3ce929d0e0e4736\n"; got != want {
+		t.Errorf("printed %q, want %q", got, want)
+	}
+	conn, done := dial(t, tr.DialOptions()...)
+	defer done()
+	cli := testpb.NewTestServiceClient(conn)
+
+	resp, err := cli.UnaryCall(context.Background(), &testpb.SimpleRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sent, err := ParseTraceparent(resp.GetUsername())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sent.TraceID != [16]byte{0x4b, 0xf9, 0x2f, 0x35, 0x77, 0xb3, 0x4d, 0xa6, 0xa3, 0xce, 0x92, 0x9d, 0x0e, 0x0e, 0x47, 0x36} || sent.Flags != 1 {
+		t.Errorf("sent %s, want a child of %s", resp.GetUsername(), parent)
+	}
+	if _, err := cli.UnaryCall(context.Background(), &testpb.SimpleRequest{ResponseSize: -1}); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("got %v, want InvalidArgument", err)
+	}
+	stream, err := cli.StreamingOutputCall(context.Background(), &testpb.StreamingOutputCallRequest{
+		ResponseParameters: []*testpb.ResponseParameters{{}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for {
+		if _, err := stream.Recv(); err == io.EOF {
+			break
+		} else if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	d := json.NewDecoder(bytes.NewReader(b))
+	for {
+		var r otlpRequest
+		if err := d.Decode(&r); err == io.EOF {
+			break
+		} else if err != nil {
+			t.Fatal(err)
+		}
+		s := r.ResourceSpans[0].ScopeSpans[0].Spans[0]
+		if s.StartTimeUnixNano > s.EndTimeUnixNano || len(s.SpanID) != 16 {
+			t.Errorf("bad span: %+v", s)
+		}
+		var attrs []string
+		for _, a := range s.Attributes {
+			v := a.Value.StringValue
+			if v == nil {
+				v = a.Value.IntValue
+			}
+			attrs = append(attrs, a.Key+"="+*v)
+		}
+		got = append(got, strings.Join([]string{s.TraceID, s.ParentSpanID, s.Name, strings.Join(attrs, " "), strconv.Itoa(s.Status.Code), s.Status.Message}, " "))
+	}
+	want := []string{
+		"4bf92f3577b34da6a3ce929d0e0e4736 00f067aa0ba902b7 grpc.testing.TestService/UnaryCall rpc.system=grpc rpc.service=grpc.testing.TestService rpc.method=UnaryCall rpc.grpc.status_code=0 server.address=localhost server.port=50051 0 ",
+		"4bf92f3577b34da6a3ce929d0e0e4736 00f067aa0ba902b7 grpc.testing.TestService/UnaryCall rpc.system=grpc rpc.service=grpc.testing.TestService rpc.method=UnaryCall rpc.grpc.status_code=3 server.address=localhost server.port=50051 2 negative size",
+		"4bf92f3577b34da6a3ce929d0e0e4736 00f067aa0ba902b7 grpc.testing.TestService/StreamingOutputCall rpc.system=grpc rpc.service=grpc.testing.TestService rpc.method=StreamingOutputCall rpc.grpc.status_code=0 server.address=localhost server.port=50051 0 ",
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("got:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestTracerStoppedStream(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tracing")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "spans.json")
+	tr, err := New(Options{Export: path}, ioutil.Discard)
+	if err != nil {
+		t.Fatal(err)
+	}
+	conn, done := dial(t, tr.DialOptions()...)
+	defer done()
+	cli := testpb.NewTestServiceClient(conn)
+
+	// the stream is stopped after its first message, as with
+	// --max-messages 1, and never received from again
+	ctx, cancel := context.WithCancel(context.Background())
+	stream, err := cli.StreamingOutputCall(ctx, &testpb.StreamingOutputCallRequest{
+		ResponseParameters: []*testpb.ResponseParameters{{}, {}, {}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := stream.Recv(); err != nil {
+		t.Fatal(err)
+	}
+	cancel()
+
+	var b []byte
+	for deadline := time.Now().Add(time.Second); len(b) == 0 && time.Now().Before(deadline); time.Sleep(time.Millisecond) {
+		if b, err = ioutil.ReadFile(path); err != nil {
+			t.Fatal(err)
+		}
+	}
+	var r otlpRequest
+	if err := json.Unmarshal(b, &r); err != nil {
+		t.Fatalf("%v in %q", err, b)
+	}
+	s := r.ResourceSpans[0].ScopeSpans[0].Spans[0]
+	if s.Name != "grpc.testing.TestService/StreamingOutputCall" || s.Status.Code != 2 || s.Status.Message != "context canceled" {
+		t.Errorf("got span %s with status %+v", s.Name, s.Status)
+	}
+}
+
+func TestNewTrace(t *testing.T) {
+	tr, err := New(Options{}, ioutil.Discard)
+	if err != nil {
+		t.Fatal(err)
+	}
+	conn, done := dial(t, tr.DialOptions()...)
+	defer done()
+	cli := testpb.NewTestServiceClient(conn)
+	var ids []string
+	for i := 0; i < 2; i++ {
+		resp, err := cli.UnaryCall(context.Background(), &testpb.SimpleRequest{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		c, err := ParseTraceparent(resp.GetUsername())
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, c.String())
+	}
+	// the calls share the trace, each in a span of its own
+	if ids[0][:36] != ids[1][:36] || ids[0] == ids[1] {
+		t.Errorf("got %v, want two spans of the same trace", ids)
+	}
+}
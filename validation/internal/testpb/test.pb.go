@@ -0,0 +1,304 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: validation/internal/testpb/test.proto
+
+package testpb
+
+import (
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	wrappers "github.com/golang/protobuf/ptypes/wrappers"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type Request_Tier int32
+
+const (
+	Request_TIER_UNSPECIFIED Request_Tier = 0
+	Request_FREE             Request_Tier = 1
+	Request_PAID             Request_Tier = 2
+)
+
+var Request_Tier_name = map[int32]string{
+	0: "TIER_UNSPECIFIED",
+	1: "FREE",
+	2: "PAID",
+}
+
+var Request_Tier_value = map[string]int32{
+	"TIER_UNSPECIFIED": 0,
+	"FREE":             1,
+	"PAID":             2,
+}
+
+func (x Request_Tier) String() string {
+	return proto.EnumName(Request_Tier_name, int32(x))
+}
+
+func (Request_Tier) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_4f79d34c06116fab, []int{0, 0}
+}
+
+// Request has a field of every kind the validation rules apply to. The tests
+// register the rules themselves.
+type Request struct {
+	Name     string                `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Quota    uint32                `protobuf:"varint,2,opt,name=quota,proto3" json:"quota,omitempty"`
+	Offset   int64                 `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	Ratio    float64               `protobuf:"fixed64,4,opt,name=ratio,proto3" json:"ratio,omitempty"`
+	Tier     Request_Tier          `protobuf:"varint,5,opt,name=tier,proto3,enum=validation.testpb.Request_Tier" json:"tier,omitempty"`
+	Blob     []byte                `protobuf:"bytes,6,opt,name=blob,proto3" json:"blob,omitempty"`
+	Owner    *Owner                `protobuf:"bytes,7,opt,name=owner,proto3" json:"owner,omitempty"`
+	Owners   []*Owner              `protobuf:"bytes,8,rep,name=owners,proto3" json:"owners,omitempty"`
+	Tags     []string              `protobuf:"bytes,9,rep,name=tags,proto3" json:"tags,omitempty"`
+	Limits   map[string]int32      `protobuf:"bytes,10,rep,name=limits,proto3" json:"limits,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Nickname *wrappers.StringValue `protobuf:"bytes,11,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	// Types that are valid to be assigned to Target:
+	//	*Request_Url
+	//	*Request_TargetOwner
+	Target               isRequest_Target `protobuf_oneof:"target"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *Request) Reset()         { *m = Request{} }
+func (m *Request) String() string { return proto.CompactTextString(m) }
+func (*Request) ProtoMessage()    {}
+func (*Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f79d34c06116fab, []int{0}
+}
+
+func (m *Request) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Request.Unmarshal(m, b)
+}
+func (m *Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Request.Marshal(b, m, deterministic)
+}
+func (m *Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Request.Merge(m, src)
+}
+func (m *Request) XXX_Size() int {
+	return xxx_messageInfo_Request.Size(m)
+}
+func (m *Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_Request.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Request proto.InternalMessageInfo
+
+func (m *Request) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Request) GetQuota() uint32 {
+	if m != nil {
+		return m.Quota
+	}
+	return 0
+}
+
+func (m *Request) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *Request) GetRatio() float64 {
+	if m != nil {
+		return m.Ratio
+	}
+	return 0
+}
+
+func (m *Request) GetTier() Request_Tier {
+	if m != nil {
+		return m.Tier
+	}
+	return Request_TIER_UNSPECIFIED
+}
+
+func (m *Request) GetBlob() []byte {
+	if m != nil {
+		return m.Blob
+	}
+	return nil
+}
+
+func (m *Request) GetOwner() *Owner {
+	if m != nil {
+		return m.Owner
+	}
+	return nil
+}
+
+func (m *Request) GetOwners() []*Owner {
+	if m != nil {
+		return m.Owners
+	}
+	return nil
+}
+
+func (m *Request) GetTags() []string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *Request) GetLimits() map[string]int32 {
+	if m != nil {
+		return m.Limits
+	}
+	return nil
+}
+
+func (m *Request) GetNickname() *wrappers.StringValue {
+	if m != nil {
+		return m.Nickname
+	}
+	return nil
+}
+
+type isRequest_Target interface {
+	isRequest_Target()
+}
+
+type Request_Url struct {
+	Url string `protobuf:"bytes,12,opt,name=url,proto3,oneof"`
+}
+
+type Request_TargetOwner struct {
+	TargetOwner *Owner `protobuf:"bytes,13,opt,name=target_owner,json=targetOwner,proto3,oneof"`
+}
+
+func (*Request_Url) isRequest_Target() {}
+
+func (*Request_TargetOwner) isRequest_Target() {}
+
+func (m *Request) GetTarget() isRequest_Target {
+	if m != nil {
+		return m.Target
+	}
+	return nil
+}
+
+func (m *Request) GetUrl() string {
+	if x, ok := m.GetTarget().(*Request_Url); ok {
+		return x.Url
+	}
+	return ""
+}
+
+func (m *Request) GetTargetOwner() *Owner {
+	if x, ok := m.GetTarget().(*Request_TargetOwner); ok {
+		return x.TargetOwner
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*Request) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*Request_Url)(nil),
+		(*Request_TargetOwner)(nil),
+	}
+}
+
+type Owner struct {
+	Email                string   `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Owner) Reset()         { *m = Owner{} }
+func (m *Owner) String() string { return proto.CompactTextString(m) }
+func (*Owner) ProtoMessage()    {}
+func (*Owner) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f79d34c06116fab, []int{1}
+}
+
+func (m *Owner) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Owner.Unmarshal(m, b)
+}
+func (m *Owner) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Owner.Marshal(b, m, deterministic)
+}
+func (m *Owner) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Owner.Merge(m, src)
+}
+func (m *Owner) XXX_Size() int {
+	return xxx_messageInfo_Owner.Size(m)
+}
+func (m *Owner) XXX_DiscardUnknown() {
+	xxx_messageInfo_Owner.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Owner proto.InternalMessageInfo
+
+func (m *Owner) GetEmail() string {
+	if m != nil {
+		return m.Email
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("validation.testpb.Request_Tier", Request_Tier_name, Request_Tier_value)
+	proto.RegisterType((*Request)(nil), "validation.testpb.Request")
+	proto.RegisterMapType((map[string]int32)(nil), "validation.testpb.Request.LimitsEntry")
+	proto.RegisterType((*Owner)(nil), "validation.testpb.Owner")
+}
+
+func init() {
+	proto.RegisterFile("validation/internal/testpb/test.proto", fileDescriptor_4f79d34c06116fab)
+}
+
+var fileDescriptor_4f79d34c06116fab = []byte{
+	// 476 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x53, 0x5b, 0x6b, 0xdb, 0x30,
+	0x18, 0xad, 0xe2, 0x4b, 0xd3, 0x2f, 0xe9, 0xf0, 0x44, 0x19, 0xa2, 0xec, 0x62, 0x02, 0x1b, 0x7e,
+	0xa9, 0x5c, 0xda, 0x97, 0x6e, 0xb0, 0xc1, 0xb2, 0xb8, 0x34, 0x30, 0xb6, 0xa2, 0x76, 0x7b, 0xd8,
+	0x4b, 0x91, 0x33, 0xc5, 0x13, 0x75, 0xac, 0x54, 0x96, 0x5b, 0xfa, 0x7f, 0xf7, 0x43, 0x86, 0x24,
+	0xef, 0x02, 0xdb, 0xf2, 0xe4, 0x73, 0xd0, 0xd1, 0x77, 0x8e, 0xbf, 0x63, 0xc3, 0xf3, 0x5b, 0x5e,
+	0xcb, 0xaf, 0xdc, 0x48, 0xd5, 0xe4, 0xb2, 0x31, 0x42, 0x37, 0xbc, 0xce, 0x8d, 0x68, 0xcd, 0xba,
+	0x74, 0x0f, 0xba, 0xd6, 0xca, 0x28, 0xfc, 0xf0, 0xb7, 0x8c, 0xfa, 0xd3, 0xfd, 0xa7, 0x95, 0x52,
+	0x55, 0x2d, 0x72, 0x27, 0x28, 0xbb, 0x65, 0x7e, 0xa7, 0xf9, 0x7a, 0x2d, 0x74, 0xeb, 0xaf, 0x4c,
+	0xbe, 0x87, 0xb0, 0xcd, 0xc4, 0x4d, 0x27, 0x5a, 0x83, 0x31, 0x84, 0x0d, 0x5f, 0x09, 0x82, 0x52,
+	0x94, 0xed, 0x30, 0x87, 0xf1, 0x1e, 0x44, 0x37, 0x9d, 0x32, 0x9c, 0x0c, 0x52, 0x94, 0xed, 0x32,
+	0x4f, 0xf0, 0x23, 0x88, 0xd5, 0x72, 0xd9, 0x0a, 0x43, 0x82, 0x14, 0x65, 0x01, 0xeb, 0x99, 0x55,
+	0x6b, 0x6b, 0x4f, 0xc2, 0x14, 0x65, 0x88, 0x79, 0x82, 0x8f, 0x21, 0x34, 0x52, 0x68, 0x12, 0xa5,
+	0x28, 0x7b, 0x70, 0xf4, 0x8c, 0xfe, 0x95, 0x92, 0xf6, 0x09, 0xe8, 0xa5, 0x14, 0x9a, 0x39, 0xb1,
+	0x0d, 0x53, 0xd6, 0xaa, 0x24, 0x71, 0x8a, 0xb2, 0x31, 0x73, 0x18, 0x53, 0x88, 0xd4, 0x5d, 0x23,
+	0x34, 0xd9, 0x4e, 0x51, 0x36, 0x3a, 0x22, 0xff, 0x98, 0xf4, 0xd1, 0x9e, 0x33, 0x2f, 0xc3, 0x87,
+	0x10, 0x3b, 0xd0, 0x92, 0x61, 0x1a, 0x6c, 0xbc, 0xd0, 0xeb, 0xac, 0xab, 0xe1, 0x55, 0x4b, 0x76,
+	0xd2, 0xc0, 0xae, 0xc0, 0x62, 0xfc, 0x06, 0xe2, 0x5a, 0xae, 0xa4, 0x69, 0x09, 0xb8, 0x29, 0x2f,
+	0x36, 0xbc, 0xc0, 0x7b, 0x27, 0x2c, 0x1a, 0xa3, 0xef, 0x59, 0x7f, 0x0b, 0x9f, 0xc0, 0xb0, 0x91,
+	0x8b, 0x6b, 0xb7, 0xda, 0x91, 0x0b, 0xfe, 0x98, 0xfa, 0x56, 0xe8, 0xcf, 0x56, 0xe8, 0x85, 0xd1,
+	0xb2, 0xa9, 0x3e, 0xf3, 0xba, 0x13, 0xec, 0x97, 0x1a, 0x63, 0x08, 0x3a, 0x5d, 0x93, 0xb1, 0xed,
+	0xe3, 0x6c, 0x8b, 0x59, 0x82, 0x5f, 0xc3, 0xd8, 0x70, 0x5d, 0x09, 0x73, 0xe5, 0x57, 0xb1, 0xbb,
+	0x79, 0x15, 0x67, 0x5b, 0x6c, 0xe4, 0xf5, 0x8e, 0xee, 0xbf, 0x84, 0xd1, 0x1f, 0x19, 0x71, 0x02,
+	0xc1, 0xb5, 0xb8, 0xef, 0x1b, 0xb7, 0xd0, 0x56, 0x78, 0x6b, 0x63, 0xb8, 0xc2, 0x23, 0xe6, 0xc9,
+	0xab, 0xc1, 0x09, 0x9a, 0x1c, 0x42, 0x68, 0xfb, 0xc1, 0x7b, 0x90, 0x5c, 0xce, 0x0b, 0x76, 0xf5,
+	0xe9, 0xc3, 0xc5, 0x79, 0xf1, 0x6e, 0x7e, 0x3a, 0x2f, 0x66, 0xc9, 0x16, 0x1e, 0x42, 0x78, 0xca,
+	0x8a, 0x22, 0x41, 0x16, 0x9d, 0xbf, 0x9d, 0xcf, 0x92, 0xc1, 0x74, 0x08, 0xb1, 0xf7, 0x9e, 0x3c,
+	0x81, 0xc8, 0xf9, 0xdb, 0xf1, 0x62, 0xc5, 0x65, 0xdd, 0x5b, 0x7a, 0x32, 0x9d, 0x7d, 0x99, 0x56,
+	0xd2, 0x7c, 0xeb, 0x4a, 0xba, 0x50, 0xab, 0xdc, 0x08, 0xa3, 0xb9, 0x11, 0x35, 0x2f, 0x5b, 0xff,
+	0xdd, 0x2e, 0x0e, 0x2a, 0xd1, 0x1c, 0x2c, 0x54, 0xa9, 0x79, 0xfe, 0xff, 0x3f, 0xa1, 0x8c, 0x9d,
+	0xf8, 0xf8, 0xc7, 0x00, 0x71, 0x18, 0x34, 0x19, 0x2e, 0x03, 0x00, 0x00,
+}
@@ -0,0 +1,739 @@
+// Package validation checks request messages against the protoc-gen-validate
+// rules of their fields, so invalid requests are reported before they are sent.
+//
+// The rules are registered by generated code, keyed by the fully-qualified
+// names of the fields they apply to. Validate reports every rule a message
+// breaks at once, each one pointing at the field's path in the request file
+// and, when a flag sets the field, at the flag.
+//
+// Scalar, string, bytes, enum, message, repeated, map and Any rules are
+// checked. Of the duration and timestamp rules only required is; the others
+// are left to the server. Fields can also be registered as required on their
+// own, for APIs that annotate them with google.api.field_behavior instead.
+package validation
+
+import (
+	"bytes"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/envoyproxy/protoc-gen-validate/validate"
+	"github.com/golang/protobuf/proto"
+	"github.com/spf13/pflag"
+)
+
+var (
+	rulesMu sync.RWMutex
+	rules   = map[string]*validate.FieldRules{}
+)
+
+// RegisterRules records the validation rules of message fields, keyed by the
+// fields' fully-qualified names (e.g. "pkg.Message.field") and written in the
+// text format of validate.FieldRules. It panics if the rules can't be parsed.
+func RegisterRules(r map[string]string) {
+	rulesMu.Lock()
+	defer rulesMu.Unlock()
+	for k, text := range r {
+		fr := &validate.FieldRules{}
+		if err := proto.UnmarshalText(text, fr); err != nil {
+			panic(fmt.Sprintf("validation: bad rules for %s: %v", k, err))
+		}
+		rules[k] = fr
+	}
+}
+
+func rulesOf(name string) *validate.FieldRules {
+	rulesMu.RLock()
+	defer rulesMu.RUnlock()
+	return rules[name]
+}
+
+var (
+	requiredMu sync.RWMutex
+	required   = map[string]bool{}
+)
+
+// RegisterRequired records the fields that requests must set, like the fields
+// annotated with (google.api.field_behavior) = REQUIRED, by their
+// fully-qualified names. Scalars must not be zero, repeated fields and maps
+// not empty and messages neither nil nor empty.
+func RegisterRequired(names ...string) {
+	requiredMu.Lock()
+	defer requiredMu.Unlock()
+	for _, name := range names {
+		required[name] = true
+	}
+}
+
+func isRequired(name string) bool {
+	requiredMu.RLock()
+	defer requiredMu.RUnlock()
+	return required[name]
+}
+
+// A Violation is a field value that breaks one of the field's rules.
+type Violation struct {
+	Field  string // the path of the field in the request, e.g. items[0].name
+	Flag   string // the flag that sets the field, if any
+	Reason string
+}
+
+func (v *Violation) String() string {
+	if v.Flag != "" {
+		return fmt.Sprintf("%s (--%s): %s", v.Field, v.Flag, v.Reason)
+	}
+	return v.Field + ": " + v.Reason
+}
+
+// Violations is the error returned by Validate, with every rule the message breaks.
+type Violations []*Violation
+
+func (vs Violations) Error() string {
+	var b strings.Builder
+	b.WriteString("invalid request:")
+	for _, v := range vs {
+		b.WriteString("\n  " + v.String())
+	}
+	return b.String()
+}
+
+// FieldAnnotation is the flag annotation holding the path of the field a
+// request flag sets, for flags that aren't named after the path.
+const FieldAnnotation = "protoc-gen-cobra/field"
+
+// Validate checks m against the registered rules and returns Violations if
+// it breaks any. Violations of fields that can be set with one of flags name
+// the flag; flags may be nil.
+func Validate(m proto.Message, flags *pflag.FlagSet) error {
+	v := &validator{flags: flags, renamed: map[string]string{}}
+	if flags != nil {
+		flags.VisitAll(func(f *pflag.Flag) {
+			if path := f.Annotations[FieldAnnotation]; len(path) == 1 {
+				v.renamed[path[0]] = f.Name
+			}
+		})
+	}
+	v.message(reflect.ValueOf(m), location{})
+	if len(v.violations) == 0 {
+		return nil
+	}
+	return v.violations
+}
+
+// A location is where a value is in the request.
+type location struct {
+	path   string
+	flag   string
+	noFlag bool // within a repeated field or map, which flags can't set
+}
+
+func (l location) field(name string) location {
+	f := location{path: name, noFlag: l.noFlag}
+	if l.path != "" {
+		f.path = l.path + "." + name
+	}
+	if !f.noFlag {
+		// request flags are named after the lowercased JSON names of
+		// the fields on the path, separated by dashes
+		f.flag = strings.ToLower(name)
+		if l.flag != "" {
+			f.flag = l.flag + "-" + f.flag
+		}
+	}
+	return f
+}
+
+func (l location) index(key interface{}) location {
+	if s, ok := key.(string); ok {
+		key = fmt.Sprintf("%q", s)
+	}
+	return location{path: fmt.Sprintf("%s[%v]", l.path, key), noFlag: true}
+}
+
+type validator struct {
+	flags      *pflag.FlagSet
+	renamed    map[string]string // field paths to the names of their annotated flags
+	violations Violations
+}
+
+func (v *validator) fail(l location, format string, args ...interface{}) {
+	viol := &Violation{Field: l.path, Reason: fmt.Sprintf(format, args...)}
+	if name, ok := v.renamed[l.path]; ok {
+		viol.Flag = name
+	} else if !l.noFlag && v.flags != nil {
+		if f := v.flags.Lookup(l.flag); f != nil && f.Annotations[FieldAnnotation] == nil {
+			viol.Flag = l.flag
+		}
+	}
+	v.violations = append(v.violations, viol)
+}
+
+// message validates the fields of the generated message m, a non-nil pointer.
+func (v *validator) message(m reflect.Value, l location) {
+	name := proto.MessageName(m.Interface().(proto.Message))
+	st := m.Type().Elem()
+	sp := proto.GetProperties(st)
+	for i, p := range sp.Prop {
+		if strings.HasPrefix(p.Name, "XXX_") {
+			continue
+		}
+		fv := m.Elem().Field(i)
+		if p.Tag != 0 {
+			v.field(name, p, fv, l)
+			continue
+		}
+		// oneof: only the member that is set is validated
+		if fv.IsNil() {
+			continue
+		}
+		for _, oop := range sp.OneofTypes {
+			if oop.Type == fv.Elem().Type() {
+				v.field(name, oop.Prop, fv.Elem().Elem().Field(0), l)
+			}
+		}
+	}
+}
+
+func (v *validator) field(msgName string, p *proto.Properties, fv reflect.Value, l location) {
+	name := p.JSONName
+	if name == "" {
+		name = p.OrigName
+	}
+	l = l.field(name)
+	if isRequired(msgName+"."+p.OrigName) && unset(fv) {
+		v.fail(l, "value is required")
+		return
+	}
+	r := rulesOf(msgName + "." + p.OrigName)
+	switch {
+	case fv.Kind() == reflect.Map:
+		v.mapField(r.GetMap(), p, fv, l)
+	case fv.Kind() == reflect.Slice && fv.Type().Elem().Kind() != reflect.Uint8:
+		v.repeated(r.GetRepeated(), p, fv, l)
+	default:
+		v.value(r, p, fv, l)
+	}
+}
+
+func (v *validator) repeated(r *validate.RepeatedRules, p *proto.Properties, fv reflect.Value, l location) {
+	if r == nil {
+		r = &validate.RepeatedRules{}
+	}
+	n := uint64(fv.Len())
+	if r.MinItems != nil && n < r.GetMinItems() {
+		v.fail(l, "value must contain at least %d item(s)", r.GetMinItems())
+	}
+	if r.MaxItems != nil && n > r.GetMaxItems() {
+		v.fail(l, "value must contain no more than %d item(s)", r.GetMaxItems())
+	}
+	for i := 0; i < fv.Len(); i++ {
+		if r.GetUnique() {
+			for j := 0; j < i; j++ {
+				if equal(fv.Index(i), fv.Index(j)) {
+					v.fail(l.index(i), "repeated value must contain unique items")
+					break
+				}
+			}
+		}
+		v.value(r.GetItems(), p, fv.Index(i), l.index(i))
+	}
+}
+
+func (v *validator) mapField(r *validate.MapRules, p *proto.Properties, fv reflect.Value, l location) {
+	if r == nil {
+		r = &validate.MapRules{}
+	}
+	n := uint64(fv.Len())
+	if r.MinPairs != nil && n < r.GetMinPairs() {
+		v.fail(l, "value must contain at least %d pair(s)", r.GetMinPairs())
+	}
+	if r.MaxPairs != nil && n > r.GetMaxPairs() {
+		v.fail(l, "value must contain no more than %d pair(s)", r.GetMaxPairs())
+	}
+	keys := fv.MapKeys()
+	// report in a stable order
+	sortValues(keys)
+	for _, k := range keys {
+		kl := l.index(k.Interface())
+		value := fv.MapIndex(k)
+		if r.GetNoSparse() && value.Kind() == reflect.Ptr && value.IsNil() {
+			v.fail(kl, "value cannot be sparse, all pairs must be non-nil")
+		}
+		v.value(r.GetKeys(), p.MapKeyProp, k, kl)
+		v.value(r.GetValues(), p.MapValProp, value, kl)
+	}
+}
+
+// value validates a single value of a field: the field itself, an item of a
+// repeated field or a key or value of a map.
+func (v *validator) value(r *validate.FieldRules, p *proto.Properties, fv reflect.Value, l location) {
+	if isMessage(fv.Type()) {
+		v.messageValue(r, fv, l)
+		return
+	}
+	if r == nil {
+		return
+	}
+	switch t := r.Type.(type) {
+	case *validate.FieldRules_String_:
+		if fv.Kind() == reflect.String {
+			v.string(t.String_, fv.String(), l)
+		}
+	case *validate.FieldRules_Bytes:
+		if fv.Kind() == reflect.Slice {
+			v.bytes(t.Bytes, fv.Bytes(), l)
+		}
+	case *validate.FieldRules_Bool:
+		if t.Bool.Const != nil && fv.Kind() == reflect.Bool && fv.Bool() != t.Bool.GetConst() {
+			v.fail(l, "value must equal %v", t.Bool.GetConst())
+		}
+	case *validate.FieldRules_Enum:
+		if fv.Kind() == reflect.Int32 {
+			v.enum(t.Enum, p, int32(fv.Int()), l)
+		}
+	default:
+		// the numeric rules all hold a single pointer to a struct of
+		// Const, Lt, Lte, Gt, Gte, In and NotIn fields
+		if rules := reflect.ValueOf(t); rules.Kind() == reflect.Ptr && !rules.IsNil() {
+			v.number(rules.Elem().Field(0), fv, l)
+		}
+	}
+}
+
+func (v *validator) messageValue(r *validate.FieldRules, fv reflect.Value, l location) {
+	if fv.IsNil() {
+		if requiredMessage(r) {
+			v.fail(l, "value is required")
+		}
+		return
+	}
+	switch wellKnownType(fv.Type()) {
+	case "":
+		if !r.GetMessage().GetSkip() {
+			v.message(fv, l)
+		}
+	case "DoubleValue", "FloatValue", "Int64Value", "UInt64Value", "Int32Value", "UInt32Value",
+		"BoolValue", "StringValue", "BytesValue":
+		// scalar rules apply to the wrapped value
+		v.value(r, nil, fv.Elem().FieldByName("Value"), l)
+	case "Any":
+		typeURL := fv.Elem().FieldByName("TypeUrl").String()
+		if in := r.GetAny().GetIn(); len(in) > 0 && !containsString(in, typeURL) {
+			v.fail(l, "type URL must be in list %v", in)
+		}
+		if containsString(r.GetAny().GetNotIn(), typeURL) {
+			v.fail(l, "type URL must not be in list %v", r.GetAny().GetNotIn())
+		}
+	}
+}
+
+func requiredMessage(r *validate.FieldRules) bool {
+	switch t := r.GetType().(type) {
+	case *validate.FieldRules_Message:
+		return t.Message.GetRequired()
+	case *validate.FieldRules_Duration:
+		return t.Duration.GetRequired()
+	case *validate.FieldRules_Timestamp:
+		return t.Timestamp.GetRequired()
+	case *validate.FieldRules_Any:
+		return t.Any.GetRequired()
+	}
+	return false
+}
+
+func (v *validator) string(r *validate.StringRules, s string, l location) {
+	runes := uint64(utf8.RuneCountInString(s))
+	if r.Const != nil && s != r.GetConst() {
+		v.fail(l, "value must equal %q", r.GetConst())
+	}
+	if r.Len != nil && runes != r.GetLen() {
+		v.fail(l, "value length must be %d rune(s)", r.GetLen())
+	}
+	if r.MinLen != nil && runes < r.GetMinLen() {
+		v.fail(l, "value length must be at least %d rune(s)", r.GetMinLen())
+	}
+	if r.MaxLen != nil && runes > r.GetMaxLen() {
+		v.fail(l, "value length must be at most %d rune(s)", r.GetMaxLen())
+	}
+	if r.LenBytes != nil && uint64(len(s)) != r.GetLenBytes() {
+		v.fail(l, "value length must be %d byte(s)", r.GetLenBytes())
+	}
+	if r.MinBytes != nil && uint64(len(s)) < r.GetMinBytes() {
+		v.fail(l, "value length must be at least %d byte(s)", r.GetMinBytes())
+	}
+	if r.MaxBytes != nil && uint64(len(s)) > r.GetMaxBytes() {
+		v.fail(l, "value length must be at most %d byte(s)", r.GetMaxBytes())
+	}
+	if r.Pattern != nil {
+		v.pattern(r.GetPattern(), []byte(s), l)
+	}
+	if r.Prefix != nil && !strings.HasPrefix(s, r.GetPrefix()) {
+		v.fail(l, "value does not have prefix %q", r.GetPrefix())
+	}
+	if r.Suffix != nil && !strings.HasSuffix(s, r.GetSuffix()) {
+		v.fail(l, "value does not have suffix %q", r.GetSuffix())
+	}
+	if r.Contains != nil && !strings.Contains(s, r.GetContains()) {
+		v.fail(l, "value does not contain substring %q", r.GetContains())
+	}
+	if len(r.In) > 0 && !containsString(r.In, s) {
+		v.fail(l, "value must be in list %q", r.In)
+	}
+	if containsString(r.NotIn, s) {
+		v.fail(l, "value must not be in list %q", r.NotIn)
+	}
+	switch {
+	case r.GetEmail():
+		if a, err := mail.ParseAddress(s); err != nil || a.Name != "" {
+			v.fail(l, "value must be a valid email address")
+		}
+	case r.GetHostname():
+		if !isHostname(s) {
+			v.fail(l, "value must be a valid hostname")
+		}
+	case r.GetAddress():
+		if net.ParseIP(s) == nil && !isHostname(s) {
+			v.fail(l, "value must be a valid hostname or IP address")
+		}
+	case r.GetIp():
+		if net.ParseIP(s) == nil {
+			v.fail(l, "value must be a valid IP address")
+		}
+	case r.GetIpv4():
+		if ip := net.ParseIP(s); ip == nil || ip.To4() == nil {
+			v.fail(l, "value must be a valid IPv4 address")
+		}
+	case r.GetIpv6():
+		if ip := net.ParseIP(s); ip == nil || ip.To4() != nil {
+			v.fail(l, "value must be a valid IPv6 address")
+		}
+	case r.GetUri():
+		if u, err := url.Parse(s); err != nil || !u.IsAbs() {
+			v.fail(l, "value must be a valid absolute URI")
+		}
+	case r.GetUriRef():
+		if _, err := url.Parse(s); err != nil {
+			v.fail(l, "value must be a valid URI")
+		}
+	}
+}
+
+func (v *validator) bytes(r *validate.BytesRules, b []byte, l location) {
+	n := uint64(len(b))
+	if r.Const != nil && !bytes.Equal(b, r.Const) {
+		v.fail(l, "value must equal %q", r.Const)
+	}
+	if r.Len != nil && n != r.GetLen() {
+		v.fail(l, "value length must be %d byte(s)", r.GetLen())
+	}
+	if r.MinLen != nil && n < r.GetMinLen() {
+		v.fail(l, "value length must be at least %d byte(s)", r.GetMinLen())
+	}
+	if r.MaxLen != nil && n > r.GetMaxLen() {
+		v.fail(l, "value length must be at most %d byte(s)", r.GetMaxLen())
+	}
+	if r.Pattern != nil {
+		v.pattern(r.GetPattern(), b, l)
+	}
+	if r.Prefix != nil && !bytes.HasPrefix(b, r.Prefix) {
+		v.fail(l, "value does not have prefix %q", r.Prefix)
+	}
+	if r.Suffix != nil && !bytes.HasSuffix(b, r.Suffix) {
+		v.fail(l, "value does not have suffix %q", r.Suffix)
+	}
+	if r.Contains != nil && !bytes.Contains(b, r.Contains) {
+		v.fail(l, "value does not contain %q", r.Contains)
+	}
+	if len(r.In) > 0 && !containsBytes(r.In, b) {
+		v.fail(l, "value must be in list %q", r.In)
+	}
+	if containsBytes(r.NotIn, b) {
+		v.fail(l, "value must not be in list %q", r.NotIn)
+	}
+	switch {
+	case r.GetIp() && n != net.IPv4len && n != net.IPv6len:
+		v.fail(l, "value must be a valid IP address")
+	case r.GetIpv4() && n != net.IPv4len:
+		v.fail(l, "value must be a valid IPv4 address")
+	case r.GetIpv6() && n != net.IPv6len:
+		v.fail(l, "value must be a valid IPv6 address")
+	}
+}
+
+var patterns sync.Map // map[string]*regexp.Regexp
+
+func (v *validator) pattern(pattern string, b []byte, l location) {
+	re, ok := patterns.Load(pattern)
+	if !ok {
+		compiled, err := regexp.Compile(pattern)
+		if err != nil {
+			v.fail(l, "invalid pattern %q: %v", pattern, err)
+			return
+		}
+		re, _ = patterns.LoadOrStore(pattern, compiled)
+	}
+	if !re.(*regexp.Regexp).Match(b) {
+		v.fail(l, "value does not match pattern %q", pattern)
+	}
+}
+
+func (v *validator) enum(r *validate.EnumRules, p *proto.Properties, n int32, l location) {
+	if r.Const != nil && n != r.GetConst() {
+		v.fail(l, "value must equal %s", enumName(p, r.GetConst()))
+	}
+	if r.GetDefinedOnly() && p != nil && p.Enum != "" {
+		defined := false
+		for _, value := range proto.EnumValueMap(p.Enum) {
+			defined = defined || value == n
+		}
+		if !defined {
+			v.fail(l, "value must be one of the defined enum values")
+		}
+	}
+	if len(r.In) > 0 && !containsInt32(r.In, n) {
+		v.fail(l, "value must be in list %s", enumNames(p, r.In))
+	}
+	if containsInt32(r.NotIn, n) {
+		v.fail(l, "value must not be in list %s", enumNames(p, r.NotIn))
+	}
+}
+
+func enumName(p *proto.Properties, n int32) string {
+	if p != nil && p.Enum != "" {
+		for name, value := range proto.EnumValueMap(p.Enum) {
+			if value == n {
+				return name
+			}
+		}
+	}
+	return fmt.Sprint(n)
+}
+
+func enumNames(p *proto.Properties, ns []int32) string {
+	names := make([]string, len(ns))
+	for i, n := range ns {
+		names[i] = enumName(p, n)
+	}
+	return "[" + strings.Join(names, " ") + "]"
+}
+
+// number validates the numeric value fv against rules, one of the numeric
+// rule structs of validate.FieldRules (e.g. *validate.Int32Rules).
+func (v *validator) number(rules reflect.Value, fv reflect.Value, l location) {
+	if rules.Kind() != reflect.Ptr || rules.IsNil() || !isNumber(fv) {
+		return
+	}
+	rs := rules.Elem()
+	bound := func(name string) (reflect.Value, bool) {
+		f := rs.FieldByName(name)
+		if !f.IsValid() || f.IsNil() {
+			return reflect.Value{}, false
+		}
+		return f.Elem(), true
+	}
+	if c, ok := bound("Const"); ok && compare(fv, c) != 0 {
+		v.fail(l, "value must equal %v", c)
+	}
+
+	lt, hasLt := bound("Lt")
+	lte, hasLte := bound("Lte")
+	gt, hasGt := bound("Gt")
+	gte, hasGte := bound("Gte")
+	var upper, lower reflect.Value
+	var upperText, lowerText string
+	belowUpper, aboveLower := true, true
+	switch {
+	case hasLt:
+		upper, upperText, belowUpper = lt, fmt.Sprintf("less than %v", lt), compare(fv, lt) < 0
+	case hasLte:
+		upper, upperText, belowUpper = lte, fmt.Sprintf("less than or equal to %v", lte), compare(fv, lte) <= 0
+	}
+	switch {
+	case hasGt:
+		lower, lowerText, aboveLower = gt, fmt.Sprintf("greater than %v", gt), compare(fv, gt) > 0
+	case hasGte:
+		lower, lowerText, aboveLower = gte, fmt.Sprintf("greater than or equal to %v", gte), compare(fv, gte) >= 0
+	}
+	switch {
+	case upper.IsValid() && lower.IsValid() && compare(upper, lower) < 0:
+		// an upper bound below the lower bound excludes the range in between
+		if !belowUpper && !aboveLower {
+			v.fail(l, "value must be %s or %s", upperText, lowerText)
+		}
+	case upper.IsValid() && lower.IsValid():
+		if !belowUpper || !aboveLower {
+			v.fail(l, "value must be %s and %s", lowerText, upperText)
+		}
+	case !belowUpper:
+		v.fail(l, "value must be %s", upperText)
+	case !aboveLower:
+		v.fail(l, "value must be %s", lowerText)
+	}
+
+	if in := rs.FieldByName("In"); in.IsValid() && in.Len() > 0 && !containsNumber(in, fv) {
+		v.fail(l, "value must be in list %v", in)
+	}
+	if notIn := rs.FieldByName("NotIn"); notIn.IsValid() && containsNumber(notIn, fv) {
+		v.fail(l, "value must not be in list %v", notIn)
+	}
+}
+
+func isNumber(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Int32, reflect.Int64, reflect.Uint32, reflect.Uint64, reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+// compare compares two numbers, which may have different Go types.
+func compare(a, b reflect.Value) int {
+	switch {
+	case isSigned(a) && isSigned(b):
+		return compareOrdered(a.Int() < b.Int(), a.Int() > b.Int())
+	case isUnsigned(a) && isUnsigned(b):
+		return compareOrdered(a.Uint() < b.Uint(), a.Uint() > b.Uint())
+	}
+	x, y := toFloat(a), toFloat(b)
+	return compareOrdered(x < y, x > y)
+}
+
+func compareOrdered(less, greater bool) int {
+	switch {
+	case less:
+		return -1
+	case greater:
+		return 1
+	}
+	return 0
+}
+
+func isSigned(v reflect.Value) bool {
+	return v.Kind() == reflect.Int32 || v.Kind() == reflect.Int64
+}
+
+func isUnsigned(v reflect.Value) bool {
+	return v.Kind() == reflect.Uint32 || v.Kind() == reflect.Uint64
+}
+
+func toFloat(v reflect.Value) float64 {
+	switch {
+	case isSigned(v):
+		return float64(v.Int())
+	case isUnsigned(v):
+		return float64(v.Uint())
+	}
+	return v.Float()
+}
+
+func containsNumber(list, v reflect.Value) bool {
+	for i := 0; i < list.Len(); i++ {
+		if compare(list.Index(i), v) == 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+func containsBytes(list [][]byte, b []byte) bool {
+	for _, item := range list {
+		if bytes.Equal(item, b) {
+			return true
+		}
+	}
+	return false
+}
+
+func containsInt32(list []int32, n int32) bool {
+	for _, item := range list {
+		if item == n {
+			return true
+		}
+	}
+	return false
+}
+
+// unset reports whether the field value fv is missing from the request.
+// Request flags of nested fields leave their messages empty rather than nil,
+// so empty messages count as missing too.
+func unset(fv reflect.Value) bool {
+	switch {
+	case isMessage(fv.Type()):
+		return fv.IsNil() || proto.Size(fv.Interface().(proto.Message)) == 0
+	case fv.Kind() == reflect.Map, fv.Kind() == reflect.Slice:
+		return fv.Len() == 0
+	}
+	return reflect.DeepEqual(fv.Interface(), reflect.Zero(fv.Type()).Interface())
+}
+
+func equal(a, b reflect.Value) bool {
+	if isMessage(a.Type()) {
+		return proto.Equal(a.Interface().(proto.Message), b.Interface().(proto.Message))
+	}
+	return reflect.DeepEqual(a.Interface(), b.Interface())
+}
+
+// isHostname reports whether s is a valid (RFC 1034) host name.
+func isHostname(s string) bool {
+	s = strings.TrimSuffix(s, ".")
+	if len(s) == 0 || len(s) > 253 {
+		return false
+	}
+	for _, label := range strings.Split(s, ".") {
+		if len(label) == 0 || len(label) > 63 || label[0] == '-' || label[len(label)-1] == '-' {
+			return false
+		}
+		for _, r := range label {
+			if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '-') {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// isMessage reports whether t is the Go type of a generated message.
+func isMessage(t reflect.Type) bool {
+	return t.Kind() == reflect.Ptr && t.Elem().Kind() == reflect.Struct && t.Implements(messageType)
+}
+
+var messageType = reflect.TypeOf((*proto.Message)(nil)).Elem()
+
+// wellKnownType returns the name of the well-known type t implements, if any.
+func wellKnownType(t reflect.Type) string {
+	if w, ok := reflect.Zero(t).Interface().(interface{ XXX_WellKnownType() string }); ok {
+		return w.XXX_WellKnownType()
+	}
+	return ""
+}
+
+// sortValues sorts map keys, which all have the same basic kind.
+func sortValues(keys []reflect.Value) {
+	sort.Slice(keys, func(i, j int) bool {
+		a, b := keys[i], keys[j]
+		switch a.Kind() {
+		case reflect.String:
+			return a.String() < b.String()
+		case reflect.Bool:
+			return !a.Bool() && b.Bool()
+		}
+		return compare(a, b) < 0
+	})
+}
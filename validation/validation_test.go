@@ -0,0 +1,161 @@
+package validation
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/spf13/pflag"
+
+	"github.com/tetratelabs/protoc-gen-cobra/validation/internal/testpb"
+)
+
+func init() {
+	RegisterRules(map[string]string{
+		"validation.testpb.Request.name":         `string:<min_len:3 pattern:"^[a-z]+$" >`,
+		"validation.testpb.Request.quota":        `uint32:<gte:1 lte:100 >`,
+		"validation.testpb.Request.offset":       `int64:<lt:0 gt:10 >`,
+		"validation.testpb.Request.ratio":        `double:<not_in:0.5 >`,
+		"validation.testpb.Request.tier":         `enum:<defined_only:true not_in:0 >`,
+		"validation.testpb.Request.blob":         `bytes:<max_len:2 >`,
+		"validation.testpb.Request.owner":        `message:<required:true >`,
+		"validation.testpb.Request.owners":       `repeated:<max_items:2 >`,
+		"validation.testpb.Request.tags":         `repeated:<min_items:1 unique:true items:<string:<min_len:1 > > >`,
+		"validation.testpb.Request.limits":       `map:<keys:<string:<prefix:"x-" > > values:<int32:<gt:0 > > >`,
+		"validation.testpb.Request.nickname":     `string:<max_len:4 >`,
+		"validation.testpb.Request.url":          `string:<uri:true >`,
+		"validation.testpb.Request.target_owner": `message:<skip:true >`,
+		"validation.testpb.Owner.email":          `string:<email:true >`,
+	})
+}
+
+func valid() *testpb.Request {
+	return &testpb.Request{
+		Name:     "abc",
+		Quota:    10,
+		Offset:   -1,
+		Tier:     testpb.Request_FREE,
+		Owner:    &testpb.Owner{Email: "a@example.com"},
+		Tags:     []string{"a"},
+		Limits:   map[string]int32{"x-a": 1},
+		Nickname: &wrappers.StringValue{Value: "nick"},
+		Target:   &testpb.Request_Url{Url: "https://example.com"},
+	}
+}
+
+func TestValid(t *testing.T) {
+	if err := Validate(valid(), nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestViolations(t *testing.T) {
+	flags := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	flags.String("name", "", "")
+	flags.String("owner-email", "", "")
+
+	m := &testpb.Request{
+		Name:     "A",
+		Quota:    200,
+		Offset:   5,
+		Ratio:    0.5,
+		Tier:     testpb.Request_Tier(7),
+		Blob:     []byte("abc"),
+		Owner:    &testpb.Owner{Email: "nope"},
+		Owners:   []*testpb.Owner{{Email: "a@example.com"}, {Email: "b@example.com"}, {Email: "bad"}},
+		Tags:     []string{"a", "", "a"},
+		Limits:   map[string]int32{"b": 1, "x-c": 0},
+		Nickname: &wrappers.StringValue{Value: "toolong"},
+		Target:   &testpb.Request_Url{Url: "relative/path"},
+	}
+	want := []string{
+		`name (--name): value length must be at least 3 rune(s)`,
+		`name (--name): value does not match pattern "^[a-z]+$"`,
+		`quota: value must be greater than or equal to 1 and less than or equal to 100`,
+		`offset: value must be less than 0 or greater than 10`,
+		`ratio: value must not be in list [0.5]`,
+		`tier: value must be one of the defined enum values`,
+		`blob: value length must be at most 2 byte(s)`,
+		`owner.email (--owner-email): value must be a valid email address`,
+		`owners: value must contain no more than 2 item(s)`,
+		`owners[2].email: value must be a valid email address`,
+		`tags[1]: value length must be at least 1 rune(s)`,
+		`tags[2]: repeated value must contain unique items`,
+		`limits["b"]: value does not have prefix "x-"`,
+		`limits["x-c"]: value must be greater than 0`,
+		`nickname: value length must be at most 4 rune(s)`,
+		`url: value must be a valid absolute URI`,
+	}
+	err := Validate(m, flags)
+	vs, ok := err.(Violations)
+	if !ok {
+		t.Fatalf("got %v, want Violations", err)
+	}
+	got := make([]string, len(vs))
+	for i, v := range vs {
+		got[i] = v.String()
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("got:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestRequiredMessage(t *testing.T) {
+	m := valid()
+	m.Owner = nil
+	err := Validate(m, nil)
+	if err == nil || err.Error() != "invalid request:\n  owner: value is required" {
+		t.Errorf("got %v", err)
+	}
+}
+
+func TestSkippedMessage(t *testing.T) {
+	m := valid()
+	m.Target = &testpb.Request_TargetOwner{TargetOwner: &testpb.Owner{Email: "bad"}}
+	if err := Validate(m, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestAnnotatedFlag(t *testing.T) {
+	flags := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	flags.String("title", "", "")
+	flags.SetAnnotation("title", FieldAnnotation, []string{"name"})
+	flags.String("owner-email", "", "")
+	flags.SetAnnotation("owner-email", FieldAnnotation, []string{"nickname"})
+
+	m := valid()
+	m.Name = "abcd1"
+	m.Owner.Email = "nope"
+	want := "invalid request:\n" +
+		`  name (--title): value does not match pattern "^[a-z]+$"` + "\n" +
+		"  owner.email: value must be a valid email address"
+	if err := Validate(m, flags); err == nil || err.Error() != want {
+		t.Errorf("got %v, want %s", err, want)
+	}
+}
+
+func TestRequiredFields(t *testing.T) {
+	RegisterRequired("validation.testpb.Request.ratio", "validation.testpb.Owner.email", "validation.testpb.Request.nickname")
+	defer func() { required = map[string]bool{} }()
+
+	flags := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	flags.Float64("ratio", 0, "")
+	flags.String("owner-email", "", "")
+
+	m := valid()
+	m.Owner.Email = ""
+	m.Nickname = &wrappers.StringValue{}
+	want := "invalid request:\n" +
+		"  ratio (--ratio): value is required\n" +
+		"  owner.email (--owner-email): value is required\n" +
+		"  nickname: value is required"
+	if err := Validate(m, flags); err == nil || err.Error() != want {
+		t.Errorf("got %v, want %s", err, want)
+	}
+
+	m.Ratio, m.Owner.Email, m.Nickname.Value = 0.1, "a@example.com", "nick"
+	if err := Validate(m, flags); err != nil {
+		t.Error(err)
+	}
+}
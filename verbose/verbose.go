@@ -0,0 +1,344 @@
+// Package verbose logs what happens on the wire for generated commands: how
+// the server address resolves, the connection states, the TLS handshake, and
+// the headers, messages and trailers of every call, with their timings.
+//
+// Lines start with "*" for the connection, ">" for what is sent and "<" for
+// what is received, followed by the time since the logger was created.
+package verbose
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"net"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/stats"
+	"google.golang.org/grpc/status"
+
+	"github.com/tetratelabs/protoc-gen-cobra/dryrun"
+)
+
+// A Logger logs to a writer, one line at a time.
+type Logger struct {
+	mu    sync.Mutex
+	w     io.Writer
+	start time.Time
+}
+
+// New returns a logger writing to w.
+func New(w io.Writer) *Logger {
+	return &Logger{w: w, start: time.Now()}
+}
+
+func (l *Logger) logf(marker, format string, args ...interface{}) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	fmt.Fprintf(l.w, "%s [%v] %s\n", marker, time.Since(l.start).Round(time.Microsecond), fmt.Sprintf(format, args...))
+}
+
+// DialOptions returns the options logging how a connection is made and the
+// calls made over it.
+func (l *Logger) DialOptions() []grpc.DialOption {
+	return []grpc.DialOption{
+		grpc.WithContextDialer(l.dial),
+		grpc.WithChainUnaryInterceptor(l.unary),
+		grpc.WithChainStreamInterceptor(l.stream),
+		grpc.WithStatsHandler(&handler{l}),
+	}
+}
+
+// Dial dials target, the way grpc.Dial does with grpc.WithBlock and
+// grpc.WithTimeout, and logs the state transitions of the connection until it
+// is shut down.
+func (l *Logger) Dial(target string, timeout time.Duration, opts ...grpc.DialOption) (*grpc.ClientConn, error) {
+	l.logf("*", "dialing %s", target)
+	conn, err := grpc.Dial(target, opts...)
+	if err != nil {
+		return nil, err
+	}
+	ctx := context.Background()
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	state := conn.GetState()
+	l.logf("*", "connection %v", state)
+	for state != connectivity.Ready {
+		if !conn.WaitForStateChange(ctx, state) {
+			conn.Close()
+			return nil, ctx.Err()
+		}
+		state = conn.GetState()
+		l.logf("*", "connection %v", state)
+	}
+	go func() {
+		for state != connectivity.Shutdown && conn.WaitForStateChange(context.Background(), state) {
+			state = conn.GetState()
+			l.logf("*", "connection %v", state)
+		}
+	}()
+	return conn, nil
+}
+
+// dial resolves the host of addr and connects to its first address that
+// accepts the connection.
+func (l *Logger) dial(ctx context.Context, addr string) (net.Conn, error) {
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		return nil, err
+	}
+	t := time.Now()
+	addrs, err := net.DefaultResolver.LookupHost(ctx, host)
+	if err != nil {
+		l.logf("*", "resolving %s: %v", host, err)
+		return nil, err
+	}
+	l.logf("*", "resolved %s to %s in %v", host, strings.Join(addrs, ", "), since(t))
+	var d net.Dialer
+	for _, a := range addrs {
+		t := time.Now()
+		conn, err := d.DialContext(ctx, "tcp", net.JoinHostPort(a, port))
+		if err != nil {
+			l.logf("*", "connecting to %s: %v", net.JoinHostPort(a, port), err)
+			continue
+		}
+		l.logf("*", "connected to %v from %v in %v", conn.RemoteAddr(), conn.LocalAddr(), since(t))
+		return conn, nil
+	}
+	return nil, fmt.Errorf("no address of %s accepts connections", host)
+}
+
+// Credentials returns c, logging its TLS handshakes.
+func (l *Logger) Credentials(c credentials.TransportCredentials) credentials.TransportCredentials {
+	return &loggedCredentials{c, l}
+}
+
+type loggedCredentials struct {
+	credentials.TransportCredentials
+	l *Logger
+}
+
+func (c *loggedCredentials) ClientHandshake(ctx context.Context, authority string, conn net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	t := time.Now()
+	conn, info, err := c.TransportCredentials.ClientHandshake(ctx, authority, conn)
+	if err != nil {
+		c.l.logf("*", "TLS handshake with %s: %v", authority, err)
+		return conn, info, err
+	}
+	if ti, ok := info.(credentials.TLSInfo); ok {
+		subject := "none"
+		if certs := ti.State.PeerCertificates; len(certs) > 0 {
+			subject = certs[0].Subject.String()
+		}
+		c.l.logf("*", "TLS handshake with %s in %v: %s, %s, peer certificate %q",
+			authority, since(t), TLSVersion(ti.State.Version), CipherSuite(ti.State.CipherSuite), subject)
+	}
+	return conn, info, nil
+}
+
+func (c *loggedCredentials) Clone() credentials.TransportCredentials {
+	return &loggedCredentials{c.TransportCredentials.Clone(), c.l}
+}
+
+func (l *Logger) unary(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	t := time.Now()
+	l.logf("*", "calling %s", method)
+	md, _ := metadata.FromOutgoingContext(ctx)
+	l.logMetadata(">", "metadata", md)
+	var header, trailer metadata.MD
+	err := invoker(ctx, method, req, reply, cc, append(opts, grpc.Header(&header), grpc.Trailer(&trailer))...)
+	l.logMetadata("<", "headers", header)
+	l.logMetadata("<", "trailers", trailer)
+	l.logf("*", "%s ended with %v in %v", method, status.Code(err), since(t))
+	return err
+}
+
+func (l *Logger) stream(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	t := time.Now()
+	l.logf("*", "opening stream %s", method)
+	md, _ := metadata.FromOutgoingContext(ctx)
+	l.logMetadata(">", "metadata", md)
+	s, err := streamer(ctx, desc, cc, method, opts...)
+	if err != nil {
+		l.logf("*", "%s ended with %v in %v", method, status.Code(err), since(t))
+		return nil, err
+	}
+	return &loggedStream{ClientStream: s, l: l, method: method, start: t, serverStream: desc.ServerStreams}, nil
+}
+
+// loggedStream logs the headers of the stream on its first message, and its
+// trailers and status when it ends.
+type loggedStream struct {
+	grpc.ClientStream
+	l            *Logger
+	method       string
+	start        time.Time
+	serverStream bool
+	header, end  sync.Once
+}
+
+func (s *loggedStream) RecvMsg(m interface{}) error {
+	err := s.ClientStream.RecvMsg(m)
+	s.header.Do(func() {
+		if md, err := s.ClientStream.Header(); err == nil {
+			s.l.logMetadata("<", "headers", md)
+		}
+	})
+	if err != nil || !s.serverStream {
+		s.end.Do(func() {
+			s.l.logMetadata("<", "trailers", s.ClientStream.Trailer())
+			code := status.Code(err)
+			if err == io.EOF {
+				code = codes.OK
+			}
+			s.l.logf("*", "%s ended with %v in %v", s.method, code, since(s.start))
+		})
+	}
+	return err
+}
+
+// handler logs the sizes, compression and timings of what the calls send and
+// receive, since the calls began.
+type handler struct {
+	l *Logger
+}
+
+type beginKey struct{}
+
+func (h *handler) TagRPC(ctx context.Context, info *stats.RPCTagInfo) context.Context {
+	return context.WithValue(ctx, beginKey{}, time.Now())
+}
+
+func (h *handler) HandleRPC(ctx context.Context, s stats.RPCStats) {
+	begin, _ := ctx.Value(beginKey{}).(time.Time)
+	at := since(begin)
+	switch s := s.(type) {
+	case *stats.OutHeader:
+		h.l.logf(">", "headers to %v, compression %s, after %v", s.RemoteAddr, compression(s.Compression), at)
+	case *stats.OutPayload:
+		h.l.logf(">", "message of %d bytes, %d on the wire, after %v", s.Length, s.WireLength, at)
+	case *stats.InHeader:
+		h.l.logf("<", "headers of %d bytes, compression %s, after %v", s.WireLength, compression(s.Compression), at)
+	case *stats.InPayload:
+		h.l.logf("<", "message of %d bytes, %d on the wire, after %v", s.Length, s.WireLength, at)
+	case *stats.InTrailer:
+		h.l.logf("<", "trailers of %d bytes after %v", s.WireLength, at)
+	case *stats.End:
+		h.l.logf("*", "call ended on the wire after %v", s.EndTime.Sub(s.BeginTime).Round(time.Microsecond))
+	}
+}
+
+func (h *handler) TagConn(ctx context.Context, info *stats.ConnTagInfo) context.Context {
+	return ctx
+}
+
+func (h *handler) HandleConn(ctx context.Context, s stats.ConnStats) {}
+
+// logMetadata logs md as what, sorted, with its secrets redacted and its
+// binary values replaced by their sizes.
+func (l *Logger) logMetadata(marker, what string, md metadata.MD) {
+	if len(md) == 0 {
+		return
+	}
+	keys := make([]string, 0, len(md))
+	for k := range md {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	l.logf(marker, "%s:", what)
+	for _, k := range keys {
+		for _, v := range md[k] {
+			switch {
+			case sensitive(k):
+				v = dryrun.Redact(v)
+			case strings.HasSuffix(k, "-bin"):
+				v = fmt.Sprintf("(%d bytes)", len(v))
+			}
+			l.logf(marker, "  %s: %s", k, v)
+		}
+	}
+}
+
+// sensitive reports whether the values of the metadata key k are secrets.
+func sensitive(k string) bool {
+	switch k {
+	case "authorization", "proxy-authorization", "cookie", "set-cookie":
+		return true
+	}
+	for _, s := range []string{"token", "secret", "password", "api-key", "apikey"} {
+		if strings.Contains(k, s) {
+			return true
+		}
+	}
+	return false
+}
+
+func compression(name string) string {
+	if name == "" {
+		return "none"
+	}
+	return name
+}
+
+func since(t time.Time) time.Duration {
+	return time.Since(t).Round(time.Microsecond)
+}
+
+// TLSVersion returns the name of the TLS version v, such as "TLS 1.3".
+func TLSVersion(v uint16) string {
+	switch v {
+	case tls.VersionTLS10:
+		return "TLS 1.0"
+	case tls.VersionTLS11:
+		return "TLS 1.1"
+	case tls.VersionTLS12:
+		return "TLS 1.2"
+	case tls.VersionTLS13:
+		return "TLS 1.3"
+	}
+	return fmt.Sprintf("TLS version %#04x", v)
+}
+
+var cipherSuites = map[uint16]string{
+	tls.TLS_AES_128_GCM_SHA256:                  "TLS_AES_128_GCM_SHA256",
+	tls.TLS_AES_256_GCM_SHA384:                  "TLS_AES_256_GCM_SHA384",
+	tls.TLS_CHACHA20_POLY1305_SHA256:            "TLS_CHACHA20_POLY1305_SHA256",
+	tls.TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256: "TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256",
+	tls.TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384: "TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384",
+	tls.TLS_ECDHE_ECDSA_WITH_CHACHA20_POLY1305:  "TLS_ECDHE_ECDSA_WITH_CHACHA20_POLY1305",
+	tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256:   "TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256",
+	tls.TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384:   "TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384",
+	tls.TLS_ECDHE_RSA_WITH_CHACHA20_POLY1305:    "TLS_ECDHE_RSA_WITH_CHACHA20_POLY1305",
+	tls.TLS_ECDHE_ECDSA_WITH_AES_128_CBC_SHA:    "TLS_ECDHE_ECDSA_WITH_AES_128_CBC_SHA",
+	tls.TLS_ECDHE_ECDSA_WITH_AES_256_CBC_SHA:    "TLS_ECDHE_ECDSA_WITH_AES_256_CBC_SHA",
+	tls.TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA:      "TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA",
+	tls.TLS_ECDHE_RSA_WITH_AES_256_CBC_SHA:      "TLS_ECDHE_RSA_WITH_AES_256_CBC_SHA",
+	tls.TLS_RSA_WITH_AES_128_GCM_SHA256:         "TLS_RSA_WITH_AES_128_GCM_SHA256",
+	tls.TLS_RSA_WITH_AES_256_GCM_SHA384:         "TLS_RSA_WITH_AES_256_GCM_SHA384",
+	tls.TLS_RSA_WITH_AES_128_CBC_SHA:            "TLS_RSA_WITH_AES_128_CBC_SHA",
+	tls.TLS_RSA_WITH_AES_256_CBC_SHA:            "TLS_RSA_WITH_AES_256_CBC_SHA",
+	tls.TLS_ECDHE_RSA_WITH_3DES_EDE_CBC_SHA:     "TLS_ECDHE_RSA_WITH_3DES_EDE_CBC_SHA",
+	tls.TLS_RSA_WITH_3DES_EDE_CBC_SHA:           "TLS_RSA_WITH_3DES_EDE_CBC_SHA",
+	tls.TLS_ECDHE_ECDSA_WITH_AES_128_CBC_SHA256: "TLS_ECDHE_ECDSA_WITH_AES_128_CBC_SHA256",
+	tls.TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA256:   "TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA256",
+	tls.TLS_RSA_WITH_AES_128_CBC_SHA256:         "TLS_RSA_WITH_AES_128_CBC_SHA256",
+}
+
+// CipherSuite returns the name of the TLS cipher suite id.
+func CipherSuite(id uint16) string {
+	if name, ok := cipherSuites[id]; ok {
+		return name
+	}
+	return fmt.Sprintf("cipher suite %#04x", id)
+}
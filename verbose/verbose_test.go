@@ -0,0 +1,180 @@
+package verbose
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"io"
+	"math/big"
+	"net"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	testpb "google.golang.org/grpc/test/grpc_testing"
+)
+
+type testServer struct {
+	testpb.UnimplementedTestServiceServer
+}
+
+func (*testServer) UnaryCall(ctx context.Context, req *testpb.SimpleRequest) (*testpb.SimpleResponse, error) {
+	grpc.SetHeader(ctx, metadata.Pairs("server", "test"))
+	grpc.SetTrailer(ctx, metadata.Pairs("set-cookie", "id=42"))
+	return &testpb.SimpleResponse{Payload: req.GetPayload()}, nil
+}
+
+func (*testServer) StreamingOutputCall(req *testpb.StreamingOutputCallRequest, stream testpb.TestService_StreamingOutputCallServer) error {
+	for range req.GetResponseParameters() {
+		if err := stream.Send(&testpb.StreamingOutputCallResponse{}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// syncBuffer is a buffer the connection state goroutine can write to while
+// the test reads it.
+type syncBuffer struct {
+	mu sync.Mutex
+	b  bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.b.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.b.String()
+}
+
+// certificate returns a self-signed certificate for example.com.
+func certificate(t *testing.T) tls.Certificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "example.com", Organization: []string{"Test"}},
+		DNSNames:              []string{"example.com"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}
+}
+
+// serve serves testServer over TLS with cert on a loopback port, and returns
+// its address and a function stopping it.
+func serve(t *testing.T, cert tls.Certificate) (string, func()) {
+	creds := credentials.NewServerTLSFromCert(&cert)
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := grpc.NewServer(grpc.Creds(creds))
+	testpb.RegisterTestServiceServer(s, &testServer{})
+	go s.Serve(lis)
+	return lis.Addr().String(), s.Stop
+}
+
+func TestLogger(t *testing.T) {
+	cert := certificate(t)
+	addr, stop := serve(t, cert)
+	defer stop()
+	ca, err := x509.ParseCertificate(cert.Certificate[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	roots := x509.NewCertPool()
+	roots.AddCert(ca)
+	creds := credentials.NewClientTLSFromCert(roots, "example.com")
+	var b syncBuffer
+	l := New(&b)
+	conn, err := l.Dial(addr, 5*time.Second, append(l.DialOptions(), grpc.WithTransportCredentials(l.Credentials(creds)))...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cli := testpb.NewTestServiceClient(conn)
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer s3cr3t", "user", "ann")
+	if _, err := cli.UnaryCall(ctx, &testpb.SimpleRequest{Payload: &testpb.Payload{Body: []byte("hi")}}); err != nil {
+		t.Fatal(err)
+	}
+	stream, err := cli.StreamingOutputCall(ctx, &testpb.StreamingOutputCallRequest{
+		ResponseParameters: []*testpb.ResponseParameters{{}, {}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for {
+		if _, err := stream.Recv(); err == io.EOF {
+			break
+		} else if err != nil {
+			t.Fatal(err)
+		}
+	}
+	conn.Close()
+
+	got := b.String()
+	if strings.Contains(got, "s3cr3t") {
+		t.Errorf("secret logged:\n%s", got)
+	}
+	for _, want := range []string{
+		`(?m)^\* \[.+\] dialing 127\.0\.0\.1:\d+$`,
+		`(?m)^\* \[.+\] resolved 127\.0\.0\.1 to 127\.0\.0\.1 in .+$`,
+		`(?m)^\* \[.+\] connected to 127\.0\.0\.1:\d+ from 127\.0\.0\.1:\d+ in .+$`,
+		`(?m)^\* \[.+\] TLS handshake with example\.com in .+: TLS 1\.[23], TLS_\w+, peer certificate "CN=example\.com,O=Test"$`,
+		`(?m)^\* \[.+\] connection READY$`,
+		`(?m)^\* \[.+\] calling /grpc\.testing\.TestService/UnaryCall$`,
+		`(?m)^> \[.+\]   authorization: Bearer REDACTED$`,
+		`(?m)^> \[.+\]   user: ann$`,
+		`(?m)^> \[.+\] message of 6 bytes, 11 on the wire, after .+$`,
+		`(?m)^< \[.+\]   server: test$`,
+		`(?m)^< \[.+\]   set-cookie: REDACTED$`,
+		`(?m)^\* \[.+\] /grpc\.testing\.TestService/UnaryCall ended with OK in .+$`,
+		`(?m)^\* \[.+\] opening stream /grpc\.testing\.TestService/StreamingOutputCall$`,
+		`(?m)^< \[.+\] message of 0 bytes, \d+ on the wire, after .+$`,
+		`(?m)^\* \[.+\] /grpc\.testing\.TestService/StreamingOutputCall ended with OK in .+$`,
+	} {
+		if !regexp.MustCompile(want).MatchString(got) {
+			t.Errorf("no line matching %s in:\n%s", want, got)
+		}
+	}
+}
+
+func TestSensitive(t *testing.T) {
+	for k, want := range map[string]bool{
+		"authorization":   true,
+		"x-api-key":       true,
+		"x-session-token": true,
+		"cookie":          true,
+		"user-agent":      false,
+		"x-request-id":    false,
+	} {
+		if got := sensitive(k); got != want {
+			t.Errorf("sensitive(%q) = %v, want %v", k, got, want)
+		}
+	}
+}